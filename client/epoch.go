@@ -0,0 +1,118 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	routeEpochs           = "epochs"
+	routeLatestCommitment = "ec"
+	routeEpoch            = "epoch/"
+
+	// route path modifiers.
+	pathRoots        = "/roots"
+	pathUTXOs        = "/utxos"
+	pathBlocks       = "/blocks"
+	pathTransactions = "/transactions"
+	pathVotersWeight = "/voters-weight"
+)
+
+// GetEpochs gets a page of the committed epochs (ordered from the latest to the oldest epoch). An empty cursor starts
+// at the latest committed epoch and a pageSize of 0 uses the default page size of the node.
+func (api *GoShimmerAPI) GetEpochs(cursor string, pageSize int) (*jsonmodels.EpochsResponse, error) {
+	res := &jsonmodels.EpochsResponse{}
+	if err := api.do(http.MethodGet, routeEpochs+pagingQuery(cursor, pageSize), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetLatestCommitment gets the commitment of the latest committed epoch.
+func (api *GoShimmerAPI) GetLatestCommitment() (*jsonmodels.EpochInfo, error) {
+	res := &jsonmodels.EpochInfo{}
+	if err := api.do(http.MethodGet, routeLatestCommitment, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEpoch gets the commitment of the given epoch.
+func (api *GoShimmerAPI) GetEpoch(epochIndex uint64) (*jsonmodels.EpochInfo, error) {
+	res := &jsonmodels.EpochInfo{}
+	if err := api.do(http.MethodGet, epochRoute(epochIndex), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEpochRoots gets the roots of the commitment of the given epoch.
+func (api *GoShimmerAPI) GetEpochRoots(epochIndex uint64) (*jsonmodels.EpochRootsResponse, error) {
+	res := &jsonmodels.EpochRootsResponse{}
+	if err := api.do(http.MethodGet, epochRoute(epochIndex)+pathRoots, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEpochUTXOs gets a page of the outputs that were spent and created in the given epoch (the spent outputs followed
+// by the created outputs are paged as a single list).
+func (api *GoShimmerAPI) GetEpochUTXOs(epochIndex uint64, cursor string, pageSize int) (*jsonmodels.EpochUTXOsResponse, error) {
+	res := &jsonmodels.EpochUTXOsResponse{}
+	if err := api.do(http.MethodGet, epochRoute(epochIndex)+pathUTXOs+pagingQuery(cursor, pageSize), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEpochBlocks gets a page of the blocks that were accepted in the given epoch.
+func (api *GoShimmerAPI) GetEpochBlocks(epochIndex uint64, cursor string, pageSize int) (*jsonmodels.EpochBlocksResponse, error) {
+	res := &jsonmodels.EpochBlocksResponse{}
+	if err := api.do(http.MethodGet, epochRoute(epochIndex)+pathBlocks+pagingQuery(cursor, pageSize), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEpochTransactions gets a page of the transactions that were accepted in the given epoch.
+func (api *GoShimmerAPI) GetEpochTransactions(epochIndex uint64, cursor string, pageSize int) (*jsonmodels.EpochTransactionsResponse, error) {
+	res := &jsonmodels.EpochTransactionsResponse{}
+	if err := api.do(http.MethodGet, epochRoute(epochIndex)+pathTransactions+pagingQuery(cursor, pageSize), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEpochVotersWeight gets a page of the attestors of the given epoch and their weight.
+func (api *GoShimmerAPI) GetEpochVotersWeight(epochIndex uint64, cursor string, pageSize int) (*jsonmodels.EpochVotersWeightResponse, error) {
+	res := &jsonmodels.EpochVotersWeightResponse{}
+	if err := api.do(http.MethodGet, epochRoute(epochIndex)+pathVotersWeight+pagingQuery(cursor, pageSize), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// epochRoute returns the route of the given epoch.
+func epochRoute(epochIndex uint64) string {
+	return routeEpoch + strconv.FormatUint(epochIndex, 10)
+}
+
+// pagingQuery returns the query string for the given paging parameters.
+func pagingQuery(cursor string, pageSize int) string {
+	query := url.Values{}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if pageSize > 0 {
+		query.Set("pageSize", strconv.Itoa(pageSize))
+	}
+
+	if len(query) == 0 {
+		return ""
+	}
+
+	return "?" + query.Encode()
+}
@@ -6,10 +6,13 @@ import (
 
 	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 )
 
 const (
 	routeFirewallPeerFaultinessCount = "firewall/peer-faultiness-count"
+	routeFirewallPeers               = "firewall/peers"
 )
 
 // GetPeerFaultinessCount return number of time peer has been marked as faulty.
@@ -24,3 +27,21 @@ func (api *GoShimmerAPI) GetPeerFaultinessCount(peerID identity.ID) (int, error)
 	}
 	return count, nil
 }
+
+// GetPeerScores returns the faultiness scores of all peers that have been faulty.
+func (api *GoShimmerAPI) GetPeerScores() (*jsonmodels.PeerScoresResponse, error) {
+	res := &jsonmodels.PeerScoresResponse{}
+	if err := api.do(http.MethodGet, routeFirewallPeers, nil, res); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch peer scores via HTTP API")
+	}
+	return res, nil
+}
+
+// GetPeerScore returns the faultiness score of the given peer.
+func (api *GoShimmerAPI) GetPeerScore(peerID identity.ID) (*jsonmodels.PeerScore, error) {
+	res := &jsonmodels.PeerScore{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%s", routeFirewallPeers, peerID.EncodeBase58()), nil, res); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch peer score via HTTP API")
+	}
+	return res, nil
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 )
@@ -13,15 +15,19 @@ const (
 	routeGetOutputs       = "ledgerstate/outputs/"
 	routeGetTransactions  = "ledgerstate/transactions/"
 	routePostTransactions = "ledgerstate/transactions"
+	routePostRollback     = "admin/ledgerstate/rollback"
 
 	// route path modifiers.
 	pathUnspentOutputs = "/unspentOutputs"
+	pathSpentOutputs   = "/spentOutputs"
 	pathChildren       = "/children"
 	pathConflicts      = "/conflicts"
 	pathConsumers      = "/consumers"
 	pathMetadata       = "/metadata"
 	pathVoters         = "/voters"
 	pathAttachments    = "/attachments"
+	pathProof          = "/proof"
+	pathSimulate       = "/simulate"
 )
 
 // GetAddressOutputs gets the spent and unspent outputs of an address.
@@ -46,6 +52,58 @@ func (api *GoShimmerAPI) GetAddressUnspentOutputs(base58EncodedAddress string) (
 	return res, nil
 }
 
+// GetAddressUnspentOutputsPage gets a page of the unspent outputs of an address. An empty cursor starts at the first
+// output.
+func (api *GoShimmerAPI) GetAddressUnspentOutputsPage(base58EncodedAddress string, cursor string, pageSize int) (*jsonmodels.GetAddressResponse, error) {
+	res := &jsonmodels.GetAddressResponse{}
+	if err := api.do(http.MethodGet, routeGetAddresses+base58EncodedAddress+pathUnspentOutputs+pagingQuery(cursor, pageSize), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAddressSpentOutputs gets a page of the outputs of an address that were spent in the given (inclusive) epoch range
+// (ordered by the epoch in which they were spent). The epochs must not exceed math.MaxInt64. An empty cursor starts at
+// the first output and a pageSize of 0 uses the default page size of the node.
+func (api *GoShimmerAPI) GetAddressSpentOutputs(base58EncodedAddress string, startEpoch, endEpoch uint64, cursor string, pageSize int) (*jsonmodels.GetAddressSpentOutputsResponse, error) {
+	query := url.Values{}
+	query.Set("startEpoch", strconv.FormatUint(startEpoch, 10))
+	query.Set("endEpoch", strconv.FormatUint(endEpoch, 10))
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if pageSize > 0 {
+		query.Set("pageSize", strconv.Itoa(pageSize))
+	}
+
+	res := &jsonmodels.GetAddressSpentOutputsResponse{}
+	if err := api.do(http.MethodGet, routeGetAddresses+base58EncodedAddress+pathSpentOutputs+"?"+query.Encode(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAddressTransactions gets a page of the transactions that created or spent outputs of an address and were included
+// in the given (inclusive) epoch range (ordered by their inclusion epoch). The epochs must not exceed math.MaxInt64 and
+// the node needs to have the transaction history of its indexer enabled.
+func (api *GoShimmerAPI) GetAddressTransactions(base58EncodedAddress string, startEpoch, endEpoch uint64, cursor string, pageSize int) (*jsonmodels.GetAddressTransactionsResponse, error) {
+	query := url.Values{}
+	query.Set("startEpoch", strconv.FormatUint(startEpoch, 10))
+	query.Set("endEpoch", strconv.FormatUint(endEpoch, 10))
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if pageSize > 0 {
+		query.Set("pageSize", strconv.Itoa(pageSize))
+	}
+
+	res := &jsonmodels.GetAddressTransactionsResponse{}
+	if err := api.do(http.MethodGet, routeGetAddresses+base58EncodedAddress+pathTransactions+"?"+query.Encode(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // PostAddressUnspentOutputs gets the unspent outputs of several addresses.
 func (api *GoShimmerAPI) PostAddressUnspentOutputs(base58EncodedAddresses []string) (*jsonmodels.PostAddressesUnspentOutputsResponse, error) {
 	res := &jsonmodels.PostAddressesUnspentOutputsResponse{}
@@ -134,6 +192,18 @@ func (api *GoShimmerAPI) GetOutputMetadata(base58EncodedOutputID string) (*jsonm
 	return res, nil
 }
 
+// GetOutputProof gets the proof for the inclusion of the unspent output corresponding to OutputID in the latest
+// commitment.
+func (api *GoShimmerAPI) GetOutputProof(base58EncodedOutputID string) (*jsonmodels.Proof, error) {
+	res := &jsonmodels.Proof{}
+	if err := api.do(http.MethodGet, func() string {
+		return routeGetOutputs + base58EncodedOutputID + pathProof
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetTransaction gets the transaction of the corresponding to TransactionID.
 func (api *GoShimmerAPI) GetTransaction(base58EncodedTransactionID string) (*jsonmodels.Transaction, error) {
 	res := &jsonmodels.Transaction{}
@@ -167,6 +237,18 @@ func (api *GoShimmerAPI) GetTransactionAttachments(base58EncodedTransactionID st
 	return res, nil
 }
 
+// GetTransactionProof gets the proof for the inclusion of the transaction corresponding to TransactionID in the
+// commitment of its epoch.
+func (api *GoShimmerAPI) GetTransactionProof(base58EncodedTransactionID string) (*jsonmodels.Proof, error) {
+	res := &jsonmodels.Proof{}
+	if err := api.do(http.MethodGet, func() string {
+		return routeGetTransactions + base58EncodedTransactionID + pathProof
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // PostTransaction sends the transaction(bytes) to the Tangle and returns its transaction ID.
 func (api *GoShimmerAPI) PostTransaction(transactionBytes []byte) (*jsonmodels.PostTransactionResponse, error) {
 	res := &jsonmodels.PostTransactionResponse{}
@@ -177,3 +259,27 @@ func (api *GoShimmerAPI) PostTransaction(transactionBytes []byte) (*jsonmodels.P
 
 	return res, nil
 }
+
+// SimulateTransaction runs the transaction(bytes) against the current ledger state of the node without issuing it and
+// returns the outputs that it would create and the reasons why it is invalid.
+func (api *GoShimmerAPI) SimulateTransaction(transactionBytes []byte) (*jsonmodels.PostTransactionSimulateResponse, error) {
+	res := &jsonmodels.PostTransactionSimulateResponse{}
+	if err := api.do(http.MethodPost, routePostTransactions+pathSimulate,
+		&jsonmodels.PostTransactionRequest{TransactionBytes: transactionBytes}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ScheduleLedgerRollback schedules a rollback of the ledger state of the node to the given committed epoch. The
+// rollback is applied the next time the node is started. It requires the admin routes of the node to be enabled and
+// the client to be created with WithAdminToken.
+func (api *GoShimmerAPI) ScheduleLedgerRollback(targetEpoch int64) (*jsonmodels.PostRollbackResponse, error) {
+	res := &jsonmodels.PostRollbackResponse{}
+	if err := api.do(http.MethodPost, routePostRollback, &jsonmodels.PostRollbackRequest{TargetEpoch: targetEpoch}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
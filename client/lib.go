@@ -31,9 +31,12 @@ var (
 )
 
 const (
+	adminTokenHeader = "X-Admin-Token"
+
 	contentType     = "Content-Type"
 	contentTypeJSON = "application/json"
 	contentTypeCSV  = "text/csv"
+	contentTypeBlob = "application/octet-stream"
 )
 
 // Option is a function which sets the given option.
@@ -57,6 +60,13 @@ func WithBasicAuth(username, password string) Option {
 	}
 }
 
+// WithAdminToken sets the token that is used to access the admin routes of the node.
+func WithAdminToken(token string) Option {
+	return func(g *GoShimmerAPI) {
+		g.adminToken = token
+	}
+}
+
 // WithHTTPClient sets the http Client.
 func WithHTTPClient(c http.Client) Option {
 	return func(g *GoShimmerAPI) {
@@ -90,6 +100,7 @@ type GoShimmerAPI struct {
 	baseURL    string
 	httpClient http.Client
 	basicAuth  BasicAuth
+	adminToken string
 }
 
 type errorresponse struct {
@@ -97,6 +108,18 @@ type errorresponse struct {
 }
 
 func interpretBody(res *http.Response, decodeTo interface{}) error {
+	// blobs (e.g. snapshots) can be large, so they are streamed to the writer instead of being buffered in memory
+	if (res.StatusCode == http.StatusOK || res.StatusCode == http.StatusCreated) && strings.HasPrefix(res.Header.Get(contentType), contentTypeBlob) {
+		defer res.Body.Close()
+
+		writer, isWriter := decodeTo.(io.Writer)
+		if !isWriter {
+			return errors.Errorf("can't decode %s content-type into %T", res.Header.Get(contentType), decodeTo)
+		}
+		_, err := io.Copy(writer, res.Body)
+		return errors.Wrap(err, "unable to read response body")
+	}
+
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
 		return errors.Wrap(err, "unable to read response body")
@@ -126,7 +149,7 @@ func interpretBody(res *http.Response, decodeTo interface{}) error {
 		return errors.WithMessage(ErrNotFound, res.Request.URL.String())
 	case http.StatusBadRequest:
 		return errors.WithMessage(ErrBadRequest, errRes.Error)
-	case http.StatusUnauthorized:
+	case http.StatusUnauthorized, http.StatusForbidden:
 		return errors.WithMessage(ErrUnauthorized, errRes.Error)
 	case http.StatusNotImplemented:
 		return errors.WithMessage(ErrNotImplemented, errRes.Error)
@@ -166,6 +189,11 @@ func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, res
 		req.SetBasicAuth(api.basicAuth.Credentials())
 	}
 
+	// if set, add the token for the admin routes
+	if api.adminToken != "" {
+		req.Header.Set(adminTokenHeader, api.adminToken)
+	}
+
 	// make the request
 	res, err := api.httpClient.Do(req)
 	if err != nil {
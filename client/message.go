@@ -10,6 +10,7 @@ import (
 const (
 	routeBlock         = "blocks/"
 	routeBlockMetadata = "/metadata"
+	routeBlockProof    = "/proof"
 	routeSendPayload   = "blocks/payload"
 )
 
@@ -47,6 +48,22 @@ func (api *GoShimmerAPI) GetBlockMetadata(base58EncodedID string) (*retainer.Blo
 	return res, nil
 }
 
+// GetBlockProof is the handler for the /blocks/:blockID/proof endpoint.
+func (api *GoShimmerAPI) GetBlockProof(base58EncodedID string) (*jsonmodels.Proof, error) {
+	res := &jsonmodels.Proof{}
+
+	if err := api.do(
+		http.MethodGet,
+		routeBlock+base58EncodedID+routeBlockProof,
+		nil,
+		res,
+	); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // SendPayload send a block with the given payload.
 func (api *GoShimmerAPI) SendPayload(payload []byte) (string, error) {
 	res := &jsonmodels.PostPayloadResponse{}
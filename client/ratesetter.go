@@ -1,6 +1,7 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -11,10 +12,16 @@ const (
 	rateSetterInfo = "ratesetter"
 )
 
-// RateSetter gets the ratesetter estimate and the rate-setter info.
+// RateSetter gets the ratesetter estimate for a block without payload and the rate-setter info.
 func (api *GoShimmerAPI) RateSetter() (*jsonmodels.RateSetter, error) {
+	return api.RateSetterForPayloadSize(0)
+}
+
+// RateSetterForPayloadSize gets the ratesetter estimate for a block whose payload has the given size in bytes and the
+// rate-setter info.
+func (api *GoShimmerAPI) RateSetterForPayloadSize(payloadSize int) (*jsonmodels.RateSetter, error) {
 	res := &jsonmodels.RateSetter{}
-	if err := api.do(http.MethodGet, rateSetterInfo, nil, res); err != nil {
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s?payloadSize=%d", rateSetterInfo, payloadSize), nil, res); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -22,7 +29,13 @@ func (api *GoShimmerAPI) RateSetter() (*jsonmodels.RateSetter, error) {
 
 // SleepRateSetterEstimate gets the rate-setter estimate and the rate-setter info and later sleeps the estimated amount of time.
 func (api *GoShimmerAPI) SleepRateSetterEstimate() error {
-	res, err := api.RateSetter()
+	return api.SleepRateSetterEstimateForPayloadSize(0)
+}
+
+// SleepRateSetterEstimateForPayloadSize gets the rate-setter estimate for a block whose payload has the given size in
+// bytes and later sleeps the estimated amount of time.
+func (api *GoShimmerAPI) SleepRateSetterEstimateForPayloadSize(payloadSize int) error {
+	res, err := api.RateSetterForPayloadSize(payloadSize)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	routeSnapshots = "snapshots"
+	routeSnapshot  = "snapshots/"
+)
+
+// GetSnapshots gets the snapshots that the node offers for download (ordered from the latest to the oldest epoch).
+func (api *GoShimmerAPI) GetSnapshots() (*jsonmodels.SnapshotsResponse, error) {
+	res := &jsonmodels.SnapshotsResponse{}
+	if err := api.do(http.MethodGet, routeSnapshots, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadSnapshot downloads the snapshot of the given epoch and streams it to the given writer.
+func (api *GoShimmerAPI) DownloadSnapshot(epochIndex uint64, writer io.Writer) error {
+	return api.do(http.MethodGet, routeSnapshot+strconv.FormatUint(epochIndex, 10), nil, writer)
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	routeStorageUsage      = "admin/storage/usage"
+	routeStorageCompaction = "admin/storage/compaction"
+)
+
+// GetStorageUsage gets the disk usage of the storage of the node broken down by component and by prunable DB instance
+// (as of its most recent periodic computation). It requires the admin routes of the node to be enabled and the client to
+// be created with WithAdminToken.
+func (api *GoShimmerAPI) GetStorageUsage() (*jsonmodels.StorageUsageResponse, error) {
+	res := &jsonmodels.StorageUsageResponse{}
+	if err := api.do(http.MethodGet, routeStorageUsage, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CompactStorage runs the garbage collection of the databases of the node and returns their size before and after it.
+// It requires the admin routes of the node to be enabled and the client to be created with WithAdminToken.
+func (api *GoShimmerAPI) CompactStorage() (*jsonmodels.StorageCompactionResponse, error) {
+	res := &jsonmodels.StorageCompactionResponse{}
+	if err := api.do(http.MethodPost, routeStorageCompaction, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	routeSubscriptions = "subscriptions"
+)
+
+// Subscribe opens a WebSocket connection to the node and subscribes to the given topics (see the topic helpers in
+// jsonmodels, i.e. AddressTopic, TransactionTopic, BlockTopic and TopicCommitments).
+func (api *GoShimmerAPI) Subscribe(topics ...string) (*Subscription, error) {
+	subscriptionURL, err := url.Parse(api.baseURL + "/" + routeSubscriptions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse base URL %s", api.baseURL)
+	}
+
+	switch subscriptionURL.Scheme {
+	case "https":
+		subscriptionURL.Scheme = "wss"
+	default:
+		subscriptionURL.Scheme = "ws"
+	}
+
+	if len(topics) > 0 {
+		subscriptionURL.RawQuery = url.Values{"topics": []string{strings.Join(topics, ",")}}.Encode()
+	}
+
+	header := http.Header{}
+	if api.basicAuth.IsEnabled() {
+		username, password := api.basicAuth.Credentials()
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	}
+
+	conn, res, err := websocket.DefaultDialer.Dial(subscriptionURL.String(), header)
+	if err != nil {
+		if res != nil && res.StatusCode >= http.StatusBadRequest {
+			if interpretErr := interpretBody(res, nil); interpretErr != nil {
+				return nil, interpretErr
+			}
+		}
+
+		return nil, errors.Wrapf(err, "failed to connect to %s", subscriptionURL.String())
+	}
+
+	subscription := &Subscription{
+		conn:   conn,
+		events: make(chan *jsonmodels.SubscriptionEvent),
+		closed: make(chan struct{}),
+	}
+	go subscription.readEvents()
+
+	return subscription, nil
+}
+
+// Subscription is an open WebSocket connection that receives the events of the subscribed topics.
+type Subscription struct {
+	conn       *websocket.Conn
+	events     chan *jsonmodels.SubscriptionEvent
+	closed     chan struct{}
+	closeOnce  sync.Once
+	err        error
+	writeMutex sync.Mutex
+	errMutex   sync.Mutex
+}
+
+// Events returns the channel of the received events (it is closed when the connection is closed). Events of type
+// jsonmodels.SubscriptionEventError inform about failed requests or a subscription that was closed by the node.
+func (s *Subscription) Events() <-chan *jsonmodels.SubscriptionEvent {
+	return s.events
+}
+
+// Subscribe adds the given topics to the Subscription.
+func (s *Subscription) Subscribe(topics ...string) error {
+	return s.sendRequest(jsonmodels.SubscriptionActionSubscribe, topics)
+}
+
+// Unsubscribe removes the given topics from the Subscription.
+func (s *Subscription) Unsubscribe(topics ...string) error {
+	return s.sendRequest(jsonmodels.SubscriptionActionUnsubscribe, topics)
+}
+
+// Err returns the reason why the connection was closed (nil if it was closed by calling Close).
+func (s *Subscription) Err() error {
+	s.errMutex.Lock()
+	defer s.errMutex.Unlock()
+
+	return s.err
+}
+
+// Close closes the connection to the node.
+func (s *Subscription) Close() error {
+	s.closeOnce.Do(func() { close(s.closed) })
+
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	_ = s.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+
+	return s.conn.Close()
+}
+
+func (s *Subscription) sendRequest(action string, topics []string) error {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	return s.conn.WriteJSON(&jsonmodels.SubscriptionRequest{
+		Action: action,
+		Topics: topics,
+	})
+}
+
+// readEvents forwards the received events to the event channel until the connection is closed.
+func (s *Subscription) readEvents() {
+	defer close(s.events)
+
+	for {
+		event := new(jsonmodels.SubscriptionEvent)
+		if err := s.conn.ReadJSON(event); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) && !errors.Is(err, net.ErrClosed) {
+				s.errMutex.Lock()
+				s.err = err
+				s.errMutex.Unlock()
+			}
+
+			return
+		}
+
+		select {
+		case s.events <- event:
+		case <-s.closed:
+			return
+		}
+	}
+}
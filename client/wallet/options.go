@@ -5,6 +5,7 @@ import (
 
 	"github.com/capossele/asset-registry/pkg/registryservice"
 	"github.com/iotaledger/hive.go/core/bitmask"
+	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
@@ -28,6 +29,20 @@ func Import(seed *seed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.B
 	}
 }
 
+// ImportFromSeedProvider restores a wallet that has previously been created with the seed that is returned by the given
+// provider instead of the raw seed. If the provider fails, the error is returned by TryNew.
+func ImportFromSeedProvider(provider seed.Provider, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *AssetRegistry) Option {
+	return func(wallet *Wallet) {
+		walletSeed, err := provider.Seed()
+		if err != nil {
+			wallet.optionsErr = errors.Wrap(err, "failed to retrieve the seed of the wallet")
+			return
+		}
+
+		Import(walletSeed, lastAddressIndex, spentAddresses, assetRegistry)(wallet)
+	}
+}
+
 // ReusableAddress configures the wallet to run in "single address" mode where all the funds are always managed on a
 // single reusable address.
 func ReusableAddress(enabled bool) Option {
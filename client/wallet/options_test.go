@@ -0,0 +1,20 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/bitmask"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+)
+
+func TestImportFromSeedProvider_Error(t *testing.T) {
+	providerErr := errors.New("secret store is not available")
+
+	_, err := TryNew(ImportFromSeedProvider(seed.ProviderFunc(func() (*seed.Seed, error) {
+		return nil, providerErr
+	}), 0, []bitmask.BitMask{}, nil))
+	require.ErrorIs(t, err, providerErr)
+}
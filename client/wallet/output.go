@@ -94,7 +94,7 @@ func (o OutputsByAddressAndOutputID) ConditionalOutputsOnly() OutputsByAddressAn
 			if output.Object.Type() == devnetvm.ExtendedLockedOutputType {
 				casted := output.Object.(*devnetvm.ExtendedLockedOutput)
 				_, fallbackDeadline := casted.FallbackOptions()
-				if !fallbackDeadline.IsZero() && addy.Address().Equals(casted.UnlockAddressNow(now)) {
+				if !fallbackDeadline.IsZero() && !casted.HashLockedNow(now) && addy.Address().Equals(casted.UnlockAddressNow(now)) {
 					if _, addressExists := result[addy]; !addressExists {
 						result[addy] = make(map[utxo.OutputID]*Output)
 					}
@@ -122,6 +122,22 @@ func (o OutputsByAddressAndOutputID) AliasOutputsOnly() OutputsByAddressAndOutpu
 	return result
 }
 
+// ConfirmedOutputsOnly filters out any outputs that have not reached the confirmation state yet.
+func (o OutputsByAddressAndOutputID) ConfirmedOutputsOnly() OutputsByAddressAndOutputID {
+	result := NewAddressToOutputs()
+	for addy, IDToOutputMap := range o {
+		for outputID, output := range IDToOutputMap {
+			if output.ConfirmationStateReached {
+				if _, addressExists := result[addy]; !addressExists {
+					result[addy] = make(map[utxo.OutputID]*Output)
+				}
+				result[addy][outputID] = output
+			}
+		}
+	}
+	return result
+}
+
 // TotalFundsInOutputs returns the total funds present in the outputs.
 func (o OutputsByAddressAndOutputID) TotalFundsInOutputs() map[devnetvm.Color]uint64 {
 	result := make(map[devnetvm.Color]uint64)
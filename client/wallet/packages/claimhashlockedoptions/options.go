@@ -0,0 +1,97 @@
+package claimhashlockedoptions
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+// ClaimHashLockedFundsOption is a function that provides an option.
+type ClaimHashLockedFundsOption func(options *ClaimHashLockedFundsOptions) error
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) ClaimHashLockedFundsOption {
+	return func(options *ClaimHashLockedFundsOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for ClaimHashLockedFunds call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) ClaimHashLockedFundsOption {
+	return func(options *ClaimHashLockedFundsOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for ClaimHashLockedFunds call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) ClaimHashLockedFundsOption {
+	return func(options *ClaimHashLockedFundsOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// OutputID specifies which hash locked output to claim.
+func OutputID(outputID string) ClaimHashLockedFundsOption {
+	return func(options *ClaimHashLockedFundsOptions) error {
+		var parsed utxo.OutputID
+		if err := parsed.FromBase58(outputID); err != nil {
+			return errors.Wrap(err, "failed to parse output id")
+		}
+		options.OutputID = parsed
+		return nil
+	}
+}
+
+// Preimage specifies the hex encoded secret whose SHA-256 hash matches the hash lock of the output.
+func Preimage(preimage string) ClaimHashLockedFundsOption {
+	return func(options *ClaimHashLockedFundsOptions) error {
+		preimageBytes, err := hex.DecodeString(preimage)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode preimage")
+		}
+		if len(preimageBytes) != devnetvm.PreimageSize {
+			return errors.Errorf("preimage must be %d bytes long, got %d", devnetvm.PreimageSize, len(preimageBytes))
+		}
+		copy(options.Preimage[:], preimageBytes)
+		options.PreimageProvided = true
+		return nil
+	}
+}
+
+// ClaimHashLockedFundsOptions is a struct that is used to aggregate the optional parameters in the ClaimHashLockedFunds call.
+type ClaimHashLockedFundsOptions struct {
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	OutputID              utxo.OutputID
+	Preimage              [devnetvm.PreimageSize]byte
+	PreimageProvided      bool
+	WaitForConfirmation   bool
+}
+
+// Build builds the options.
+func Build(options ...ClaimHashLockedFundsOption) (result *ClaimHashLockedFundsOptions, err error) {
+	// create options to collect the arguments provided
+	result = &ClaimHashLockedFundsOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	if result.OutputID == utxo.EmptyOutputID {
+		return nil, errors.New("an output id must be specified for claiming hash locked funds")
+	}
+	if !result.PreimageProvided {
+		return nil, errors.New("a preimage must be specified for claiming hash locked funds")
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package refundhashlockedoptions
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+)
+
+// RefundHashLockedFundsOption is a function that provides an option.
+type RefundHashLockedFundsOption func(options *RefundHashLockedFundsOptions) error
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) RefundHashLockedFundsOption {
+	return func(options *RefundHashLockedFundsOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for RefundHashLockedFunds call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) RefundHashLockedFundsOption {
+	return func(options *RefundHashLockedFundsOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for RefundHashLockedFunds call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) RefundHashLockedFundsOption {
+	return func(options *RefundHashLockedFundsOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// OutputID specifies which expired hash locked output to refund.
+func OutputID(outputID string) RefundHashLockedFundsOption {
+	return func(options *RefundHashLockedFundsOptions) error {
+		var parsed utxo.OutputID
+		if err := parsed.FromBase58(outputID); err != nil {
+			return errors.Wrap(err, "failed to parse output id")
+		}
+		options.OutputID = parsed
+		return nil
+	}
+}
+
+// RefundHashLockedFundsOptions is a struct that is used to aggregate the optional parameters in the RefundHashLockedFunds call.
+type RefundHashLockedFundsOptions struct {
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	OutputID              utxo.OutputID
+	WaitForConfirmation   bool
+}
+
+// Build builds the options.
+func Build(options ...RefundHashLockedFundsOption) (result *RefundHashLockedFundsOptions, err error) {
+	// create options to collect the arguments provided
+	result = &RefundHashLockedFundsOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	if result.OutputID == utxo.EmptyOutputID {
+		return nil, errors.New("an output id must be specified for refunding hash locked funds")
+	}
+
+	return
+}
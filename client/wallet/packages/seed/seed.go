@@ -30,3 +30,18 @@ func (seed *Seed) Address(index uint64) (addr address.Address) {
 
 	return
 }
+
+// Provider provides the seed of a wallet (i.e. by decrypting it or by loading it from an external secret store), so
+// that the raw seed bytes do not have to be handed to the wallet.
+type Provider interface {
+	// Seed returns the seed of the wallet.
+	Seed() (*Seed, error)
+}
+
+// ProviderFunc is a function that implements the Provider interface.
+type ProviderFunc func() (*Seed, error)
+
+// Seed returns the seed of the wallet.
+func (p ProviderFunc) Seed() (*Seed, error) {
+	return p()
+}
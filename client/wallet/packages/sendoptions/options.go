@@ -152,6 +152,19 @@ func Fallback(addy devnetvm.Address, deadline time.Time) SendFundsOption {
 	}
 }
 
+// HashLock defines the SHA-256 hash of a secret preimage that the recipient has to reveal to unlock the output. It is
+// only valid in combination with the Fallback option, that defines until when the recipient can claim the funds and who
+// can refund them afterwards.
+func HashLock(hashLock [devnetvm.HashLockSize]byte) SendFundsOption {
+	return func(options *SendFundsOptions) error {
+		if hashLock == [devnetvm.HashLockSize]byte{} {
+			return errors.New("empty hash lock provided")
+		}
+		options.HashLock = hashLock
+		return nil
+	}
+}
+
 // SendFundsOptions is a struct that is used to aggregate the optional parameters provided in the SendFunds call.
 type SendFundsOptions struct {
 	Destinations          map[address.Address]map[devnetvm.Color]uint64
@@ -159,6 +172,7 @@ type SendFundsOptions struct {
 	LockUntil             time.Time
 	FallbackAddress       devnetvm.Address
 	FallbackDeadline      time.Time
+	HashLock              [devnetvm.HashLockSize]byte
 	AccessManaPledgeID    string
 	ConsensusManaPledgeID string
 	WaitForConfirmation   bool
@@ -202,6 +216,20 @@ func Build(options ...SendFundsOption) (result *SendFundsOptions, err error) {
 
 		return
 	}
+	if result.HashLock != [devnetvm.HashLockSize]byte{} && (result.FallbackAddress == nil || result.FallbackDeadline.IsZero()) {
+		err = errors.New("a hash locked transfer needs a Fallback to refund the funds if the preimage is not revealed in time")
+
+		return
+	}
+	if result.HashLock != [devnetvm.HashLockSize]byte{} {
+		for destination := range result.Destinations {
+			if !devnetvm.HashLockableAddress(destination.Address()) {
+				err = errors.Errorf("a hash locked transfer can not be sent to the %s address %s", destination.Address().Type(), destination.Base58())
+
+				return
+			}
+		}
+	}
 
 	return
 }
@@ -0,0 +1,193 @@
+package walletfile
+
+import (
+	"bytes"
+	"crypto/rand"
+
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/marshalutil"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// Version is the version of the format of the encrypted wallet files that is written by Encrypt.
+	Version byte = 1
+
+	saltSize = 16
+
+	// maxTime is the maximum number of passes of the key derivation.
+	maxTime = 16
+
+	// minMemoryPerThread is the minimum memory (in KiB) of the key derivation per thread that is required by argon2.
+	minMemoryPerThread = 8
+
+	// maxMemory is the maximum memory (in KiB) of the key derivation.
+	maxMemory = 4 * 1024 * 1024
+
+	// maxThreads is the maximum number of threads of the key derivation.
+	maxThreads = 64
+)
+
+var (
+	// magic is the prefix that identifies an encrypted wallet file (plaintext wallet files start with the raw seed).
+	magic = []byte("GSWALLET")
+
+	// ErrInvalidPassphrase is returned when a wallet file can not be decrypted with the given passphrase.
+	ErrInvalidPassphrase = errors.New("invalid passphrase or corrupted wallet file")
+
+	// ErrUnsupportedVersion is returned when the wallet file was written with an unknown version of the format.
+	ErrUnsupportedVersion = errors.New("unsupported wallet file version")
+
+	// ErrInvalidKDFParams is returned when the parameters of the key derivation are out of bounds.
+	ErrInvalidKDFParams = errors.New("invalid key derivation parameters")
+)
+
+// IsEncrypted returns true if the given bytes are the content of an encrypted wallet file.
+func IsEncrypted(fileBytes []byte) bool {
+	return bytes.HasPrefix(fileBytes, magic)
+}
+
+// Encrypt encrypts the exported state of a wallet with a key that is derived from the given passphrase (using argon2id)
+// and returns the content of the encrypted wallet file.
+//
+// The file consists of a header (magic, version, key derivation parameters, salt and nonce) that is authenticated
+// together with the encrypted state (using XChaCha20-Poly1305).
+func Encrypt(state, passphrase []byte, opts ...options.Option[KDFParams]) (fileBytes []byte, err error) {
+	params := options.Apply(&KDFParams{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}, opts)
+	if err = params.validate(); err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err = rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+
+	nonce := make([]byte, chacha20poly1305.NonceSizeX)
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+
+	marshalUtil := marshalutil.New()
+	marshalUtil.WriteBytes(magic)
+	marshalUtil.WriteByte(Version)
+	marshalUtil.WriteUint32(params.Time)
+	marshalUtil.WriteUint32(params.Memory)
+	marshalUtil.WriteUint8(params.Threads)
+	marshalUtil.WriteBytes(salt)
+	marshalUtil.WriteBytes(nonce)
+	header := marshalUtil.Bytes()
+
+	aead, err := chacha20poly1305.NewX(params.deriveKey(passphrase, salt))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+
+	return aead.Seal(header, nonce, state, header), nil
+}
+
+// Decrypt decrypts the content of an encrypted wallet file with the given passphrase and returns the exported state of
+// the wallet.
+func Decrypt(fileBytes, passphrase []byte) (state []byte, err error) {
+	if !IsEncrypted(fileBytes) {
+		return nil, errors.New("wallet file is not encrypted")
+	}
+
+	marshalUtil := marshalutil.New(fileBytes)
+	marshalUtil.ReadSeek(len(magic))
+
+	version, err := marshalUtil.ReadByte()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse version")
+	}
+	if version != Version {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "version %d", version)
+	}
+
+	params := new(KDFParams)
+	if params.Time, err = marshalUtil.ReadUint32(); err != nil {
+		return nil, errors.Wrap(err, "failed to parse key derivation time")
+	}
+	if params.Memory, err = marshalUtil.ReadUint32(); err != nil {
+		return nil, errors.Wrap(err, "failed to parse key derivation memory")
+	}
+	if params.Threads, err = marshalUtil.ReadUint8(); err != nil {
+		return nil, errors.Wrap(err, "failed to parse key derivation threads")
+	}
+	if err = params.validate(); err != nil {
+		return nil, err
+	}
+
+	salt, err := marshalUtil.ReadBytes(saltSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse salt")
+	}
+
+	nonce, err := marshalUtil.ReadBytes(chacha20poly1305.NonceSizeX)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse nonce")
+	}
+	header := fileBytes[:marshalUtil.ReadOffset()]
+
+	aead, err := chacha20poly1305.NewX(params.deriveKey(passphrase, salt))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+
+	if state, err = aead.Open(nil, nonce, marshalUtil.ReadRemainingBytes(), header); err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	return state, nil
+}
+
+// region KDFParams ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// KDFParams contains the parameters of the argon2id key derivation of an encrypted wallet file.
+type KDFParams struct {
+	// Time is the number of passes over the memory.
+	Time uint32
+
+	// Memory is the size of the memory in KiB.
+	Memory uint32
+
+	// Threads is the number of threads that are used.
+	Threads uint8
+}
+
+// validate returns an error if the parameters are out of the bounds that are accepted for a wallet file (which limits
+// the resources that decrypting a crafted wallet file can consume).
+func (k *KDFParams) validate() (err error) {
+	switch {
+	case k.Time == 0 || k.Time > maxTime:
+		return errors.Wrapf(ErrInvalidKDFParams, "time %d is not between 1 and %d", k.Time, maxTime)
+	case k.Threads == 0 || k.Threads > maxThreads:
+		return errors.Wrapf(ErrInvalidKDFParams, "threads %d is not between 1 and %d", k.Threads, maxThreads)
+	case k.Memory < minMemoryPerThread*uint32(k.Threads) || k.Memory > maxMemory:
+		return errors.Wrapf(ErrInvalidKDFParams, "memory %d KiB is not between %d KiB and %d KiB", k.Memory, minMemoryPerThread*uint32(k.Threads), maxMemory)
+	default:
+		return nil
+	}
+}
+
+// deriveKey derives the encryption key of a wallet file from the given passphrase and salt.
+func (k *KDFParams) deriveKey(passphrase, salt []byte) []byte {
+	return argon2.IDKey(passphrase, salt, k.Time, k.Memory, k.Threads, chacha20poly1305.KeySize)
+}
+
+// WithKDFParams overrides the default parameters of the key derivation (i.e. to speed it up in tests).
+func WithKDFParams(time, memory uint32, threads uint8) options.Option[KDFParams] {
+	return func(params *KDFParams) {
+		params.Time = time
+		params.Memory = memory
+		params.Threads = threads
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,58 @@
+package walletfile
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	state := []byte("seed, last address index and spent addresses")
+
+	fileBytes, err := Encrypt(state, []byte("passphrase"), WithKDFParams(1, 64, 1))
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(fileBytes))
+	assert.NotContains(t, string(fileBytes), string(state))
+	assert.False(t, IsEncrypted(state))
+
+	decrypted, err := Decrypt(fileBytes, []byte("passphrase"))
+	require.NoError(t, err)
+	assert.Equal(t, state, decrypted)
+
+	_, err = Decrypt(fileBytes, []byte("wrong passphrase"))
+	assert.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	// Modifications of the file are detected.
+	tamperedFileBytes := append([]byte{}, fileBytes...)
+	tamperedFileBytes[len(magic)+1+4+4+1] ^= 1
+	_, err = Decrypt(tamperedFileBytes, []byte("passphrase"))
+	assert.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	unknownVersionFileBytes := append([]byte{}, fileBytes...)
+	unknownVersionFileBytes[len(magic)] = Version + 1
+	_, err = Decrypt(unknownVersionFileBytes, []byte("passphrase"))
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	_, err = Decrypt(fileBytes[:len(magic)+5], []byte("passphrase"))
+	assert.Error(t, err)
+
+	// The key derivation parameters are bounded.
+	for _, params := range [][3]uint32{{0, 64, 1}, {maxTime + 1, 64, 1}, {1, 7, 1}, {1, 64, 9}, {1, maxMemory + 1, 1}, {1, 1024, 0}, {1, 1024, maxThreads + 1}} {
+		_, err = Encrypt(state, []byte("passphrase"), WithKDFParams(params[0], params[1], uint8(params[2])))
+		assert.ErrorIs(t, err, ErrInvalidKDFParams, "%v", params)
+
+		invalidParamsFileBytes := append([]byte{}, fileBytes...)
+		binary.LittleEndian.PutUint32(invalidParamsFileBytes[len(magic)+1:], params[0])
+		binary.LittleEndian.PutUint32(invalidParamsFileBytes[len(magic)+1+4:], params[1])
+		invalidParamsFileBytes[len(magic)+1+4+4] = uint8(params[2])
+		_, err = Decrypt(invalidParamsFileBytes, []byte("passphrase"))
+		assert.ErrorIs(t, err, ErrInvalidKDFParams, "%v", params)
+	}
+
+	// Every encryption uses a new salt and nonce.
+	otherFileBytes, err := Encrypt(state, []byte("passphrase"), WithKDFParams(1, 64, 1))
+	require.NoError(t, err)
+	assert.NotEqual(t, fileBytes, otherFileBytes)
+}
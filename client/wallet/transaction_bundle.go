@@ -0,0 +1,552 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+const (
+	// TransactionBundleVersion is the version of the format of the transaction bundles that is written by Bytes.
+	TransactionBundleVersion = 2
+
+	// thresholdSignersBundleVersion is the first version of the format that contains the signer sets of threshold
+	// addresses (older versions can still be read as they only lack the signer sets).
+	thresholdSignersBundleVersion = 2
+
+	// addressLookahead is the number of addresses after the last address index of a wallet that are searched for the
+	// addresses of the inputs of a transaction bundle.
+	addressLookahead = 20
+)
+
+// region PrepareSendFunds /////////////////////////////////////////////////////////////////////////////////////////////
+
+// PrepareSendFunds prepares an unsigned transaction that sends funds from the given source addresses without having
+// access to the seed of the wallet (i.e. on an online machine while the transaction is signed on an air-gapped one).
+// If no remainder address is given in the options, the remainder is sent back to the first source address.
+//
+// Sending funds is the only operation that can be prepared offline. All other operations of the wallet (i.e. the ones
+// that create or modify aliases, NFTs or conditional outputs) still need the seed when they build their transaction.
+func PrepareSendFunds(connector Connector, sourceAddresses []address.Address, options ...sendoptions.SendFundsOption) (bundle *TransactionBundle, err error) {
+	if len(sourceAddresses) == 0 {
+		return nil, errors.New("no source addresses provided")
+	}
+
+	sendOptions, err := sendoptions.Build(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	unspentOutputs, err := connector.UnspentOutputs(sourceAddresses...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve the unspent outputs of the source addresses")
+	}
+
+	if !sendOptions.UsePendingOutputs {
+		unspentOutputs = unspentOutputs.ConfirmedOutputsOnly()
+	}
+
+	consumedOutputs, err := selectOutputsForFunding(unspentOutputs.ValueOutputsOnly(), sendOptions.RequiredFunds(), sourceAddresses)
+	if err != nil {
+		if errors.Is(err, ErrTooManyOutputs) {
+			err = errors.Wrap(err, "consolidate funds and try again")
+		}
+		return nil, err
+	}
+
+	aPledgeID, cPledgeID, err := derivePledgeIDs(sendOptions.AccessManaPledgeID, sendOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return nil, err
+	}
+
+	remainderAddress := sendOptions.RemainderAddress
+	if remainderAddress == address.AddressEmpty {
+		remainderAddress = sourceAddresses[0]
+	}
+
+	outputs := buildOutputs(sendOptions, consumedOutputs.TotalFundsInOutputs(), remainderAddress)
+	txEssence := devnetvm.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, buildInputs(consumedOutputs), outputs)
+
+	return NewTransactionBundle(txEssence, consumedOutputs.OutputsByID())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionBundle ////////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionBundle is an unsigned transaction together with the outputs that it consumes. It contains everything
+// that is needed to sign the transaction on a machine that is not connected to the network.
+type TransactionBundle struct {
+	// Essence is the essence of the transaction that is signed.
+	Essence *devnetvm.TransactionEssence
+
+	// ConsumedOutputs are the outputs that are referenced by the inputs of the transaction (in the same order).
+	ConsumedOutputs devnetvm.Outputs
+
+	// ThresholdSigners are the signer sets of the threshold addresses that lock consumed outputs together with the
+	// partial signatures that were collected from the co-signers so far.
+	ThresholdSigners []*ThresholdSigners
+}
+
+// NewTransactionBundle creates a new TransactionBundle from the given essence and the outputs that it consumes.
+func NewTransactionBundle(essence *devnetvm.TransactionEssence, consumedOutputs OutputsByID) (bundle *TransactionBundle, err error) {
+	bundle = &TransactionBundle{
+		Essence:         essence,
+		ConsumedOutputs: make(devnetvm.Outputs, 0, len(essence.Inputs())),
+	}
+
+	for _, input := range essence.Inputs() {
+		consumedOutput, exists := consumedOutputs[input.(*devnetvm.UTXOInput).ReferencedOutputID()]
+		if !exists {
+			return nil, errors.Errorf("consumed output of input %s is missing", input)
+		}
+
+		bundle.ConsumedOutputs = append(bundle.ConsumedOutputs, consumedOutput.Object)
+	}
+
+	return bundle, nil
+}
+
+// TransactionBundleFromBytes parses a TransactionBundle from the content of a bundle file.
+func TransactionBundleFromBytes(bundleBytes []byte) (bundle *TransactionBundle, err error) {
+	bundleModel := new(transactionBundleModel)
+	if err = json.Unmarshal(bundleBytes, bundleModel); err != nil {
+		return nil, errors.Wrap(err, "failed to parse transaction bundle")
+	}
+
+	if bundleModel.Version < 1 || bundleModel.Version > TransactionBundleVersion {
+		return nil, errors.Errorf("unsupported transaction bundle version %d", bundleModel.Version)
+	} else if bundleModel.Version < thresholdSignersBundleVersion && len(bundleModel.ThresholdSigners) != 0 {
+		return nil, errors.Errorf("transaction bundle version %d does not support threshold signers", bundleModel.Version)
+	}
+
+	essenceBytes, err := base58.Decode(bundleModel.Essence)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction essence")
+	}
+
+	bundle = new(TransactionBundle)
+	if bundle.Essence, _, err = devnetvm.TransactionEssenceFromBytes(essenceBytes); err != nil {
+		return nil, err
+	}
+
+	if len(bundleModel.ConsumedOutputs) != len(bundle.Essence.Inputs()) {
+		return nil, errors.Errorf("transaction bundle contains %d consumed outputs for %d inputs", len(bundleModel.ConsumedOutputs), len(bundle.Essence.Inputs()))
+	}
+
+	for i, consumedOutputModel := range bundleModel.ConsumedOutputs {
+		var outputID utxo.OutputID
+		if err = outputID.FromBase58(consumedOutputModel.OutputID); err != nil {
+			return nil, errors.Wrap(err, "failed to parse output ID")
+		}
+
+		if outputID != bundle.Essence.Inputs()[i].(*devnetvm.UTXOInput).ReferencedOutputID() {
+			return nil, errors.Errorf("consumed output %s does not match input %d", outputID, i)
+		}
+
+		outputBytes, decodeErr := base58.Decode(consumedOutputModel.Output)
+		if decodeErr != nil {
+			return nil, errors.Wrapf(decodeErr, "failed to decode output %s", outputID)
+		}
+
+		output, parseErr := devnetvm.OutputFromBytes(outputBytes)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		output.SetID(outputID)
+
+		bundle.ConsumedOutputs = append(bundle.ConsumedOutputs, output.(devnetvm.Output))
+	}
+
+	for _, thresholdSignersModel := range bundleModel.ThresholdSigners {
+		if thresholdSignersModel.ThresholdAddress == nil {
+			return nil, errors.New("threshold signers are missing their signer set")
+		}
+
+		publicKeys, parseErr := thresholdPublicKeysFromModel(thresholdSignersModel.ThresholdAddress)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		thresholdSigners, addErr := bundle.AddThresholdSigners(thresholdSignersModel.Threshold, publicKeys)
+		if addErr != nil {
+			return nil, addErr
+		}
+
+		if thresholdSignersModel.Base58 != thresholdSigners.Address.Base58() {
+			return nil, errors.Errorf("threshold address %s does not match its signer set", thresholdSignersModel.Base58)
+		}
+
+		for _, signatureModel := range thresholdSignersModel.Signatures {
+			signature, parseSignatureErr := ed25519SignatureFromModel(signatureModel)
+			if parseSignatureErr != nil {
+				return nil, parseSignatureErr
+			}
+
+			if _, err = thresholdSigners.AddSignature(signature, bundle.Essence); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return bundle, nil
+}
+
+// AddThresholdSigners registers the signer set of a threshold address that locks at least one of the consumed outputs,
+// so that the co-signers can add their partial signatures to the TransactionBundle.
+func (t *TransactionBundle) AddThresholdSigners(threshold uint8, publicKeys []ed25519.PublicKey) (thresholdSigners *ThresholdSigners, err error) {
+	thresholdAddress, err := devnetvm.NewThresholdAddress(threshold, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.thresholdSigners(thresholdAddress) != nil {
+		return nil, errors.Errorf("signer set of threshold address %s was already added", thresholdAddress.Base58())
+	}
+
+	if len(lo.Filter(t.ConsumedOutputs, func(consumedOutput devnetvm.Output) bool {
+		return t.unlockAddress(consumedOutput).Equals(thresholdAddress)
+	})) == 0 {
+		return nil, errors.Errorf("threshold address %s does not lock any of the consumed outputs", thresholdAddress.Base58())
+	}
+
+	thresholdSigners = &ThresholdSigners{
+		Address:    thresholdAddress,
+		Threshold:  threshold,
+		PublicKeys: publicKeys,
+	}
+	t.ThresholdSigners = append(t.ThresholdSigners, thresholdSigners)
+
+	return thresholdSigners, nil
+}
+
+// CoSign adds the partial signatures of all public keys of the threshold signer sets that can be derived from the
+// given seed (by an index that is at most addressLookahead larger than the given last address index) and returns the
+// number of added signatures.
+func (t *TransactionBundle) CoSign(walletSeed *seed.Seed, lastAddressIndex uint64) (addedSignatures int, err error) {
+	essenceBytes, err := t.Essence.Bytes()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to serialize transaction essence")
+	}
+
+	for i := uint64(0); i <= lastAddressIndex+addressLookahead; i++ {
+		keyPair := walletSeed.KeyPair(i)
+
+		for _, thresholdSigners := range t.ThresholdSigners {
+			if !thresholdSigners.IsSigner(keyPair.PublicKey) || thresholdSigners.HasSigned(keyPair.PublicKey) {
+				continue
+			}
+
+			if _, err = thresholdSigners.AddSignature(devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essenceBytes)), t.Essence); err != nil {
+				return addedSignatures, err
+			}
+			addedSignatures++
+		}
+	}
+
+	return addedSignatures, nil
+}
+
+// Merge adds the partial signatures of the given TransactionBundle (i.e. the bundle that was co-signed by another
+// co-signer) to the TransactionBundle and returns the number of added signatures.
+func (t *TransactionBundle) Merge(other *TransactionBundle) (addedSignatures int, err error) {
+	essenceBytes, err := t.Essence.Bytes()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to serialize transaction essence")
+	}
+	otherEssenceBytes, err := other.Essence.Bytes()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to serialize transaction essence")
+	}
+	if !bytes.Equal(essenceBytes, otherEssenceBytes) {
+		return 0, errors.New("transaction bundles contain different transactions")
+	}
+
+	for _, otherThresholdSigners := range other.ThresholdSigners {
+		thresholdSigners := t.thresholdSigners(otherThresholdSigners.Address)
+		if thresholdSigners == nil {
+			if thresholdSigners, err = t.AddThresholdSigners(otherThresholdSigners.Threshold, otherThresholdSigners.PublicKeys); err != nil {
+				return addedSignatures, err
+			}
+		}
+
+		for _, signature := range otherThresholdSigners.Signatures {
+			added, addErr := thresholdSigners.AddSignature(signature, t.Essence)
+			if addErr != nil {
+				return addedSignatures, addErr
+			}
+			if added {
+				addedSignatures++
+			}
+		}
+	}
+
+	return addedSignatures, nil
+}
+
+// Sign signs the transaction with the keys of the given seed and returns the signed transaction. The addresses of the
+// consumed outputs need to be derivable from the seed by an index that is at most addressLookahead larger than the
+// given last address index of the wallet. Outputs that are locked by a threshold address are unlocked with the
+// partial signatures that were collected from the co-signers, which need to reach the threshold of the address.
+func (t *TransactionBundle) Sign(walletSeed *seed.Seed, lastAddressIndex uint64) (tx *devnetvm.Transaction, err error) {
+	addressIndexes := make(map[address.Address]uint64)
+	for i := uint64(0); i <= lastAddressIndex+addressLookahead; i++ {
+		walletAddress := walletSeed.Address(i)
+		addressIndexes[address.Address{AddressBytes: walletAddress.AddressBytes}] = i
+	}
+
+	essenceBytes, err := t.Essence.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize transaction essence")
+	}
+
+	unlockBlocks := make(devnetvm.UnlockBlocks, len(t.ConsumedOutputs))
+	existingUnlockBlocks := make(map[address.Address]uint16)
+	for i, consumedOutput := range t.ConsumedOutputs {
+		unlockAddress := t.unlockAddress(consumedOutput)
+		walletAddress := address.Address{AddressBytes: unlockAddress.Array()}
+		if unlockBlockIndex, unlockBlockExists := existingUnlockBlocks[walletAddress]; unlockBlockExists {
+			unlockBlocks[i] = devnetvm.NewReferenceUnlockBlock(unlockBlockIndex)
+			continue
+		}
+
+		if unlockAddress.Type() == devnetvm.ThresholdAddressType {
+			thresholdSigners := t.thresholdSigners(unlockAddress)
+			if thresholdSigners == nil {
+				return nil, errors.Errorf("signer set of threshold address %s of consumed output %s is missing", unlockAddress.Base58(), consumedOutput.ID())
+			}
+			if len(thresholdSigners.Signatures) < int(thresholdSigners.Threshold) {
+				return nil, errors.Errorf("threshold address %s has only %d of %d required signatures", unlockAddress.Base58(), len(thresholdSigners.Signatures), thresholdSigners.Threshold)
+			}
+
+			unlockBlocks[i] = devnetvm.NewThresholdSignatureUnlockBlock(thresholdSigners.Threshold, thresholdSigners.PublicKeys, thresholdSigners.Signatures)
+		} else {
+			addressIndex, exists := addressIndexes[walletAddress]
+			if !exists {
+				return nil, errors.Errorf("consumed output %s can not be unlocked with the keys of the wallet", consumedOutput.ID())
+			}
+
+			keyPair := walletSeed.KeyPair(addressIndex)
+			unlockBlocks[i] = devnetvm.NewSignatureUnlockBlock(devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essenceBytes)))
+		}
+		existingUnlockBlocks[walletAddress] = uint16(i)
+	}
+
+	// check syntactical validity by marshaling and unmarshalling
+	txBytes, err := devnetvm.NewTransaction(t.Essence, unlockBlocks).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize signed transaction")
+	}
+
+	tx = new(devnetvm.Transaction)
+	if err = tx.FromBytes(txBytes); err != nil {
+		return nil, errors.Wrap(err, "failed to parse signed transaction")
+	}
+
+	// check tx validity (balances, unlock blocks)
+	ok, err := checkBalancesAndUnlocks(t.ConsumedOutputs, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("signed transaction is invalid: %s", tx.String())
+	}
+
+	return tx, nil
+}
+
+// Bytes returns the content of a portable bundle file (JSON) of the TransactionBundle.
+func (t *TransactionBundle) Bytes() (bundleBytes []byte, err error) {
+	essenceBytes, err := t.Essence.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize transaction essence")
+	}
+
+	bundleModel := &transactionBundleModel{
+		Version:         TransactionBundleVersion,
+		Essence:         base58.Encode(essenceBytes),
+		ConsumedOutputs: make([]*consumedOutputModel, 0, len(t.ConsumedOutputs)),
+	}
+
+	for _, consumedOutput := range t.ConsumedOutputs {
+		outputBytes, serializeErr := consumedOutput.Bytes()
+		if serializeErr != nil {
+			return nil, errors.Wrapf(serializeErr, "failed to serialize output %s", consumedOutput.ID())
+		}
+
+		bundleModel.ConsumedOutputs = append(bundleModel.ConsumedOutputs, &consumedOutputModel{
+			OutputID: consumedOutput.ID().Base58(),
+			Output:   base58.Encode(outputBytes),
+		})
+	}
+
+	for _, thresholdSigners := range t.ThresholdSigners {
+		thresholdAddressModel, modelErr := jsonmodels.NewThresholdAddress(thresholdSigners.Threshold, thresholdSigners.PublicKeys)
+		if modelErr != nil {
+			return nil, modelErr
+		}
+
+		bundleModel.ThresholdSigners = append(bundleModel.ThresholdSigners, &thresholdSignersModel{
+			ThresholdAddress: thresholdAddressModel,
+			Signatures: lo.Map(thresholdSigners.Signatures, func(signature *devnetvm.ED25519Signature) *jsonmodels.ED25519Signature {
+				return &jsonmodels.ED25519Signature{
+					PublicKey: signature.PublicKey.String(),
+					Signature: signature.Signature.String(),
+				}
+			}),
+		})
+	}
+
+	return json.MarshalIndent(bundleModel, "", "  ")
+}
+
+// unlockAddress returns the address that needs to be unlocked to consume the given output.
+func (t *TransactionBundle) unlockAddress(consumedOutput devnetvm.Output) devnetvm.Address {
+	if extendedOutput, isExtended := consumedOutput.(*devnetvm.ExtendedLockedOutput); isExtended {
+		return extendedOutput.UnlockAddressNow(t.Essence.Timestamp())
+	}
+
+	return consumedOutput.Address()
+}
+
+// thresholdSigners returns the signer set of the given threshold address (or nil if it was not added).
+func (t *TransactionBundle) thresholdSigners(thresholdAddress devnetvm.Address) *ThresholdSigners {
+	for _, thresholdSigners := range t.ThresholdSigners {
+		if thresholdSigners.Address.Equals(thresholdAddress) {
+			return thresholdSigners
+		}
+	}
+
+	return nil
+}
+
+// transactionBundleModel is the JSON model of a TransactionBundle.
+type transactionBundleModel struct {
+	Version          int                      `json:"version"`
+	Essence          string                   `json:"essence"`
+	ConsumedOutputs  []*consumedOutputModel   `json:"consumedOutputs"`
+	ThresholdSigners []*thresholdSignersModel `json:"thresholdSigners,omitempty"`
+}
+
+// consumedOutputModel is the JSON model of an output that is consumed by a TransactionBundle.
+type consumedOutputModel struct {
+	OutputID string `json:"outputID"`
+	Output   string `json:"output"`
+}
+
+// thresholdSignersModel is the JSON model of the ThresholdSigners of a TransactionBundle.
+type thresholdSignersModel struct {
+	*jsonmodels.ThresholdAddress
+
+	Signatures []*jsonmodels.ED25519Signature `json:"signatures"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdSigners /////////////////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdSigners is the signer set of a threshold address together with the partial signatures of the co-signers.
+type ThresholdSigners struct {
+	// Address is the threshold address that is derived from the signer set.
+	Address *devnetvm.ThresholdAddress
+
+	// Threshold is the number of signatures that is required to unlock the Address.
+	Threshold uint8
+
+	// PublicKeys are the public keys of the co-signers.
+	PublicKeys []ed25519.PublicKey
+
+	// Signatures are the partial signatures that were collected so far.
+	Signatures []*devnetvm.ED25519Signature
+}
+
+// IsSigner returns true if the given public key is part of the signer set.
+func (t *ThresholdSigners) IsSigner(publicKey ed25519.PublicKey) bool {
+	for _, signerPublicKey := range t.PublicKeys {
+		if signerPublicKey == publicKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasSigned returns true if a signature of the given public key was already collected.
+func (t *ThresholdSigners) HasSigned(publicKey ed25519.PublicKey) bool {
+	for _, signature := range t.Signatures {
+		if signature.PublicKey == publicKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddSignature adds the partial signature of a co-signer after checking that it signs the given essence. It returns
+// false if a signature of the same co-signer was already collected.
+func (t *ThresholdSigners) AddSignature(signature *devnetvm.ED25519Signature, essence *devnetvm.TransactionEssence) (added bool, err error) {
+	if !t.IsSigner(signature.PublicKey) {
+		return false, errors.Errorf("public key %s is not part of the signer set of threshold address %s", signature.PublicKey, t.Address.Base58())
+	}
+	if t.HasSigned(signature.PublicKey) {
+		return false, nil
+	}
+
+	essenceBytes, err := essence.Bytes()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to serialize transaction essence")
+	}
+	if !signature.SignatureValid(essenceBytes) {
+		return false, errors.Errorf("signature of public key %s is invalid", signature.PublicKey)
+	}
+
+	t.Signatures = append(t.Signatures, signature)
+
+	return true, nil
+}
+
+// thresholdPublicKeysFromModel parses the public keys of the given JSON model of a threshold address.
+func thresholdPublicKeysFromModel(thresholdAddressModel *jsonmodels.ThresholdAddress) (publicKeys []ed25519.PublicKey, err error) {
+	publicKeys = make([]ed25519.PublicKey, len(thresholdAddressModel.PublicKeys))
+	for i, base58PublicKey := range thresholdAddressModel.PublicKeys {
+		if publicKeys[i], err = ed25519.PublicKeyFromString(base58PublicKey); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse public key %s", base58PublicKey)
+		}
+	}
+
+	return publicKeys, nil
+}
+
+// ed25519SignatureFromModel parses the given JSON model of a partial signature.
+func ed25519SignatureFromModel(signatureModel *jsonmodels.ED25519Signature) (signature *devnetvm.ED25519Signature, err error) {
+	publicKey, err := ed25519.PublicKeyFromString(signatureModel.PublicKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse public key %s", signatureModel.PublicKey)
+	}
+
+	signatureBytes, err := base58.Decode(signatureModel.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signature")
+	}
+
+	ed25519Signature, _, err := ed25519.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse signature")
+	}
+
+	return devnetvm.NewED25519Signature(publicKey, ed25519Signature), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,194 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func TestTransactionBundle_BytesRoundTrip(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	bundle := newTransactionBundle(t, newConsumedOutput(t, 100, walletSeed.Address(0).Address()), newConsumedOutput(t, 50, walletSeed.Address(1).Address()))
+
+	parsedBundle := transactionBundleRoundTrip(t, bundle)
+
+	essenceBytes, err := bundle.Essence.Bytes()
+	require.NoError(t, err)
+	parsedEssenceBytes, err := parsedBundle.Essence.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, essenceBytes, parsedEssenceBytes)
+
+	require.Len(t, parsedBundle.ConsumedOutputs, len(bundle.ConsumedOutputs))
+	for i, consumedOutput := range bundle.ConsumedOutputs {
+		assert.Equal(t, consumedOutput.ID(), parsedBundle.ConsumedOutputs[i].ID())
+		assert.Equal(t, lo.PanicOnErr(consumedOutput.Bytes()), lo.PanicOnErr(parsedBundle.ConsumedOutputs[i].Bytes()))
+	}
+	assert.Empty(t, parsedBundle.ThresholdSigners)
+
+	_, err = TransactionBundleFromBytes([]byte("{}"))
+	require.Error(t, err)
+}
+
+func TestTransactionBundle_Sign(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	bundle := newTransactionBundle(t,
+		newConsumedOutput(t, 100, walletSeed.Address(0).Address()),
+		newConsumedOutput(t, 50, walletSeed.Address(0).Address()),
+		newConsumedOutput(t, 25, walletSeed.Address(addressLookahead).Address()),
+	)
+
+	tx, err := bundle.Sign(walletSeed, 0)
+	require.NoError(t, err)
+	// the second output of the same address references the signature of the first one
+	assert.ElementsMatch(t, []devnetvm.UnlockBlockType{
+		devnetvm.SignatureUnlockBlockType,
+		devnetvm.SignatureUnlockBlockType,
+		devnetvm.ReferenceUnlockBlockType,
+	}, lo.Map(tx.UnlockBlocks(), devnetvm.UnlockBlock.Type))
+
+	// outputs of addresses beyond the lookahead of the last address index can not be unlocked
+	_, err = newTransactionBundle(t, newConsumedOutput(t, 100, walletSeed.Address(addressLookahead+1).Address())).Sign(walletSeed, 0)
+	require.Error(t, err)
+
+	// outputs of other wallets can not be unlocked
+	_, err = newTransactionBundle(t, newConsumedOutput(t, 100, seed.NewSeed().Address(0).Address())).Sign(walletSeed, 0)
+	require.Error(t, err)
+}
+
+func TestTransactionBundle_CoSignAndMerge(t *testing.T) {
+	coSigner1, coSigner2 := seed.NewSeed(), seed.NewSeed()
+	bundle, thresholdAddress := newThresholdBundle(t, coSigner1, coSigner2)
+
+	coSignedBundle := transactionBundleRoundTrip(t, bundle)
+
+	// each co-signer adds its partial signature only once
+	addedSignatures, err := bundle.CoSign(coSigner1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, addedSignatures)
+	addedSignatures, err = bundle.CoSign(coSigner1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, addedSignatures)
+
+	// a wallet that is not part of the signer set can not add signatures
+	addedSignatures, err = coSignedBundle.CoSign(seed.NewSeed(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, addedSignatures)
+
+	// the transaction can not be signed before the threshold is reached
+	_, err = bundle.Sign(coSigner1, 0)
+	require.Error(t, err)
+
+	addedSignatures, err = coSignedBundle.CoSign(coSigner2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, addedSignatures)
+
+	// merging adds the signatures of the other co-signer only once
+	addedSignatures, err = bundle.Merge(coSignedBundle)
+	require.NoError(t, err)
+	assert.Equal(t, 1, addedSignatures)
+	addedSignatures, err = bundle.Merge(coSignedBundle)
+	require.NoError(t, err)
+	assert.Equal(t, 0, addedSignatures)
+
+	// the collected signatures survive the round trip through a bundle file
+	combinedBundle := transactionBundleRoundTrip(t, bundle)
+	require.Len(t, combinedBundle.ThresholdSigners, 1)
+	assert.True(t, combinedBundle.ThresholdSigners[0].Address.Equals(thresholdAddress))
+	assert.Equal(t, bundle.ThresholdSigners[0].Signatures, combinedBundle.ThresholdSigners[0].Signatures)
+
+	tx, err := combinedBundle.Sign(coSigner1, 0)
+	require.NoError(t, err)
+	require.Len(t, tx.UnlockBlocks(), 1)
+	assert.Equal(t, devnetvm.ThresholdSignatureUnlockBlockType, tx.UnlockBlocks()[0].Type())
+
+	// bundles of different transactions can not be merged
+	otherBundle, _ := newThresholdBundle(t, coSigner1, coSigner2)
+	_, err = bundle.Merge(otherBundle)
+	require.Error(t, err)
+}
+
+func TestTransactionBundleFromBytes_ThresholdSignersVersion(t *testing.T) {
+	bundle, _ := newThresholdBundle(t, seed.NewSeed(), seed.NewSeed())
+
+	bundleBytes, err := bundle.Bytes()
+	require.NoError(t, err)
+
+	// the signer sets of threshold addresses are not part of the first version of the format
+	_, err = TransactionBundleFromBytes([]byte(strings.Replace(string(bundleBytes), `"version": 2`, `"version": 1`, 1)))
+	require.Error(t, err)
+
+	_, err = TransactionBundleFromBytes([]byte(strings.Replace(string(bundleBytes), `"version": 2`, `"version": 3`, 1)))
+	require.Error(t, err)
+}
+
+// newThresholdBundle returns a new TransactionBundle that consumes an output of a threshold address that requires the
+// signatures of both given co-signers.
+func newThresholdBundle(t *testing.T, coSigner1, coSigner2 *seed.Seed) (bundle *TransactionBundle, thresholdAddress *devnetvm.ThresholdAddress) {
+	publicKeys := []ed25519.PublicKey{coSigner1.KeyPair(0).PublicKey, coSigner2.KeyPair(0).PublicKey}
+
+	thresholdAddress, err := devnetvm.NewThresholdAddress(2, publicKeys)
+	require.NoError(t, err)
+
+	bundle = newTransactionBundle(t, newConsumedOutput(t, 100, thresholdAddress))
+
+	_, err = bundle.AddThresholdSigners(2, publicKeys)
+	require.NoError(t, err)
+
+	return bundle, thresholdAddress
+}
+
+// newTransactionBundle returns a new TransactionBundle that sends the funds of the given outputs to a new address.
+func newTransactionBundle(t *testing.T, consumedOutputs ...devnetvm.Output) (bundle *TransactionBundle) {
+	inputs := make([]devnetvm.Input, 0, len(consumedOutputs))
+	outputsByID := make(OutputsByID)
+	var totalBalance uint64
+	for _, consumedOutput := range consumedOutputs {
+		inputs = append(inputs, devnetvm.NewUTXOInput(consumedOutput.ID()))
+		outputsByID[consumedOutput.ID()] = &Output{Object: consumedOutput}
+
+		balance, _ := consumedOutput.Balances().Get(devnetvm.ColorIOTA)
+		totalBalance += balance
+	}
+
+	essence := devnetvm.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		devnetvm.NewInputs(inputs...),
+		devnetvm.NewOutputs(devnetvm.NewSigLockedSingleOutput(totalBalance, seed.NewSeed().Address(0).Address())),
+	)
+
+	bundle, err := NewTransactionBundle(essence, outputsByID)
+	require.NoError(t, err)
+
+	return bundle
+}
+
+// newConsumedOutput returns a new output with the given balance that is locked by the given address.
+func newConsumedOutput(t *testing.T, balance uint64, address devnetvm.Address) (consumedOutput devnetvm.Output) {
+	var txID utxo.TransactionID
+	require.NoError(t, txID.FromRandomness())
+
+	consumedOutput = devnetvm.NewSigLockedSingleOutput(balance, address)
+	consumedOutput.SetID(utxo.NewOutputID(txID, 0))
+
+	return consumedOutput
+}
+
+// transactionBundleRoundTrip writes the given TransactionBundle to a bundle file and reads it again.
+func transactionBundleRoundTrip(t *testing.T, bundle *TransactionBundle) (parsedBundle *TransactionBundle) {
+	bundleBytes, err := bundle.Bytes()
+	require.NoError(t, err)
+
+	parsedBundle, err = TransactionBundleFromBytes(bundleBytes)
+	require.NoError(t, err)
+
+	return parsedBundle
+}
@@ -15,10 +15,12 @@ import (
 
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/claimconditionaloptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/claimhashlockedoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/consolidateoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/createnftoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/deposittonftoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/destroynftoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/refundhashlockedoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sweepnftownednftsoptions"
@@ -56,17 +58,33 @@ type Wallet struct {
 	ConfirmationPollInterval time.Duration
 	ConfirmationTimeout      time.Duration
 	Stateless                bool
+
+	// optionsErr contains the first error of the options that failed to configure the wallet.
+	optionsErr error
 }
 
 // New is the factory method of the wallet. It either creates a new wallet or restores the wallet backup that is handed
-// in as an optional parameter.
+// in as an optional parameter. It panics if the wallet can not be created (see TryNew).
 func New(options ...Option) (wallet *Wallet) {
+	wallet, err := TryNew(options...)
+	if err != nil {
+		panic(err)
+	}
+
+	return wallet
+}
+
+// TryNew is the error returning counterpart of New that returns an error instead of panicking if the options fail to
+// configure the wallet (i.e. if the seed of the wallet can not be retrieved) or if its outputs can not be loaded.
+func TryNew(options ...Option) (wallet *Wallet, err error) {
 	// create wallet
 	wallet = &Wallet{}
 
 	// configure wallet
 	for _, option := range options {
-		option(wallet)
+		if option(wallet); wallet.optionsErr != nil {
+			return nil, wallet.optionsErr
+		}
 	}
 
 	if wallet.ConfirmationPollInterval == 0 {
@@ -89,17 +107,16 @@ func New(options ...Option) (wallet *Wallet) {
 
 	// initialize wallet with default connector (server) if none was provided
 	if wallet.connector == nil {
-		panic("you need to provide a connector for your wallet")
+		return nil, errors.New("you need to provide a connector for your wallet")
 	}
 
 	// initialize output manager
 	wallet.outputManager = NewUnspentOutputManager(wallet.addressManager, wallet.connector, wallet.Stateless)
-	err := wallet.outputManager.Refresh(true)
-	if err != nil {
-		panic(err)
+	if err = wallet.outputManager.Refresh(true); err != nil {
+		return nil, errors.Wrap(err, "failed to load the outputs of the wallet")
 	}
 
-	return
+	return wallet, nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -125,22 +142,22 @@ func (wallet *Wallet) SendFunds(options ...sendoptions.SendFundsOption) (tx *dev
 	}
 
 	// determine pledgeIDs
-	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(sendOptions.AccessManaPledgeID, sendOptions.ConsensusManaPledgeID)
+	aPledgeID, cPledgeID, err := derivePledgeIDs(sendOptions.AccessManaPledgeID, sendOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
 
 	// build inputs from consumed outputs
-	inputs := wallet.buildInputs(consumedOutputs)
+	inputs := buildInputs(consumedOutputs)
 	// aggregate all the funds we consume from inputs
 	totalConsumedFunds := consumedOutputs.TotalFundsInOutputs()
 	remainderAddress := wallet.chooseRemainderAddress(consumedOutputs, sendOptions.RemainderAddress)
-	outputs := wallet.buildOutputs(sendOptions, totalConsumedFunds, remainderAddress)
+	outputs := buildOutputs(sendOptions, totalConsumedFunds, remainderAddress)
 
 	txEssence := devnetvm.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 	outputsByID := consumedOutputs.OutputsByID()
 
-	unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	unlockBlocks, inputsAsOutputsInOrder := buildUnlockBlocks(wallet.Seed(), inputs, outputsByID, txEssence)
 
 	tx = devnetvm.NewTransaction(txEssence, unlockBlocks)
 	txBytes, err := tx.Bytes()
@@ -208,7 +225,7 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 
 	for _, consumedOutputs := range consumedOutputsSlice {
 		// build inputs from consumed outputs
-		inputs := wallet.buildInputs(consumedOutputs)
+		inputs := buildInputs(consumedOutputs)
 		// aggregate all the funds we consume from inputs
 		totalConsumedFunds := consumedOutputs.TotalFundsInOutputs()
 		toAddress := wallet.chooseToAddress(consumedOutputs, address.AddressEmpty) // no optional toAddress from options
@@ -216,7 +233,7 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 		outputs := devnetvm.NewOutputs(devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(totalConsumedFunds), toAddress.Address()))
 
 		// determine pledgeIDs
-		aPledgeID, cPledgeID, pErr := wallet.derivePledgeIDs(consolidateOptions.AccessManaPledgeID, consolidateOptions.ConsensusManaPledgeID)
+		aPledgeID, cPledgeID, pErr := derivePledgeIDs(consolidateOptions.AccessManaPledgeID, consolidateOptions.ConsensusManaPledgeID)
 		if pErr != nil {
 			err = pErr
 			return
@@ -225,7 +242,7 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 		txEssence := devnetvm.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 		outputsByID := consumedOutputs.OutputsByID()
 
-		unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+		unlockBlocks, inputsAsOutputsInOrder := buildUnlockBlocks(wallet.Seed(), inputs, outputsByID, txEssence)
 
 		tx := devnetvm.NewTransaction(txEssence, unlockBlocks)
 
@@ -290,14 +307,14 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 	}
 
 	// build inputs from consumed outputs
-	inputs := wallet.buildInputs(consumedOutputs)
+	inputs := buildInputs(consumedOutputs)
 	// aggregate all the funds we consume from inputs
 	totalConsumedFunds := consumedOutputs.TotalFundsInOutputs()
 	toAddress := wallet.chooseToAddress(consumedOutputs, address.AddressEmpty) // no optional toAddress from options
 	outputs := devnetvm.NewOutputs(devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(totalConsumedFunds), toAddress.Address()))
 
 	// determine pledgeIDs
-	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(claimOptions.AccessManaPledgeID, claimOptions.ConsensusManaPledgeID)
+	aPledgeID, cPledgeID, err := derivePledgeIDs(claimOptions.AccessManaPledgeID, claimOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -305,7 +322,7 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 	txEssence := devnetvm.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 	outputsByID := consumedOutputs.OutputsByID()
 
-	unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	unlockBlocks, inputsAsOutputsInOrder := buildUnlockBlocks(wallet.Seed(), inputs, outputsByID, txEssence)
 
 	tx = devnetvm.NewTransaction(txEssence, unlockBlocks)
 
@@ -343,6 +360,138 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 
 // endregion //////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region ClaimHashLockedFunds /////////////////////////////////////////////////////////////////////////////////////////
+
+// ClaimHashLockedFunds spends a hash locked output that is owned by the wallet by revealing the preimage of its hash lock
+// before the fallback deadline.
+func (wallet *Wallet) ClaimHashLockedFunds(options ...claimhashlockedoptions.ClaimHashLockedFundsOption) (tx *devnetvm.Transaction, err error) {
+	claimOptions, err := claimhashlockedoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	return wallet.spendHashLockedOutput(claimOptions.OutputID, &claimOptions.Preimage, claimOptions.AccessManaPledgeID,
+		claimOptions.ConsensusManaPledgeID, claimOptions.WaitForConfirmation)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RefundHashLockedFunds ////////////////////////////////////////////////////////////////////////////////////////
+
+// RefundHashLockedFunds spends a hash locked output whose fallback deadline has passed without the preimage having been
+// revealed, and which therefore belongs to the fallback address of the wallet again.
+func (wallet *Wallet) RefundHashLockedFunds(options ...refundhashlockedoptions.RefundHashLockedFundsOption) (tx *devnetvm.Transaction, err error) {
+	refundOptions, err := refundhashlockedoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	return wallet.spendHashLockedOutput(refundOptions.OutputID, nil, refundOptions.AccessManaPledgeID,
+		refundOptions.ConsensusManaPledgeID, refundOptions.WaitForConfirmation)
+}
+
+// spendHashLockedOutput moves the funds of the hash locked output with the given id to an address of the wallet. If a
+// preimage is given, the output is claimed by the recipient, otherwise it is refunded to the fallback address.
+func (wallet *Wallet) spendHashLockedOutput(outputID utxo.OutputID, preimage *[devnetvm.PreimageSize]byte, accessManaPledgeID, consensusManaPledgeID string, waitForConfirmation bool) (tx *devnetvm.Transaction, err error) {
+	if err = wallet.outputManager.Refresh(); err != nil {
+		return
+	}
+
+	consumedOutputs := NewAddressToOutputs()
+	for addy, outputs := range wallet.outputManager.UnspentValueOutputs(false) {
+		if output, exists := outputs[outputID]; exists {
+			consumedOutputs[addy] = map[utxo.OutputID]*Output{outputID: output}
+			break
+		}
+	}
+	if len(consumedOutputs) == 0 {
+		return nil, errors.Errorf("failed to find confirmed unspent output %s in wallet", outputID.Base58())
+	}
+
+	now := time.Now()
+	var unlockAddress address.Address
+	for addy, outputs := range consumedOutputs {
+		unlockAddress = addy
+		casted, ok := outputs[outputID].Object.(*devnetvm.ExtendedLockedOutput)
+		if !ok || !casted.HashLocked() {
+			return nil, errors.Errorf("output %s is not hash locked", outputID.Base58())
+		}
+		if casted.TimeLockedNow(now) {
+			return nil, errors.Errorf("output %s is timelocked until %s", outputID.Base58(), casted.TimeLock().String())
+		}
+		if preimage != nil && !casted.HashLockedNow(now) {
+			return nil, errors.Errorf("the fallback deadline of output %s has passed, it can only be refunded", outputID.Base58())
+		}
+		if preimage == nil && casted.HashLockedNow(now) {
+			_, fallbackDeadline := casted.FallbackOptions()
+			return nil, errors.Errorf("output %s can't be refunded before its fallback deadline %s", outputID.Base58(), fallbackDeadline.String())
+		}
+		if !casted.UnlockAddressNow(now).Equals(addy.Address()) {
+			return nil, errors.Errorf("output %s can't be unlocked by the wallet at the moment", outputID.Base58())
+		}
+		if preimage != nil && devnetvm.NewHashLock(*preimage) != casted.HashLock() {
+			return nil, errors.New("the preimage doesn't match the hash lock of the output")
+		}
+	}
+
+	// build inputs from consumed outputs
+	inputs := buildInputs(consumedOutputs)
+	// aggregate all the funds we consume from inputs
+	totalConsumedFunds := consumedOutputs.TotalFundsInOutputs()
+	toAddress := wallet.chooseToAddress(consumedOutputs, address.AddressEmpty) // no optional toAddress from options
+	outputs := devnetvm.NewOutputs(devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(totalConsumedFunds), toAddress.Address()))
+
+	// determine pledgeIDs
+	aPledgeID, cPledgeID, err := derivePledgeIDs(accessManaPledgeID, consensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	txEssence := devnetvm.NewTransactionEssence(0, now, aPledgeID, cPledgeID, inputs, outputs)
+
+	keyPair := wallet.Seed().KeyPair(unlockAddress.Index)
+	signature := devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(lo.PanicOnErr(txEssence.Bytes())))
+	var unlockBlock devnetvm.UnlockBlock = devnetvm.NewSignatureUnlockBlock(signature)
+	if preimage != nil {
+		unlockBlock = devnetvm.NewPreimageUnlockBlock(signature, *preimage)
+	}
+
+	tx = devnetvm.NewTransaction(txEssence, devnetvm.UnlockBlocks{unlockBlock})
+
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	// check syntactical validity by marshaling an unmarshalling
+	tx = new(devnetvm.Transaction)
+	err = tx.FromBytes(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// check tx validity (balances, unlock blocks)
+	ok, err := checkBalancesAndUnlocks(devnetvm.Outputs{consumedOutputs[unlockAddress][outputID].Object}, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
+	}
+
+	wallet.markOutputsAndAddressesSpent(consumedOutputs)
+
+	err = wallet.connector.SendTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	if waitForConfirmation {
+		err = wallet.WaitForTxAcceptance(tx.ID())
+	}
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region CreateAsset //////////////////////////////////////////////////////////////////////////////////////////////////
 
 // CreateAsset creates a new colored token with the given details.
@@ -420,7 +569,7 @@ func (wallet *Wallet) CreateNFT(options ...createnftoptions.CreateNFTOption) (tx
 		return
 	}
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(createNFTOptions.AccessManaPledgeID, createNFTOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(createNFTOptions.AccessManaPledgeID, createNFTOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -435,7 +584,7 @@ func (wallet *Wallet) CreateNFT(options ...createnftoptions.CreateNFTOption) (tx
 	// determine which address should receive the nft
 	nftWalletAddress := wallet.chooseToAddress(consumedOutputs, address.AddressEmpty)
 	// build inputs from consumed outputs
-	inputs := wallet.buildInputs(consumedOutputs)
+	inputs := buildInputs(consumedOutputs)
 	// aggregate all the funds we consume from inputs
 	totalConsumedFunds := consumedOutputs.TotalFundsInOutputs()
 	// create an alias mint output
@@ -468,7 +617,7 @@ func (wallet *Wallet) CreateNFT(options ...createnftoptions.CreateNFTOption) (tx
 	txEssence := devnetvm.NewTransactionEssence(0, time.Now(), accessPledgeNodeID, consensusPledgeNodeID, inputs, outputs)
 
 	// build unlock blocks
-	unlockBlocks, inputsInOrder := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	unlockBlocks, inputsInOrder := buildUnlockBlocks(wallet.Seed(), inputs, consumedOutputs.OutputsByID(), txEssence)
 
 	tx = devnetvm.NewTransaction(txEssence, unlockBlocks)
 
@@ -525,7 +674,7 @@ func (wallet *Wallet) TransferNFT(options ...transfernftoptions.TransferNFTOptio
 	}
 
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(transferOptions.AccessManaPledgeID, transferOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(transferOptions.AccessManaPledgeID, transferOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -644,7 +793,7 @@ func (wallet *Wallet) DestroyNFT(options ...destroynftoptions.DestroyNFTOption)
 		return
 	}
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(destroyOptions.AccessManaPledgeID, destroyOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(destroyOptions.AccessManaPledgeID, destroyOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -800,7 +949,7 @@ func (wallet *Wallet) WithdrawFundsFromNFT(options ...withdrawfromnftoptions.Wit
 	outputs := devnetvm.Outputs{remainderOutput, nextAlias}
 
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(withdrawOptions.AccessManaPledgeID, withdrawOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(withdrawOptions.AccessManaPledgeID, withdrawOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -860,7 +1009,7 @@ func (wallet *Wallet) DepositFundsToNFT(options ...deposittonftoptions.DepositFu
 		return
 	}
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(depositOptions.AccessManaPledgeID, depositOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(depositOptions.AccessManaPledgeID, depositOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -886,7 +1035,7 @@ func (wallet *Wallet) DepositFundsToNFT(options ...deposittonftoptions.DepositFu
 		return nil, err
 	}
 	// build inputs from consumed outputs annd add the alias
-	unsortedInputs := append(wallet.buildInputs(consumedOutputs), alias.Input())
+	unsortedInputs := append(buildInputs(consumedOutputs), alias.Input())
 	// sort all inputs
 	inputs := devnetvm.NewInputs(unsortedInputs...)
 	// aggregate all the funds we consume from inputs used to fund the deposit (there is the alias input as well)
@@ -927,7 +1076,7 @@ func (wallet *Wallet) DepositFundsToNFT(options ...deposittonftoptions.DepositFu
 	consumedOutputs[walletAlias.Address][walletAlias.Object.ID()] = walletAlias
 
 	// build unlock blocks
-	unlockBlocks, inputsInOrder := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	unlockBlocks, inputsInOrder := buildUnlockBlocks(wallet.Seed(), inputs, consumedOutputs.OutputsByID(), txEssence)
 
 	tx = devnetvm.NewTransaction(txEssence, unlockBlocks)
 
@@ -974,7 +1123,7 @@ func (wallet Wallet) SweepNFTOwnedFunds(options ...sweepnftownedoptions.SweepNFT
 		return
 	}
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(sweepOptions.AccessManaPledgeID, sweepOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(sweepOptions.AccessManaPledgeID, sweepOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -1120,7 +1269,7 @@ func (wallet *Wallet) SweepNFTOwnedNFTs(options ...sweepnftownednftsoptions.Swee
 		return
 	}
 	// derive mana pledge IDs
-	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(sweepOptions.AccessManaPledgeID, sweepOptions.ConsensusManaPledgeID)
+	accessPledgeNodeID, consensusPledgeNodeID, err := derivePledgeIDs(sweepOptions.AccessManaPledgeID, sweepOptions.ConsensusManaPledgeID)
 	if err != nil {
 		return
 	}
@@ -1510,8 +1659,8 @@ func (wallet *Wallet) AvailableBalance(refresh ...bool) (confirmedBalance, pendi
 				})
 			case devnetvm.ExtendedLockedOutputType:
 				casted := output.Object.(*devnetvm.ExtendedLockedOutput)
-				if casted.TimeLockedNow(now) {
-					// timelocked funds are not available
+				if casted.TimeLockedNow(now) || casted.HashLockedNow(now) {
+					// timelocked and hash locked funds are not available
 					continue
 				}
 				unlockAddyNow := casted.UnlockAddressNow(now)
@@ -1603,8 +1752,8 @@ func (wallet *Wallet) ConditionalBalances(refresh ...bool) (confirmed, pending T
 			}
 			casted := output.Object.(*devnetvm.ExtendedLockedOutput)
 			_, fallbackDeadline := casted.FallbackOptions()
-			if !fallbackDeadline.IsZero() && addy.Address().Equals(casted.UnlockAddressNow(now)) {
-				// fallback option is set and currently we are the unlock address
+			if !fallbackDeadline.IsZero() && !casted.HashLockedNow(now) && addy.Address().Equals(casted.UnlockAddressNow(now)) {
+				// fallback option is set, no preimage is required and currently we are the unlock address
 				cBal := &TimedBalance{
 					Balance: casted.Balances().Map(),
 					Time:    fallbackDeadline,
@@ -1905,7 +2054,7 @@ func (wallet *Wallet) waitForStateAliasBalanceConfirmation(preStateAliasBalance
 }
 
 // derivePledgeIDs returns the mana pledge IDs from the provided options.
-func (wallet *Wallet) derivePledgeIDs(aIDFromOptions, cIDFromOptions string) (aID, cID identity.ID, err error) {
+func derivePledgeIDs(aIDFromOptions, cIDFromOptions string) (aID, cID identity.ID, err error) {
 	// determine pledge IDs
 	if aIDFromOptions != "" {
 		aID, err = identity.DecodeIDBase58(aIDFromOptions)
@@ -1972,8 +2121,12 @@ func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[devnetvm.Color
 	if len(addresses) == 0 {
 		addresses = wallet.addressManager.Addresses()
 	}
-	unspentOutputs := wallet.outputManager.UnspentValueOutputs(includePending, addresses...)
+	return selectOutputsForFunding(wallet.outputManager.UnspentValueOutputs(includePending, addresses...), fundingBalance, addresses)
+}
 
+// selectOutputsForFunding selects unspent outputs of the given addresses (in the given order) that can be unlocked now
+// until the fundingBalance is reached.
+func selectOutputsForFunding(unspentOutputs OutputsByAddressAndOutputID, fundingBalance map[devnetvm.Color]uint64, addresses []address.Address) (OutputsByAddressAndOutputID, error) {
 	collected := make(map[devnetvm.Color]uint64)
 	outputsToConsume := NewAddressToOutputs()
 	numOfCollectedOutputs := 0
@@ -1982,8 +2135,8 @@ func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[devnetvm.Color
 		for outputID, output := range unspentOutputs[addy] {
 			if output.Object.Type() == devnetvm.ExtendedLockedOutputType {
 				casted := output.Object.(*devnetvm.ExtendedLockedOutput)
-				if casted.TimeLockedNow(now) || !casted.UnlockAddressNow(now).Equals(addy.Address()) {
-					// skip the output because we wouldn't be able to unlock it
+				if casted.TimeLockedNow(now) || casted.HashLockedNow(now) || !casted.UnlockAddressNow(now).Equals(addy.Address()) {
+					// skip the output because we wouldn't be able to unlock it (hash locked outputs need a preimage)
 					continue
 				}
 			}
@@ -2031,7 +2184,7 @@ func enoughCollected(collected, target map[devnetvm.Color]uint64) bool {
 }
 
 // buildInputs builds a list of deterministically sorted inputs from the provided OutputsByAddressAndOutputID mapping.
-func (wallet *Wallet) buildInputs(addressToIDToOutput OutputsByAddressAndOutputID) devnetvm.Inputs {
+func buildInputs(addressToIDToOutput OutputsByAddressAndOutputID) devnetvm.Inputs {
 	unsortedInputs := devnetvm.Inputs{}
 	for _, outputIDToOutputMap := range addressToIDToOutput {
 		for _, output := range outputIDToOutputMap {
@@ -2043,7 +2196,7 @@ func (wallet *Wallet) buildInputs(addressToIDToOutput OutputsByAddressAndOutputI
 
 // buildOutputs builds outputs based on desired destination balances and consumedFunds. If consumedFunds is greater, than
 // the destination funds, remainderAddress specifies where the remaining amount is put.
-func (wallet *Wallet) buildOutputs(
+func buildOutputs(
 	sendOptions *sendoptions.SendFundsOptions,
 	consumedFunds map[devnetvm.Color]uint64,
 	remainderAddress address.Address,
@@ -2090,6 +2243,9 @@ func (wallet *Wallet) buildOutputs(
 			if !sendOptions.FallbackDeadline.IsZero() && sendOptions.FallbackAddress != nil {
 				extended = extended.WithFallbackOptions(sendOptions.FallbackAddress, sendOptions.FallbackDeadline)
 			}
+			if sendOptions.HashLock != [devnetvm.HashLockSize]byte{} {
+				extended = extended.WithHashLock(sendOptions.HashLock)
+			}
 			output = extended
 		} else {
 			output = devnetvm.NewSigLockedColoredOutput(coloredBalances, addr.Address())
@@ -2102,8 +2258,9 @@ func (wallet *Wallet) buildOutputs(
 	return
 }
 
-// buildUnlockBlocks constructs the unlock blocks for a transaction.
-func (wallet *Wallet) buildUnlockBlocks(inputs devnetvm.Inputs, consumedOutputsByID OutputsByID, essence *devnetvm.TransactionEssence) (unlocks devnetvm.UnlockBlocks, inputsInOrder devnetvm.Outputs) {
+// buildUnlockBlocks constructs the unlock blocks for a transaction by signing its essence with the keys of the given
+// seed.
+func buildUnlockBlocks(walletSeed *seed.Seed, inputs devnetvm.Inputs, consumedOutputsByID OutputsByID, essence *devnetvm.TransactionEssence) (unlocks devnetvm.UnlockBlocks, inputsInOrder devnetvm.Outputs) {
 	unlocks = make([]devnetvm.UnlockBlock, len(inputs))
 	existingUnlockBlocks := make(map[address.Address]uint16)
 	for outputIndex, input := range inputs {
@@ -2114,7 +2271,7 @@ func (wallet *Wallet) buildUnlockBlocks(inputs devnetvm.Inputs, consumedOutputsB
 			continue
 		}
 
-		keyPair := wallet.Seed().KeyPair(output.Address.Index)
+		keyPair := walletSeed.KeyPair(output.Address.Index)
 		unlockBlock := devnetvm.NewSignatureUnlockBlock(devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(lo.PanicOnErr(essence.Bytes()))))
 		unlocks[outputIndex] = unlockBlock
 		existingUnlockBlocks[output.Address] = uint16(outputIndex)
@@ -105,11 +105,11 @@ func (webConnector WebConnector) UnspentOutputs(addresses ...address.Address) (u
 
 // SendTransaction sends a new transaction to the network.
 func (webConnector WebConnector) SendTransaction(tx *devnetvm.Transaction) (err error) {
-	err = webConnector.client.SleepRateSetterEstimate()
+	txBytes, err := tx.Bytes()
 	if err != nil {
 		return err
 	}
-	txBytes, err := tx.Bytes()
+	err = webConnector.client.SleepRateSetterEstimateForPayloadSize(len(txBytes))
 	if err != nil {
 		return err
 	}
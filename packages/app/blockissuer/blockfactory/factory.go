@@ -1,15 +1,11 @@
 package blockfactory
 
 import (
-	"context"
 	"time"
 
-	"github.com/iotaledger/hive.go/core/byteutils"
 	"github.com/iotaledger/hive.go/core/crypto/ed25519"
-	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/identity"
-	"github.com/iotaledger/hive.go/core/serix"
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
@@ -207,17 +203,12 @@ func (f *Factory) tips(p payload.Payload, parentsCount int) (parents models.Bloc
 }
 
 func (f *Factory) sign(block *models.Block) (ed25519.Signature, error) {
-	contentHash, err := block.ContentHash()
+	message, err := block.SigningMessage()
 	if err != nil {
-		return ed25519.EmptySignature, errors.Wrap(err, "failed to obtain block content's hash")
+		return ed25519.EmptySignature, err
 	}
 
-	issuingTimeBytes, err := serix.DefaultAPI.Encode(context.Background(), block.IssuingTime(), serix.WithValidation())
-	if err != nil {
-		return ed25519.EmptySignature, errors.Wrap(err, "failed to serialize block's issuing time")
-	}
-
-	return f.identity.Sign(byteutils.ConcatBytes(issuingTimeBytes, lo.PanicOnErr(block.Commitment().ID().Bytes()), contentHash[:])), nil
+	return f.identity.Sign(message), nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -111,8 +111,8 @@ func (r *RateSetter) Rate() float64 {
 	return r.ownRate.Load()
 }
 
-// Estimate estimates the issuing time of new block.
-func (r *RateSetter) Estimate() time.Duration {
+// EstimateWork estimates the issuing time of a new block that requires the given amount of work.
+func (r *RateSetter) EstimateWork(work int) time.Duration {
 	r.initOnce.Do(func() {
 		// initialize mana vectors in cache here when mana vectors are already loaded
 		r.initializeRate()
@@ -122,9 +122,7 @@ func (r *RateSetter) Estimate() time.Duration {
 	if r.pauseUpdates > 0 {
 		pauseUpdate = time.Duration(float64(r.pauseUpdates)) * r.optsSchedulerRate
 	}
-	// dummy estimate of work, replace with estimated work as argument.
-	estimatedWork := float64(models.MaxBlockWork)
-	return lo.Max(time.Duration(0), pauseUpdate+time.Duration((r.getIssueCredits()-estimatedWork)/r.ownRate.Load()))
+	return lo.Max(time.Duration(0), pauseUpdate+time.Duration((r.getIssueCredits()-float64(work))/r.ownRate.Load()))
 }
 
 func (r *RateSetter) rateSetting() {
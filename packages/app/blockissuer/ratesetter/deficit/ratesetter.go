@@ -6,7 +6,6 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/protocol"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol/icca/scheduler"
-	"github.com/iotaledger/goshimmer/packages/protocol/models"
 
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/generics/options"
@@ -55,16 +54,15 @@ func (r *RateSetter) Rate() float64 {
 	return r.ownRate.Load()
 }
 
-// Estimate returns the estimated time until the next block should be issued based on own deficit in the scheduler.
-func (r *RateSetter) Estimate() time.Duration {
+// EstimateWork returns the estimated time until the next block that requires the given amount of work should be issued
+// based on own deficit in the scheduler.
+func (r *RateSetter) EstimateWork(work int) time.Duration {
 	// Note: excess deficit is always 0 for <minmana issuers.
 	if r.protocol.CongestionControl.Scheduler().IsUncongested() {
 		return time.Duration(0)
-	} else {
-		// Note: this method needs to be updated to take the expected work of the incoming block as an argument.
-		expectedWork := models.MaxBlockWork
-		return time.Duration(lo.Max(0.0, (float64(expectedWork)-r.getExcessDeficit())/r.ownRate.Load()))
 	}
+
+	return time.Duration(lo.Max(0.0, (float64(work)-r.getExcessDeficit())/r.ownRate.Load()))
 }
 
 func (r *RateSetter) initializeRate() {
@@ -19,7 +19,7 @@ func (r *RateSetter) Shutdown() {
 func (r *RateSetter) Rate() float64 {
 	return 0
 }
-func (r *RateSetter) Estimate() time.Duration {
+func (r *RateSetter) EstimateWork(int) time.Duration {
 	return time.Duration(0)
 }
 
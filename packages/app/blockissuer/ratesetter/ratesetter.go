@@ -70,7 +70,7 @@ func New(localID identity.ID, protocol *protocol.Protocol, opts ...options.Optio
 
 type RateSetter interface {
 	Rate() float64
-	Estimate() time.Duration
+	EstimateWork(work int) time.Duration
 	Shutdown()
 }
 
@@ -21,7 +21,7 @@ import (
 
 type TestFramework struct {
 	test          *testing.T
-	localIdentity []*identity.Identity
+	localIdentity []*identity.LocalIdentity
 	RateSetter    []RateSetter
 
 	optsRateSetter []options.Option[Options]
@@ -44,7 +44,7 @@ func NewTestFramework(test *testing.T, opts ...options.Option[TestFramework]) (n
 		t.ProtocolTestFramework = p
 		p.Protocol.Run()
 		for i := 0; i < t.optsNumIssuers; i++ {
-			localID := identity.GenerateIdentity()
+			localID := identity.GenerateLocalIdentity()
 			t.localIdentity = append(t.localIdentity, localID)
 			t.RateSetter = append(t.RateSetter, New(localID.ID(), p.Protocol, t.optsRateSetter...))
 		}
@@ -56,12 +56,18 @@ func (tf *TestFramework) CreateBlock(issuer int) *models.Block {
 	parents := models.NewParentBlockIDs()
 	parents.AddStrong(models.EmptyBlockID)
 	blk := models.NewBlock(models.WithIssuer(tf.localIdentity[issuer].PublicKey()), models.WithParents(parents))
+
+	// sign the block so that it passes the filter
+	signingMessage, err := blk.SigningMessage()
+	assert.NoError(tf.test, err)
+	blk.SetSignature(tf.localIdentity[issuer].Sign(signingMessage))
+
 	assert.NoError(tf.test, blk.DetermineID())
 	return blk
 }
 
 func (tf *TestFramework) IssueBlock(block *models.Block, issuer int) error {
-	for estimate := tf.RateSetter[issuer].Estimate(); estimate > 0; estimate = tf.RateSetter[issuer].Estimate() {
+	for estimate := tf.RateSetter[issuer].EstimateWork(block.Work()); estimate > 0; estimate = tf.RateSetter[issuer].EstimateWork(block.Work()) {
 		time.Sleep(estimate)
 	}
 
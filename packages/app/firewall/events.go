@@ -0,0 +1,32 @@
+package firewall
+
+import (
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/identity"
+)
+
+// Events contains the events of the Firewall.
+type Events struct {
+	// PeerPenalized is triggered when a faulty peer is penalized.
+	PeerPenalized *event.Linkable[*PeerPenalizedEvent]
+
+	// PeerBlocked is triggered when the score of a peer crosses the threshold.
+	PeerBlocked *event.Linkable[*PeerScore]
+
+	event.LinkableCollection[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.LinkableConstructor(func() (newEvents *Events) {
+	return &Events{
+		PeerPenalized: event.NewLinkable[*PeerPenalizedEvent](),
+		PeerBlocked:   event.NewLinkable[*PeerScore](),
+	}
+})
+
+// PeerPenalizedEvent contains the details of a PeerPenalized event.
+type PeerPenalizedEvent struct {
+	PeerID  identity.ID
+	Details *FaultinessDetails
+	Score   float64
+}
@@ -1,42 +1,63 @@
 package firewall
 
 import (
+	"math"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/iotaledger/hive.go/core/autopeering/selection"
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/iotaledger/hive.go/core/logger"
 
+	"github.com/iotaledger/goshimmer/packages/app/ratelimiter"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
 )
 
-// Firewall is a object responsible for taking actions on faulty peers.
+// region Firewall /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Firewall is a object responsible for taking actions on faulty peers. Every fault adds a penalty to the score of the
+// peer that decays over time, and peers whose score crosses the threshold are dropped and blocklisted.
 type Firewall struct {
-	p2pManager                *p2p.Manager
-	autopeering               *selection.Protocol
-	log                       *logger.Logger
-	peersFaultinessCountMutex sync.RWMutex
-	peersFaultinessCount      map[identity.ID]int
+	// Events contains the Events of the Firewall.
+	Events *Events
+
+	p2pManager        *p2p.Manager
+	autopeering       *selection.Protocol
+	log               *logger.Logger
+	peerScoresMutex   sync.RWMutex
+	peerScores        map[identity.ID]*scoreRecord
+	optsThreshold     float64
+	optsHalfLife      time.Duration
+	optsBlockDuration time.Duration
 }
 
-// NewFirewall create a new instance of Firewall object.
-func NewFirewall(p2pManager *p2p.Manager, autopeering *selection.Protocol, log *logger.Logger) (*Firewall, error) {
-	return &Firewall{
-		p2pManager:           p2pManager,
-		autopeering:          autopeering,
-		log:                  log,
-		peersFaultinessCount: map[identity.ID]int{},
-	}, nil
+// NewFirewall create a new instance of Firewall object. The scores of the peers are removed once they are dropped as
+// neighbors by the given p2p.Manager.
+func NewFirewall(p2pManager *p2p.Manager, autopeering *selection.Protocol, log *logger.Logger, opts ...options.Option[Firewall]) (*Firewall, error) {
+	return options.Apply(&Firewall{
+		Events:            NewEvents(),
+		p2pManager:        p2pManager,
+		autopeering:       autopeering,
+		log:               log,
+		peerScores:        map[identity.ID]*scoreRecord{},
+		optsThreshold:     10,
+		optsHalfLife:      10 * time.Minute,
+		optsBlockDuration: time.Hour,
+	}, opts, (*Firewall).attachNeighborEvents), nil
 }
 
 // FaultinessDetails contains information about why the peers is considered faulty.
 type FaultinessDetails struct {
-	Reason string                 `json:"reason"`
-	Info   map[string]interface{} `json:"info"`
+	Reason  string                 `json:"reason"`
+	Penalty float64                `json:"penalty"`
+	Info    map[string]interface{} `json:"info"`
 }
 
 func (fd *FaultinessDetails) toKVList() []interface{} {
-	list := []interface{}{"reason", fd.Reason}
+	list := []interface{}{"reason", fd.Reason, "penalty", fd.Penalty}
 	for k, v := range fd.Info {
 		list = append(list, k, v)
 	}
@@ -46,36 +67,231 @@ func (fd *FaultinessDetails) toKVList() []interface{} {
 // HandleFaultyPeer handles a faulty peer and takes appropriate actions.
 func (f *Firewall) HandleFaultyPeer(peerID identity.ID, details *FaultinessDetails) {
 	logKVList := append([]interface{}{"peerId", peerID}, details.toKVList()...)
-	f.log.Infow("Peer is faulty, executing firewall logic to handle the peer", logKVList...)
-	f.incrPeerFaultinessCount(peerID)
+	f.log.Debugw("Peer is faulty, executing firewall logic to handle the peer", logKVList...)
+
+	score, thresholdCrossed := f.penalize(peerID, details.Penalty)
+	f.Events.PeerPenalized.Trigger(&PeerPenalizedEvent{
+		PeerID:  peerID,
+		Details: details,
+		Score:   score,
+	})
+
+	if !thresholdCrossed {
+		return
+	}
+
+	// the score is captured before the peer is dropped, as dropping it removes its score
+	peerScore := f.PeerScore(peerID)
+
+	f.log.Infow("Peer crossed the faultiness threshold, executing firewall logic to handle the peer", append(logKVList, "score", score)...)
+	f.blockPeer(peerID, logKVList)
+	f.Events.PeerBlocked.Trigger(peerScore)
+}
+
+// MonitorRateLimiter penalizes the peers that hit the limit of the given PeerRateLimiter.
+func (f *Firewall) MonitorRateLimiter(rateLimiter *ratelimiter.PeerRateLimiter, reason string, penalty float64) {
+	rateLimiter.Events.Hit.Attach(event.NewClosure(func(hitEvent *ratelimiter.HitEvent) {
+		f.HandleFaultyPeer(hitEvent.Source, &FaultinessDetails{
+			Reason:  reason,
+			Penalty: penalty,
+			Info: map[string]interface{}{
+				"rateLimit": hitEvent.RateLimit,
+			},
+		})
+	}))
+}
+
+// GetPeerFaultinessCount returns number of times the peer has been considered faulty.
+func (f *Firewall) GetPeerFaultinessCount(peerID identity.ID) int {
+	f.peerScoresMutex.RLock()
+	defer f.peerScoresMutex.RUnlock()
+
+	if score, exists := f.peerScores[peerID]; exists {
+		return score.faultinessCount
+	}
+
+	return 0
+}
+
+// PeerScore returns the current (decayed) score of the given peer.
+func (f *Firewall) PeerScore(peerID identity.ID) (score *PeerScore) {
+	f.peerScoresMutex.Lock()
+	defer f.peerScoresMutex.Unlock()
+
+	if peerScore, exists := f.peerScores[peerID]; exists {
+		return peerScore.snapshot(peerID, f.decay(peerScore, time.Now()))
+	}
+
+	return &PeerScore{PeerID: peerID}
+}
+
+// PeerScores returns the current (decayed) scores of all peers that have been faulty and were not dropped as neighbors,
+// yet (ordered by descending score).
+func (f *Firewall) PeerScores() (scores []*PeerScore) {
+	f.peerScoresMutex.Lock()
+	defer f.peerScoresMutex.Unlock()
+
+	now := time.Now()
+	scores = make([]*PeerScore, 0, len(f.peerScores))
+	for peerID, peerScore := range f.peerScores {
+		scores = append(scores, peerScore.snapshot(peerID, f.decay(peerScore, now)))
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+
+	return scores
+}
+
+// Threshold returns the score at which peers are blocked.
+func (f *Firewall) Threshold() float64 {
+	return f.optsThreshold
+}
+
+// attachNeighborEvents removes the scores of the peers that are dropped as neighbors.
+func (f *Firewall) attachNeighborEvents() {
+	if f.p2pManager == nil {
+		return
+	}
+
+	onNeighborRemoved := event.NewClosure(func(event *p2p.NeighborRemovedEvent) {
+		f.removePeerScore(event.Neighbor.ID())
+	})
+	f.p2pManager.NeighborGroupEvents(p2p.NeighborsGroupAuto).NeighborRemoved.Attach(onNeighborRemoved)
+	f.p2pManager.NeighborGroupEvents(p2p.NeighborsGroupManual).NeighborRemoved.Attach(onNeighborRemoved)
+}
+
+// removePeerScore removes the score of the given peer.
+func (f *Firewall) removePeerScore(peerID identity.ID) {
+	f.peerScoresMutex.Lock()
+	defer f.peerScoresMutex.Unlock()
+
+	delete(f.peerScores, peerID)
+}
+
+// penalize adds the penalty to the score of the peer and returns if the threshold was crossed.
+func (f *Firewall) penalize(peerID identity.ID, penalty float64) (score float64, thresholdCrossed bool) {
+	f.peerScoresMutex.Lock()
+	defer f.peerScoresMutex.Unlock()
+
+	peerScore, exists := f.peerScores[peerID]
+	if !exists {
+		peerScore = new(scoreRecord)
+		f.peerScores[peerID] = peerScore
+	}
+
+	now := time.Now()
+	peerScore.score = f.decay(peerScore, now) + penalty
+	peerScore.lastUpdate = now
+	peerScore.lastFault = now
+	peerScore.faultinessCount++
+
+	if thresholdCrossed = !peerScore.blocked && peerScore.score >= f.optsThreshold; thresholdCrossed {
+		peerScore.blocked = true
+	}
+
+	return peerScore.score, thresholdCrossed
+}
+
+// decay updates the score of the peer to the given time and unblocks it once the score fell below the threshold.
+func (f *Firewall) decay(peerScore *scoreRecord, now time.Time) (score float64) {
+	if f.optsHalfLife > 0 && !peerScore.lastUpdate.IsZero() {
+		peerScore.score *= math.Exp2(-float64(now.Sub(peerScore.lastUpdate)) / float64(f.optsHalfLife))
+		peerScore.lastUpdate = now
+	}
+
+	if peerScore.blocked && peerScore.score < f.optsThreshold {
+		peerScore.blocked = false
+	}
+
+	return peerScore.score
+}
+
+// blockPeer drops the peer and prevents it from being selected by the autopeering again.
+func (f *Firewall) blockPeer(peerID identity.ID, logKVList []interface{}) {
+	if f.p2pManager == nil {
+		return
+	}
+
 	nbr, err := f.p2pManager.GetNeighbor(peerID)
 	if err != nil {
-		f.log.Errorw("Can't get neighbor info from the gossip manager", "peerId", peerID, "err", err)
+		f.log.Debugw("Faulty peer is not a neighbor", "peerId", peerID, "err", err)
+		if f.autopeering != nil {
+			f.autopeering.BlockNeighbor(peerID, f.optsBlockDuration)
+		}
 		return
 	}
-	if nbr.Group == p2p.NeighborsGroupAuto {
+
+	switch nbr.Group {
+	case p2p.NeighborsGroupAuto:
 		if f.autopeering != nil {
-			f.log.Infow(
-				"Blocklisting peer in the autopeering selection",
-				"peerId", peerID,
-			)
-			f.autopeering.BlockNeighbor(peerID)
+			f.log.Infow("Blocklisting peer in the autopeering selection", "peerId", peerID, "duration", f.optsBlockDuration)
+			f.autopeering.BlockNeighbor(peerID, f.optsBlockDuration)
+		} else if err = f.p2pManager.DropNeighbor(peerID, p2p.NeighborsGroupAuto); err != nil {
+			f.log.Errorw("Failed to drop faulty neighbor", "peerId", peerID, "err", err)
 		}
-	} else if nbr.Group == p2p.NeighborsGroupManual {
+	case p2p.NeighborsGroupManual:
 		f.log.Warnw("To the node operator. One of neighbors connected via manual peering acts faulty, no automatic actions taken. Consider removing it from the known peers list.",
 			logKVList...)
 	}
 }
 
-// GetPeerFaultinessCount returns number of times the peer has been considered faulty.
-func (f *Firewall) GetPeerFaultinessCount(peerID identity.ID) int {
-	f.peersFaultinessCountMutex.RLock()
-	defer f.peersFaultinessCountMutex.RUnlock()
-	return f.peersFaultinessCount[peerID]
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PeerScore ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PeerScore contains the faultiness score of a peer.
+type PeerScore struct {
+	PeerID          identity.ID
+	Score           float64
+	FaultinessCount int
+	Blocked         bool
+	LastFault       time.Time
+}
+
+// scoreRecord is the internal (mutable) representation of the score of a peer.
+type scoreRecord struct {
+	score           float64
+	faultinessCount int
+	blocked         bool
+	lastUpdate      time.Time
+	lastFault       time.Time
 }
 
-func (f *Firewall) incrPeerFaultinessCount(peerID identity.ID) {
-	f.peersFaultinessCountMutex.Lock()
-	defer f.peersFaultinessCountMutex.Unlock()
-	f.peersFaultinessCount[peerID]++
+func (s *scoreRecord) snapshot(peerID identity.ID, score float64) *PeerScore {
+	return &PeerScore{
+		PeerID:          peerID,
+		Score:           score,
+		FaultinessCount: s.faultinessCount,
+		Blocked:         s.blocked,
+		LastFault:       s.lastFault,
+	}
 }
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithThreshold sets the score at which faulty peers are dropped and blocklisted.
+func WithThreshold(threshold float64) options.Option[Firewall] {
+	return func(f *Firewall) {
+		f.optsThreshold = threshold
+	}
+}
+
+// WithHalfLife sets the time after which the score of a peer has decayed to half of its value (0 disables the decay).
+func WithHalfLife(halfLife time.Duration) options.Option[Firewall] {
+	return func(f *Firewall) {
+		f.optsHalfLife = halfLife
+	}
+}
+
+// WithBlockDuration sets the time for which blocked peers are excluded from the autopeering.
+func WithBlockDuration(duration time.Duration) options.Option[Firewall] {
+	return func(f *Firewall) {
+		f.optsBlockDuration = duration
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
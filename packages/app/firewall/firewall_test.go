@@ -0,0 +1,96 @@
+package firewall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/app/ratelimiter"
+)
+
+func TestFirewall_HandleFaultyPeer(t *testing.T) {
+	firewall, err := NewFirewall(nil, nil, logger.NewNopLogger(), WithThreshold(5), WithHalfLife(0))
+	require.NoError(t, err)
+
+	var blockedPeers []identity.ID
+	firewall.Events.PeerBlocked.Hook(event.NewClosure(func(peerScore *PeerScore) {
+		blockedPeers = append(blockedPeers, peerScore.PeerID)
+	}))
+
+	faultyPeer := identity.GenerateIdentity().ID()
+	for i := 0; i < 4; i++ {
+		firewall.HandleFaultyPeer(faultyPeer, &FaultinessDetails{Reason: "test", Penalty: 2})
+	}
+
+	peerScore := firewall.PeerScore(faultyPeer)
+	assert.Equal(t, float64(8), peerScore.Score)
+	assert.Equal(t, 4, peerScore.FaultinessCount)
+	assert.True(t, peerScore.Blocked)
+	assert.Equal(t, 4, firewall.GetPeerFaultinessCount(faultyPeer))
+
+	// the peer is only blocked once, when the threshold is crossed
+	assert.Equal(t, []identity.ID{faultyPeer}, blockedPeers)
+
+	unknownPeer := identity.GenerateIdentity().ID()
+	assert.Equal(t, &PeerScore{PeerID: unknownPeer}, firewall.PeerScore(unknownPeer))
+
+	peerScores := firewall.PeerScores()
+	require.Len(t, peerScores, 1)
+	assert.Equal(t, faultyPeer, peerScores[0].PeerID)
+
+	// the score is removed once the peer is dropped as a neighbor
+	firewall.removePeerScore(faultyPeer)
+	assert.Empty(t, firewall.PeerScores())
+	assert.Equal(t, 0, firewall.GetPeerFaultinessCount(faultyPeer))
+}
+
+func TestFirewall_Decay(t *testing.T) {
+	firewall, err := NewFirewall(nil, nil, logger.NewNopLogger(), WithThreshold(5), WithHalfLife(50*time.Millisecond))
+	require.NoError(t, err)
+
+	faultyPeer := identity.GenerateIdentity().ID()
+	firewall.HandleFaultyPeer(faultyPeer, &FaultinessDetails{Reason: "test", Penalty: 10})
+	require.True(t, firewall.PeerScore(faultyPeer).Blocked)
+
+	// the peer is unblocked once its score decayed below the threshold
+	require.Eventually(t, func() bool {
+		return !firewall.PeerScore(faultyPeer).Blocked
+	}, time.Second, 10*time.Millisecond)
+	assert.Less(t, firewall.PeerScore(faultyPeer).Score, float64(5))
+	assert.Equal(t, 1, firewall.GetPeerFaultinessCount(faultyPeer))
+}
+
+func TestFirewall_MonitorRateLimiter(t *testing.T) {
+	firewall, err := NewFirewall(nil, nil, logger.NewNopLogger(), WithHalfLife(0))
+	require.NoError(t, err)
+
+	rateLimiter, err := ratelimiter.NewPeerRateLimiter(time.Minute, 2, logger.NewNopLogger())
+	require.NoError(t, err)
+	defer rateLimiter.Close()
+
+	firewall.MonitorRateLimiter(rateLimiter, "rate limit hit", 3)
+
+	penalized := make(chan *PeerPenalizedEvent, 1)
+	firewall.Events.PeerPenalized.Hook(event.NewClosure(func(penalizedEvent *PeerPenalizedEvent) {
+		penalized <- penalizedEvent
+	}))
+
+	faultyPeer := identity.GenerateIdentity().ID()
+	for i := 0; i < 3; i++ {
+		rateLimiter.Count(faultyPeer)
+	}
+
+	select {
+	case penalizedEvent := <-penalized:
+		assert.Equal(t, faultyPeer, penalizedEvent.PeerID)
+		assert.Equal(t, "rate limit hit", penalizedEvent.Details.Reason)
+		assert.Equal(t, float64(3), penalizedEvent.Score)
+	case <-time.After(time.Second):
+		t.Fatal("peer was not penalized")
+	}
+}
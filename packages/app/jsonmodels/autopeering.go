@@ -13,6 +13,18 @@ type Neighbor struct {
 	ID        string        `json:"id"`        // comparable node identifier
 	PublicKey string        `json:"publicKey"` // public key used to verify signatures
 	Services  []PeerService `json:"services,omitempty"`
+	Traffic   *TrafficStats `json:"traffic,omitempty"`
+}
+
+// TrafficStats contains the traffic statistics of a neighbor.
+type TrafficStats struct {
+	PacketsRead            uint64                  `json:"packetsRead"`
+	PacketsWritten         uint64                  `json:"packetsWritten"`
+	BytesRead              uint64                  `json:"bytesRead"`
+	BytesWritten           uint64                  `json:"bytesWritten"`
+	PacketsDroppedInbound  uint64                  `json:"packetsDroppedInbound"`
+	PacketsDroppedOutbound uint64                  `json:"packetsDroppedOutbound"`
+	Protocols              map[string]TrafficStats `json:"protocols,omitempty"`
 }
 
 // PeerService contains information about a neighbor peer service.
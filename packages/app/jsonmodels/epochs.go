@@ -4,45 +4,76 @@ import (
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 )
 
+// EpochInfo represents the JSON model of a commitment.Commitment.
 type EpochInfo struct {
-	EI     uint64 `json:"Index"`
-	ECR    string `json:"RootsID"`
-	PrevEC string `json:"prevEC"`
+	ID               string `json:"id"`
+	EI               uint64 `json:"Index"`
+	ECR              string `json:"RootsID"`
+	PrevEC           string `json:"prevEC"`
+	CumulativeWeight int64  `json:"cumulativeWeight"`
 }
 
+// EpochInfoFromRecord returns an EpochInfo from the given commitment.Commitment.
 func EpochInfoFromRecord(c *commitment.Commitment) *EpochInfo {
 	return &EpochInfo{
-		EI:     uint64(c.Index()),
-		ECR:    c.RootsID().Base58(),
-		PrevEC: c.PrevID().Base58(),
+		ID:               c.ID().Base58(),
+		EI:               uint64(c.Index()),
+		ECR:              c.RootsID().Base58(),
+		PrevEC:           c.PrevID().Base58(),
+		CumulativeWeight: c.CumulativeWeight(),
 	}
 }
 
+// EpochsResponse is the response of the epochs endpoint (ordered from the latest to the oldest epoch).
 type EpochsResponse struct {
-	Epochs []*EpochInfo `json:"epochs"`
+	Epochs     []*EpochInfo `json:"epochs"`
+	NextCursor string       `json:"nextCursor,omitempty"`
 }
 
-type EpochVotersWeightResponse struct {
-	VotersWeight map[string]*NodeWeight `json:"ecrVoters"`
+// EpochRootsResponse is the response of the epoch/:ei/roots endpoint.
+type EpochRootsResponse struct {
+	TangleRoot        string `json:"tangleRoot"`
+	StateMutationRoot string `json:"stateMutationRoot"`
+	AttestationsRoot  string `json:"attestationsRoot"`
+	StateRoot         string `json:"stateRoot"`
+	ManaRoot          string `json:"manaRoot"`
+	RootsID           string `json:"rootsID"`
 }
 
-type NodeWeight struct {
-	Weights map[string]float64 `json:"weights"`
+// EpochRootsResponseFromRoots returns an EpochRootsResponse from the given commitment.Roots.
+func EpochRootsResponseFromRoots(roots *commitment.Roots) *EpochRootsResponse {
+	return &EpochRootsResponse{
+		TangleRoot:        roots.TangleRoot().Base58(),
+		StateMutationRoot: roots.StateMutationRoot().Base58(),
+		AttestationsRoot:  roots.AttestationsRoot().Base58(),
+		StateRoot:         roots.StateRoot().Base58(),
+		ManaRoot:          roots.ManaRoot().Base58(),
+		RootsID:           roots.ID().Base58(),
+	}
 }
 
+// EpochVotersWeightResponse is the response of the epoch/:ei/voters-weight endpoint (the attestors of the epoch).
+type EpochVotersWeightResponse struct {
+	VotersWeight map[string]int64 `json:"votersWeight"`
+	NextCursor   string           `json:"nextCursor,omitempty"`
+}
+
+// EpochUTXOsResponse is the response of the epoch/:ei/utxos endpoint (the spent outputs followed by the created outputs
+// are paged as a single list).
 type EpochUTXOsResponse struct {
 	SpentOutputs   []string `json:"spentOutputs"`
 	CreatedOutputs []string `json:"createdOutputs"`
+	NextCursor     string   `json:"nextCursor,omitempty"`
 }
 
+// EpochBlocksResponse is the response of the epoch/:ei/blocks endpoint.
 type EpochBlocksResponse struct {
-	Blocks []string `json:"blocks"`
+	Blocks     []string `json:"blocks"`
+	NextCursor string   `json:"nextCursor,omitempty"`
 }
 
+// EpochTransactionsResponse is the response of the epoch/:ei/transactions endpoint.
 type EpochTransactionsResponse struct {
 	Transactions []string `json:"transactions"`
-}
-
-type EpochPendingConflictCountResponse struct {
-	PendingConflictCount uint64 `json:"pendingConflictCount"`
+	NextCursor   string   `json:"nextCursor,omitempty"`
 }
@@ -0,0 +1,36 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/firewall"
+)
+
+// PeerScore represents the JSON model of a firewall.PeerScore.
+type PeerScore struct {
+	PeerID          string  `json:"peerID"`
+	Score           float64 `json:"score"`
+	FaultinessCount int     `json:"faultinessCount"`
+	Blocked         bool    `json:"blocked"`
+	LastFault       int64   `json:"lastFault"`
+}
+
+// NewPeerScore returns a PeerScore from the given firewall.PeerScore.
+func NewPeerScore(peerScore *firewall.PeerScore) *PeerScore {
+	var lastFault int64
+	if !peerScore.LastFault.IsZero() {
+		lastFault = peerScore.LastFault.Unix()
+	}
+
+	return &PeerScore{
+		PeerID:          peerScore.PeerID.EncodeBase58(),
+		Score:           peerScore.Score,
+		FaultinessCount: peerScore.FaultinessCount,
+		Blocked:         peerScore.Blocked,
+		LastFault:       lastFault,
+	}
+}
+
+// PeerScoresResponse is the response of the firewall peers endpoint (ordered by descending score).
+type PeerScoresResponse struct {
+	Threshold float64      `json:"threshold"`
+	Peers     []*PeerScore `json:"peers"`
+}
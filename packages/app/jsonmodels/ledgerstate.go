@@ -1,9 +1,11 @@
 package jsonmodels
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"time"
 
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/types/confirmation"
 	"github.com/iotaledger/hive.go/core/typeutils"
@@ -34,6 +36,31 @@ func NewAddress(address devnetvm.Address) *Address {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region ThresholdAddress /////////////////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdAddress represents the JSON model of the signer set of a ledgerstate.ThresholdAddress.
+type ThresholdAddress struct {
+	Base58     string   `json:"base58"`
+	Threshold  uint8    `json:"threshold"`
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// NewThresholdAddress returns a ThresholdAddress from the given threshold and public keys.
+func NewThresholdAddress(threshold uint8, publicKeys []ed25519.PublicKey) (*ThresholdAddress, error) {
+	address, err := devnetvm.NewThresholdAddress(threshold, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ThresholdAddress{
+		Base58:     address.Base58(),
+		Threshold:  threshold,
+		PublicKeys: lo.Map(publicKeys, ed25519.PublicKey.String),
+	}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region Output ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Output represents the JSON model of a ledgerstate.Output.
@@ -390,6 +417,7 @@ type ExtendedLockedOutput struct {
 	FallbackAddress  string            `json:"fallbackAddress,omitempty"`
 	FallbackDeadline int64             `json:"fallbackDeadline,omitempty"`
 	TimeLock         int64             `json:"timelock,omitempty"`
+	HashLock         string            `json:"hashLock,omitempty"`
 	Payload          []byte            `json:"payload,omitempty"`
 }
 
@@ -416,6 +444,13 @@ func (e *ExtendedLockedOutput) ToLedgerStateOutput(id utxo.OutputID) (devnetvm.O
 	if e.TimeLock != 0 {
 		res = res.WithTimeLock(time.Unix(e.TimeLock, 0))
 	}
+	if e.HashLock != "" {
+		hashLock, hErr := HashLockFromHexString(e.HashLock)
+		if hErr != nil {
+			return nil, errors.Wrap(hErr, "wrong hash lock in ExtendedLockedOutput")
+		}
+		res = res.WithHashLock(hashLock)
+	}
 	if e.Payload != nil {
 		rErr := res.SetPayload(e.Payload)
 		if rErr != nil {
@@ -444,6 +479,10 @@ func ExtendedLockedOutputFromLedgerstate(output devnetvm.Output) (*ExtendedLocke
 	if !castedOutput.TimeLock().Equal(time.Time{}) {
 		res.TimeLock = castedOutput.TimeLock().Unix()
 	}
+	if castedOutput.HashLocked() {
+		hashLock := castedOutput.HashLock()
+		res.HashLock = hex.EncodeToString(hashLock[:])
+	}
 	return res, nil
 }
 
@@ -457,6 +496,34 @@ func UnmarshalExtendedLockedOutputFromBytes(data []byte) (*ExtendedLockedOutput,
 	return marshalledOutput, nil
 }
 
+// HashLockFromHexString parses a hex encoded hash lock of an ExtendedLockedOutput.
+func HashLockFromHexString(hashLockString string) (hashLock [devnetvm.HashLockSize]byte, err error) {
+	hashLockBytes, err := hex.DecodeString(hashLockString)
+	if err != nil {
+		return hashLock, errors.Wrap(err, "failed to decode hash lock")
+	}
+	if len(hashLockBytes) != devnetvm.HashLockSize {
+		return hashLock, errors.Errorf("hash lock must be %d bytes long, got %d", devnetvm.HashLockSize, len(hashLockBytes))
+	}
+	copy(hashLock[:], hashLockBytes)
+
+	return hashLock, nil
+}
+
+// PreimageFromHexString parses a hex encoded preimage that unlocks a hash locked ExtendedLockedOutput.
+func PreimageFromHexString(preimageString string) (preimage [devnetvm.PreimageSize]byte, err error) {
+	preimageBytes, err := hex.DecodeString(preimageString)
+	if err != nil {
+		return preimage, errors.Wrap(err, "failed to decode preimage")
+	}
+	if len(preimageBytes) != devnetvm.PreimageSize {
+		return preimage, errors.Errorf("preimage must be %d bytes long, got %d", devnetvm.PreimageSize, len(preimageBytes))
+	}
+	copy(preimage[:], preimageBytes)
+
+	return preimage, nil
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region OutputID /////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -689,6 +756,16 @@ type UnlockBlock struct {
 	SignatureType   devnetvm.SignatureType `json:"signatureType,omitempty"`
 	PublicKey       string                 `json:"publicKey,omitempty"`
 	Signature       string                 `json:"signature,omitempty"`
+	Threshold       uint8                  `json:"threshold,omitempty"`
+	PublicKeys      []string               `json:"publicKeys,omitempty"`
+	Signatures      []*ED25519Signature    `json:"signatures,omitempty"`
+	Preimage        string                 `json:"preimage,omitempty"`
+}
+
+// ED25519Signature represents the JSON model of a ledgerstate.ED25519Signature.
+type ED25519Signature struct {
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature"`
 }
 
 // NewUnlockBlock returns an UnlockBlock from the given ledgerstate.UnlockBlock.
@@ -714,6 +791,25 @@ func NewUnlockBlock(unlockBlock devnetvm.UnlockBlock) *UnlockBlock {
 	case devnetvm.ReferenceUnlockBlockType:
 		referenceUnlockBlock, _, _ := devnetvm.ReferenceUnlockBlockFromBytes(lo.PanicOnErr(unlockBlock.Bytes()))
 		result.ReferencedIndex = referenceUnlockBlock.ReferencedIndex()
+	case devnetvm.ThresholdSignatureUnlockBlockType:
+		thresholdSignatureUnlockBlock := unlockBlock.(*devnetvm.ThresholdSignatureUnlockBlock)
+		result.Threshold = thresholdSignatureUnlockBlock.Threshold()
+		result.PublicKeys = lo.Map(thresholdSignatureUnlockBlock.PublicKeys(), ed25519.PublicKey.String)
+		for _, signature := range thresholdSignatureUnlockBlock.Signatures() {
+			result.Signatures = append(result.Signatures, &ED25519Signature{
+				PublicKey: signature.PublicKey.String(),
+				Signature: signature.Signature.String(),
+			})
+		}
+	case devnetvm.PreimageUnlockBlockType:
+		preimageUnlockBlock := unlockBlock.(*devnetvm.PreimageUnlockBlock)
+		result.SignatureType = preimageUnlockBlock.Signature().Type()
+		if signature, ok := preimageUnlockBlock.Signature().(*devnetvm.ED25519Signature); ok {
+			result.PublicKey = signature.PublicKey.String()
+			result.Signature = signature.Signature.String()
+		}
+		preimage := preimageUnlockBlock.Preimage()
+		result.Preimage = hex.EncodeToString(preimage[:])
 	}
 
 	return result
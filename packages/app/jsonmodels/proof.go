@@ -0,0 +1,40 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization/proofs"
+)
+
+// region Proof ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Proof represents the JSON model of a proofs.Proof.
+type Proof struct {
+	EpochIndex   uint64 `json:"epochIndex"`
+	CommitmentID string `json:"commitmentID"`
+	RootsID      string `json:"rootsID"`
+	Bytes        []byte `json:"bytes"`
+}
+
+// NewProof returns a Proof from the given proofs.Proof.
+func NewProof(proof *proofs.Proof) *Proof {
+	return &Proof{
+		EpochIndex:   uint64(proof.Commitment.Index()),
+		CommitmentID: proof.Commitment.ID().Base58(),
+		RootsID:      proof.Roots.ID().Base58(),
+		Bytes:        lo.PanicOnErr(proof.Bytes()),
+	}
+}
+
+// Proof returns the proofs.Proof that is encoded in the JSON model (to be verified by the caller).
+func (p *Proof) Proof() (proof *proofs.Proof, err error) {
+	proof = new(proofs.Proof)
+	if _, err = proof.FromBytes(p.Bytes); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proof")
+	}
+
+	return proof, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,30 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/snapshotter"
+)
+
+// SnapshotInfo represents the JSON model of a snapshotter.SnapshotInfo.
+type SnapshotInfo struct {
+	Index        uint64 `json:"index"`
+	CommitmentID string `json:"commitmentID"`
+	FileName     string `json:"fileName"`
+	Size         int64  `json:"size"`
+	CreatedAt    int64  `json:"createdAt"`
+}
+
+// NewSnapshotInfo returns a SnapshotInfo from the given snapshotter.SnapshotInfo.
+func NewSnapshotInfo(snapshotInfo *snapshotter.SnapshotInfo) *SnapshotInfo {
+	return &SnapshotInfo{
+		Index:        uint64(snapshotInfo.Index),
+		CommitmentID: snapshotInfo.CommitmentID.Base58(),
+		FileName:     snapshotInfo.FileName(),
+		Size:         snapshotInfo.Size,
+		CreatedAt:    snapshotInfo.ModTime.Unix(),
+	}
+}
+
+// SnapshotsResponse is the response of the snapshots endpoint (ordered from the latest to the oldest epoch).
+type SnapshotsResponse struct {
+	Snapshots []*SnapshotInfo `json:"snapshots"`
+}
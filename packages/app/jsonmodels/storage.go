@@ -0,0 +1,75 @@
+package jsonmodels
+
+import (
+	"sort"
+
+	"github.com/iotaledger/goshimmer/packages/storage"
+)
+
+// StorageUsageResponse is the response of the storage usage endpoint.
+type StorageUsageResponse struct {
+	// PermanentSize is the size of the permanent storage on disk.
+	PermanentSize int64 `json:"permanentSize"`
+	// PrunableSize is the size of the prunable storage on disk.
+	PrunableSize int64 `json:"prunableSize"`
+	// RetainerSize is the size of the databases of the retainer on disk.
+	RetainerSize int64 `json:"retainerSize"`
+	// Permanent contains the size of the data of the components of the permanent storage (without compression).
+	Permanent map[string]int64 `json:"permanent"`
+	// Prunable contains the size of the data of the components of the prunable storage (without compression).
+	Prunable map[string]int64 `json:"prunable"`
+	// Indexer contains the size of the data of the realms of the indexer in the permanent storage (without compression).
+	Indexer map[string]int64 `json:"indexer"`
+	// Retainer contains the size of the data of the realms of the retainer (without compression).
+	Retainer map[string]int64 `json:"retainer"`
+	// PrunableBuckets contains the size on disk of the DB instances of the prunable storage (ordered from the latest to
+	// the oldest).
+	PrunableBuckets []*PrunableBucketUsage `json:"prunableBuckets"`
+	// ComputedAt is the unix timestamp at which the usage of the data of the components was computed.
+	ComputedAt int64 `json:"computedAt"`
+}
+
+// NewStorageUsageResponse returns a StorageUsageResponse from the given storage.Usage.
+func NewStorageUsageResponse(usage *storage.Usage, permanentSize, prunableSize, retainerSize int64) *StorageUsageResponse {
+	resp := &StorageUsageResponse{
+		PermanentSize:   permanentSize,
+		PrunableSize:    prunableSize,
+		RetainerSize:    retainerSize,
+		Permanent:       usage.Permanent,
+		Prunable:        usage.Prunable,
+		Indexer:         usage.Indexer,
+		Retainer:        usage.Retainer,
+		PrunableBuckets: make([]*PrunableBucketUsage, 0, len(usage.PrunableBuckets)),
+		ComputedAt:      usage.ComputedAt.Unix(),
+	}
+
+	for index, size := range usage.PrunableBuckets {
+		resp.PrunableBuckets = append(resp.PrunableBuckets, &PrunableBucketUsage{
+			StartEpoch: int64(index),
+			Size:       size,
+		})
+	}
+	sort.Slice(resp.PrunableBuckets, func(i, j int) bool {
+		return resp.PrunableBuckets[i].StartEpoch > resp.PrunableBuckets[j].StartEpoch
+	})
+
+	return resp
+}
+
+// PrunableBucketUsage represents the JSON model of the size of a DB instance of the prunable storage.
+type PrunableBucketUsage struct {
+	// StartEpoch is the first epoch that is stored in the DB instance.
+	StartEpoch int64 `json:"startEpoch"`
+	// Size is the size of the DB instance on disk.
+	Size int64 `json:"size"`
+}
+
+// StorageCompactionResponse is the response of the storage compaction endpoint.
+type StorageCompactionResponse struct {
+	// SizeBefore is the size of the storage and the retainer on disk before the compaction.
+	SizeBefore int64 `json:"sizeBefore"`
+	// SizeAfter is the size of the storage and the retainer on disk after the compaction.
+	SizeAfter int64 `json:"sizeAfter"`
+	// Duration is the duration of the compaction in milliseconds.
+	Duration int64 `json:"duration"`
+}
@@ -0,0 +1,128 @@
+package jsonmodels
+
+import (
+	"encoding/json"
+)
+
+// region Topics ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// TopicCommitments is the topic of newly committed epochs.
+	TopicCommitments = "commitments"
+
+	// TopicPrefixAddress is the prefix of the topics of the outputs of an address.
+	TopicPrefixAddress = "address/"
+
+	// TopicPrefixTransaction is the prefix of the topics of the confirmation state of a transaction.
+	TopicPrefixTransaction = "transaction/"
+
+	// TopicPrefixBlock is the prefix of the topics of the acceptance and confirmation of a block.
+	TopicPrefixBlock = "block/"
+)
+
+// AddressTopic returns the topic of the outputs that are created and spent for the given base58 encoded address.
+func AddressTopic(address string) string {
+	return TopicPrefixAddress + address
+}
+
+// TransactionTopic returns the topic of the confirmation state changes of the given base58 encoded transaction.
+func TransactionTopic(transactionID string) string {
+	return TopicPrefixTransaction + transactionID
+}
+
+// BlockTopic returns the topic of the acceptance and confirmation of the given base58 encoded block.
+func BlockTopic(blockID string) string {
+	return TopicPrefixBlock + blockID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SubscriptionRequest //////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// SubscriptionActionSubscribe is the action that adds topics to a subscription.
+	SubscriptionActionSubscribe = "subscribe"
+
+	// SubscriptionActionUnsubscribe is the action that removes topics from a subscription.
+	SubscriptionActionUnsubscribe = "unsubscribe"
+)
+
+// SubscriptionRequest is the message that a client sends to change the topics of its subscription.
+type SubscriptionRequest struct {
+	Action string   `json:"action"`
+	Topics []string `json:"topics"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SubscriptionEvent ////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// SubscriptionEventOutputBooked is the type of the event of an output that was booked for an address.
+	SubscriptionEventOutputBooked = "outputBooked"
+
+	// SubscriptionEventOutputAccepted is the type of the event of an output of an address whose transaction was
+	// accepted.
+	SubscriptionEventOutputAccepted = "outputAccepted"
+
+	// SubscriptionEventOutputRejected is the type of the event of an output of an address whose transaction was
+	// rejected.
+	SubscriptionEventOutputRejected = "outputRejected"
+
+	// SubscriptionEventOutputSpent is the type of the event of an output of an address that was spent by an accepted
+	// transaction.
+	SubscriptionEventOutputSpent = "outputSpent"
+
+	// SubscriptionEventTransactionState is the type of the event of a changed confirmation state of a transaction.
+	SubscriptionEventTransactionState = "transactionState"
+
+	// SubscriptionEventCommitment is the type of the event of a newly committed epoch.
+	SubscriptionEventCommitment = "commitment"
+
+	// SubscriptionEventBlockAccepted is the type of the event of an accepted block.
+	SubscriptionEventBlockAccepted = "blockAccepted"
+
+	// SubscriptionEventBlockConfirmed is the type of the event of a confirmed block.
+	SubscriptionEventBlockConfirmed = "blockConfirmed"
+
+	// SubscriptionEventError is the type of the event that informs the client about a failed request or a closed
+	// subscription.
+	SubscriptionEventError = "error"
+)
+
+// SubscriptionEvent is the message that is sent to a client for every event of the topics it subscribed to. Data
+// contains the JSON model that belongs to the type of the event.
+type SubscriptionEvent struct {
+	Topic string          `json:"topic,omitempty"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// OutputEvent is the data of the events of the outputs of an address.
+type OutputEvent struct {
+	Output *Output `json:"output"`
+}
+
+// TransactionStateEvent is the data of the event of a changed confirmation state of a transaction.
+type TransactionStateEvent struct {
+	TransactionID     string `json:"transactionID"`
+	ConfirmationState string `json:"confirmationState"`
+}
+
+// CommitmentEvent is the data of the event of a newly committed epoch.
+type CommitmentEvent struct {
+	Commitment                *EpochInfo `json:"commitment"`
+	AcceptedBlocksCount       int        `json:"acceptedBlocksCount"`
+	AcceptedTransactionsCount int        `json:"acceptedTransactionsCount"`
+	ActiveValidatorsCount     int        `json:"activeValidatorsCount"`
+}
+
+// BlockStateEvent is the data of the events of the acceptance and confirmation of a block.
+type BlockStateEvent struct {
+	BlockID   string `json:"blockID"`
+	Accepted  bool   `json:"accepted"`
+	Confirmed bool   `json:"confirmed"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
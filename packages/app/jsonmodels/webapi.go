@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/types/confirmation"
 
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
@@ -17,8 +18,9 @@ import (
 
 // GetAddressResponse represents the JSON model of a response from the GetAddress endpoint.
 type GetAddressResponse struct {
-	Address *Address  `json:"address"`
-	Outputs []*Output `json:"outputs"`
+	Address    *Address  `json:"address"`
+	Outputs    []*Output `json:"outputs"`
+	NextCursor string    `json:"nextCursor,omitempty"`
 }
 
 // NewGetAddressResponse returns a GetAddressResponse from the given details.
@@ -40,6 +42,44 @@ func NewGetAddressResponse(address devnetvm.Address, outputs devnetvm.Outputs) *
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetAddressSpentOutputsResponse ///////////////////////////////////////////////////////////////////////////////
+
+// GetAddressSpentOutputsResponse represents the JSON model of a response from the GetAddressSpentOutputs endpoint.
+type GetAddressSpentOutputsResponse struct {
+	Address      *Address       `json:"address"`
+	SpentOutputs []*SpentOutput `json:"spentOutputs"`
+	NextCursor   string         `json:"nextCursor,omitempty"`
+}
+
+// SpentOutput represents the JSON model of an entry of the spent output history of an address.
+type SpentOutput struct {
+	OutputID      string `json:"outputID"`
+	CreationEpoch int64  `json:"creationEpoch"`
+	SpentEpoch    int64  `json:"spentEpoch"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressTransactionsResponse ///////////////////////////////////////////////////////////////////////////////
+
+// GetAddressTransactionsResponse represents the JSON model of a response from the GetAddressTransactions endpoint.
+type GetAddressTransactionsResponse struct {
+	Address      *Address              `json:"address"`
+	Transactions []*AddressTransaction `json:"transactions"`
+	NextCursor   string                `json:"nextCursor,omitempty"`
+}
+
+// AddressTransaction represents the JSON model of an entry of the transaction history of an address.
+type AddressTransaction struct {
+	TransactionID     string             `json:"transactionID"`
+	InclusionEpoch    int64              `json:"inclusionEpoch"`
+	CreatedOutputs    bool               `json:"createdOutputs"`
+	SpentOutputs      bool               `json:"spentOutputs"`
+	ConfirmationState confirmation.State `json:"confirmationState"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostAddressesUnspentOutputsRequest
 
 // PostAddressesUnspentOutputsRequest is a the request object for the /ledgerstate/addresses/unspentOutputs endpoint.
@@ -223,6 +263,83 @@ type PostTransactionResponse struct {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region PostTransactionSimulate Req/Resp /////////////////////////////////////////////////////////////////////////////
+
+// Types of the TransactionValidationErrors returned by the PostTransactionSimulate endpoint.
+const (
+	// ValidationErrorUnsolidInput denotes an Input that references an Output that is unknown to the node.
+	ValidationErrorUnsolidInput = "unsolidInput"
+	// ValidationErrorBalances denotes that the consumed and created balances don't match.
+	ValidationErrorBalances = "balances"
+	// ValidationErrorUnlock denotes an UnlockBlock that doesn't authorize spending its Input.
+	ValidationErrorUnlock = "unlock"
+	// ValidationErrorAliasInitialState denotes an invalid initial state of a created alias.
+	ValidationErrorAliasInitialState = "aliasInitialState"
+	// ValidationErrorLedger denotes any other reason why the ledger rejects the transaction.
+	ValidationErrorLedger = "ledger"
+)
+
+// PostTransactionSimulateResponse is the HTTP response from simulating a transaction against the current ledger state.
+type PostTransactionSimulateResponse struct {
+	TransactionID             string                        `json:"transactionID,omitempty"`
+	Valid                     bool                          `json:"valid"`
+	Inputs                    []*SimulatedInput             `json:"inputs,omitempty"`
+	Outputs                   []*Output                     `json:"outputs,omitempty"`
+	Conflicting               bool                          `json:"conflicting"`
+	ConflictingTransactionIDs []string                      `json:"conflictingTransactionIDs,omitempty"`
+	ValidationErrors          []*TransactionValidationError `json:"validationErrors,omitempty"`
+	Error                     string                        `json:"error,omitempty"`
+}
+
+// SimulatedInput represents the JSON model of an Input of a simulated transaction together with the ledger state of
+// the Output that it references.
+type SimulatedInput struct {
+	Index     int             `json:"index"`
+	OutputID  *OutputID       `json:"outputID"`
+	Solid     bool            `json:"solid"`
+	Spent     bool            `json:"spent"`
+	Output    *Output         `json:"output,omitempty"`
+	Metadata  *OutputMetadata `json:"metadata,omitempty"`
+	Consumers []*Consumer     `json:"consumers,omitempty"`
+}
+
+// TransactionValidationError represents the JSON model of a reason why a simulated transaction is invalid.
+type TransactionValidationError struct {
+	Type       string `json:"type"`
+	InputIndex *int   `json:"inputIndex,omitempty"`
+	Error      string `json:"error"`
+}
+
+// NewTransactionValidationError returns a TransactionValidationError of the given type. The index of the Input that
+// caused it is optional.
+func NewTransactionValidationError(validationErrorType string, err error, optionalInputIndex ...int) *TransactionValidationError {
+	validationError := &TransactionValidationError{
+		Type:  validationErrorType,
+		Error: err.Error(),
+	}
+	if len(optionalInputIndex) > 0 {
+		validationError.InputIndex = &optionalInputIndex[0]
+	}
+
+	return validationError
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostRollbackRequest //////////////////////////////////////////////////////////////////////////////////////////
+
+// PostRollbackRequest represents the JSON model of a request that schedules a rollback of the ledger state.
+type PostRollbackRequest struct {
+	TargetEpoch int64 `json:"targetEpoch"`
+}
+
+// PostRollbackResponse represents the JSON model of a response from the PostRollback endpoint.
+type PostRollbackResponse struct {
+	TargetEpoch int64 `json:"targetEpoch"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region ErrorResponse ////////////////////////////////////////////////////////////////////////////////////////////////
 
 // ErrorResponse represents the JSON model of an error response from an API endpoint.
@@ -112,6 +112,18 @@ func (r *Retainer) DatabaseSize() int64 {
 	return r.dbManager.TotalStorageSize()
 }
 
+// Usage returns the size of the data of the retainer (by the names of its realms).
+func (r *Retainer) Usage() (usage map[string]int64, err error) {
+	return r.dbManager.PrunableRealmSizes(map[string]kvstore.Realm{
+		"blockMetadata": r.optsRealm,
+	})
+}
+
+// GC runs the garbage collection of the underlying databases if their provider requires it.
+func (r *Retainer) GC() error {
+	return r.dbManager.GC()
+}
+
 // WorkerPool returns the worker pool of the retainer.
 func (r *Retainer) WorkerPool() *workerpool.UnboundedWorkerPool {
 	return r.workerPool
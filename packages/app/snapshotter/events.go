@@ -0,0 +1,28 @@
+package snapshotter
+
+import (
+	"github.com/iotaledger/hive.go/core/generics/event"
+)
+
+// Events represents events happening on a Snapshotter.
+type Events struct {
+	// Triggered when a snapshot was written to the snapshot directory.
+	SnapshotWritten *event.Linkable[*SnapshotInfo]
+
+	// Triggered when a snapshot was removed from the snapshot directory because of the retention policy.
+	SnapshotRemoved *event.Linkable[*SnapshotInfo]
+
+	// Fired when an error occurred.
+	Error *event.Linkable[error]
+
+	event.LinkableCollection[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.LinkableConstructor(func() (newEvents *Events) {
+	return &Events{
+		SnapshotWritten: event.NewLinkable[*SnapshotInfo](),
+		SnapshotRemoved: event.NewLinkable[*SnapshotInfo](),
+		Error:           event.NewLinkable[error](),
+	}
+})
@@ -0,0 +1,347 @@
+package snapshotter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/workerpool"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/snapshot"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+)
+
+// ErrSnapshotNotFound is returned if the snapshot of an epoch is not available.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+const (
+	// fileNamePrefix is the prefix of the names of the snapshot files written by the Snapshotter.
+	fileNamePrefix = "snapshot-"
+
+	// fileNameSuffix is the suffix of the names of the snapshot files written by the Snapshotter.
+	fileNameSuffix = ".bin"
+)
+
+// region Snapshotter //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Snapshotter periodically writes snapshots of the committed epochs of the active engine to a directory and only
+// retains the most recent ones.
+type Snapshotter struct {
+	// Events contains the Events of the Snapshotter.
+	Events *Events
+
+	protocol   *protocol.Protocol
+	workerPool *workerpool.UnboundedWorkerPool
+	readers    map[string]int
+	mutex      sync.Mutex
+
+	optsDirectory string
+	optsInterval  epoch.Index
+	optsRetention int
+}
+
+// New creates a new Snapshotter that writes snapshots of the epochs committed by the given protocol.
+func New(protocol *protocol.Protocol, opts ...options.Option[Snapshotter]) (s *Snapshotter) {
+	return options.Apply(&Snapshotter{
+		Events:        NewEvents(),
+		protocol:      protocol,
+		workerPool:    workerpool.NewUnboundedWorkerPool(1),
+		readers:       make(map[string]int),
+		optsDirectory: "snapshots",
+		optsInterval:  360,
+		optsRetention: 3,
+	}, opts, (*Snapshotter).setupEvents)
+}
+
+// WriteSnapshot writes a snapshot of the given epoch to the snapshot directory and removes the snapshots that exceed
+// the retention limit. The file is written under a temporary name first, so that incomplete snapshots are never listed
+// (and the export does not block the listing and the downloads of the existing snapshots).
+func (s *Snapshotter) WriteSnapshot(index epoch.Index) (snapshotInfo *SnapshotInfo, err error) {
+	if err = os.MkdirAll(s.optsDirectory, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory '%s'", s.optsDirectory)
+	}
+
+	tempFile, err := os.CreateTemp(s.optsDirectory, "."+fileNamePrefix+"*.tmp")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary snapshot file")
+	}
+	defer func() {
+		if err != nil {
+			_ = tempFile.Close()
+			_ = os.Remove(tempFile.Name())
+		}
+	}()
+
+	if err = s.protocol.Engine().Export(tempFile, index); err != nil {
+		return nil, errors.Wrapf(err, "failed to export snapshot of epoch %d", index)
+	} else if err = tempFile.Sync(); err != nil {
+		return nil, errors.Wrap(err, "failed to sync snapshot file")
+	} else if err = tempFile.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close snapshot file")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filePath := s.filePath(index)
+	if err = os.Rename(tempFile.Name(), filePath); err != nil {
+		return nil, errors.Wrapf(err, "failed to move snapshot to '%s'", filePath)
+	}
+
+	if snapshotInfo, err = readSnapshotInfo(filePath, index); err != nil {
+		return nil, err
+	}
+	s.Events.SnapshotWritten.Trigger(snapshotInfo)
+
+	return snapshotInfo, s.prune()
+}
+
+// Snapshots returns the snapshots in the snapshot directory (ordered from the latest to the oldest epoch).
+func (s *Snapshotter) Snapshots() (snapshots []*SnapshotInfo, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.snapshots()
+}
+
+// Snapshot returns the snapshot of the given epoch (if it exists).
+func (s *Snapshotter) Snapshot(index epoch.Index) (snapshotInfo *SnapshotInfo, exists bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshotInfo, err := readSnapshotInfo(s.filePath(index), index)
+
+	return snapshotInfo, err == nil
+}
+
+// ReadSnapshot opens the snapshot of the given epoch and passes it to the given callback. The snapshot is not pruned
+// while the callback is running, so the file can be read in its entirety.
+func (s *Snapshotter) ReadSnapshot(index epoch.Index, callback func(snapshotInfo *SnapshotInfo, file *os.File) error) (err error) {
+	snapshotInfo, file, err := s.openSnapshot(index)
+	if err != nil {
+		return err
+	}
+	defer s.closeSnapshot(snapshotInfo, file)
+
+	return callback(snapshotInfo, file)
+}
+
+// WorkerPool returns the worker pool that is used to write the snapshots.
+func (s *Snapshotter) WorkerPool() *workerpool.UnboundedWorkerPool {
+	return s.workerPool
+}
+
+// Shutdown shuts down the Snapshotter and waits for a snapshot that is currently being written.
+func (s *Snapshotter) Shutdown() {
+	s.workerPool.Shutdown()
+	s.workerPool.ShutdownComplete.Wait()
+}
+
+func (s *Snapshotter) setupEvents() {
+	s.workerPool.Start()
+
+	s.protocol.Events.Engine.NotarizationManager.EpochCommitted.AttachWithWorkerPool(event.NewClosure(func(details *notarization.EpochCommittedDetails) {
+		if index := details.Commitment.Index(); s.optsInterval != 0 && index%s.optsInterval == 0 {
+			if _, err := s.WriteSnapshot(index); err != nil {
+				s.Events.Error.Trigger(errors.Wrapf(err, "failed to write snapshot of epoch %d", index))
+			}
+		}
+	}), s.workerPool)
+}
+
+// openSnapshot opens the snapshot of the given epoch and registers it as being read, so that it is not pruned before
+// closeSnapshot is called.
+func (s *Snapshotter) openSnapshot(index epoch.Index) (snapshotInfo *SnapshotInfo, file *os.File, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if snapshotInfo, err = readSnapshotInfo(s.filePath(index), index); err != nil {
+		return nil, nil, errors.WithMessagef(ErrSnapshotNotFound, "%s", err)
+	}
+
+	if file, err = os.Open(snapshotInfo.FilePath); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to open snapshot '%s'", snapshotInfo.FilePath)
+	}
+	s.readers[snapshotInfo.FilePath]++
+
+	return snapshotInfo, file, nil
+}
+
+// closeSnapshot closes a snapshot that was opened by openSnapshot and removes it if it was the last reader of a
+// snapshot that exceeds the retention limit in the meantime.
+func (s *Snapshotter) closeSnapshot(snapshotInfo *SnapshotInfo, file *os.File) {
+	_ = file.Close()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.readers[snapshotInfo.FilePath]--; s.readers[snapshotInfo.FilePath] > 0 {
+		return
+	}
+	delete(s.readers, snapshotInfo.FilePath)
+
+	if err := s.prune(); err != nil {
+		s.Events.Error.Trigger(errors.Wrap(err, "failed to prune snapshots"))
+	}
+}
+
+// prune removes the oldest snapshots until only the configured amount is retained. Snapshots that are currently being
+// read are skipped and removed by the last reader instead.
+func (s *Snapshotter) prune() (err error) {
+	snapshots, err := s.snapshots()
+	if err != nil {
+		return err
+	}
+
+	for len(snapshots) > s.optsRetention {
+		snapshotToRemove := snapshots[len(snapshots)-1]
+		snapshots = snapshots[:len(snapshots)-1]
+
+		if s.readers[snapshotToRemove.FilePath] > 0 {
+			continue
+		}
+
+		if err = os.Remove(snapshotToRemove.FilePath); err != nil {
+			return errors.Wrapf(err, "failed to remove snapshot '%s'", snapshotToRemove.FilePath)
+		}
+		s.Events.SnapshotRemoved.Trigger(snapshotToRemove)
+	}
+
+	return nil
+}
+
+func (s *Snapshotter) snapshots() (snapshots []*SnapshotInfo, err error) {
+	entries, err := os.ReadDir(s.optsDirectory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make([]*SnapshotInfo, 0), nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to read snapshot directory '%s'", s.optsDirectory)
+	}
+
+	snapshots = make([]*SnapshotInfo, 0)
+	for _, entry := range entries {
+		index, isSnapshot := indexFromFileName(entry.Name())
+		if !isSnapshot || entry.IsDir() {
+			continue
+		}
+
+		// a single broken file must not make the other snapshots unavailable
+		snapshotInfo, readErr := readSnapshotInfo(filepath.Join(s.optsDirectory, entry.Name()), index)
+		if readErr != nil {
+			s.Events.Error.Trigger(errors.Wrap(readErr, "skipped invalid snapshot"))
+			continue
+		}
+
+		snapshots = append(snapshots, snapshotInfo)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Index > snapshots[j].Index
+	})
+
+	return snapshots, nil
+}
+
+func (s *Snapshotter) filePath(index epoch.Index) string {
+	return filepath.Join(s.optsDirectory, fmt.Sprintf("%s%d%s", fileNamePrefix, index, fileNameSuffix))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SnapshotInfo /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SnapshotInfo contains the information about a snapshot file written by the Snapshotter.
+type SnapshotInfo struct {
+	Index        epoch.Index
+	CommitmentID commitment.ID
+	FilePath     string
+	Size         int64
+	ModTime      time.Time
+}
+
+// FileName returns the name of the snapshot file.
+func (s *SnapshotInfo) FileName() string {
+	return filepath.Base(s.FilePath)
+}
+
+// readSnapshotInfo reads the header of the snapshot file at the given path.
+func readSnapshotInfo(filePath string, index epoch.Index) (snapshotInfo *SnapshotInfo, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open snapshot '%s'", filePath)
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stat snapshot '%s'", filePath)
+	}
+
+	header, err := snapshot.ReadHeader(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read header of snapshot '%s'", filePath)
+	}
+
+	return &SnapshotInfo{
+		Index:        index,
+		CommitmentID: header.TargetCommitmentID,
+		FilePath:     filePath,
+		Size:         fileInfo.Size(),
+		ModTime:      fileInfo.ModTime(),
+	}, nil
+}
+
+// indexFromFileName returns the epoch index that is encoded in the name of a snapshot file.
+func indexFromFileName(fileName string) (index epoch.Index, isSnapshot bool) {
+	if !strings.HasPrefix(fileName, fileNamePrefix) || !strings.HasSuffix(fileName, fileNameSuffix) {
+		return 0, false
+	}
+
+	parsedIndex, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(fileName, fileNamePrefix), fileNameSuffix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return epoch.Index(parsedIndex), true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithDirectory sets the directory that the snapshots are written to.
+func WithDirectory(directory string) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsDirectory = directory
+	}
+}
+
+// WithInterval sets the amount of committed epochs after which a new snapshot is written (0 disables the automatic
+// snapshots).
+func WithInterval(interval epoch.Index) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsInterval = interval
+	}
+}
+
+// WithRetention sets the amount of snapshots that are retained.
+func WithRetention(retention int) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsRetention = retention
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
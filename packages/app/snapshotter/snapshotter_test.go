@@ -0,0 +1,91 @@
+package snapshotter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+)
+
+func TestSnapshotter(t *testing.T) {
+	tf := protocol.NewTestFramework(t)
+	tf.Protocol.Run()
+
+	directory := filepath.Join(t.TempDir(), "snapshots")
+	snapshotter := New(tf.Protocol, WithDirectory(directory), WithInterval(0), WithRetention(2))
+	defer snapshotter.Shutdown()
+
+	snapshots, err := snapshotter.Snapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	snapshotInfo, err := snapshotter.WriteSnapshot(0)
+	require.NoError(t, err)
+	require.Equal(t, epoch.Index(0), snapshotInfo.Index)
+	require.Equal(t, tf.Protocol.Engine().Storage.Settings.LatestCommitment().ID(), snapshotInfo.CommitmentID)
+	require.Equal(t, "snapshot-0.bin", snapshotInfo.FileName())
+
+	loadedSnapshotInfo, exists := snapshotter.Snapshot(0)
+	require.True(t, exists)
+	require.Equal(t, snapshotInfo.FilePath, loadedSnapshotInfo.FilePath)
+
+	_, exists = snapshotter.Snapshot(1)
+	require.False(t, exists)
+
+	require.NoError(t, snapshotter.ReadSnapshot(0, func(readSnapshotInfo *SnapshotInfo, file *os.File) error {
+		require.Equal(t, snapshotInfo.FilePath, readSnapshotInfo.FilePath)
+
+		fileInfo, err := file.Stat()
+		require.NoError(t, err)
+		require.Equal(t, snapshotInfo.Size, fileInfo.Size())
+
+		return nil
+	}))
+	require.ErrorIs(t, snapshotter.ReadSnapshot(1, func(*SnapshotInfo, *os.File) error { return nil }), ErrSnapshotNotFound)
+
+	// files that were not written by the snapshotter are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "snapshot-latest.bin"), []byte("foo"), 0o600))
+
+	// fake snapshots of later epochs so that the retention limit is exceeded
+	snapshotBytes, err := os.ReadFile(snapshotInfo.FilePath)
+	require.NoError(t, err)
+	for _, index := range []epoch.Index{1, 2} {
+		require.NoError(t, os.WriteFile(snapshotter.filePath(index), snapshotBytes, 0o600))
+	}
+
+	require.NoError(t, snapshotter.prune())
+
+	snapshots, err = snapshotter.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, epoch.Index(2), snapshots[0].Index)
+	require.Equal(t, epoch.Index(1), snapshots[1].Index)
+
+	_, exists = snapshotter.Snapshot(0)
+	require.False(t, exists)
+
+	// snapshots that are being read are only pruned after the last reader finished
+	require.NoError(t, snapshotter.ReadSnapshot(1, func(*SnapshotInfo, *os.File) error {
+		require.NoError(t, os.WriteFile(snapshotter.filePath(3), snapshotBytes, 0o600))
+		require.NoError(t, snapshotter.prune())
+
+		_, exists = snapshotter.Snapshot(1)
+		require.True(t, exists)
+
+		return nil
+	}))
+	_, exists = snapshotter.Snapshot(1)
+	require.False(t, exists)
+
+	// snapshots with an invalid header are skipped
+	require.NoError(t, os.WriteFile(snapshotter.filePath(4), []byte("foo"), 0o600))
+	snapshots, err = snapshotter.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, epoch.Index(3), snapshots[0].Index)
+	require.Equal(t, epoch.Index(2), snapshots[1].Index)
+}
@@ -24,8 +24,9 @@ const (
 // IssuePayloadFunc is a function which issues a payload.
 type IssuePayloadFunc = func(payload payload.Payload, parentsCount ...int) (*models.Block, error)
 
-// EstimateFunc returns the time estimate required for the block to be issued by the rate setter.
-type EstimateFunc = func() time.Duration
+// EstimateFunc returns the time estimate required for a block that requires the given amount of work to be issued by
+// the rate setter.
+type EstimateFunc = func(work int) time.Duration
 
 // Spammer spams blocks with a static data payload.
 type Spammer struct {
@@ -74,6 +75,7 @@ func (s *Spammer) run(rate int, payloadSize uint64, timeUnit time.Duration, imif
 	defer s.wg.Done()
 
 	dataPayload := payload.NewGenericDataPayload(make([]byte, payloadSize))
+	dataPayloadWork := models.PayloadWork(len(lo.PanicOnErr(dataPayload.Bytes())))
 
 	// create ticker with interval for default imif
 	ticker := time.NewTicker(timeUnit / time.Duration(rate))
@@ -85,7 +87,7 @@ func (s *Spammer) run(rate int, payloadSize uint64, timeUnit time.Duration, imif
 			return
 		case <-ticker.C:
 			// TODO: only sleep if estimate > some threshold.
-			for estimatedDuration := s.estimateFunc(); estimatedDuration > 0; estimatedDuration = s.estimateFunc() {
+			for estimatedDuration := s.estimateFunc(dataPayloadWork); estimatedDuration > 0; estimatedDuration = s.estimateFunc(dataPayloadWork) {
 				time.Sleep(lo.Min(estimatedDuration, time.Duration(rate)))
 			}
 
@@ -0,0 +1,296 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+var (
+	// ErrTooManySubscribers is returned if a Subscriber is created while the maximum amount of Subscribers is connected.
+	ErrTooManySubscribers = errors.New("too many subscribers")
+	// ErrTooManyTopics is returned if a Subscriber tries to subscribe to more topics than allowed.
+	ErrTooManyTopics = errors.New("too many topics")
+
+	// ErrSubscriberTooSlow is the reason of closing a Subscriber that does not consume its events fast enough.
+	ErrSubscriberTooSlow = errors.New("subscriber is too slow")
+
+	// ErrSubscriberClosed is returned if a closed Subscriber is used.
+	ErrSubscriberClosed = errors.New("subscriber is closed")
+)
+
+// region Hub //////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Hub distributes the events of topics to the Subscribers of these topics.
+type Hub struct {
+	subscribers     map[string]map[*Subscriber]types.Empty
+	subscriberCount int
+	mutex           sync.RWMutex
+
+	optsBufferSize     int
+	optsMaxTopics      int
+	optsMaxSubscribers int
+}
+
+// NewHub creates a new Hub.
+func NewHub(opts ...options.Option[Hub]) *Hub {
+	return options.Apply(&Hub{
+		subscribers: make(map[string]map[*Subscriber]types.Empty),
+
+		optsBufferSize:     1000,
+		optsMaxTopics:      100,
+		optsMaxSubscribers: 100,
+	}, opts)
+}
+
+// NewSubscriber creates a new Subscriber that is not subscribed to any topic, yet. It returns ErrTooManySubscribers if
+// the maximum amount of Subscribers is open (a Subscriber counts until it is closed).
+func (h *Hub) NewSubscriber() (subscriber *Subscriber, err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.subscriberCount >= h.optsMaxSubscribers {
+		return nil, errors.Wrapf(ErrTooManySubscribers, "at most %d subscribers can be connected", h.optsMaxSubscribers)
+	}
+	h.subscriberCount++
+
+	return &Subscriber{
+		hub:    h,
+		topics: make(map[string]types.Empty),
+		events: make(chan *jsonmodels.SubscriptionEvent, h.optsBufferSize),
+	}, nil
+}
+
+// HasSubscribers returns true if the given topic has at least one Subscriber (it allows publishers to skip the
+// creation of expensive events).
+func (h *Hub) HasSubscribers(topic string) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return len(h.subscribers[topic]) > 0
+}
+
+// Publish sends an event with the given type and data to all Subscribers of the given topic.
+func (h *Hub) Publish(topic, eventType string, data interface{}) (err error) {
+	subscribers := h.topicSubscribers(topic)
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	marshaledData, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal data of %s event of topic %s", eventType, topic)
+	}
+
+	event := &jsonmodels.SubscriptionEvent{
+		Topic: topic,
+		Type:  eventType,
+		Data:  marshaledData,
+	}
+
+	for _, subscriber := range subscribers {
+		subscriber.send(event)
+	}
+
+	return nil
+}
+
+// topicSubscribers returns a copy of the Subscribers of the given topic.
+func (h *Hub) topicSubscribers(topic string) (subscribers []*Subscriber) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	subscribers = make([]*Subscriber, 0, len(h.subscribers[topic]))
+	for subscriber := range h.subscribers[topic] {
+		subscribers = append(subscribers, subscriber)
+	}
+
+	return subscribers
+}
+
+func (h *Hub) subscribe(subscriber *Subscriber, topic string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	topicSubscribers, exists := h.subscribers[topic]
+	if !exists {
+		topicSubscribers = make(map[*Subscriber]types.Empty)
+		h.subscribers[topic] = topicSubscribers
+	}
+
+	topicSubscribers[subscriber] = types.Void
+}
+
+func (h *Hub) unsubscribe(subscriber *Subscriber, topic string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if topicSubscribers, exists := h.subscribers[topic]; exists {
+		if delete(topicSubscribers, subscriber); len(topicSubscribers) == 0 {
+			delete(h.subscribers, topic)
+		}
+	}
+}
+
+// releaseSubscriber frees the slot of a closed Subscriber.
+func (h *Hub) releaseSubscriber() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.subscriberCount--
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Subscriber ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Subscriber receives the events of the topics it is subscribed to.
+type Subscriber struct {
+	hub    *Hub
+	topics map[string]types.Empty
+	events chan *jsonmodels.SubscriptionEvent
+	closed bool
+	err    error
+	mutex  sync.Mutex
+}
+
+// Subscribe adds the given topics to the Subscriber.
+func (s *Subscriber) Subscribe(topics ...string) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return ErrSubscriberClosed
+	}
+
+	newTopics := make(map[string]types.Empty)
+	for _, topic := range topics {
+		if _, exists := s.topics[topic]; !exists {
+			newTopics[topic] = types.Void
+		}
+	}
+
+	if len(s.topics)+len(newTopics) > s.hub.optsMaxTopics {
+		return errors.Wrapf(ErrTooManyTopics, "a subscriber can subscribe to at most %d topics", s.hub.optsMaxTopics)
+	}
+
+	for topic := range newTopics {
+		s.topics[topic] = types.Void
+		s.hub.subscribe(s, topic)
+	}
+
+	return nil
+}
+
+// Unsubscribe removes the given topics from the Subscriber.
+func (s *Subscriber) Unsubscribe(topics ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, topic := range topics {
+		if _, exists := s.topics[topic]; exists {
+			delete(s.topics, topic)
+			s.hub.unsubscribe(s, topic)
+		}
+	}
+}
+
+// Topics returns the topics that the Subscriber is subscribed to.
+func (s *Subscriber) Topics() (topics []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	topics = make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
+
+// Events returns the channel of the events of the subscribed topics (it is closed when the Subscriber is closed).
+func (s *Subscriber) Events() <-chan *jsonmodels.SubscriptionEvent {
+	return s.events
+}
+
+// Err returns the reason why the Subscriber was closed by the Hub (nil if it was closed by calling Close).
+func (s *Subscriber) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.err
+}
+
+// Close unsubscribes the Subscriber from all topics and closes its event channel.
+func (s *Subscriber) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.close(nil)
+}
+
+// send queues the given event and closes the Subscriber if its buffer is full.
+func (s *Subscriber) send(event *jsonmodels.SubscriptionEvent) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.events <- event:
+	default:
+		s.close(ErrSubscriberTooSlow)
+	}
+}
+
+// close closes the Subscriber with the given reason (the mutex needs to be held by the caller).
+func (s *Subscriber) close(err error) {
+	if s.closed {
+		return
+	}
+
+	for topic := range s.topics {
+		s.hub.unsubscribe(s, topic)
+	}
+
+	s.topics = make(map[string]types.Empty)
+	s.closed = true
+	s.err = err
+	close(s.events)
+
+	s.hub.releaseSubscriber()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithBufferSize sets the amount of events that are buffered for a Subscriber before it is closed for being too slow.
+func WithBufferSize(bufferSize int) options.Option[Hub] {
+	return func(h *Hub) {
+		h.optsBufferSize = bufferSize
+	}
+}
+
+// WithMaxTopics sets the maximum amount of topics that a single Subscriber can subscribe to.
+func WithMaxTopics(maxTopics int) options.Option[Hub] {
+	return func(h *Hub) {
+		h.optsMaxTopics = maxTopics
+	}
+}
+
+// WithMaxSubscribers sets the maximum amount of Subscribers that can be open at the same time.
+func WithMaxSubscribers(maxSubscribers int) options.Option[Hub] {
+	return func(h *Hub) {
+		h.optsMaxSubscribers = maxSubscribers
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,110 @@
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+func TestHub_Publish(t *testing.T) {
+	hub := NewHub()
+
+	subscriber1 := newSubscriber(t, hub)
+	subscriber2 := newSubscriber(t, hub)
+	require.NoError(t, subscriber1.Subscribe("topic1", "topic2"))
+	require.NoError(t, subscriber2.Subscribe("topic2"))
+
+	assert.True(t, hub.HasSubscribers("topic1"))
+	assert.False(t, hub.HasSubscribers("topic3"))
+
+	require.NoError(t, hub.Publish("topic1", "type1", &jsonmodels.BlockStateEvent{BlockID: "block1", Accepted: true}))
+	require.NoError(t, hub.Publish("topic2", "type2", &jsonmodels.BlockStateEvent{BlockID: "block2"}))
+	require.NoError(t, hub.Publish("topic3", "type3", &jsonmodels.BlockStateEvent{BlockID: "block3"}))
+
+	event := <-subscriber1.Events()
+	assert.Equal(t, "topic1", event.Topic)
+	assert.Equal(t, "type1", event.Type)
+	assert.JSONEq(t, `{"blockID":"block1","accepted":true,"confirmed":false}`, string(event.Data))
+	assert.Equal(t, "topic2", (<-subscriber1.Events()).Topic)
+	assert.Equal(t, "topic2", (<-subscriber2.Events()).Topic)
+	assert.Empty(t, subscriber1.Events())
+	assert.Empty(t, subscriber2.Events())
+
+	// unsubscribed topics are no longer delivered
+	subscriber1.Unsubscribe("topic2")
+	require.NoError(t, hub.Publish("topic2", "type2", nil))
+	assert.Empty(t, subscriber1.Events())
+	assert.Len(t, subscriber2.Events(), 1)
+
+	// closed subscribers are removed from all topics
+	subscriber1.Close()
+	assert.False(t, hub.HasSubscribers("topic1"))
+	assert.NoError(t, subscriber1.Err())
+	assert.ErrorIs(t, subscriber1.Subscribe("topic1"), ErrSubscriberClosed)
+
+	_, open := <-subscriber1.Events()
+	assert.False(t, open)
+}
+
+func TestHub_MaxTopics(t *testing.T) {
+	subscriber := newSubscriber(t, NewHub(WithMaxTopics(2)))
+
+	require.NoError(t, subscriber.Subscribe("topic1", "topic2"))
+	require.NoError(t, subscriber.Subscribe("topic2"))
+	require.ErrorIs(t, subscriber.Subscribe("topic3"), ErrTooManyTopics)
+	assert.ElementsMatch(t, []string{"topic1", "topic2"}, subscriber.Topics())
+
+	subscriber.Unsubscribe("topic1")
+	require.NoError(t, subscriber.Subscribe("topic3"))
+	assert.ElementsMatch(t, []string{"topic2", "topic3"}, subscriber.Topics())
+}
+
+func TestHub_SlowSubscriber(t *testing.T) {
+	hub := NewHub(WithBufferSize(2))
+
+	subscriber := newSubscriber(t, hub)
+	require.NoError(t, subscriber.Subscribe("topic"))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, hub.Publish("topic", "type", i))
+	}
+
+	// the buffered events are still delivered before the channel is closed
+	assert.Equal(t, "0", string((<-subscriber.Events()).Data))
+	assert.Equal(t, "1", string((<-subscriber.Events()).Data))
+
+	_, open := <-subscriber.Events()
+	assert.False(t, open)
+	assert.ErrorIs(t, subscriber.Err(), ErrSubscriberTooSlow)
+	assert.False(t, hub.HasSubscribers("topic"))
+}
+
+func TestHub_MaxSubscribers(t *testing.T) {
+	hub := NewHub(WithMaxSubscribers(1), WithBufferSize(1))
+	subscriber := newSubscriber(t, hub)
+
+	_, err := hub.NewSubscriber()
+	require.ErrorIs(t, err, ErrTooManySubscribers)
+
+	// closed subscribers free their slot, no matter if they were closed by the client or for being too slow
+	subscriber.Close()
+	subscriber = newSubscriber(t, hub)
+	require.NoError(t, subscriber.Subscribe("topic"))
+	for i := 0; i < 2; i++ {
+		require.NoError(t, hub.Publish("topic", "type", i))
+	}
+	require.ErrorIs(t, subscriber.Err(), ErrSubscriberTooSlow)
+
+	newSubscriber(t, hub)
+}
+
+// newSubscriber creates a new Subscriber of the given Hub.
+func newSubscriber(t *testing.T, hub *Hub) (subscriber *Subscriber) {
+	subscriber, err := hub.NewSubscriber()
+	require.NoError(t, err)
+
+	return subscriber
+}
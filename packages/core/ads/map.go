@@ -125,6 +125,21 @@ func (m *Map[K, V, KPtr, VPtr]) Get(key K) (value VPtr, exists bool) {
 	return value, true
 }
 
+// Proof returns a merkle proof for the inclusion (or exclusion) of the key in the map.
+func (m *Map[K, V, KPtr, VPtr]) Proof(key K) (proof *Proof, err error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	keyBytes := lo.PanicOnErr(key.Bytes())
+
+	valueBytes, err := m.tree.Get(keyBytes)
+	if err != nil && !errors.Is(err, kvstore.ErrKeyNotFound) {
+		return nil, errors.Wrapf(err, "failed to get value for key %s", keyBytes)
+	}
+
+	return newProof(m.tree, keyBytes, valueBytes)
+}
+
 // Stream streams all the keys and values.
 func (m *Map[K, V, KPtr, VPtr]) Stream(callback func(key K, value VPtr) bool) (err error) {
 	m.mutex.Lock()
@@ -0,0 +1,76 @@
+package ads
+
+import (
+	"bytes"
+
+	"github.com/celestiaorg/smt"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Proof is a merkle proof for the inclusion (or exclusion) of a key in a sparse merkle tree based data structure.
+type Proof struct {
+	// Key is the serialized key that the proof is about.
+	Key []byte `serix:"0,lengthPrefixType=uint32"`
+
+	// Value is the serialized value that is stored for the key (empty for proofs of exclusion).
+	Value []byte `serix:"1,lengthPrefixType=uint32"`
+
+	// SideNodes are the sibling nodes on the path from the leaf to the root.
+	SideNodes [][]byte `serix:"2,lengthPrefixType=uint32"`
+
+	// NonMembershipLeafData is the data of the unrelated leaf at the position of the key (for proofs of exclusion).
+	NonMembershipLeafData []byte `serix:"3,lengthPrefixType=uint32"`
+
+	// SiblingData is the data of the sibling node of the leaf.
+	SiblingData []byte `serix:"4,lengthPrefixType=uint32"`
+}
+
+// newProof creates a new Proof for the given key and value from the given tree.
+func newProof(tree *smt.SparseMerkleTree, key, value []byte) (proof *Proof, err error) {
+	merkleProof, err := tree.Prove(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate proof for key %s", key)
+	}
+
+	return &Proof{
+		Key:                   key,
+		Value:                 value,
+		SideNodes:             merkleProof.SideNodes,
+		NonMembershipLeafData: merkleProof.NonMembershipLeafData,
+		SiblingData:           merkleProof.SiblingData,
+	}, nil
+}
+
+// IsInclusionProof returns true if the Proof proves the inclusion of its key (instead of its exclusion).
+func (p *Proof) IsInclusionProof() (isInclusionProof bool) {
+	return len(p.Value) != 0
+}
+
+// Verify checks if the Proof is valid for the given root.
+func (p *Proof) Verify(root types.Identifier) (valid bool) {
+	return smt.VerifyProof(smt.SparseMerkleProof{
+		SideNodes:             p.SideNodes,
+		NonMembershipLeafData: p.NonMembershipLeafData,
+		SiblingData:           p.SiblingData,
+	}, root[:], p.Key, p.Value, lo.PanicOnErr(blake2b.New256(nil)))
+}
+
+// VerifyInclusion checks if the Proof proves the inclusion of the given key in the tree with the given root.
+func (p *Proof) VerifyInclusion(root types.Identifier, key []byte) (err error) {
+	if !bytes.Equal(p.Key, key) {
+		return errors.Errorf("proof is about key %s instead of %s", p.Key, key)
+	}
+
+	if !p.IsInclusionProof() {
+		return errors.Errorf("proof is not an inclusion proof")
+	}
+
+	if !p.Verify(root) {
+		return errors.Errorf("proof is invalid for root %s", root)
+	}
+
+	return nil
+}
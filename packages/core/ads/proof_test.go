@@ -0,0 +1,60 @@
+package ads
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet_Proof(t *testing.T) {
+	set := NewSet[testKey](mapdb.NewMapDB())
+
+	included := newTestKey("included")
+	excluded := newTestKey("excluded")
+	set.Add(included)
+	set.Add(newTestKey("other"))
+
+	inclusionProof := lo.PanicOnErr(set.Proof(included))
+	require.True(t, inclusionProof.IsInclusionProof())
+	require.NoError(t, inclusionProof.VerifyInclusion(set.Root(), lo.PanicOnErr(included.Bytes())))
+	require.Error(t, inclusionProof.VerifyInclusion(set.Root(), lo.PanicOnErr(excluded.Bytes())))
+	require.Error(t, inclusionProof.VerifyInclusion(types.Identifier{}, lo.PanicOnErr(included.Bytes())))
+
+	exclusionProof := lo.PanicOnErr(set.Proof(excluded))
+	require.False(t, exclusionProof.IsInclusionProof())
+	require.True(t, exclusionProof.Verify(set.Root()))
+	require.Error(t, exclusionProof.VerifyInclusion(set.Root(), lo.PanicOnErr(excluded.Bytes())))
+}
+
+func TestMap_Proof(t *testing.T) {
+	adsMap := NewMap[testKey, testKey](mapdb.NewMapDB())
+
+	key := newTestKey("key")
+	value := newTestKey("value")
+	adsMap.Set(key, &value)
+
+	proof := lo.PanicOnErr(adsMap.Proof(key))
+	require.Equal(t, lo.PanicOnErr(value.Bytes()), proof.Value)
+	require.NoError(t, proof.VerifyInclusion(adsMap.Root(), lo.PanicOnErr(key.Bytes())))
+
+	proof.Value = lo.PanicOnErr(newTestKey("forged").Bytes())
+	require.False(t, proof.Verify(adsMap.Root()))
+}
+
+// testKey is a simple serializable type that is used as key and value in the tests.
+type testKey types.Identifier
+
+func newTestKey(name string) testKey {
+	return testKey(types.NewIdentifier([]byte(name)))
+}
+
+func (t testKey) Bytes() (bytes []byte, err error) {
+	return types.Identifier(t).Bytes(), nil
+}
+
+func (t *testKey) FromBytes(bytes []byte) (consumedBytes int, err error) {
+	return copy(t[:], bytes), nil
+}
@@ -121,6 +121,25 @@ func (s *Set[K, KPtr]) Has(key K) (has bool) {
 	return s.has(lo.PanicOnErr(KPtr(&key).Bytes()))
 }
 
+// Proof returns a merkle proof for the inclusion (or exclusion) of the key in the set.
+func (s *Set[K, KPtr]) Proof(key K) (proof *Proof, err error) {
+	if s == nil {
+		return nil, errors.Errorf("cannot generate proof for nil set")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keyBytes := lo.PanicOnErr(KPtr(&key).Bytes())
+
+	var value []byte
+	if s.has(keyBytes) {
+		value = []byte{nonEmptyLeaf}
+	}
+
+	return newProof(s.tree, keyBytes, value)
+}
+
 // Stream iterates over the set and calls the callback for each element.
 func (s *Set[K, KPtr]) Stream(callback func(key K) bool) (err error) {
 	if s == nil {
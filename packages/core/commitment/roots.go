@@ -14,25 +14,26 @@ type Roots struct {
 type roots struct {
 	TangleRoot        types.Identifier `serix:"0"`
 	StateMutationRoot types.Identifier `serix:"1"`
-	ActivityRoot      types.Identifier `serix:"4"`
+	AttestationsRoot  types.Identifier `serix:"4"`
 	StateRoot         types.Identifier `serix:"2"`
 	ManaRoot          types.Identifier `serix:"3"`
 }
 
-func NewRoots(tangleRoot, stateMutationRoot, activityRoot, stateRoot, manaRoot types.Identifier) (newRoots *Roots) {
+func NewRoots(tangleRoot, stateMutationRoot, attestationsRoot, stateRoot, manaRoot types.Identifier) (newRoots *Roots) {
 	return model.NewImmutable[Roots](&roots{
 		TangleRoot:        tangleRoot,
 		StateMutationRoot: stateMutationRoot,
-		ActivityRoot:      activityRoot,
+		AttestationsRoot:  attestationsRoot,
 		StateRoot:         stateRoot,
 		ManaRoot:          manaRoot,
 	})
 }
 
+// ID returns the identifier of the Roots that commits to all of its roots (including the attestations of the epoch).
 func (r *Roots) ID() (id types.Identifier) {
 	branch1Hashed := blake2b.Sum256(byteutils.ConcatBytes(r.M.TangleRoot.Bytes(), r.M.StateMutationRoot.Bytes()))
 	branch2Hashed := blake2b.Sum256(byteutils.ConcatBytes(r.M.StateRoot.Bytes(), r.M.ManaRoot.Bytes()))
-	rootHashed := blake2b.Sum256(byteutils.ConcatBytes(branch1Hashed[:], branch2Hashed[:]))
+	rootHashed := blake2b.Sum256(byteutils.ConcatBytes(branch1Hashed[:], branch2Hashed[:], r.M.AttestationsRoot.Bytes()))
 
 	return rootHashed
 }
@@ -53,6 +54,6 @@ func (r *Roots) ManaRoot() (manaRoot types.Identifier) {
 	return r.M.ManaRoot
 }
 
-func (r *Roots) ActivityRoot() (activityRoot types.Identifier) {
-	return r.M.ActivityRoot
+func (r *Roots) AttestationsRoot() (attestationsRoot types.Identifier) {
+	return r.M.AttestationsRoot
 }
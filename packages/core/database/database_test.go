@@ -0,0 +1,197 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/core/database"
+	"github.com/iotaledger/hive.go/core/kvstore"
+)
+
+// testProviders contains the DBProviders that the conformance tests are run against.
+var testProviders = map[string]struct {
+	provider   DBProvider
+	persistent bool
+}{
+	"mapdb":   {provider: NewMemDB},
+	"rocksdb": {provider: NewDB, persistent: true},
+	"pebble":  {provider: NewPebbleDB, persistent: true},
+}
+
+// forEachProvider runs the given test against all (persistent) providers that are available in the current build.
+func forEachProvider(t *testing.T, persistentOnly bool, test func(t *testing.T, provider DBProvider)) {
+	names := make([]string, 0, len(testProviders))
+	for name := range testProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		testProvider := testProviders[name]
+		if persistentOnly && !testProvider.persistent {
+			continue
+		}
+
+		t.Run(name, func(t *testing.T) {
+			if !providerAvailable(t, testProvider.provider) {
+				t.Skipf("database provider %s is not included in this build", name)
+			}
+
+			test(t, testProvider.provider)
+		})
+	}
+}
+
+// providerAvailable checks if the provider can be used (i.e. RocksDB requires to be built with '-tags rocksdb').
+func providerAvailable(t *testing.T, provider DBProvider) (available bool) {
+	defer func() {
+		if recover() != nil {
+			available = false
+		}
+	}()
+
+	db, err := provider(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	return true
+}
+
+func TestDB_Conformance(t *testing.T) {
+	forEachProvider(t, false, func(t *testing.T, provider DBProvider) {
+		db, err := provider(t.TempDir())
+		require.NoError(t, err)
+		defer db.Close()
+
+		store := db.NewStore()
+
+		// single entries
+		{
+			require.NoError(t, store.Set([]byte("key1"), []byte("value1")))
+
+			value, err := store.Get([]byte("key1"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("value1"), value)
+
+			has, err := store.Has([]byte("key1"))
+			require.NoError(t, err)
+			require.True(t, has)
+
+			require.NoError(t, store.Delete([]byte("key1")))
+
+			_, err = store.Get([]byte("key1"))
+			require.ErrorIs(t, err, kvstore.ErrKeyNotFound)
+		}
+
+		// realms are isolated from each other
+		realmA, err := store.WithExtendedRealm(kvstore.Realm("a"))
+		require.NoError(t, err)
+		realmB, err := store.WithExtendedRealm(kvstore.Realm("b"))
+		require.NoError(t, err)
+		{
+			for _, key := range []string{"key1", "key2", "key3"} {
+				require.NoError(t, realmA.Set([]byte(key), []byte("a-"+key)))
+			}
+			require.NoError(t, realmB.Set([]byte("key1"), []byte("b-key1")))
+
+			require.ElementsMatch(t, []string{"key1", "key2", "key3"}, collectKeys(t, realmA, kvstore.EmptyPrefix))
+			require.ElementsMatch(t, []string{"key1"}, collectKeys(t, realmB, kvstore.EmptyPrefix))
+
+			value, err := realmB.Get([]byte("key1"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("b-key1"), value)
+		}
+
+		// batched mutations are applied on commit
+		{
+			batch, err := realmA.Batched()
+			require.NoError(t, err)
+			require.NoError(t, batch.Set([]byte("key4"), []byte("a-key4")))
+			require.NoError(t, batch.Delete([]byte("key1")))
+			require.NoError(t, batch.Commit())
+
+			require.ElementsMatch(t, []string{"key2", "key3", "key4"}, collectKeys(t, realmA, kvstore.EmptyPrefix))
+		}
+
+		// prefixes can be iterated and deleted
+		{
+			require.NoError(t, realmA.Set([]byte("other"), []byte("a-other")))
+			require.ElementsMatch(t, []string{"key2", "key3", "key4"}, collectKeys(t, realmA, []byte("key")))
+
+			require.NoError(t, realmA.DeletePrefix([]byte("key")))
+			require.ElementsMatch(t, []string{"other"}, collectKeys(t, realmA, kvstore.EmptyPrefix))
+			require.ElementsMatch(t, []string{"key1"}, collectKeys(t, realmB, kvstore.EmptyPrefix))
+
+			require.NoError(t, realmB.Clear())
+			require.Empty(t, collectKeys(t, realmB, kvstore.EmptyPrefix))
+		}
+
+		require.NoError(t, store.Flush())
+
+		if db.RequiresGC() {
+			require.NoError(t, db.GC())
+		}
+		require.ElementsMatch(t, []string{"other"}, collectKeys(t, realmA, kvstore.EmptyPrefix))
+	})
+}
+
+func TestDB_Persistence(t *testing.T) {
+	forEachProvider(t, true, func(t *testing.T, provider DBProvider) {
+		directory := t.TempDir()
+
+		db, err := provider(directory)
+		require.NoError(t, err)
+		require.NoError(t, db.NewStore().Set([]byte("key"), []byte("value")))
+		require.NoError(t, db.Close())
+
+		db, err = provider(directory)
+		require.NoError(t, err)
+		defer db.Close()
+
+		value, err := db.NewStore().Get([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+	})
+}
+
+func TestDB_OpenError(t *testing.T) {
+	forEachProvider(t, true, func(t *testing.T, provider DBProvider) {
+		// a regular file can not be opened as a database
+		filePath := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(filePath, []byte("foo"), 0o600))
+
+		db, err := provider(filePath)
+		require.Error(t, err)
+		require.Nil(t, db)
+	})
+}
+
+func TestDBProviderFromEngine(t *testing.T) {
+	directory := t.TempDir()
+
+	provider, err := DBProviderFromEngine(hivedb.EnginePebble, directory)
+	require.NoError(t, err)
+	require.NotNil(t, provider)
+
+	// the directory is marked with the engine that was used to create it
+	_, err = DBProviderFromEngine(hivedb.EngineRocksDB, directory)
+	require.Error(t, err)
+
+	_, err = DBProviderFromEngine(hivedb.EnginePebble, directory)
+	require.NoError(t, err)
+}
+
+func collectKeys(t *testing.T, store kvstore.KVStore, prefix kvstore.KeyPrefix) (keys []string) {
+	keys = make([]string, 0)
+	require.NoError(t, store.IterateKeys(prefix, func(key kvstore.Key) bool {
+		keys = append(keys, string(key))
+		return true
+	}))
+
+	return keys
+}
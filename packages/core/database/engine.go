@@ -0,0 +1,34 @@
+package database
+
+import (
+	"github.com/pkg/errors"
+
+	hivedb "github.com/iotaledger/hive.go/core/database"
+)
+
+// Engines contains the database engines that can be used to store the data of a node.
+var Engines = []hivedb.Engine{
+	hivedb.EngineMapDB,
+	hivedb.EngineRocksDB,
+	hivedb.EnginePebble,
+}
+
+// DBProviderFromEngine returns the DBProvider that creates DB instances of the given engine. If a database already
+// exists in the given directory, it makes sure that it was created with the same engine (databases that are created
+// from now on are marked with the engine that they use).
+func DBProviderFromEngine(engine hivedb.Engine, directory string) (provider DBProvider, err error) {
+	if engine, err = hivedb.CheckEngine(directory, true, engine, Engines...); err != nil {
+		return nil, errors.Wrapf(err, "failed to check database engine of '%s'", directory)
+	}
+
+	switch engine {
+	case hivedb.EngineMapDB:
+		return NewMemDB, nil
+	case hivedb.EngineRocksDB:
+		return NewDB, nil
+	case hivedb.EnginePebble:
+		return NewPebbleDB, nil
+	default:
+		return nil, errors.Errorf("unsupported database engine '%s'", engine)
+	}
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -23,6 +24,9 @@ import (
 // region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type Manager struct {
+	version Version
+
+	permanentDB      DB
 	permanentStorage kvstore.KVStore
 	permanentBaseDir string
 
@@ -43,6 +47,7 @@ type Manager struct {
 
 func NewManager(version Version, opts ...options.Option[Manager]) *Manager {
 	m := options.Apply(&Manager{
+		version:         version,
 		maxPruned:       -1,
 		optsGranularity: 10,
 		optsBaseDir:     "db",
@@ -55,11 +60,12 @@ func NewManager(version Version, opts ...options.Option[Manager]) *Manager {
 		if err != nil {
 			panic(err)
 		}
+		m.permanentDB = db
 		m.permanentStorage = db.NewStore()
 
 		m.openDBs = cache.New[epoch.Index, *dbInstance](m.optsMaxOpenDBs)
 		m.openDBs.SetEvictCallback(func(baseIndex epoch.Index, db *dbInstance) {
-			err := db.instance.Close()
+			err := db.close()
 			if err != nil {
 				panic(err)
 			}
@@ -105,6 +111,11 @@ func (m *Manager) checkVersion(version Version) error {
 	return nil
 }
 
+// Version returns the database schema version of the Manager.
+func (m *Manager) Version() Version {
+	return m.version
+}
+
 func (m *Manager) PermanentStorage() kvstore.KVStore {
 	return m.permanentStorage
 }
@@ -112,7 +123,10 @@ func (m *Manager) PermanentStorage() kvstore.KVStore {
 func (m *Manager) RestoreFromDisk() (latestBucketIndex epoch.Index) {
 	dbInfos := getSortedDBInstancesFromDisk(m.bucketedBaseDir)
 
-	// TODO: what to do if dbInfos is empty? -> start with a fresh DB?
+	// nothing was persisted yet, so we start with a fresh DB where nothing was pruned
+	if len(dbInfos) == 0 {
+		return 0
+	}
 
 	for _, dbInfo := range dbInfos {
 		size, err := dbPrunableDirectorySize(m.bucketedBaseDir, dbInfo.baseIndex)
@@ -144,6 +158,19 @@ func (m *Manager) RestoreFromDisk() (latestBucketIndex epoch.Index) {
 	return 0
 }
 
+// PrunableDBBaseIndexes returns the base indexes of the DB instances of the prunable storage that exist on disk (ordered
+// from the latest to the oldest) without opening them.
+func (m *Manager) PrunableDBBaseIndexes() (baseIndexes []epoch.Index) {
+	return lo.Map(getSortedDBInstancesFromDisk(m.bucketedBaseDir), func(dbInfo *dbInstanceFileInfo) epoch.Index {
+		return dbInfo.baseIndex
+	})
+}
+
+// Granularity returns how many buckets/epochs are stored in one DB instance of the prunable storage.
+func (m *Manager) Granularity() int64 {
+	return m.optsGranularity
+}
+
 func (m *Manager) MaxPrunedEpoch() epoch.Index {
 	m.maxPrunedMutex.RLock()
 	defer m.maxPrunedMutex.RUnlock()
@@ -222,7 +249,7 @@ func (m *Manager) Shutdown() {
 	defer m.openDBsMutex.Unlock()
 
 	m.openDBs.Each(func(index epoch.Index, db *dbInstance) {
-		err := db.instance.Close()
+		err := db.close()
 		if err != nil {
 			panic(err)
 		}
@@ -274,6 +301,96 @@ func (m *Manager) PrunableStorageSize() int64 {
 	return sum
 }
 
+// PrunableStorageSizes returns the size on disk of each DB instance of the prunable storage (by its base index).
+func (m *Manager) PrunableStorageSizes() (sizes map[epoch.Index]int64) {
+	sizes = make(map[epoch.Index]int64)
+	for _, baseIndex := range m.PrunableDBBaseIndexes() {
+		size, err := dbPrunableDirectorySize(m.bucketedBaseDir, baseIndex)
+		if err != nil {
+			// the DB instance was pruned in the meantime
+			continue
+		}
+		sizes[baseIndex] = size
+	}
+
+	return sizes
+}
+
+// PermanentPrefixSizes returns the size of the keys and values of the permanent storage grouped by the first byte of
+// their keys (the prefix of the realm of the component that stored them).
+func (m *Manager) PermanentPrefixSizes() (sizes map[byte]int64, err error) {
+	return PrefixSizes(m.permanentStorage)
+}
+
+// PrunablePrefixSizes returns the size of the keys and values in the buckets of the prunable storage grouped by the
+// first byte of the realm that they were stored in (the prefix of the realm of the component that stored them).
+func (m *Manager) PrunablePrefixSizes() (sizes map[byte]int64, err error) {
+	sizes = make(map[byte]int64)
+
+	return sizes, m.forEachDBInstance(func(db *dbInstance) error {
+		return addPrefixSizes(sizes, db.store, len(indexToRealm(db.index)))
+	})
+}
+
+// PrunableRealmSizes returns the size of the keys and values in the buckets of the prunable storage that were stored in
+// the given realms (by the names of the realms). The size of the data outside the given realms is reported as "other".
+func (m *Manager) PrunableRealmSizes(realms map[string]kvstore.Realm) (sizes map[string]int64, err error) {
+	sizes = make(map[string]int64)
+
+	return sizes, m.forEachDBInstance(func(db *dbInstance) error {
+		bucketRealmLength := len(indexToRealm(db.index))
+
+		return db.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+			if len(key) <= bucketRealmLength {
+				return true
+			}
+
+			realmName := "other"
+			for name, realm := range realms {
+				if bytes.HasPrefix(key[bucketRealmLength:], realm) {
+					realmName = name
+					break
+				}
+			}
+			sizes[realmName] += int64(len(key) + len(value))
+
+			return true
+		})
+	})
+}
+
+// DeleteAfterEpoch deletes the buckets of all epochs after the given one from the prunable storage (i.e. to discard the
+// data of epochs that were rolled back).
+func (m *Manager) DeleteAfterEpoch(index epoch.Index) (err error) {
+	return m.forEachDBInstance(func(db *dbInstance) error {
+		for bucketIndex := lo.Max(db.index, index+1); bucketIndex < db.index+epoch.Index(m.optsGranularity); bucketIndex++ {
+			if err = m.createBucket(db, bucketIndex).Clear(); err != nil {
+				return errors.Wrapf(err, "failed to delete bucket of epoch %d", bucketIndex)
+			}
+		}
+
+		return errors.Wrapf(db.store.Flush(), "failed to flush DB instance %d", db.index)
+	})
+}
+
+// GC runs the garbage collection of the permanent storage and of all DB instances of the prunable storage whose
+// providers require it (i.e. to reclaim the disk space of deleted items).
+func (m *Manager) GC() (err error) {
+	if m.permanentDB.RequiresGC() {
+		if err = m.permanentDB.GC(); err != nil {
+			return errors.Wrap(err, "failed to run garbage collection of permanent storage")
+		}
+	}
+
+	return m.forEachDBInstance(func(db *dbInstance) error {
+		if !db.instance.RequiresGC() {
+			return nil
+		}
+
+		return errors.Wrapf(db.instance.GC(), "failed to run garbage collection of DB instance %d", db.index)
+	})
+}
+
 // getDBInstance returns the DB instance for the given baseIndex or creates a new one if it does not yet exist.
 // DBs are created as follows where each db is located in m.basedir/<starting baseIndex>/
 // (assuming a bucket granularity=2):
@@ -356,7 +473,7 @@ func (m *Manager) removeDBInstance(dbBaseIndex epoch.Index) {
 
 	db, exists := m.openDBs.Get(dbBaseIndex)
 	if exists {
-		err := db.instance.Close()
+		err := db.close()
 		if err != nil {
 			panic(err)
 		}
@@ -371,6 +488,59 @@ func (m *Manager) removeDBInstance(dbBaseIndex epoch.Index) {
 	m.dbSizes.Delete(dbBaseIndex)
 }
 
+// forEachDBInstance calls the callback for every DB instance of the prunable storage that exists on disk. DB instances
+// are opened (if necessary) and locked so that they are not closed (evicted or pruned) while the callback is running.
+func (m *Manager) forEachDBInstance(callback func(db *dbInstance) error) (err error) {
+	for _, baseIndex := range m.PrunableDBBaseIndexes() {
+		if err = m.withDBInstance(baseIndex, callback); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withDBInstance calls the callback with the DB instance of the given base index (if it was not pruned in the meantime).
+// The callback is executed without holding the openDBsMutex, so that long-running operations do not block the access to
+// the other DB instances.
+func (m *Manager) withDBInstance(baseIndex epoch.Index, callback func(db *dbInstance) error) (err error) {
+	db, err := m.lockDBInstance(baseIndex)
+	if err != nil || db == nil {
+		return err
+	}
+	defer db.closeMutex.RUnlock()
+
+	return callback(db)
+}
+
+// lockDBInstance returns the DB instance of the given base index (opening it if necessary) with a read lock that prevents
+// it from being closed. It returns nil if the DB instance does not exist on disk.
+func (m *Manager) lockDBInstance(baseIndex epoch.Index) (db *dbInstance, err error) {
+	m.openDBsMutex.Lock()
+	defer m.openDBsMutex.Unlock()
+
+	db, exists := m.openDBs.Get(baseIndex)
+	if !exists {
+		if _, err = os.Stat(dbPathFromIndex(m.bucketedBaseDir, baseIndex)); err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+
+			return nil, errors.Wrapf(err, "failed to access DB instance %d", baseIndex)
+		}
+
+		db = m.createDBInstance(baseIndex)
+
+		// Remove the cached db size since we will open the db
+		m.dbSizes.Delete(baseIndex)
+		m.openDBs.Put(baseIndex, db)
+	}
+
+	db.closeMutex.RLock()
+
+	return db, nil
+}
+
 func (m *Manager) removeBucket(bucket kvstore.KVStore) {
 	err := bucket.Clear()
 	if err != nil {
@@ -419,9 +589,18 @@ func WithMaxOpenDBs(optsMaxOpenDBs int) options.Option[Manager] {
 type DBProvider func(dirname string) (DB, error)
 
 type dbInstance struct {
-	index    epoch.Index
-	instance DB              // actual DB instance on disk within folder index
-	store    kvstore.KVStore // KVStore that is used to access the DB instance
+	index      epoch.Index
+	instance   DB              // actual DB instance on disk within folder index
+	store      kvstore.KVStore // KVStore that is used to access the DB instance
+	closeMutex sync.RWMutex    // prevents that the DB instance is closed while it is used outside the openDBsMutex
+}
+
+// close closes the DB instance once it is no longer used outside the openDBsMutex.
+func (d *dbInstance) close() error {
+	d.closeMutex.Lock()
+	defer d.closeMutex.Unlock()
+
+	return d.instance.Close()
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -458,6 +637,10 @@ type dbInstanceFileInfo struct {
 func getSortedDBInstancesFromDisk(baseDir string) (dbInfos []*dbInstanceFileInfo) {
 	files, err := os.ReadDir(baseDir)
 	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
 		panic(err)
 	}
 
@@ -481,6 +664,25 @@ func getSortedDBInstancesFromDisk(baseDir string) (dbInfos []*dbInstanceFileInfo
 	return dbInfos
 }
 
+// PrefixSizes returns the size of the keys and values of the given store grouped by the first byte of their keys.
+func PrefixSizes(store kvstore.KVStore) (sizes map[byte]int64, err error) {
+	sizes = make(map[byte]int64)
+
+	return sizes, addPrefixSizes(sizes, store, 0)
+}
+
+// addPrefixSizes adds the size of the keys and values of the given store to the given sizes (grouped by the byte that
+// follows the given number of leading bytes of the keys).
+func addPrefixSizes(sizes map[byte]int64, store kvstore.KVStore, skippedBytes int) (err error) {
+	return store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		if len(key) > skippedBytes {
+			sizes[key[skippedBytes]] += int64(len(key) + len(value))
+		}
+
+		return true
+	})
+}
+
 func dbPrunableDirectorySize(base string, index epoch.Index) (int64, error) {
 	return dbDirectorySize(dbPathFromIndex(base, index))
 }
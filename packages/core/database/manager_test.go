@@ -11,17 +11,22 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/hive.go/core/byteutils"
+	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/kvstore"
 
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
 )
 
 func TestManager_Get(t *testing.T) {
+	forEachProvider(t, true, testManagerGet)
+}
+
+func testManagerGet(t *testing.T, provider DBProvider) {
 	const bucketsCount = 20
 	const granularity = 3
 	baseDir := t.TempDir()
 
-	m := NewManager(1, WithGranularity(granularity), WithDBProvider(NewDB), WithBaseDir(baseDir), WithMaxOpenDBs(2))
+	m := NewManager(1, WithGranularity(granularity), WithDBProvider(provider), WithBaseDir(baseDir), WithMaxOpenDBs(2))
 
 	dbSize := m.PrunableStorageSize()
 
@@ -130,7 +135,7 @@ func TestManager_Get(t *testing.T) {
 	m.Shutdown()
 	m = nil
 
-	m = NewManager(1, WithGranularity(granularity), WithDBProvider(NewDB), WithBaseDir(baseDir))
+	m = NewManager(1, WithGranularity(granularity), WithDBProvider(provider), WithBaseDir(baseDir))
 	// Read data from buckets after shutdown (needs to be properly reconstructed from disk).
 	{
 		for i := int(expectedFirstBucket); i < bucketsCount; i++ {
@@ -185,6 +190,19 @@ func TestManager_Get(t *testing.T) {
 
 	// We pruned until epoch baseIndex granularity. Thus this should be the pruned epoch baseIndex after restoring.
 	assert.Equal(t, expectedFirstBucket-1, m.maxPruned)
+	assert.Equal(t, []epoch.Index{18, 15, 12, 9, 6}, m.PrunableDBBaseIndexes())
+}
+
+func TestManager_RestoreFromDiskEmpty(t *testing.T) {
+	forEachProvider(t, true, func(t *testing.T, provider DBProvider) {
+		m := NewManager(1, WithGranularity(3), WithDBProvider(provider), WithBaseDir(t.TempDir()))
+		defer m.Shutdown()
+
+		// Nothing was persisted yet -> we start with a fresh DB.
+		assert.Empty(t, m.PrunableDBBaseIndexes())
+		assert.EqualValues(t, 0, m.RestoreFromDisk())
+		assert.EqualValues(t, -1, m.MaxPrunedEpoch())
+	})
 }
 
 func getRealm(i int) kvstore.Realm {
@@ -198,3 +216,43 @@ func getKey(i int) []byte {
 func getValue(i int) []byte {
 	return []byte("value" + strconv.Itoa(i))
 }
+
+func TestManager_Usage(t *testing.T) {
+	forEachProvider(t, true, func(t *testing.T, provider DBProvider) {
+		m := NewManager(1, WithGranularity(2), WithDBProvider(provider), WithBaseDir(t.TempDir()), WithMaxOpenDBs(1))
+		defer m.Shutdown()
+
+		require.NoError(t, m.PermanentStorage().Set([]byte{7, 1}, []byte{1, 2, 3}))
+		for i := 0; i < 6; i++ {
+			require.NoError(t, m.Get(epoch.Index(i), kvstore.Realm{3}).Set([]byte{1}, []byte{1, 2}))
+			require.NoError(t, m.Get(epoch.Index(i), kvstore.Realm{4}).Set([]byte{1}, []byte{1}))
+		}
+
+		permanentSizes, err := m.PermanentPrefixSizes()
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, permanentSizes[7])
+
+		// The DB instances that are not open (only one can be open) are taken into account as well.
+		prunableSizes, err := m.PrunablePrefixSizes()
+		require.NoError(t, err)
+		assert.Equal(t, map[byte]int64{3: 6 * (8 + 2 + 2), 4: 6 * (8 + 2 + 1)}, prunableSizes)
+
+		// The data outside the given realms is reported as "other".
+		realmSizes, err := m.PrunableRealmSizes(map[string]kvstore.Realm{"three": {3}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"three": 6 * (8 + 2 + 2), "other": 6 * (8 + 2 + 1)}, realmSizes)
+
+		m.PruneUntilEpoch(1)
+		assert.ElementsMatch(t, []epoch.Index{2, 4}, lo.Keys(m.PrunableStorageSizes()))
+
+		prunableSizes, err = m.PrunablePrefixSizes()
+		require.NoError(t, err)
+		assert.Equal(t, map[byte]int64{3: 4 * (8 + 2 + 2), 4: 4 * (8 + 2 + 1)}, prunableSizes)
+
+		require.NoError(t, m.GC())
+
+		value, err := m.Get(3, kvstore.Realm{3}).Get([]byte{1})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, value)
+	})
+}
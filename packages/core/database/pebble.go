@@ -0,0 +1,59 @@
+package database
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	pebblestore "github.com/iotaledger/hive.go/core/kvstore/pebble"
+)
+
+type pebbleDB struct {
+	*pebble.DB
+}
+
+// NewPebbleDB returns a new persisting DB object that is backed by a (pure Go) Pebble database.
+func NewPebbleDB(dirname string) (DB, error) {
+	db, err := pebblestore.CreateDB(dirname)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open '%s'", dirname)
+	}
+
+	return &pebbleDB{DB: db}, nil
+}
+
+func (db *pebbleDB) NewStore() kvstore.KVStore {
+	return pebblestore.New(db.DB)
+}
+
+// Close closes a DB. It's crucial to call it to ensure all the pending updates make their way to disk.
+func (db *pebbleDB) Close() error {
+	return db.DB.Close()
+}
+
+func (db *pebbleDB) RequiresGC() bool {
+	return true
+}
+
+// GC compacts the whole key range of the database so that the space of deleted items is reclaimed.
+func (db *pebbleDB) GC() error {
+	iterator := db.NewIter(nil)
+	defer iterator.Close()
+
+	if !iterator.First() {
+		return nil
+	}
+	firstKey := append([]byte{}, iterator.Key()...)
+
+	if !iterator.Last() {
+		return nil
+	}
+	// the upper bound of the compaction is exclusive, so we extend the last key to include it
+	lastKey := append(append([]byte{}, iterator.Key()...), 0)
+
+	if err := db.Compact(firstKey, lastKey, true); err != nil {
+		return errors.Wrap(err, "failed to compact database")
+	}
+
+	return nil
+}
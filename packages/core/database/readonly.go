@@ -0,0 +1,171 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+
+	hivedb "github.com/iotaledger/hive.go/core/database"
+	"github.com/iotaledger/hive.go/core/kvstore"
+)
+
+// ErrReadOnly is returned when a write is issued to a store of a read-only DB.
+var ErrReadOnly = errors.New("database is opened read-only")
+
+// ErrReadOnlyUnsupported is returned when the DB instances of an engine can not be opened without modifying their files.
+var ErrReadOnlyUnsupported = errors.New("database engine can not be opened read-only")
+
+// ReadOnlyDBProviderFromEngine returns a DBProvider that opens the existing DB instances of the given engine read-only.
+// In contrast to DBProviderFromEngine it neither creates missing directories nor marks the database with its engine.
+//
+// Only Pebble databases can be opened read-only. RocksDB replays its write-ahead log and rewrites its LOG and MANIFEST
+// files whenever it is opened (the kvstore of hive.go does not expose its read-only mode), so ErrReadOnlyUnsupported is
+// returned for it.
+func ReadOnlyDBProviderFromEngine(engine hivedb.Engine, directory string) (provider DBProvider, err error) {
+	if err = checkStoredEngine(engine, directory); err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case hivedb.EngineRocksDB:
+		return nil, errors.Wrap(ErrReadOnlyUnsupported, "RocksDB modifies its files when it is opened")
+	case hivedb.EnginePebble:
+		return readOnly(NewReadOnlyPebbleDB), nil
+	default:
+		return nil, errors.Errorf("unsupported database engine '%s'", engine)
+	}
+}
+
+// WriteProtectedDBProviderFromEngine returns a DBProvider that opens the existing DB instances of the given engine in
+// their regular mode but rejects all writes to their stores. It can be used for engines that can not be opened
+// read-only, whose files are still modified when the DB instances are opened (see ReadOnlyDBProviderFromEngine).
+func WriteProtectedDBProviderFromEngine(engine hivedb.Engine, directory string) (provider DBProvider, err error) {
+	if err = checkStoredEngine(engine, directory); err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case hivedb.EngineRocksDB:
+		return readOnly(NewDB), nil
+	case hivedb.EnginePebble:
+		return readOnly(NewPebbleDB), nil
+	default:
+		return nil, errors.Errorf("unsupported database engine '%s'", engine)
+	}
+}
+
+// checkStoredEngine checks that the database in the given directory was created with the given engine (if it was
+// marked with its engine).
+func checkStoredEngine(engine hivedb.Engine, directory string) (err error) {
+	if dbInfoFilePath := filepath.Join(directory, "dbinfo"); fileExists(dbInfoFilePath) {
+		storedEngine, loadErr := hivedb.LoadEngineFromFile(dbInfoFilePath, Engines...)
+		if loadErr != nil {
+			return errors.Wrapf(loadErr, "failed to load database engine of '%s'", directory)
+		} else if storedEngine != engine {
+			return errors.Wrapf(hivedb.ErrEngineMismatch, "database '%s' uses engine '%s'", directory, storedEngine)
+		}
+	}
+
+	return nil
+}
+
+// NewReadOnlyPebbleDB opens an existing Pebble database read-only.
+func NewReadOnlyPebbleDB(dirname string) (DB, error) {
+	db, err := pebble.Open(dirname, &pebble.Options{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open '%s' read-only", dirname)
+	}
+
+	return &pebbleDB{DB: db}, nil
+}
+
+// readOnly wraps the given DBProvider so that it only opens existing DB instances whose stores reject all writes.
+func readOnly(provider DBProvider) DBProvider {
+	return func(dirname string) (DB, error) {
+		if _, err := os.Stat(dirname); err != nil {
+			return nil, errors.Wrapf(err, "failed to open '%s' read-only", dirname)
+		}
+
+		db, err := provider(dirname)
+		if err != nil {
+			return nil, err
+		}
+
+		return &readOnlyDB{DB: db}, nil
+	}
+}
+
+// fileExists returns true if the given path exists.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
+}
+
+// region readOnlyDB ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+type readOnlyDB struct {
+	DB
+}
+
+func (db *readOnlyDB) NewStore() kvstore.KVStore {
+	return &readOnlyStore{KVStore: db.DB.NewStore()}
+}
+
+func (db *readOnlyDB) RequiresGC() bool {
+	return false
+}
+
+func (db *readOnlyDB) GC() error {
+	return ErrReadOnly
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region readOnlyStore ////////////////////////////////////////////////////////////////////////////////////////////////
+
+type readOnlyStore struct {
+	kvstore.KVStore
+}
+
+func (s *readOnlyStore) WithRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	store, err := s.KVStore.WithRealm(realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readOnlyStore{KVStore: store}, nil
+}
+
+func (s *readOnlyStore) WithExtendedRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	store, err := s.KVStore.WithExtendedRealm(realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readOnlyStore{KVStore: store}, nil
+}
+
+func (s *readOnlyStore) Clear() error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyStore) Set(kvstore.Key, kvstore.Value) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyStore) Delete(kvstore.Key) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyStore) DeletePrefix(kvstore.KeyPrefix) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyStore) Batched() (kvstore.BatchedMutations, error) {
+	return nil, ErrReadOnly
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
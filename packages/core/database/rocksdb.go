@@ -3,6 +3,8 @@ package database
 import (
 	"runtime"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/hive.go/core/kvstore"
 	"github.com/iotaledger/hive.go/core/kvstore/rocksdb"
 )
@@ -16,7 +18,11 @@ type rocksDB struct {
 // NewDB returns a new persisting DB object.
 func NewDB(dirname string) (DB, error) {
 	db, err := rocksdb.CreateDB(dirname)
-	return &rocksDB{RocksDB: db}, err
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open '%s'", dirname)
+	}
+
+	return &rocksDB{RocksDB: db}, nil
 }
 
 func (db *rocksDB) NewStore() kvstore.KVStore {
@@ -1,6 +1,7 @@
 package eventticker
 
 import (
+	"math"
 	"sync"
 	"time"
 
@@ -27,6 +28,8 @@ type EventTicker[T epoch.IndexedID] struct {
 
 	optsRetryInterval       time.Duration
 	optsRetryJitter         time.Duration
+	optsRetryBackOffFactor  float64
+	optsMaxRetryInterval    time.Duration
 	optsMaxRequestThreshold int
 }
 
@@ -40,6 +43,7 @@ func New[T epoch.IndexedID](opts ...options.Option[EventTicker[T]]) *EventTicker
 
 		optsRetryInterval:       10 * time.Second,
 		optsRetryJitter:         5 * time.Second,
+		optsRetryBackOffFactor:  1,
 		optsMaxRequestThreshold: 100,
 	}, opts)
 }
@@ -105,7 +109,7 @@ func (r *EventTicker[T]) addTickerToQueue(id T) (added bool) {
 	}
 
 	// schedule the next request and trigger the event
-	queue.Set(id, r.timedExecutor.ExecuteAfter(r.createReScheduler(id, 0), r.optsRetryInterval+time.Duration(crypto.Randomness.Float64()*float64(r.optsRetryJitter))))
+	queue.Set(id, r.timedExecutor.ExecuteAfter(r.createReScheduler(id, 0), r.retryInterval(0)))
 
 	r.updateScheduledTickerCount(1)
 
@@ -162,11 +166,21 @@ func (r *EventTicker[T]) reSchedule(id T, count int) {
 			return
 		}
 
-		tickerStorage.Set(id, r.timedExecutor.ExecuteAfter(r.createReScheduler(id, count), r.optsRetryInterval+time.Duration(crypto.Randomness.Float64()*float64(r.optsRetryJitter))))
+		tickerStorage.Set(id, r.timedExecutor.ExecuteAfter(r.createReScheduler(id, count), r.retryInterval(count)))
 		return
 	}
 }
 
+// retryInterval returns the (jittered) time to wait before the next tick, after the given amount of retries.
+func (r *EventTicker[T]) retryInterval(count int) time.Duration {
+	interval := float64(r.optsRetryInterval) * math.Pow(r.optsRetryBackOffFactor, float64(count))
+	if r.optsMaxRetryInterval > 0 {
+		interval = math.Min(interval, float64(r.optsMaxRetryInterval))
+	}
+
+	return time.Duration(math.Min(interval, math.MaxInt64/2)) + time.Duration(crypto.Randomness.Float64()*float64(r.optsRetryJitter))
+}
+
 func (r *EventTicker[T]) createReScheduler(blkID T, count int) func() {
 	return func() {
 		r.reSchedule(blkID, count)
@@ -198,6 +212,21 @@ func RetryJitter[T epoch.IndexedID](retryJitter time.Duration) options.Option[Ev
 	}
 }
 
+// RetryBackOffFactor creates an option which sets the factor by which the retry interval grows with every retry (1
+// keeps the interval constant).
+func RetryBackOffFactor[T epoch.IndexedID](factor float64) options.Option[EventTicker[T]] {
+	return func(requester *EventTicker[T]) {
+		requester.optsRetryBackOffFactor = factor
+	}
+}
+
+// MaxRetryInterval creates an option which caps the retry interval that results from the back-off (0 disables the cap).
+func MaxRetryInterval[T epoch.IndexedID](maxRetryInterval time.Duration) options.Option[EventTicker[T]] {
+	return func(requester *EventTicker[T]) {
+		requester.optsMaxRetryInterval = maxRetryInterval
+	}
+}
+
 // MaxRequestThreshold creates an option which defines how often the EventTicker should try to request blocks before
 // canceling the request.
 func MaxRequestThreshold[T epoch.IndexedID](maxRequestThreshold int) options.Option[EventTicker[T]] {
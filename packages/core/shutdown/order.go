@@ -23,6 +23,8 @@ const (
 	PriorityMetrics
 	// PriorityWarpsync defines the shutdown priority for warpsync.
 	PriorityWarpsync
+	// PrioritySnapshotter defines the shutdown priority for the snapshotter.
+	PrioritySnapshotter
 	// PriorityGossip defines the shutdown priority for gossip.
 	PriorityGossip
 	// PriorityP2P defines the shutdown priority for p2p.
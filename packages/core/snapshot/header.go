@@ -0,0 +1,113 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/serix"
+	"github.com/iotaledger/hive.go/core/types"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+)
+
+// region Header ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Header describes the content of a versioned snapshot and is written in front of the section payloads.
+type Header struct {
+	// FormatVersion is the version of the snapshot format (it is written outside the serialized header, so that it can
+	// be checked before the rest of the header gets decoded).
+	FormatVersion uint16
+
+	// DatabaseVersion is the version of the database schema of the node that created the snapshot.
+	DatabaseVersion database.Version `serix:"0"`
+
+	// GenesisTime is the genesis time (Unix in seconds) of the network that the snapshot belongs to.
+	GenesisTime int64 `serix:"1"`
+
+	// EpochDuration is the epoch duration (in seconds) of the network that the snapshot belongs to.
+	EpochDuration int64 `serix:"2"`
+
+	// TargetEpoch is the epoch that the snapshot was created for.
+	TargetEpoch epoch.Index `serix:"3"`
+
+	// TargetCommitmentID is the ID of the commitment of the TargetEpoch.
+	TargetCommitmentID commitment.ID `serix:"4"`
+
+	// Sections is the table of the sections contained in the snapshot (in the order of their payloads).
+	Sections []*SectionInfo `serix:"5,lengthPrefixType=uint8"`
+}
+
+// Section returns the SectionInfo of the named section.
+func (h *Header) Section(name string) (sectionInfo *SectionInfo, exists bool) {
+	for _, sectionInfo = range h.Sections {
+		if sectionInfo.Name == name {
+			return sectionInfo, true
+		}
+	}
+
+	return nil, false
+}
+
+// PayloadSize returns the accumulated size of all section payloads.
+func (h *Header) PayloadSize() (size uint64) {
+	for _, sectionInfo := range h.Sections {
+		size += sectionInfo.Length
+	}
+
+	return size
+}
+
+// FromBytes unmarshals the Header from a sequence of bytes.
+func (h *Header) FromBytes(bytes []byte) (consumedBytes int, err error) {
+	return serix.DefaultAPI.Decode(context.Background(), bytes, h, serix.WithValidation())
+}
+
+// Bytes returns a serialized version of the Header.
+func (h *Header) Bytes() (bytes []byte, err error) {
+	return serix.DefaultAPI.Encode(context.Background(), h, serix.WithValidation())
+}
+
+// String returns a human-readable version of the Header.
+func (h *Header) String() string {
+	var builder strings.Builder
+	builder.WriteString("Header {\n")
+	builder.WriteString(fmt.Sprintf("    FormatVersion: %d\n", h.FormatVersion))
+	builder.WriteString(fmt.Sprintf("    DatabaseVersion: %d\n", h.DatabaseVersion))
+	builder.WriteString(fmt.Sprintf("    GenesisTime: %s\n", time.Unix(h.GenesisTime, 0).UTC()))
+	builder.WriteString(fmt.Sprintf("    EpochDuration: %ds\n", h.EpochDuration))
+	builder.WriteString(fmt.Sprintf("    TargetEpoch: %d\n", h.TargetEpoch))
+	builder.WriteString(fmt.Sprintf("    TargetCommitmentID: %s\n", h.TargetCommitmentID))
+	for _, sectionInfo := range h.Sections {
+		builder.WriteString(fmt.Sprintf("    %s\n", sectionInfo))
+	}
+	builder.WriteString("}")
+
+	return builder.String()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SectionInfo //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SectionInfo is the entry of a section in the section table of the Header.
+type SectionInfo struct {
+	// Name is the name of the section.
+	Name string `serix:"0,lengthPrefixType=uint8"`
+
+	// Length is the length of the section payload in bytes.
+	Length uint64 `serix:"1"`
+
+	// Hash is the blake2b-256 hash of the section payload.
+	Hash types.Identifier `serix:"2"`
+}
+
+// String returns a human-readable version of the SectionInfo.
+func (s *SectionInfo) String() string {
+	return fmt.Sprintf("Section(%s) {Length: %d, Hash: %s}", s.Name, s.Length, s.Hash.Base58())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,344 @@
+package snapshot
+
+import (
+	"io"
+
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/core/stream"
+)
+
+const (
+	// FormatVersion is the current version of the snapshot format.
+	FormatVersion uint16 = 1
+
+	// maxHeaderSize is the maximum size of a serialized Header (protects against allocating huge buffers for corrupted
+	// length prefixes).
+	maxHeaderSize = 1 << 16
+)
+
+var (
+	// Magic is the byte sequence that marks the beginning of a versioned snapshot.
+	Magic = [8]byte{'G', 'S', 'S', 'N', 'A', 'P', 'S', 'H'}
+
+	// ErrLegacyFormat is returned when trying to read the Header of a snapshot that was written before the snapshot
+	// format was versioned (these snapshots consist of the raw sections only).
+	ErrLegacyFormat = errors.New("legacy snapshot format")
+
+	// ErrUnsupportedVersion is returned when the snapshot was written in a newer format version.
+	ErrUnsupportedVersion = errors.New("unsupported snapshot format version")
+
+	// ErrCorrupted is returned when the content of the snapshot does not match its Header.
+	ErrCorrupted = errors.New("corrupted snapshot")
+)
+
+// region Section //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Section is a named part of a snapshot that is written and read by the component owning the contained state.
+type Section struct {
+	// Name is the name of the section.
+	Name string
+
+	// Export writes the payload of the section.
+	Export func(writer io.WriteSeeker) (err error)
+
+	// Import reads the payload of the section.
+	Import func(reader io.ReadSeeker) (err error)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Write ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Write writes a versioned snapshot with the given header and sections to the writer (the section table of the header
+// is filled in automatically). The sections are written straight to the writer and read back to compute their hashes,
+// so the memory usage does not depend on the size of the snapshot.
+func Write(writer io.ReadWriteSeeker, header *Header, sections ...*Section) (err error) {
+	header.FormatVersion = FormatVersion
+	header.Sections = make([]*SectionInfo, len(sections))
+	for i, section := range sections {
+		header.Sections[i] = &SectionInfo{Name: section.Name}
+	}
+
+	// we write a placeholder of the header first, and overwrite it once the section table is complete
+	headerOffset, err := writeHeader(writer, header)
+	if err != nil {
+		return errors.Wrap(err, "failed to write header placeholder")
+	}
+
+	for i, section := range sections {
+		if header.Sections[i].Length, header.Sections[i].Hash, err = writeSection(writer, section); err != nil {
+			return errors.Wrapf(err, "failed to write section '%s'", section.Name)
+		}
+	}
+
+	endOffset, err := stream.Offset(writer)
+	if err != nil {
+		return errors.Wrap(err, "failed to read end offset of snapshot")
+	}
+
+	if _, err = stream.GoTo(writer, headerOffset); err != nil {
+		return errors.Wrap(err, "failed to seek to header")
+	} else if _, err = writeHeader(writer, header); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	} else if headerEndOffset, offsetErr := stream.Offset(writer); offsetErr != nil {
+		return errors.Wrap(offsetErr, "failed to read end offset of header")
+	} else if headerEndOffset != endOffset-int64(header.PayloadSize()) {
+		return errors.Errorf("size of header changed while writing the snapshot")
+	} else if _, err = stream.GoTo(writer, endOffset); err != nil {
+		return errors.Wrap(err, "failed to seek to end of snapshot")
+	}
+
+	return nil
+}
+
+// writeHeader writes the magic bytes, the format version and the checksummed header and returns the start offset.
+func writeHeader(writer io.WriteSeeker, header *Header) (startOffset int64, err error) {
+	headerBytes, err := header.Bytes()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to serialize header")
+	}
+
+	if startOffset, err = stream.Offset(writer); err != nil {
+		return 0, errors.Wrap(err, "failed to read start offset of header")
+	} else if err = stream.Write(writer, Magic); err != nil {
+		return 0, errors.Wrap(err, "failed to write magic bytes")
+	} else if err = stream.Write(writer, header.FormatVersion); err != nil {
+		return 0, errors.Wrap(err, "failed to write format version")
+	} else if err = stream.WriteBlob(writer, headerBytes); err != nil {
+		return 0, errors.Wrap(err, "failed to write header")
+	} else if err = stream.Write(writer, headerChecksum(header.FormatVersion, headerBytes)); err != nil {
+		return 0, errors.Wrap(err, "failed to write header checksum")
+	}
+
+	return startOffset, nil
+}
+
+// writeSection writes the payload of the section to the writer and reads it back to compute its hash (sections are
+// allowed to seek within their own payload to patch placeholders, so the hash can only be computed once the payload is
+// complete).
+func writeSection(writer io.ReadWriteSeeker, section *Section) (length uint64, hash types.Identifier, err error) {
+	startOffset, err := stream.Offset(writer)
+	if err != nil {
+		return 0, types.Identifier{}, errors.Wrap(err, "failed to read start offset of section")
+	}
+
+	sectionWriter := &sectionWriter{WriteSeeker: writer, offset: startOffset, endOffset: startOffset}
+	if err = section.Export(sectionWriter); err != nil {
+		return 0, types.Identifier{}, errors.Wrap(err, "failed to export section")
+	}
+	length = uint64(sectionWriter.endOffset - startOffset)
+
+	if _, err = stream.GoTo(writer, startOffset); err != nil {
+		return 0, types.Identifier{}, errors.Wrap(err, "failed to seek to start of section")
+	}
+
+	hasher, _ := blake2b.New256(nil)
+	if _, err = io.CopyN(hasher, writer, int64(length)); err != nil {
+		return 0, types.Identifier{}, errors.Wrap(err, "failed to read back section payload")
+	}
+	copy(hash[:], hasher.Sum(nil))
+
+	// the payload was read up to its end, so the writer is positioned where the next section starts
+	return length, hash, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Read /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ReadHeader reads and checks the header of a versioned snapshot. It returns ErrLegacyFormat (and resets the reader to
+// the start of the snapshot) if the snapshot was written before the format was versioned.
+func ReadHeader(reader io.ReadSeeker) (header *Header, err error) {
+	startOffset, err := stream.Offset(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read start offset of snapshot")
+	}
+
+	magic, err := stream.Read[[8]byte](reader)
+	if err != nil || magic != Magic {
+		if _, seekErr := stream.GoTo(reader, startOffset); seekErr != nil {
+			return nil, errors.Wrap(seekErr, "failed to seek to start of snapshot")
+		}
+
+		return nil, ErrLegacyFormat
+	}
+
+	header = new(Header)
+	if header.FormatVersion, err = stream.Read[uint16](reader); err != nil {
+		return nil, errors.Wrapf(ErrCorrupted, "failed to read format version: %s", err)
+	} else if header.FormatVersion == 0 || header.FormatVersion > FormatVersion {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "snapshot has format version %d, supported version: %d", header.FormatVersion, FormatVersion)
+	}
+
+	headerSize, err := stream.Read[uint64](reader)
+	if err != nil {
+		return nil, errors.Wrapf(ErrCorrupted, "failed to read header size: %s", err)
+	} else if headerSize > maxHeaderSize {
+		return nil, errors.Wrapf(ErrCorrupted, "header size %d exceeds maximum of %d", headerSize, maxHeaderSize)
+	}
+
+	headerBytes, err := stream.ReadBytes(reader, headerSize)
+	if err != nil {
+		return nil, errors.Wrapf(ErrCorrupted, "failed to read header: %s", err)
+	}
+
+	if checksum, checksumErr := stream.Read[types.Identifier](reader); checksumErr != nil {
+		return nil, errors.Wrapf(ErrCorrupted, "failed to read header checksum: %s", checksumErr)
+	} else if checksum != headerChecksum(header.FormatVersion, headerBytes) {
+		return nil, errors.Wrap(ErrCorrupted, "header checksum mismatch")
+	}
+
+	if consumedBytes, parseErr := header.FromBytes(headerBytes); parseErr != nil {
+		return nil, errors.Wrapf(ErrCorrupted, "failed to parse header: %s", parseErr)
+	} else if consumedBytes != len(headerBytes) {
+		return nil, errors.Wrapf(ErrCorrupted, "failed to parse header: consumed bytes (%d) != header size (%d)", consumedBytes, len(headerBytes))
+	}
+
+	return header, nil
+}
+
+// Verify checks that the section payloads following the header match the lengths and hashes of the section table (the
+// reader is reset to the start of the first section afterwards).
+func Verify(reader io.ReadSeeker, header *Header) (err error) {
+	payloadOffset, err := stream.Offset(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read start offset of sections")
+	}
+
+	for _, sectionInfo := range header.Sections {
+		var hash types.Identifier
+		hasher, _ := blake2b.New256(nil)
+		if copiedBytes, copyErr := io.CopyN(hasher, reader, int64(sectionInfo.Length)); copyErr != nil {
+			return errors.Wrapf(ErrCorrupted, "section '%s' is truncated: expected %d bytes, found %d", sectionInfo.Name, sectionInfo.Length, copiedBytes)
+		} else if copy(hash[:], hasher.Sum(nil)); hash != sectionInfo.Hash {
+			return errors.Wrapf(ErrCorrupted, "hash of section '%s' (%s) does not match the header (%s)", sectionInfo.Name, hash.Base58(), sectionInfo.Hash.Base58())
+		}
+	}
+
+	if endOffset, offsetErr := stream.Offset(reader); offsetErr != nil {
+		return errors.Wrap(offsetErr, "failed to read end offset of sections")
+	} else if fileEndOffset, seekErr := reader.Seek(0, io.SeekEnd); seekErr != nil {
+		return errors.Wrap(seekErr, "failed to seek to end of snapshot")
+	} else if fileEndOffset != endOffset {
+		return errors.Wrapf(ErrCorrupted, "found %d unexpected trailing bytes", fileEndOffset-endOffset)
+	}
+
+	if _, err = stream.GoTo(reader, payloadOffset); err != nil {
+		return errors.Wrap(err, "failed to seek to start of sections")
+	}
+
+	return nil
+}
+
+// Read reads a snapshot by handing the section payloads to the Import functions of the given sections. The whole
+// snapshot is verified against its header before any section is imported. Legacy snapshots (without header) are read
+// by importing the sections in the given order without verification.
+func Read(reader io.ReadSeeker, verifyHeader func(header *Header) error, sections ...*Section) (header *Header, err error) {
+	if header, err = ReadHeader(reader); err != nil {
+		if !errors.Is(err, ErrLegacyFormat) {
+			return nil, errors.Wrap(err, "failed to read header")
+		}
+
+		return nil, readLegacy(reader, sections...)
+	}
+
+	if len(header.Sections) != len(sections) {
+		return nil, errors.Wrapf(ErrCorrupted, "snapshot contains %d sections, expected %d", len(header.Sections), len(sections))
+	}
+	for i, section := range sections {
+		if header.Sections[i].Name != section.Name {
+			return nil, errors.Wrapf(ErrCorrupted, "found section '%s' at position %d, expected '%s'", header.Sections[i].Name, i, section.Name)
+		}
+	}
+
+	if verifyHeader != nil {
+		if err = verifyHeader(header); err != nil {
+			return nil, errors.Wrap(err, "snapshot does not match the node")
+		}
+	}
+
+	if err = Verify(reader, header); err != nil {
+		return nil, errors.Wrap(err, "failed to verify sections")
+	}
+
+	for i, section := range sections {
+		if err = readSection(reader, section, header.Sections[i]); err != nil {
+			return nil, errors.Wrapf(err, "failed to read section '%s'", section.Name)
+		}
+	}
+
+	return header, nil
+}
+
+// readSection imports a single section and checks that exactly the announced amount of bytes was consumed.
+func readSection(reader io.ReadSeeker, section *Section, sectionInfo *SectionInfo) (err error) {
+	startOffset, err := stream.Offset(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read start offset of section")
+	}
+
+	if err = section.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to import section")
+	}
+
+	if endOffset, offsetErr := stream.Offset(reader); offsetErr != nil {
+		return errors.Wrap(offsetErr, "failed to read end offset of section")
+	} else if consumedBytes := endOffset - startOffset; consumedBytes != int64(sectionInfo.Length) {
+		return errors.Wrapf(ErrCorrupted, "consumed %d bytes, expected %d", consumedBytes, sectionInfo.Length)
+	}
+
+	return nil
+}
+
+// readLegacy imports the sections of a snapshot that was written before the format was versioned.
+func readLegacy(reader io.ReadSeeker, sections ...*Section) (err error) {
+	for _, section := range sections {
+		if err = section.Import(reader); err != nil {
+			return errors.Wrapf(err, "failed to read legacy section '%s'", section.Name)
+		}
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utils ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// headerChecksum returns the checksum that protects the format version and the serialized header.
+func headerChecksum(formatVersion uint16, headerBytes []byte) types.Identifier {
+	return blake2b.Sum256(append([]byte{byte(formatVersion), byte(formatVersion >> 8)}, headerBytes...))
+}
+
+// sectionWriter is an io.WriteSeeker that keeps track of the end of the payload that a section wrote (which is not the
+// current offset if the section seeked back to patch a placeholder).
+type sectionWriter struct {
+	io.WriteSeeker
+
+	offset    int64
+	endOffset int64
+}
+
+// Write writes the given bytes at the current offset.
+func (s *sectionWriter) Write(p []byte) (n int, err error) {
+	n, err = s.WriteSeeker.Write(p)
+	if s.offset += int64(n); s.offset > s.endOffset {
+		s.endOffset = s.offset
+	}
+
+	return n, err
+}
+
+// Seek sets the offset for the next Write.
+func (s *sectionWriter) Seek(offset int64, whence int) (newOffset int64, err error) {
+	if newOffset, err = s.WriteSeeker.Seek(offset, whence); err == nil {
+		s.offset = newOffset
+	}
+
+	return newOffset, err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,155 @@
+package snapshot
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/stream"
+)
+
+func TestSnapshot(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "snapshot.bin")
+	writeTestSnapshot(t, filePath)
+
+	t.Run("read", func(t *testing.T) {
+		values, blob := make([]uint32, 0), make([]byte, 0)
+		header, err := Read(openFile(t, filePath), nil, testSections(&values, &blob)...)
+		require.NoError(t, err)
+		require.Equal(t, FormatVersion, header.FormatVersion)
+		require.Equal(t, epoch.Index(7), header.TargetEpoch)
+		require.Equal(t, []uint32{1, 2, 3}, values)
+		require.Equal(t, []byte("payload"), blob)
+	})
+
+	t.Run("reject header", func(t *testing.T) {
+		values, blob := make([]uint32, 0), make([]byte, 0)
+		_, err := Read(openFile(t, filePath), func(*Header) error { return errors.New("mismatch") }, testSections(&values, &blob)...)
+		require.Error(t, err)
+		require.Empty(t, values)
+	})
+
+	t.Run("corrupted section", func(t *testing.T) {
+		snapshotBytes := readFile(t, filePath)
+		snapshotBytes[len(snapshotBytes)-1] ^= 0xFF
+		corruptedFilePath := writeFile(t, snapshotBytes)
+
+		values, blob := make([]uint32, 0), make([]byte, 0)
+		_, err := Read(openFile(t, corruptedFilePath), nil, testSections(&values, &blob)...)
+		require.ErrorIs(t, err, ErrCorrupted)
+		require.Empty(t, values)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		snapshotBytes := readFile(t, filePath)
+		truncatedFilePath := writeFile(t, snapshotBytes[:len(snapshotBytes)-3])
+
+		values, blob := make([]uint32, 0), make([]byte, 0)
+		_, err := Read(openFile(t, truncatedFilePath), nil, testSections(&values, &blob)...)
+		require.ErrorIs(t, err, ErrCorrupted)
+		require.Empty(t, values)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		snapshotBytes := readFile(t, filePath)
+		snapshotBytes[len(Magic)] = byte(FormatVersion + 1)
+		futureFilePath := writeFile(t, snapshotBytes)
+
+		_, err := ReadHeader(openFile(t, futureFilePath))
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+}
+
+func TestSnapshot_Legacy(t *testing.T) {
+	legacyFile, err := os.Create(filepath.Join(t.TempDir(), "legacy.bin"))
+	require.NoError(t, err)
+	for _, section := range testSections(&[]uint32{4, 5}, &[]byte{'x'}) {
+		require.NoError(t, section.Export(legacyFile))
+	}
+	_, err = stream.GoTo(legacyFile, 0)
+	require.NoError(t, err)
+
+	_, err = ReadHeader(legacyFile)
+	require.ErrorIs(t, err, ErrLegacyFormat)
+
+	values, blob := make([]uint32, 0), make([]byte, 0)
+	header, err := Read(legacyFile, nil, testSections(&values, &blob)...)
+	require.NoError(t, err)
+	require.Nil(t, header)
+	require.Equal(t, []uint32{4, 5}, values)
+	require.Equal(t, []byte{'x'}, blob)
+}
+
+func writeTestSnapshot(t *testing.T, filePath string) {
+	file, err := os.Create(filePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	payload := []byte("payload")
+	require.NoError(t, Write(file, &Header{TargetEpoch: 7}, testSections(&[]uint32{1, 2, 3}, &payload)...))
+}
+
+func testSections(values *[]uint32, blob *[]byte) []*Section {
+	return []*Section{
+		{
+			Name: "values",
+			Export: func(writer io.WriteSeeker) error {
+				return stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
+					for _, value := range *values {
+						if err = stream.Write(writer, value); err != nil {
+							return 0, err
+						}
+					}
+
+					return uint64(len(*values)), nil
+				})
+			},
+			Import: func(reader io.ReadSeeker) error {
+				return stream.ReadCollection(reader, func(int) error {
+					value, err := stream.Read[uint32](reader)
+					*values = append(*values, value)
+
+					return err
+				})
+			},
+		},
+		{
+			Name: "blob",
+			Export: func(writer io.WriteSeeker) error {
+				return stream.WriteBlob(writer, *blob)
+			},
+			Import: func(reader io.ReadSeeker) (err error) {
+				*blob, err = stream.ReadBlob(reader)
+
+				return err
+			},
+		},
+	}
+}
+
+func openFile(t *testing.T, filePath string) *os.File {
+	file, err := os.Open(filePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { file.Close() })
+
+	return file
+}
+
+func readFile(t *testing.T, filePath string) []byte {
+	fileBytes, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	return fileBytes
+}
+
+func writeFile(t *testing.T, fileBytes []byte) (filePath string) {
+	filePath = filepath.Join(t.TempDir(), "modified.bin")
+	require.NoError(t, os.WriteFile(filePath, fileBytes, 0o600))
+
+	return filePath
+}
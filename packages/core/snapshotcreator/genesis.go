@@ -0,0 +1,435 @@
+package snapshotcreator
+
+import (
+	"math"
+	"os"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/storage"
+)
+
+// region Genesis //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Genesis is the declarative description of the genesis snapshot of a network (it can be loaded from a JSON or YAML
+// file using LoadGenesis).
+type Genesis struct {
+	// GenesisTime is the time (Unix in seconds) of the genesis.
+	GenesisTime int64 `mapstructure:"genesisTime"`
+
+	// EpochDuration is the duration of an epoch in seconds.
+	EpochDuration int64 `mapstructure:"epochDuration"`
+
+	// Outputs are the token allocations to addresses.
+	Outputs []*GenesisOutput `mapstructure:"outputs"`
+
+	// Aliases are the alias outputs that exist at genesis.
+	Aliases []*GenesisAlias `mapstructure:"aliases"`
+
+	// Pledges are the amounts of mana that are pledged to identities (the corresponding funds are burned).
+	Pledges []*GenesisPledge `mapstructure:"pledges"`
+
+	// InitialAttestors are the identities that attest to the genesis (they need to receive consensus mana).
+	InitialAttestors []string `mapstructure:"initialAttestors"`
+}
+
+// LoadGenesis loads a Genesis from the given JSON or YAML file.
+func LoadGenesis(filePath string) (genesis *Genesis, err error) {
+	genesisConfig := viper.New()
+	genesisConfig.SetConfigFile(filePath)
+	if err = genesisConfig.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read genesis file '%s'", filePath)
+	}
+
+	genesis = new(Genesis)
+	if err = genesisConfig.UnmarshalExact(genesis); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse genesis file '%s'", filePath)
+	}
+
+	return genesis, nil
+}
+
+// Validate checks that the Genesis describes a valid genesis snapshot.
+func (g *Genesis) Validate() (err error) {
+	_, _, err = g.build()
+
+	return err
+}
+
+// build validates the Genesis and creates the outputs and attestors of the genesis snapshot.
+func (g *Genesis) build() (outputs []*ledger.OutputWithMetadata, attestors []identity.ID, err error) {
+	if g.GenesisTime <= 0 {
+		return nil, nil, errors.New("genesisTime must be set")
+	} else if g.EpochDuration <= 0 {
+		return nil, nil, errors.New("epochDuration must be positive")
+	}
+
+	builder := newGenesisBuilder()
+	for i, output := range g.Outputs {
+		if err = builder.addOutput(output); err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid outputs[%d]", i)
+		}
+	}
+	for i, alias := range g.Aliases {
+		if err = builder.addAlias(alias); err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid aliases[%d]", i)
+		}
+	}
+	for i, pledge := range g.Pledges {
+		if err = builder.addPledge(pledge); err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid pledges[%d]", i)
+		}
+	}
+
+	if len(builder.outputs) == 0 {
+		return nil, nil, errors.New("genesis must contain at least one output")
+	}
+
+	seenAttestors := make(map[identity.ID]bool)
+	for i, attestor := range g.InitialAttestors {
+		attestorID, parseErr := parseIdentityID(attestor)
+		if parseErr != nil {
+			return nil, nil, errors.Wrapf(parseErr, "invalid initialAttestors[%d]", i)
+		} else if seenAttestors[attestorID] {
+			return nil, nil, errors.Errorf("invalid initialAttestors[%d]: duplicate attestor %s", i, attestor)
+		} else if builder.consensusMana[attestorID] == 0 {
+			return nil, nil, errors.Errorf("invalid initialAttestors[%d]: attestor %s has no consensus mana", i, attestor)
+		}
+
+		seenAttestors[attestorID] = true
+		attestors = append(attestors, attestorID)
+	}
+
+	return builder.outputs, attestors, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GenesisOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GenesisOutput is a token allocation to an address.
+type GenesisOutput struct {
+	// Address is the base58 encoded address that receives the tokens.
+	Address string `mapstructure:"address"`
+
+	// Balances are the (colored) tokens of the output.
+	Balances []*GenesisBalance `mapstructure:"balances"`
+
+	// ConsensusManaPledgeID is the base58 encoded identity that receives the consensus mana (empty identity if unset).
+	ConsensusManaPledgeID string `mapstructure:"consensusManaPledgeID"`
+
+	// AccessManaPledgeID is the base58 encoded identity that receives the access mana (empty identity if unset).
+	AccessManaPledgeID string `mapstructure:"accessManaPledgeID"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GenesisAlias /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GenesisAlias is an alias output that exists at genesis.
+type GenesisAlias struct {
+	// StateAddress is the base58 encoded state controller of the alias.
+	StateAddress string `mapstructure:"stateAddress"`
+
+	// GoverningAddress is the base58 encoded governor of the alias (self-governed if unset).
+	GoverningAddress string `mapstructure:"governingAddress"`
+
+	// ImmutableData is the immutable data of the alias.
+	ImmutableData string `mapstructure:"immutableData"`
+
+	// Balances are the (colored) tokens of the alias (they need to be above the dust threshold).
+	Balances []*GenesisBalance `mapstructure:"balances"`
+
+	// ConsensusManaPledgeID is the base58 encoded identity that receives the consensus mana (empty identity if unset).
+	ConsensusManaPledgeID string `mapstructure:"consensusManaPledgeID"`
+
+	// AccessManaPledgeID is the base58 encoded identity that receives the access mana (empty identity if unset).
+	AccessManaPledgeID string `mapstructure:"accessManaPledgeID"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GenesisPledge ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GenesisPledge pledges mana to an identity by burning the corresponding amount of tokens. Differing consensus and
+// access mana amounts result in separate outputs whose other pledge goes to the empty identity.
+type GenesisPledge struct {
+	// IdentityID is the base58 encoded identity that receives the mana.
+	IdentityID string `mapstructure:"identityID"`
+
+	// ConsensusMana is the amount of consensus mana that is pledged to the identity.
+	ConsensusMana uint64 `mapstructure:"consensusMana"`
+
+	// AccessMana is the amount of access mana that is pledged to the identity.
+	AccessMana uint64 `mapstructure:"accessMana"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GenesisBalance ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GenesisBalance is the balance of a single color.
+type GenesisBalance struct {
+	// Color is the base58 encoded color of the tokens ("IOTA" for uncolored tokens).
+	Color string `mapstructure:"color"`
+
+	// Amount is the amount of tokens.
+	Amount uint64 `mapstructure:"amount"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CreateSnapshotFromGenesis ////////////////////////////////////////////////////////////////////////////////////
+
+// CreateSnapshotFromGenesis creates the genesis snapshot that is described by the given Genesis. The created outputs
+// have deterministic IDs, so that the same Genesis always results in the same snapshot.
+func CreateSnapshotFromGenesis(databaseVersion database.Version, snapshotFileName string, genesis *Genesis) (err error) {
+	outputs, attestors, err := genesis.build()
+	if err != nil {
+		return errors.Wrap(err, "invalid genesis")
+	}
+
+	storageDirectory, err := os.MkdirTemp(os.TempDir(), "*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary storage directory")
+	}
+	defer os.RemoveAll(storageDirectory)
+
+	s := storage.New(storageDirectory, databaseVersion)
+	defer s.Shutdown()
+
+	if err = s.Commitments.Store(commitment.NewEmptyCommitment()); err != nil {
+		return errors.Wrap(err, "failed to store genesis commitment")
+	} else if err = s.Settings.SetChainID(lo.PanicOnErr(s.Commitments.Load(0)).ID()); err != nil {
+		return errors.Wrap(err, "failed to set chainID")
+	}
+
+	// the epoch parameters of the genesis only apply to the snapshot, so they are not set globally
+	engineInstance := engine.New(s, dpos.NewProvider(), mana1.NewProvider(), engine.WithEpochParameters(genesis.GenesisTime, genesis.EpochDuration))
+	defer engineInstance.Shutdown()
+
+	for _, output := range outputs {
+		if err = engineInstance.LedgerState.UnspentOutputs.ApplyCreatedOutput(output); err != nil {
+			return errors.Wrapf(err, "failed to apply output %s", output.ID())
+		}
+	}
+
+	engineInstance.NotarizationManager.Attestations.SetLastCommittedEpoch(-1)
+	for _, attestor := range attestors {
+		// the issuing time refers to the genesis time of the snapshot, so the epoch can not be derived from it
+		if _, err = engineInstance.NotarizationManager.Attestations.AddToEpoch(0, &notarization.Attestation{
+			IssuerID:    attestor,
+			IssuingTime: time.Unix(genesis.GenesisTime-1, 0),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add attestation of %s", attestor)
+		}
+	}
+
+	if _, _, err = engineInstance.NotarizationManager.Attestations.Commit(0); err != nil {
+		return errors.Wrap(err, "failed to commit attestations")
+	}
+
+	return engineInstance.WriteSnapshot(snapshotFileName)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region genesisBuilder ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// genesisBuilder validates the entries of a Genesis and turns them into outputs.
+type genesisBuilder struct {
+	outputs       []*ledger.OutputWithMetadata
+	totalSupply   map[devnetvm.Color]uint64
+	consensusMana map[identity.ID]uint64
+}
+
+func newGenesisBuilder() *genesisBuilder {
+	return &genesisBuilder{
+		totalSupply:   make(map[devnetvm.Color]uint64),
+		consensusMana: make(map[identity.ID]uint64),
+	}
+}
+
+func (b *genesisBuilder) addOutput(genesisOutput *GenesisOutput) (err error) {
+	address, err := devnetvm.AddressFromBase58EncodedString(genesisOutput.Address)
+	if err != nil {
+		return errors.Wrapf(err, "invalid address '%s'", genesisOutput.Address)
+	}
+
+	balances, err := parseBalances(genesisOutput.Balances)
+	if err != nil {
+		return err
+	}
+
+	return b.add(devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(balances), address), balances, genesisOutput.ConsensusManaPledgeID, genesisOutput.AccessManaPledgeID)
+}
+
+func (b *genesisBuilder) addAlias(genesisAlias *GenesisAlias) (err error) {
+	stateAddress, err := devnetvm.AddressFromBase58EncodedString(genesisAlias.StateAddress)
+	if err != nil {
+		return errors.Wrapf(err, "invalid stateAddress '%s'", genesisAlias.StateAddress)
+	}
+
+	balances, err := parseBalances(genesisAlias.Balances)
+	if err != nil {
+		return err
+	}
+
+	aliasOutput, err := devnetvm.NewAliasOutputMint(balances, stateAddress, []byte(genesisAlias.ImmutableData))
+	if err != nil {
+		return errors.Wrap(err, "failed to create alias output")
+	}
+
+	if genesisAlias.GoverningAddress != "" {
+		governingAddress, parseErr := devnetvm.AddressFromBase58EncodedString(genesisAlias.GoverningAddress)
+		if parseErr != nil {
+			return errors.Wrapf(parseErr, "invalid governingAddress '%s'", genesisAlias.GoverningAddress)
+		}
+
+		aliasOutput.SetGoverningAddress(governingAddress)
+	}
+
+	return b.add(aliasOutput, balances, genesisAlias.ConsensusManaPledgeID, genesisAlias.AccessManaPledgeID)
+}
+
+func (b *genesisBuilder) addPledge(genesisPledge *GenesisPledge) (err error) {
+	if genesisPledge.ConsensusMana == 0 && genesisPledge.AccessMana == 0 {
+		return errors.New("pledge must contain consensus or access mana")
+	}
+
+	// the funds of pledges are sent to an address that nobody controls
+	burnAddress := devnetvm.NewED25519Address(ed25519.PublicKey{})
+
+	if genesisPledge.ConsensusMana == genesisPledge.AccessMana {
+		return b.addBurnedOutput(burnAddress, genesisPledge.ConsensusMana, genesisPledge.IdentityID, genesisPledge.IdentityID)
+	}
+
+	if genesisPledge.ConsensusMana > 0 {
+		if err = b.addBurnedOutput(burnAddress, genesisPledge.ConsensusMana, genesisPledge.IdentityID, ""); err != nil {
+			return err
+		}
+	}
+
+	if genesisPledge.AccessMana > 0 {
+		if err = b.addBurnedOutput(burnAddress, genesisPledge.AccessMana, "", genesisPledge.IdentityID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *genesisBuilder) addBurnedOutput(burnAddress devnetvm.Address, amount uint64, consensusManaPledgeID, accessManaPledgeID string) (err error) {
+	balances := map[devnetvm.Color]uint64{devnetvm.ColorIOTA: amount}
+
+	return b.add(devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(balances), burnAddress), balances, consensusManaPledgeID, accessManaPledgeID)
+}
+
+func (b *genesisBuilder) add(output devnetvm.Output, balances map[devnetvm.Color]uint64, consensusManaPledgeID, accessManaPledgeID string) (err error) {
+	consensusPledgeID, err := parseOptionalIdentityID(consensusManaPledgeID)
+	if err != nil {
+		return errors.Wrap(err, "invalid consensusManaPledgeID")
+	}
+
+	accessPledgeID, err := parseOptionalIdentityID(accessManaPledgeID)
+	if err != nil {
+		return errors.Wrap(err, "invalid accessManaPledgeID")
+	}
+
+	if len(b.outputs) >= math.MaxUint16-1 {
+		return errors.Errorf("genesis can contain at most %d outputs", math.MaxUint16-1)
+	}
+
+	var iotaBalance uint64
+	for color, balance := range balances {
+		if b.totalSupply[color] > math.MaxUint64-balance {
+			return errors.Errorf("total supply of color %s overflows", color)
+		}
+		b.totalSupply[color] += balance
+
+		if color == devnetvm.ColorIOTA {
+			iotaBalance = balance
+		}
+	}
+	b.consensusMana[consensusPledgeID] += iotaBalance
+
+	output.SetID(utxo.NewOutputID(utxo.EmptyTransactionID, uint16(len(b.outputs)+1)))
+	b.outputs = append(b.outputs, ledger.NewOutputWithMetadata(0, output.ID(), output, consensusPledgeID, accessPledgeID))
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utils ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func parseBalances(genesisBalances []*GenesisBalance) (balances map[devnetvm.Color]uint64, err error) {
+	if len(genesisBalances) == 0 {
+		return nil, errors.New("balances must not be empty")
+	}
+
+	balances = make(map[devnetvm.Color]uint64)
+	for i, genesisBalance := range genesisBalances {
+		color, parseErr := parseColor(genesisBalance.Color)
+		if parseErr != nil {
+			return nil, errors.Wrapf(parseErr, "invalid balances[%d]", i)
+		} else if genesisBalance.Amount == 0 {
+			return nil, errors.Errorf("invalid balances[%d]: amount must be positive", i)
+		} else if _, exists := balances[color]; exists {
+			return nil, errors.Errorf("invalid balances[%d]: duplicate color %s", i, genesisBalance.Color)
+		}
+
+		balances[color] = genesisBalance.Amount
+	}
+
+	return balances, nil
+}
+
+func parseColor(color string) (parsedColor devnetvm.Color, err error) {
+	switch color {
+	case "", "IOTA":
+		return devnetvm.ColorIOTA, nil
+	case "MINT":
+		return devnetvm.Color{}, errors.New("color MINT can not be used in the genesis")
+	}
+
+	if parsedColor, err = devnetvm.ColorFromBase58EncodedString(color); err != nil {
+		return devnetvm.Color{}, errors.Wrapf(err, "invalid color '%s'", color)
+	} else if parsedColor == devnetvm.ColorMint {
+		return devnetvm.Color{}, errors.New("color MINT can not be used in the genesis")
+	}
+
+	return parsedColor, nil
+}
+
+func parseOptionalIdentityID(id string) (identityID identity.ID, err error) {
+	if id == "" {
+		return identity.ID{}, nil
+	}
+
+	return parseIdentityID(id)
+}
+
+func parseIdentityID(id string) (identityID identity.ID, err error) {
+	if identityID, err = identity.DecodeIDBase58(id); err != nil {
+		return identity.ID{}, errors.Wrapf(err, "invalid identity '%s'", id)
+	}
+
+	return identityID, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
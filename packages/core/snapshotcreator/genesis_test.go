@@ -0,0 +1,132 @@
+package snapshotcreator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/snapshot"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1"
+	"github.com/iotaledger/goshimmer/packages/storage"
+)
+
+const testGenesis = `
+genesisTime: 1700000000
+epochDuration: 20
+outputs:
+  - address: 1FnUfGXJsetK9Cf1iyp23itZDV3My9mwGStxtAi2JjFgg
+    balances:
+      - color: IOTA
+        amount: 1000
+      - color: 4h5Z2SUZ4JTMBV9XY4zj6ZcKcBhJSVKcDawdCVQuPdEC
+        amount: 5
+aliases:
+  - stateAddress: 1FnUfGXJsetK9Cf1iyp23itZDV3My9mwGStxtAi2JjFgg
+    immutableData: genesis alias
+    balances:
+      - amount: 100
+pledges:
+  - identityID: EUq4re4sZBMbmzdKo8LJF8uVQhbS24ZNeLRf7AntGH7b
+    consensusMana: 500
+    accessMana: 500
+  - identityID: Xv5Kmv9uZfNME4KD2zBoHZ3kVqovJN59ec62rH3AeLA
+    accessMana: 200
+initialAttestors:
+  - EUq4re4sZBMbmzdKo8LJF8uVQhbS24ZNeLRf7AntGH7b
+`
+
+func TestCreateSnapshotFromGenesis(t *testing.T) {
+	genesisTime, epochDuration := epoch.GenesisTime, epoch.Duration
+
+	tempDir := t.TempDir()
+	genesisFile := filepath.Join(tempDir, "genesis.yml")
+	require.NoError(t, os.WriteFile(genesisFile, []byte(testGenesis), 0o600))
+
+	genesis, err := LoadGenesis(genesisFile)
+	require.NoError(t, err)
+	require.NoError(t, CreateSnapshotFromGenesis(database.Version(1), filepath.Join(tempDir, "snapshot.bin"), genesis))
+
+	// the epoch parameters of the genesis are written to the snapshot without changing the ones of the process
+	require.Equal(t, genesisTime, epoch.GenesisTime)
+	require.Equal(t, epochDuration, epoch.Duration)
+
+	snapshotFile, err := os.Open(filepath.Join(tempDir, "snapshot.bin"))
+	require.NoError(t, err)
+	header, err := snapshot.ReadHeader(snapshotFile)
+	require.NoError(t, err)
+	require.NoError(t, snapshotFile.Close())
+	require.EqualValues(t, 1700000000, header.GenesisTime)
+	require.EqualValues(t, 20, header.EpochDuration)
+
+	s := storage.New(t.TempDir(), database.Version(1))
+	defer s.Shutdown()
+	e := engine.New(s, dpos.NewProvider(), mana1.NewProvider(), engine.WithEpochParameters(genesis.GenesisTime, genesis.EpochDuration))
+	defer e.Shutdown()
+	require.NoError(t, e.Initialize(filepath.Join(tempDir, "snapshot.bin")))
+
+	require.Equal(t, 4, e.LedgerState.UnspentOutputs.IDs.Size())
+
+	attestorID, err := identity.DecodeIDBase58("EUq4re4sZBMbmzdKo8LJF8uVQhbS24ZNeLRf7AntGH7b")
+	require.NoError(t, err)
+	attestorWeight, exists := e.SybilProtection.Weights().Get(attestorID)
+	require.True(t, exists)
+	require.EqualValues(t, 500, attestorWeight.Value)
+
+	attestations, err := e.NotarizationManager.Attestations.Get(0)
+	require.NoError(t, err)
+	require.True(t, attestations.Has(attestorID))
+}
+
+func TestGenesis_Validate(t *testing.T) {
+	validOutput := &GenesisOutput{
+		Address:               "1FnUfGXJsetK9Cf1iyp23itZDV3My9mwGStxtAi2JjFgg",
+		Balances:              []*GenesisBalance{{Color: "IOTA", Amount: 10}},
+		ConsensusManaPledgeID: "EUq4re4sZBMbmzdKo8LJF8uVQhbS24ZNeLRf7AntGH7b",
+	}
+
+	for name, testCase := range map[string]struct {
+		genesis *Genesis
+		valid   bool
+	}{
+		"valid": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10, Outputs: []*GenesisOutput{validOutput}, InitialAttestors: []string{validOutput.ConsensusManaPledgeID}},
+			valid:   true,
+		},
+		"missing genesis time": {
+			genesis: &Genesis{EpochDuration: 10, Outputs: []*GenesisOutput{validOutput}},
+		},
+		"no outputs": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10},
+		},
+		"invalid address": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10, Outputs: []*GenesisOutput{{Address: "invalid", Balances: validOutput.Balances}}},
+		},
+		"zero amount": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10, Outputs: []*GenesisOutput{{Address: validOutput.Address, Balances: []*GenesisBalance{{Amount: 0}}}}},
+		},
+		"alias below dust threshold": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10, Aliases: []*GenesisAlias{{StateAddress: validOutput.Address, Balances: []*GenesisBalance{{Amount: 1}}}}},
+		},
+		"attestor without consensus mana": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10, Outputs: []*GenesisOutput{validOutput}, InitialAttestors: []string{"Xv5Kmv9uZfNME4KD2zBoHZ3kVqovJN59ec62rH3AeLA"}},
+		},
+		"duplicate attestor": {
+			genesis: &Genesis{GenesisTime: 1, EpochDuration: 10, Outputs: []*GenesisOutput{validOutput}, InitialAttestors: []string{validOutput.ConsensusManaPledgeID, validOutput.ConsensusManaPledgeID}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if testCase.valid {
+				require.NoError(t, testCase.genesis.Validate())
+			} else {
+				require.Error(t, testCase.genesis.Validate())
+			}
+		})
+	}
+}
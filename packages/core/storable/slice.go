@@ -87,6 +87,23 @@ func (i *Slice[A, B]) Get(index int) (entry B, err error) {
 	return
 }
 
+// Truncate removes the entries at and after the given index.
+func (i *Slice[A, B]) Truncate(index int) (err error) {
+	i.Lock()
+	defer i.Unlock()
+
+	relativeIndex := index - i.startOffset
+	if relativeIndex < 0 {
+		return errors.Errorf("index %d is out of bounds", index)
+	}
+
+	if err = i.fileHandle.Truncate(int64(8 + relativeIndex*i.entrySize)); err != nil {
+		return errors.Wrap(err, "failed to truncate file")
+	}
+
+	return i.fileHandle.Sync()
+}
+
 func (i *Slice[A, B]) Close() (err error) {
 	return i.fileHandle.Close()
 }
@@ -12,3 +12,8 @@ type Endpoint interface {
 
 	Send(packet proto.Message, protocolID string, to ...identity.ID)
 }
+
+// NeighborsProvider is implemented by Endpoints that know the neighbors that they are connected to.
+type NeighborsProvider interface {
+	AllNeighborsIDs() []identity.ID
+}
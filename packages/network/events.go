@@ -6,6 +6,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
@@ -77,7 +78,10 @@ type EpochCommitmentRequestReceivedEvent struct {
 // region AttestationsReceivedEvent ////////////////////////////////////////////////////////////////////////////////////
 
 type AttestationsReceivedEvent struct {
-	Source identity.ID
+	Commitment   *commitment.Commitment
+	Roots        *commitment.Roots
+	Attestations []*notarization.Attestation
+	Source       identity.ID
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/iotaledger/hive.go/core/autopeering/peer"
+	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/iotaledger/hive.go/core/logger"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -63,11 +64,15 @@ type Manager struct {
 
 	registeredProtocolsMutex sync.RWMutex
 	registeredProtocols      map[protocol.ID]*ProtocolHandler
+
+	trafficCounters *TrafficCounters
+
+	optsTrafficPolicy *TrafficPolicy
 }
 
 // NewManager creates a new Manager.
-func NewManager(libp2pHost host.Host, local *peer.Local, log *logger.Logger) *Manager {
-	return &Manager{
+func NewManager(libp2pHost host.Host, local *peer.Local, log *logger.Logger, opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
 		libp2pHost: libp2pHost,
 		acceptMap:  map[libp2ppeer.ID]*AcceptMatcher{},
 		local:      local,
@@ -78,7 +83,12 @@ func NewManager(libp2pHost host.Host, local *peer.Local, log *logger.Logger) *Ma
 		},
 		neighbors:           map[identity.ID]*Neighbor{},
 		registeredProtocols: map[protocol.ID]*ProtocolHandler{},
-	}
+		trafficCounters:     NewTrafficCounters(),
+		optsTrafficPolicy: &TrafficPolicy{
+			ProtocolInboundLimits:  make(map[protocol.ID]TrafficLimits),
+			ProtocolOutboundLimits: make(map[protocol.ID]TrafficLimits),
+		},
+	}, opts)
 }
 
 // Stop stops the manager and closes all established connections.
@@ -175,6 +185,11 @@ func (m *Manager) Send(packet proto.Message, protocolID string, to ...identity.I
 	}
 }
 
+// TrafficStats returns the traffic statistics of all neighbors that were connected since the Manager was started.
+func (m *Manager) TrafficStats() TrafficStats {
+	return m.trafficCounters.Stats()
+}
+
 // AllNeighbors returns all the neighbors that are currently connected.
 func (m *Manager) AllNeighbors() []*Neighbor {
 	m.neighborsMutex.RLock()
@@ -260,7 +275,7 @@ func (m *Manager) addNeighbor(ctx context.Context, p *peer.Peer, group Neighbors
 	}, func(nbr *Neighbor) {
 		m.deleteNeighbor(nbr)
 		m.NeighborGroupEvents(nbr.Group).NeighborRemoved.Trigger(&NeighborRemovedEvent{nbr})
-	})
+	}, WithTrafficPolicy(m.optsTrafficPolicy), WithTotalTrafficCounters(m.trafficCounters))
 	if err := m.setNeighbor(nbr); err != nil {
 		for _, ps := range streams {
 			if resetErr := ps.Close(); resetErr != nil {
@@ -306,3 +321,38 @@ func (m *Manager) dropAllNeighbors() {
 		nbr.Close()
 	}
 }
+
+// WithInboundLimits sets the limits of the inbound traffic of each neighbor (all protocols combined).
+func WithInboundLimits(limits TrafficLimits) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTrafficPolicy.InboundLimits = limits
+	}
+}
+
+// WithOutboundLimits sets the limits of the outbound traffic to each neighbor (all protocols combined).
+func WithOutboundLimits(limits TrafficLimits) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTrafficPolicy.OutboundLimits = limits
+	}
+}
+
+// WithProtocolInboundLimits sets the limits of the inbound traffic of the given protocol of each neighbor.
+func WithProtocolInboundLimits(protocolID string, limits TrafficLimits) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTrafficPolicy.ProtocolInboundLimits[protocol.ID(protocolID)] = limits
+	}
+}
+
+// WithProtocolOutboundLimits sets the limits of the outbound traffic of the given protocol to each neighbor.
+func WithProtocolOutboundLimits(protocolID string, limits TrafficLimits) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTrafficPolicy.ProtocolOutboundLimits[protocol.ID(protocolID)] = limits
+	}
+}
+
+// WithPacketPriorityFunc sets the function that determines the priority of the packets that are sent to neighbors.
+func WithPacketPriorityFunc(packetPriorityFunc PacketPriorityFunc) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTrafficPolicy.PacketPriorityFunc = packetPriorityFunc
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotaledger/hive.go/core/autopeering/peer"
+	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/logger"
 )
 
@@ -56,14 +57,25 @@ type Neighbor struct {
 	// As it is only initialized from the Neighbor constructor, no locking is needed.
 	protocols map[protocol.ID]*PacketsStream
 
-	sendQueue chan *queuedPacket
+	highPrioritySendQueue chan *queuedPacket
+	lowPrioritySendQueue  chan *queuedPacket
+
+	counters                 *TrafficCounters
+	protocolCounters         map[protocol.ID]*TrafficCounters
+	inboundLimiter           *rateLimiter
+	outboundLimiter          *rateLimiter
+	protocolInboundLimiters  map[protocol.ID]*rateLimiter
+	protocolOutboundLimiters map[protocol.ID]*rateLimiter
+
+	optsTrafficPolicy *TrafficPolicy
+	optsTotalCounters *TrafficCounters
 }
 
 // NewNeighbor creates a new neighbor from the provided peer and connection.
-func NewNeighbor(p *peer.Peer, group NeighborsGroup, protocols map[protocol.ID]*PacketsStream, log *logger.Logger, packetReceivedCallback PacketReceivedFunc, disconnectedCallback NeighborDisconnectedFunc) *Neighbor {
+func NewNeighbor(p *peer.Peer, group NeighborsGroup, protocols map[protocol.ID]*PacketsStream, log *logger.Logger, packetReceivedCallback PacketReceivedFunc, disconnectedCallback NeighborDisconnectedFunc, opts ...options.Option[Neighbor]) *Neighbor {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	neighbor := &Neighbor{
+	neighbor := options.Apply(&Neighbor{
 		Peer:  p,
 		Group: group,
 
@@ -73,9 +85,15 @@ func NewNeighbor(p *peer.Peer, group NeighborsGroup, protocols map[protocol.ID]*
 		loopCtx:       ctx,
 		loopCtxCancel: cancel,
 
-		protocols: protocols,
-		sendQueue: make(chan *queuedPacket, NeighborsSendQueueSize),
-	}
+		protocols:             protocols,
+		highPrioritySendQueue: make(chan *queuedPacket, NeighborsSendQueueSize),
+		lowPrioritySendQueue:  make(chan *queuedPacket, NeighborsSendQueueSize),
+
+		counters:                 NewTrafficCounters(),
+		protocolCounters:         make(map[protocol.ID]*TrafficCounters),
+		protocolInboundLimiters:  make(map[protocol.ID]*rateLimiter),
+		protocolOutboundLimiters: make(map[protocol.ID]*rateLimiter),
+	}, opts, (*Neighbor).initTrafficLimits)
 
 	conn := neighbor.getAnyStream().Conn()
 
@@ -88,11 +106,18 @@ func NewNeighbor(p *peer.Peer, group NeighborsGroup, protocols map[protocol.ID]*
 	return neighbor
 }
 
+// Enqueue adds the packet to the send queue that corresponds to its priority (it is dropped if the queue is full).
 func (n *Neighbor) Enqueue(packet proto.Message, protocolID protocol.ID) {
+	sendQueue := n.lowPrioritySendQueue
+	if n.optsTrafficPolicy.packetPriority(protocolID, packet) == PacketPriorityHigh {
+		sendQueue = n.highPrioritySendQueue
+	}
+
 	select {
-	case n.sendQueue <- &queuedPacket{protocolID: protocolID, packet: packet}:
+	case sendQueue <- &queuedPacket{protocolID: protocolID, packet: packet}:
 	default:
-		n.Log.Warn("Dropped packet due to SendQueue being full")
+		n.outboundPacketDropped(protocolID)
+		n.Log.Debugw("Dropped packet due to SendQueue being full", "protocol", protocolID)
 	}
 }
 
@@ -117,6 +142,31 @@ func (n *Neighbor) PacketsWritten() (count uint64) {
 	return count
 }
 
+// Protocols returns the IDs of the protocols that the neighbor communicates with.
+func (n *Neighbor) Protocols() (protocolIDs []protocol.ID) {
+	protocolIDs = make([]protocol.ID, 0, len(n.protocols))
+	for protocolID := range n.protocols {
+		protocolIDs = append(protocolIDs, protocolID)
+	}
+
+	return protocolIDs
+}
+
+// TrafficStats returns the traffic statistics of all protocols of this neighbor.
+func (n *Neighbor) TrafficStats() TrafficStats {
+	return n.counters.Stats()
+}
+
+// ProtocolTrafficStats returns the traffic statistics of the given protocol of this neighbor.
+func (n *Neighbor) ProtocolTrafficStats(protocolID protocol.ID) (stats TrafficStats, exists bool) {
+	counters, exists := n.protocolCounters[protocolID]
+	if !exists {
+		return stats, false
+	}
+
+	return counters.Stats(), true
+}
+
 // ConnectionEstablished returns the connection established.
 func (n *Neighbor) ConnectionEstablished() time.Time {
 	return n.getAnyStream().Stat().Opened
@@ -155,6 +205,11 @@ func (n *Neighbor) readLoop() {
 					}
 					return
 				}
+
+				if size := proto.Size(packet); !n.allowInbound(protocolID, size) {
+					n.inboundPacketDropped(protocolID)
+					continue
+				}
 				n.packetReceivedFunc(n, protocolID, packet)
 			}
 		}(protocolID, stream)
@@ -166,31 +221,125 @@ func (n *Neighbor) writeLoop() {
 	go func() {
 		defer n.wg.Done()
 		for {
-			select {
-			case <-n.loopCtx.Done():
+			sendPacket, ok := n.nextQueuedPacket()
+			if !ok {
 				n.Log.Info("Exit writeLoop due to canceled context")
 				return
-			case sendPacket := <-n.sendQueue:
-				stream := n.GetStream(sendPacket.protocolID)
-				if stream == nil {
-					n.Log.Warnw("send error, no stream for protocol", "peer-id", n.ID(), "protocol", sendPacket.protocolID)
-					if disconnectErr := n.disconnect(); disconnectErr != nil {
-						n.Log.Warnw("Failed to disconnect", "err", disconnectErr)
-					}
-					return
+			}
+
+			stream := n.GetStream(sendPacket.protocolID)
+			if stream == nil {
+				n.Log.Warnw("send error, no stream for protocol", "peer-id", n.ID(), "protocol", sendPacket.protocolID)
+				if disconnectErr := n.disconnect(); disconnectErr != nil {
+					n.Log.Warnw("Failed to disconnect", "err", disconnectErr)
 				}
-				if err := stream.WritePacket(sendPacket.packet); err != nil {
-					n.Log.Warnw("send error", "peer-id", n.ID(), "err", err)
-					if disconnectErr := n.disconnect(); disconnectErr != nil {
-						n.Log.Warnw("Failed to disconnect", "err", disconnectErr)
-					}
-					return
+				return
+			}
+
+			size := proto.Size(sendPacket.packet)
+			if !n.waitOutbound(sendPacket.protocolID, size) {
+				n.Log.Info("Exit writeLoop due to canceled context")
+				return
+			}
+
+			if err := stream.WritePacket(sendPacket.packet); err != nil {
+				n.Log.Warnw("send error", "peer-id", n.ID(), "err", err)
+				if disconnectErr := n.disconnect(); disconnectErr != nil {
+					n.Log.Warnw("Failed to disconnect", "err", disconnectErr)
 				}
+				return
 			}
+			n.packetWritten(sendPacket.protocolID, size)
 		}
 	}()
 }
 
+// nextQueuedPacket returns the next packet that should be sent, where packets with a high priority are preferred.
+func (n *Neighbor) nextQueuedPacket() (sendPacket *queuedPacket, ok bool) {
+	select {
+	case sendPacket = <-n.highPrioritySendQueue:
+		return sendPacket, true
+	default:
+	}
+
+	select {
+	case <-n.loopCtx.Done():
+		return nil, false
+	case sendPacket = <-n.highPrioritySendQueue:
+		return sendPacket, true
+	case sendPacket = <-n.lowPrioritySendQueue:
+		return sendPacket, true
+	}
+}
+
+// initTrafficLimits creates the rate limiters and the counters of the protocols of the neighbor.
+func (n *Neighbor) initTrafficLimits() {
+	for protocolID := range n.protocols {
+		n.protocolCounters[protocolID] = NewTrafficCounters()
+	}
+
+	if n.optsTrafficPolicy == nil {
+		return
+	}
+
+	n.inboundLimiter = newRateLimiter(n.optsTrafficPolicy.InboundLimits)
+	n.outboundLimiter = newRateLimiter(n.optsTrafficPolicy.OutboundLimits)
+	for protocolID := range n.protocols {
+		n.protocolInboundLimiters[protocolID] = newRateLimiter(n.optsTrafficPolicy.ProtocolInboundLimits[protocolID])
+		n.protocolOutboundLimiters[protocolID] = newRateLimiter(n.optsTrafficPolicy.ProtocolOutboundLimits[protocolID])
+	}
+}
+
+// allowInbound accounts for the received packet and returns whether it conforms to the inbound limits.
+func (n *Neighbor) allowInbound(protocolID protocol.ID, size int) bool {
+	n.counters.packetRead(size)
+	n.protocolCounters[protocolID].packetRead(size)
+	n.optsTotalCounters.packetRead(size)
+
+	return allowAll(size, n.protocolInboundLimiters[protocolID], n.inboundLimiter)
+}
+
+// waitOutbound waits until a packet of the given size conforms to the outbound limits (it returns false if the
+// neighbor was disconnected in the meantime).
+func (n *Neighbor) waitOutbound(protocolID protocol.ID, size int) bool {
+	delay := n.protocolOutboundLimiters[protocolID].reserve(size)
+	if neighborDelay := n.outboundLimiter.reserve(size); neighborDelay > delay {
+		delay = neighborDelay
+	}
+
+	if delay <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-n.loopCtx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (n *Neighbor) packetWritten(protocolID protocol.ID, size int) {
+	n.counters.packetWritten(size)
+	n.protocolCounters[protocolID].packetWritten(size)
+	n.optsTotalCounters.packetWritten(size)
+}
+
+func (n *Neighbor) inboundPacketDropped(protocolID protocol.ID) {
+	n.counters.inboundPacketDropped()
+	n.protocolCounters[protocolID].inboundPacketDropped()
+	n.optsTotalCounters.inboundPacketDropped()
+}
+
+func (n *Neighbor) outboundPacketDropped(protocolID protocol.ID) {
+	n.counters.outboundPacketDropped()
+	n.protocolCounters[protocolID].outboundPacketDropped()
+	n.optsTotalCounters.outboundPacketDropped()
+}
+
 // Close closes the connection with the neighbor.
 func (n *Neighbor) Close() {
 	if err := n.disconnect(); err != nil {
@@ -216,3 +365,17 @@ func (n *Neighbor) disconnect() (err error) {
 	})
 	return err
 }
+
+// WithTrafficPolicy sets the limits and the priorities that are applied to the traffic of the neighbor.
+func WithTrafficPolicy(trafficPolicy *TrafficPolicy) options.Option[Neighbor] {
+	return func(n *Neighbor) {
+		n.optsTrafficPolicy = trafficPolicy
+	}
+}
+
+// WithTotalTrafficCounters sets counters that account for the traffic of the neighbor in addition to its own counters.
+func WithTotalTrafficCounters(counters *TrafficCounters) options.Option[Neighbor] {
+	return func(n *Neighbor) {
+		n.optsTotalCounters = counters
+	}
+}
@@ -0,0 +1,267 @@
+package p2p
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+)
+
+// region PacketPriority ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// PacketPriority is the priority with which a packet is sent to a neighbor.
+type PacketPriority uint8
+
+const (
+	// PacketPriorityLow is the priority of bulk traffic like the gossip of blocks.
+	PacketPriorityLow PacketPriority = iota
+	// PacketPriorityHigh is the priority of latency sensitive traffic like requests and their responses.
+	PacketPriorityHigh
+)
+
+// PacketPriorityFunc returns the priority of the given packet.
+type PacketPriorityFunc func(protocolID protocol.ID, packet proto.Message) PacketPriority
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TrafficLimits ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TrafficLimits defines the token bucket limits of the traffic in one direction. A rate of 0 disables the limit.
+type TrafficLimits struct {
+	// PacketsPerSecond is the rate at which packets are allowed.
+	PacketsPerSecond float64
+	// PacketBurst is the amount of packets that are allowed in a burst.
+	PacketBurst int
+	// BytesPerSecond is the rate at which bytes are allowed.
+	BytesPerSecond float64
+	// ByteBurst is the amount of bytes that are allowed in a burst.
+	ByteBurst int
+}
+
+// TrafficPolicy contains the limits and the priorities that are applied to the traffic of a neighbor.
+type TrafficPolicy struct {
+	// InboundLimits are the limits of the inbound traffic of all protocols combined.
+	InboundLimits TrafficLimits
+	// OutboundLimits are the limits of the outbound traffic of all protocols combined.
+	OutboundLimits TrafficLimits
+	// ProtocolInboundLimits are the limits of the inbound traffic of single protocols.
+	ProtocolInboundLimits map[protocol.ID]TrafficLimits
+	// ProtocolOutboundLimits are the limits of the outbound traffic of single protocols.
+	ProtocolOutboundLimits map[protocol.ID]TrafficLimits
+	// PacketPriorityFunc determines the priority of outbound packets (all packets have a low priority if it is nil).
+	PacketPriorityFunc PacketPriorityFunc
+}
+
+// packetPriority returns the priority of the given packet.
+func (t *TrafficPolicy) packetPriority(protocolID protocol.ID, packet proto.Message) PacketPriority {
+	if t == nil || t.PacketPriorityFunc == nil {
+		return PacketPriorityLow
+	}
+
+	return t.PacketPriorityFunc(protocolID, packet)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TrafficStats /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TrafficStats is a snapshot of the traffic counters of a neighbor (or of all neighbors).
+type TrafficStats struct {
+	PacketsRead            uint64
+	PacketsWritten         uint64
+	BytesRead              uint64
+	BytesWritten           uint64
+	PacketsDroppedInbound  uint64
+	PacketsDroppedOutbound uint64
+}
+
+// TrafficCounters contains the counters of the traffic of a neighbor (or of all neighbors).
+type TrafficCounters struct {
+	packetsRead            atomic.Uint64
+	packetsWritten         atomic.Uint64
+	bytesRead              atomic.Uint64
+	bytesWritten           atomic.Uint64
+	packetsDroppedInbound  atomic.Uint64
+	packetsDroppedOutbound atomic.Uint64
+}
+
+// NewTrafficCounters creates new TrafficCounters.
+func NewTrafficCounters() *TrafficCounters {
+	return new(TrafficCounters)
+}
+
+// Stats returns a snapshot of the counters.
+func (t *TrafficCounters) Stats() TrafficStats {
+	return TrafficStats{
+		PacketsRead:            t.packetsRead.Load(),
+		PacketsWritten:         t.packetsWritten.Load(),
+		BytesRead:              t.bytesRead.Load(),
+		BytesWritten:           t.bytesWritten.Load(),
+		PacketsDroppedInbound:  t.packetsDroppedInbound.Load(),
+		PacketsDroppedOutbound: t.packetsDroppedOutbound.Load(),
+	}
+}
+
+func (t *TrafficCounters) packetRead(size int) {
+	if t != nil {
+		t.packetsRead.Inc()
+		t.bytesRead.Add(uint64(size))
+	}
+}
+
+func (t *TrafficCounters) packetWritten(size int) {
+	if t != nil {
+		t.packetsWritten.Inc()
+		t.bytesWritten.Add(uint64(size))
+	}
+}
+
+func (t *TrafficCounters) inboundPacketDropped() {
+	if t != nil {
+		t.packetsDroppedInbound.Inc()
+	}
+}
+
+func (t *TrafficCounters) outboundPacketDropped() {
+	if t != nil {
+		t.packetsDroppedOutbound.Inc()
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region rateLimiter //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// rateLimiter enforces TrafficLimits with a token bucket for packets and one for bytes.
+type rateLimiter struct {
+	packets tokenBucket
+	bytes   tokenBucket
+	mutex   sync.Mutex
+}
+
+// newRateLimiter creates a rateLimiter for the given limits (nil if the limits are disabled).
+func newRateLimiter(limits TrafficLimits) *rateLimiter {
+	if limits.PacketsPerSecond <= 0 && limits.BytesPerSecond <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	return &rateLimiter{
+		packets: newTokenBucket(limits.PacketsPerSecond, limits.PacketBurst, now),
+		bytes:   newTokenBucket(limits.BytesPerSecond, limits.ByteBurst, now),
+	}
+}
+
+// allow consumes the tokens of a packet of the given size if both buckets contain enough tokens.
+func (r *rateLimiter) allow(size int) bool {
+	return allowAll(size, r)
+}
+
+// reserve consumes the tokens of a packet of the given size and returns how long the caller has to wait before the
+// packet conforms to the limits.
+func (r *rateLimiter) reserve(size int) time.Duration {
+	if r == nil {
+		return 0
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	delay := r.packets.delay(1, now)
+	if bytesDelay := r.bytes.delay(float64(size), now); bytesDelay > delay {
+		delay = bytesDelay
+	}
+
+	r.take(size)
+
+	return delay
+}
+
+// conforms refills the buckets and returns whether they contain enough tokens for a packet of the given size.
+func (r *rateLimiter) conforms(size int, now time.Time) bool {
+	return r.packets.delay(1, now) <= 0 && r.bytes.delay(float64(size), now) <= 0
+}
+
+// take removes the tokens of a packet of the given size from both buckets.
+func (r *rateLimiter) take(size int) {
+	r.packets.take(1)
+	r.bytes.take(float64(size))
+}
+
+// allowAll consumes the tokens of a packet of the given size from all given limiters if all of them contain enough
+// tokens (no tokens are consumed if any of them rejects the packet).
+func allowAll(size int, limiters ...*rateLimiter) bool {
+	now := time.Now()
+	for _, limiter := range limiters {
+		if limiter == nil {
+			continue
+		}
+
+		limiter.mutex.Lock()
+		//nolint:gocritic // the limiters are unlocked after the tokens were taken from all of them
+		defer limiter.mutex.Unlock()
+
+		if !limiter.conforms(size, now) {
+			return false
+		}
+	}
+
+	for _, limiter := range limiters {
+		if limiter != nil {
+			limiter.take(size)
+		}
+	}
+
+	return true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region tokenBucket //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// tokenBucket is a token bucket that may go into debt to allow amounts that are larger than the burst.
+type tokenBucket struct {
+	rate       float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) tokenBucket {
+	return tokenBucket{
+		rate:       rate,
+		burst:      math.Max(float64(burst), 1),
+		tokens:     math.Max(float64(burst), 1),
+		lastRefill: now,
+	}
+}
+
+// delay refills the bucket and returns how long it takes until the given amount (capped by the burst) is available.
+func (t *tokenBucket) delay(amount float64, now time.Time) time.Duration {
+	if t.rate <= 0 {
+		return 0
+	}
+
+	t.tokens = math.Min(t.burst, t.tokens+now.Sub(t.lastRefill).Seconds()*t.rate)
+	t.lastRefill = now
+
+	if missingTokens := math.Min(amount, t.burst) - t.tokens; missingTokens > 0 {
+		return time.Duration(missingTokens / t.rate * float64(time.Second))
+	}
+
+	return 0
+}
+
+// take removes the given amount of tokens from the bucket.
+func (t *tokenBucket) take(amount float64) {
+	if t.rate > 0 {
+		t.tokens -= amount
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
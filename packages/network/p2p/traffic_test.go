@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	require.Nil(t, newRateLimiter(TrafficLimits{}))
+	require.True(t, newRateLimiter(TrafficLimits{}).allow(1<<20))
+
+	rateLimiter := newRateLimiter(TrafficLimits{
+		PacketsPerSecond: 1,
+		PacketBurst:      2,
+		BytesPerSecond:   1000,
+		ByteBurst:        1000,
+	})
+
+	// the packet burst is exhausted after two packets
+	require.True(t, rateLimiter.allow(10))
+	require.True(t, rateLimiter.allow(10))
+	require.False(t, rateLimiter.allow(10))
+
+	// packets that exceed the byte limit do not consume packet tokens
+	rateLimiter = newRateLimiter(TrafficLimits{
+		PacketsPerSecond: 1,
+		PacketBurst:      1,
+		BytesPerSecond:   1000,
+		ByteBurst:        100,
+	})
+	require.True(t, rateLimiter.allow(60))
+	rateLimiter.packets.tokens = 1
+	require.False(t, rateLimiter.allow(60))
+	assert.Equal(t, float64(1), rateLimiter.packets.tokens)
+}
+
+func TestRateLimiter_AllowAll(t *testing.T) {
+	protocolLimiter := newRateLimiter(TrafficLimits{
+		PacketsPerSecond: 1,
+		PacketBurst:      2,
+	})
+	neighborLimiter := newRateLimiter(TrafficLimits{
+		PacketsPerSecond: 1,
+		PacketBurst:      1,
+	})
+
+	require.True(t, allowAll(10, protocolLimiter, nil, neighborLimiter))
+
+	// packets that are rejected by one limiter do not consume the tokens of the others
+	require.False(t, allowAll(10, protocolLimiter, neighborLimiter))
+	assert.InDelta(t, 1, protocolLimiter.packets.tokens, 0.01)
+
+	neighborLimiter.packets.tokens = 1
+	require.True(t, allowAll(10, protocolLimiter, neighborLimiter))
+}
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	rateLimiter := newRateLimiter(TrafficLimits{
+		BytesPerSecond: 1000,
+		ByteBurst:      1000,
+	})
+
+	// packets that are larger than the burst are allowed once the bucket is full but the debt has to be paid off
+	require.Zero(t, rateLimiter.reserve(2000))
+	delay := rateLimiter.reserve(500)
+	assert.Greater(t, delay, 1400*time.Millisecond)
+	assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+}
+
+func TestTrafficCounters(t *testing.T) {
+	counters := NewTrafficCounters()
+	counters.packetRead(10)
+	counters.packetRead(20)
+	counters.packetWritten(5)
+	counters.inboundPacketDropped()
+	counters.outboundPacketDropped()
+	counters.outboundPacketDropped()
+
+	assert.Equal(t, TrafficStats{
+		PacketsRead:            2,
+		PacketsWritten:         1,
+		BytesRead:              30,
+		BytesWritten:           5,
+		PacketsDroppedInbound:  1,
+		PacketsDroppedOutbound: 2,
+	}, counters.Stats())
+
+	// nil counters are ignored
+	var nilCounters *TrafficCounters
+	assert.NotPanics(t, func() {
+		nilCounters.packetRead(10)
+		nilCounters.packetWritten(5)
+		nilCounters.inboundPacketDropped()
+		nilCounters.outboundPacketDropped()
+	})
+}
@@ -12,16 +12,20 @@ import (
 	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/generics/shrinkingmap"
 	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/marshalutil"
 	"github.com/iotaledger/hive.go/core/types"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	nwmodels "github.com/iotaledger/goshimmer/packages/network/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
 const (
-	protocolID = "iota/0.0.1"
+	// ProtocolID is the identifier of the protocol that gossips blocks and answers requests for blocks, commitments and
+	// attestations.
+	ProtocolID = "iota/0.0.1"
 )
 
 type Protocol struct {
@@ -42,14 +46,14 @@ func NewProtocol(network Endpoint, opts ...options.Option[Protocol]) (protocol *
 		duplicateBlockBytesFilter: bytesfilter.New(10000),
 		requestedBlockHashes:      shrinkingmap.New[types.Identifier, types.Empty](shrinkingmap.WithShrinkingThresholdCount(1000)),
 	}, opts, func(p *Protocol) {
-		network.RegisterProtocol(protocolID, newPacket, p.handlePacket)
+		network.RegisterProtocol(ProtocolID, newPacket, p.handlePacket)
 	})
 }
 
 func (p *Protocol) SendBlock(block *models.Block, to ...identity.ID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{
 		Bytes: lo.PanicOnErr(block.Bytes()),
-	}}}, protocolID, to...)
+	}}}, ProtocolID, to...)
 }
 
 func (p *Protocol) RequestBlock(id models.BlockID, to ...identity.ID) {
@@ -59,29 +63,53 @@ func (p *Protocol) RequestBlock(id models.BlockID, to ...identity.ID) {
 
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{
 		Bytes: lo.PanicOnErr(id.Bytes()),
-	}}}, protocolID, to...)
+	}}}, ProtocolID, to...)
 }
 
 func (p *Protocol) SendEpochCommitment(cm *commitment.Commitment, to ...identity.ID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_EpochCommitment{EpochCommitment: &nwmodels.EpochCommitment{
 		Bytes: lo.PanicOnErr(cm.Bytes()),
-	}}}, protocolID, to...)
+	}}}, ProtocolID, to...)
 }
 
 func (p *Protocol) RequestCommitment(id commitment.ID, to ...identity.ID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_EpochCommitmentRequest{EpochCommitmentRequest: &nwmodels.EpochCommitmentRequest{
 		Bytes: lo.PanicOnErr(id.Bytes()),
-	}}}, protocolID, to...)
+	}}}, ProtocolID, to...)
+}
+
+// SendAttestations sends the attestations of the given commitment together with its roots (that tie the attestations
+// to the commitment).
+func (p *Protocol) SendAttestations(cm *commitment.Commitment, roots *commitment.Roots, attestations []*notarization.Attestation, to ...identity.ID) {
+	commitmentBytes := lo.PanicOnErr(cm.Bytes())
+	rootsBytes := lo.PanicOnErr(roots.Bytes())
+
+	marshalUtil := marshalutil.New()
+	marshalUtil.WriteUint32(uint32(len(commitmentBytes)))
+	marshalUtil.WriteBytes(commitmentBytes)
+	marshalUtil.WriteUint32(uint32(len(rootsBytes)))
+	marshalUtil.WriteBytes(rootsBytes)
+	marshalUtil.WriteUint32(uint32(len(attestations)))
+	for _, attestation := range attestations {
+		attestationBytes := lo.PanicOnErr(attestation.Bytes())
+
+		marshalUtil.WriteUint32(uint32(len(attestationBytes)))
+		marshalUtil.WriteBytes(attestationBytes)
+	}
+
+	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Attestations{Attestations: &nwmodels.Attestations{
+		Bytes: marshalUtil.Bytes(),
+	}}}, ProtocolID, to...)
 }
 
 func (p *Protocol) RequestAttestations(index epoch.Index, to ...identity.ID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_AttestationsRequest{AttestationsRequest: &nwmodels.AttestationsRequest{
 		Bytes: index.Bytes(),
-	}}}, protocolID, to...)
+	}}}, ProtocolID, to...)
 }
 
 func (p *Protocol) Unregister() {
-	p.network.UnregisterProtocol(protocolID)
+	p.network.UnregisterProtocol(ProtocolID)
 }
 
 func (p *Protocol) handlePacket(nbr identity.ID, packet proto.Message) (err error) {
@@ -195,10 +223,21 @@ func (p *Protocol) onEpochCommitmentRequest(idBytes []byte, id identity.ID) {
 }
 
 func (p *Protocol) onAttestations(attestationsBytes []byte, id identity.ID) {
-	// TODO: PARSE BYTES
+	receivedCommitment, receivedRoots, attestations, err := attestationsFromBytes(attestationsBytes)
+	if err != nil {
+		p.Events.Error.Trigger(&ErrorEvent{
+			Error:  errors.Wrap(err, "failed to deserialize attestations"),
+			Source: id,
+		})
+
+		return
+	}
 
 	p.Events.AttestationsReceived.Trigger(&AttestationsReceivedEvent{
-		Source: id,
+		Commitment:   receivedCommitment,
+		Roots:        receivedRoots,
+		Attestations: attestations,
+		Source:       id,
 	})
 }
 
@@ -219,6 +258,65 @@ func (p *Protocol) onAttestationsRequest(epochIndexBytes []byte, id identity.ID)
 	})
 }
 
+func attestationsFromBytes(attestationsBytes []byte) (receivedCommitment *commitment.Commitment, receivedRoots *commitment.Roots, attestations []*notarization.Attestation, err error) {
+	marshalUtil := marshalutil.New(attestationsBytes)
+
+	commitmentBytes, err := readLengthPrefixedBytes(marshalUtil)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to read commitment bytes")
+	}
+
+	receivedCommitment = new(commitment.Commitment)
+	if _, err = receivedCommitment.FromBytes(commitmentBytes); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to parse commitment")
+	}
+
+	rootsBytes, err := readLengthPrefixedBytes(marshalUtil)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to read roots bytes")
+	}
+
+	receivedRoots = new(commitment.Roots)
+	if _, err = receivedRoots.FromBytes(rootsBytes); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to parse roots")
+	}
+
+	attestationsCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to read attestations count")
+	}
+
+	attestations = make([]*notarization.Attestation, 0)
+	for i := uint32(0); i < attestationsCount; i++ {
+		attestationBytes, readErr := readLengthPrefixedBytes(marshalUtil)
+		if readErr != nil {
+			return nil, nil, nil, errors.Wrapf(readErr, "failed to read attestation %d", i)
+		}
+
+		attestation := new(notarization.Attestation)
+		if _, err = attestation.FromBytes(attestationBytes); err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "failed to parse attestation %d", i)
+		}
+
+		attestations = append(attestations, attestation)
+	}
+
+	if done, doneErr := marshalUtil.DoneReading(); doneErr != nil || !done {
+		return nil, nil, nil, errors.New("unexpected trailing bytes")
+	}
+
+	return receivedCommitment, receivedRoots, attestations, nil
+}
+
+func readLengthPrefixedBytes(marshalUtil *marshalutil.MarshalUtil) (bytes []byte, err error) {
+	length, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read length")
+	}
+
+	return marshalUtil.ReadBytes(int(length))
+}
+
 func newPacket() proto.Message {
 	return &nwmodels.Packet{}
 }
@@ -129,6 +129,20 @@ func (m *MockedEndpoint) Send(packet proto.Message, protocolID string, to ...ide
 	}
 }
 
+// AllNeighborsIDs returns the IDs of all other endpoints in the same partition.
+func (m *MockedEndpoint) AllNeighborsIDs() (ids []identity.ID) {
+	m.network.dispatchersMutex.RLock()
+	defer m.network.dispatchersMutex.RUnlock()
+
+	for id := range m.network.dispatchersByPartition[m.partition] {
+		if id != m.id {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
 func (m *MockedEndpoint) handler(protocolID string) (handler func(identity.ID, proto.Message) error, exists bool) {
 	m.handlersMutex.RLock()
 	defer m.handlersMutex.RUnlock()
@@ -138,6 +152,9 @@ func (m *MockedEndpoint) handler(protocolID string) (handler func(identity.ID, p
 	return
 }
 
-var _ Endpoint = &MockedEndpoint{}
+var (
+	_ Endpoint          = &MockedEndpoint{}
+	_ NeighborsProvider = &MockedEndpoint{}
+)
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
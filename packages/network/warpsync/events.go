@@ -9,14 +9,16 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
-// Events defines all the events related to the gossip protocol.
+// Events defines all the events related to the warpsync protocol.
 type Events struct {
-	// Fired when a new block was received via the gossip protocol.
-	EpochCommitmentReceived    *event.Linkable[*EpochCommitmentReceivedEvent]
+	// Fired when a peer requests the blocks of an epoch.
 	EpochBlocksRequestReceived *event.Linkable[*EpochBlocksRequestReceivedEvent]
-	EpochBlocksStart           *event.Linkable[*EpochBlocksStartEvent]
-	EpochBlock                 *event.Linkable[*EpochBlockEvent]
-	EpochBlocksEnd             *event.Linkable[*EpochBlocksEndEvent]
+	// Fired when a peer starts sending the blocks of an epoch.
+	EpochBlocksStart *event.Linkable[*EpochBlocksStartEvent]
+	// Fired for every block of an epoch that was received from a peer.
+	EpochBlock *event.Linkable[*EpochBlockEvent]
+	// Fired when a peer finished sending the blocks of an epoch.
+	EpochBlocksEnd *event.Linkable[*EpochBlocksEndEvent]
 
 	event.LinkableCollection[Events, *Events]
 }
@@ -24,17 +26,14 @@ type Events struct {
 // NewEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewEvents = event.LinkableConstructor(func() (newEvents *Events) {
 	return &Events{
-		EpochCommitmentReceived: event.NewLinkable[*EpochCommitmentReceivedEvent](),
+		EpochBlocksRequestReceived: event.NewLinkable[*EpochBlocksRequestReceivedEvent](),
+		EpochBlocksStart:           event.NewLinkable[*EpochBlocksStartEvent](),
+		EpochBlock:                 event.NewLinkable[*EpochBlockEvent](),
+		EpochBlocksEnd:             event.NewLinkable[*EpochBlocksEndEvent](),
 	}
 })
 
-// EpochCommitmentReceivedEvent holds data about an epoch commitment received event.
-type EpochCommitmentReceivedEvent struct {
-	ID       identity.ID
-	ECRecord *commitment.Commitment
-}
-
-// EpochBlocksRequestReceivedEvent holds data about an epoc blocks request received event.
+// EpochBlocksRequestReceivedEvent holds data about an epoch blocks request received event.
 type EpochBlocksRequestReceivedEvent struct {
 	ID identity.ID
 	EI epoch.Index
@@ -43,14 +42,17 @@ type EpochBlocksRequestReceivedEvent struct {
 
 // EpochBlocksStartEvent holds data about an epoch blocks start event.
 type EpochBlocksStartEvent struct {
-	ID identity.ID
-	EI epoch.Index
+	ID          identity.ID
+	EI          epoch.Index
+	EC          commitment.ID
+	BlocksCount int64
 }
 
 // EpochBlockEvent holds data about an epoch block event.
 type EpochBlockEvent struct {
 	ID    identity.ID
 	EI    epoch.Index
+	EC    commitment.ID
 	Block *models.Block
 }
 
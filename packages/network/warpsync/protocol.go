@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	protocolID = "warpsync/0.0.1"
+	// ProtocolID is the identifier of the protocol that syncs whole epochs of blocks.
+	ProtocolID = "warpsync/0.0.1"
 )
 
 type Protocol struct {
@@ -29,13 +30,13 @@ func New(networkEndpoing network.Endpoint, log *logger.Logger) (protocol *Protoc
 		log:             log,
 	}
 
-	protocol.networkEndpoint.RegisterProtocol(protocolID, warpSyncPacketFactory, protocol.handlePacket)
+	protocol.networkEndpoint.RegisterProtocol(ProtocolID, warpSyncPacketFactory, protocol.handlePacket)
 
 	return
 }
 
 func (p *Protocol) Stop() {
-	p.networkEndpoint.UnregisterProtocol(protocolID)
+	p.networkEndpoint.UnregisterProtocol(ProtocolID)
 }
 
 func (p *Protocol) handlePacket(id identity.ID, packet proto.Message) error {
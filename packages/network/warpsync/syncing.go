@@ -16,7 +16,7 @@ func (p *Protocol) RequestEpochBlocks(ei epoch.Index, ec commitment.ID, to ...id
 		EC: lo.PanicOnErr(ec.Bytes()),
 	}
 	packet := &wp.Packet{Body: &wp.Packet_EpochBlocksRequest{EpochBlocksRequest: epochBlocksReq}}
-	p.networkEndpoint.Send(packet, protocolID, to...)
+	p.networkEndpoint.Send(packet, ProtocolID, to...)
 
 	p.log.Debugw("sent epoch blocks request", "Index", ei, "EC", ec.Base58())
 }
@@ -29,7 +29,7 @@ func (p *Protocol) SendEpochStarter(ei epoch.Index, ec commitment.ID, blocksCoun
 	}
 	packet := &wp.Packet{Body: &wp.Packet_EpochBlocksStart{EpochBlocksStart: epochStartRes}}
 
-	p.networkEndpoint.Send(packet, protocolID, to...)
+	p.networkEndpoint.Send(packet, ProtocolID, to...)
 }
 
 func (p *Protocol) SendBlocksBatch(ei epoch.Index, ec commitment.ID, blocks []*models.Block, to ...identity.ID) {
@@ -51,7 +51,7 @@ func (p *Protocol) SendBlocksBatch(ei epoch.Index, ec commitment.ID, blocks []*m
 	}
 	packet := &wp.Packet{Body: &wp.Packet_EpochBlocksBatch{EpochBlocksBatch: blocksBatchRes}}
 
-	p.networkEndpoint.Send(packet, protocolID, to...)
+	p.networkEndpoint.Send(packet, ProtocolID, to...)
 }
 
 func (p *Protocol) SendEpochEnd(ei epoch.Index, ec commitment.ID, roots *commitment.Roots, to ...identity.ID) {
@@ -62,14 +62,14 @@ func (p *Protocol) SendEpochEnd(ei epoch.Index, ec commitment.ID, roots *commitm
 	}
 	packet := &wp.Packet{Body: &wp.Packet_EpochBlocksEnd{EpochBlocksEnd: epochBlocksEnd}}
 
-	p.networkEndpoint.Send(packet, protocolID, to...)
+	p.networkEndpoint.Send(packet, ProtocolID, to...)
 }
 
 func (p *Protocol) processEpochBlocksRequestPacket(packetEpochRequest *wp.Packet_EpochBlocksRequest, id identity.ID) {
 	ei := epoch.Index(packetEpochRequest.EpochBlocksRequest.GetEI())
-	ec := new(commitment.Commitment)
+	var ec commitment.ID
 	if _, err := ec.FromBytes(packetEpochRequest.EpochBlocksRequest.GetEC()); err != nil {
-		p.log.Errorw("received epoch blocks request: unable to deserialize commitment", "peer", id, "Index", ei, "err", err)
+		p.log.Errorw("received epoch blocks request: unable to deserialize commitment id", "peer", id, "Index", ei, "err", err)
 		return
 	}
 
@@ -78,6 +78,7 @@ func (p *Protocol) processEpochBlocksRequestPacket(packetEpochRequest *wp.Packet
 	p.Events.EpochBlocksRequestReceived.Trigger(&EpochBlocksRequestReceivedEvent{
 		ID: id,
 		EI: ei,
+		EC: ec,
 	})
 }
 
@@ -85,11 +86,19 @@ func (p *Protocol) processEpochBlocksStartPacket(packetEpochBlocksStart *wp.Pack
 	epochBlocksStart := packetEpochBlocksStart.EpochBlocksStart
 	ei := epoch.Index(epochBlocksStart.GetEI())
 
+	var ec commitment.ID
+	if _, err := ec.FromBytes(epochBlocksStart.GetEC()); err != nil {
+		p.log.Errorw("received epoch blocks start: unable to deserialize commitment id", "peer", id, "Index", ei, "err", err)
+		return
+	}
+
 	p.log.Debugw("received epoch blocks start", "peer", id, "Index", ei, "blocksCount", epochBlocksStart.GetBlocksCount())
 
 	p.Events.EpochBlocksStart.Trigger(&EpochBlocksStartEvent{
-		ID: id,
-		EI: ei,
+		ID:          id,
+		EI:          ei,
+		EC:          ec,
+		BlocksCount: epochBlocksStart.GetBlocksCount(),
 	})
 }
 
@@ -97,46 +106,58 @@ func (p *Protocol) processEpochBlocksBatchPacket(packetEpochBlocksBatch *wp.Pack
 	epochBlocksBatch := packetEpochBlocksBatch.EpochBlocksBatch
 	ei := epoch.Index(epochBlocksBatch.GetEI())
 
+	var ec commitment.ID
+	if _, err := ec.FromBytes(epochBlocksBatch.GetEC()); err != nil {
+		p.log.Errorw("received epoch blocks batch: unable to deserialize commitment id", "peer", id, "Index", ei, "err", err)
+		return
+	}
+
 	blocksBytes := epochBlocksBatch.GetBlocks()
 	p.log.Debugw("received epoch blocks", "peer", id, "Index", ei, "blocksLen", len(blocksBytes))
 
-	// TODO: TRIGGER UNPARSED BLOCK INSTEAD
-	// for _, blockBytes := range blocksBytes {
-	// 	block, err := p.parser.ParseBlock(nbr, blockBytes)
-	// 	if err != nil {
-	// 		p.log.Errorw("failed to deserialize block", "peer", nbr.Peer.ID(), "err", err)
-	// 		return
-	// 	}
-	//
-	// 	p.Events.EpochBlock.Trigger(&EpochBlockEvent{
-	// 		EI:    ei,
-	// 		Block: block,
-	// 	})
-	// }
+	for _, blockBytes := range blocksBytes {
+		block := new(models.Block)
+		if _, err := block.FromBytes(blockBytes); err != nil {
+			p.log.Errorw("failed to deserialize block", "peer", id, "err", err)
+			return
+		}
+
+		if err := block.DetermineID(); err != nil {
+			p.log.Errorw("failed to determine block id", "peer", id, "err", err)
+			return
+		}
+
+		p.Events.EpochBlock.Trigger(&EpochBlockEvent{
+			ID:    id,
+			EI:    ei,
+			EC:    ec,
+			Block: block,
+		})
+	}
 }
 
 func (p *Protocol) processEpochBlocksEndPacket(packetEpochBlocksEnd *wp.Packet_EpochBlocksEnd, id identity.ID) {
-	epochBlocksBatch := packetEpochBlocksEnd.EpochBlocksEnd
-	ei := epoch.Index(epochBlocksBatch.GetEI())
+	epochBlocksEnd := packetEpochBlocksEnd.EpochBlocksEnd
+	ei := epoch.Index(epochBlocksEnd.GetEI())
 
-	ec := new(commitment.Commitment)
-	if _, err := ec.FromBytes(packetEpochBlocksEnd.EpochBlocksEnd.GetEC()); err != nil {
-		p.log.Errorw("received epoch blocks end: unable to deserialize commitment", "peer", id, "Index", ei, "err", err)
+	var ec commitment.ID
+	if _, err := ec.FromBytes(epochBlocksEnd.GetEC()); err != nil {
+		p.log.Errorw("received epoch blocks end: unable to deserialize commitment id", "peer", id, "Index", ei, "err", err)
 		return
 	}
 
-	p.log.Debugw("received epoch blocks end", "peer", id, "Index", ei)
-
-	eventToTrigger := &EpochBlocksEndEvent{
-		ID:    id,
-		EI:    ei,
-		EC:    ec.ID(),
-		Roots: new(commitment.Roots),
-	}
-	if _, err := eventToTrigger.Roots.FromBytes(packetEpochBlocksEnd.EpochBlocksEnd.GetRoots()); err != nil {
+	roots := new(commitment.Roots)
+	if _, err := roots.FromBytes(epochBlocksEnd.GetRoots()); err != nil {
 		p.log.Errorw("received epoch blocks end: unable to deserialize roots", "peer", id, "Index", ei, "err", err)
 		return
 	}
 
-	p.Events.EpochBlocksEnd.Trigger(eventToTrigger)
+	p.log.Debugw("received epoch blocks end", "peer", id, "Index", ei)
+
+	p.Events.EpochBlocksEnd.Trigger(&EpochBlocksEndEvent{
+		ID:    id,
+		EI:    ei,
+		EC:    ec,
+		Roots: roots,
+	})
 }
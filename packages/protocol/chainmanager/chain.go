@@ -79,6 +79,20 @@ func (c *Chain) Commitment(index epoch.Index) (commitment *Commitment) {
 	return c.commitmentsByIndex[index]
 }
 
+// LatestCommitment returns the Commitment with the highest index that is part of the Chain.
+func (c *Chain) LatestCommitment() (latestCommitment *Commitment) {
+	c.RLock()
+	defer c.RUnlock()
+
+	for index, commitment := range c.commitmentsByIndex {
+		if latestCommitment == nil || index > latestCommitment.Commitment().Index() {
+			latestCommitment = commitment
+		}
+	}
+
+	return latestCommitment
+}
+
 func (c *Chain) Size() int {
 	c.RLock()
 	defer c.RUnlock()
@@ -26,13 +26,13 @@ type Manager struct {
 	commitmentEntityMutex *syncutils.DAGMutex[commitment.ID]
 }
 
-func NewManager(snapshot *commitment.Commitment) (manager *Manager) {
-	manager = &Manager{
+func NewManager(snapshot *commitment.Commitment, opts ...options.Option[Manager]) (manager *Manager) {
+	manager = options.Apply(&Manager{
 		Events: NewEvents(),
 
 		commitmentsByID:       make(map[commitment.ID]*Commitment),
 		commitmentEntityMutex: syncutils.NewDAGMutex[commitment.ID](),
-	}
+	}, opts)
 
 	manager.SnapshotCommitment, _ = manager.Commitment(snapshot.ID(), true)
 	manager.SnapshotCommitment.PublishCommitment(snapshot)
@@ -186,3 +186,10 @@ func (m *Manager) propagateSolidity(child *Commitment) (childrenToUpdate []*Comm
 
 	return
 }
+
+// WithCommitmentRequesterOptions is an option for the Manager that allows to configure the CommitmentRequester.
+func WithCommitmentRequesterOptions(opts ...options.Option[eventticker.EventTicker[commitment.ID]]) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsCommitmentRequester = opts
+	}
+}
@@ -76,11 +76,14 @@ func New(evictionState *eviction.State, isBlockAccepted func(models.BlockID) boo
 		blocks:                             memstorage.NewEpochStorage[models.BlockID, *Block](),
 		optsMaxBufferSize:                  300,
 		optsAcceptedBlockScheduleThreshold: 5 * time.Minute,
-		optsRate:                           5 * time.Millisecond,      // measured in time per unit work
-		optsMaxDeficit:                     new(big.Rat).SetInt64(10), // must be >= max block work, but work is currently=1 for all blocks
+		optsRate:                           5 * time.Millisecond,                       // measured in time per unit work
+		optsMaxDeficit:                     new(big.Rat).SetInt64(models.MaxBlockWork), // must be >= max block work
 
 		shutdownSignal: make(chan struct{}),
 	}, opts, func(s *Scheduler) {
+		// a smaller max deficit would prevent the largest blocks from ever being scheduled
+		s.optsMaxDeficit = maxRat(s.optsMaxDeficit, new(big.Rat).SetInt64(models.MaxBlockWork))
+
 		s.ticker = time.NewTicker(s.optsRate)
 		s.buffer = NewBufferQueue(s.optsMaxBufferSize)
 	}, (*Scheduler).setupEvents)
@@ -420,18 +423,28 @@ func (s *Scheduler) block(id models.BlockID) (block *Block, exists bool) {
 func (s *Scheduler) mainLoop() {
 	defer s.ticker.Stop()
 
+	currentPause := s.optsRate
+
 loop:
 	for {
 		select {
-		// every rate time units
+		// every rate time units (times the work of the previously scheduled block)
 		case <-s.ticker.C:
+			pause := s.optsRate
 			if block := s.schedule(); block != nil {
-				// TODO: make this operate in units of work, with a variable pause between scheduling depending on work scheduled.
 				// TODO: don't use a ticker. Switch to a simple timer instead, and use a flag when ready to schedule something if there is nothing ready to be scheduled yet.
 				// TODO: implement a token bucket for the scheduler to account for bursty arrivals.
 				if block.SetScheduled() {
 					s.Events.BlockScheduled.Trigger(block)
 				}
+
+				// pause for the time that is required to process the work of the scheduled block
+				pause = time.Duration(block.Work()) * s.optsRate
+			}
+
+			if pause != currentPause {
+				s.ticker.Reset(pause)
+				currentPause = pause
 			}
 		// on close, exit the loop
 		case <-s.shutdownSignal:
@@ -20,6 +20,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker/markers"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/virtualvoting"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 )
 
 // region Scheduler_test /////////////////////////////////////////////////////////////////////////////////////////////
@@ -499,6 +500,43 @@ func TestSchedulerFlow(t *testing.T) {
 	require.Equal(t, scheduledIDs, []models.BlockID{tf.Block("A").ID(), tf.Block("B").ID(), tf.Block("D").ID(), tf.Block("E").ID(), tf.Block("C").ID()})
 }
 
+func TestScheduler_Work(t *testing.T) {
+	tf := NewTestFramework(t)
+
+	tf.CreateIssuer("heavy", 10)
+	tf.CreateIssuer("light", 10)
+
+	// blocks of the heavy issuer carry enough payload to cost 5 units of work each
+	for i := 0; i < 20; i++ {
+		data := make([]byte, 4*models.WorkUnitSize)
+		data[0] = byte(i)
+
+		blk := tf.CreateSchedulerBlock(models.WithIssuer(tf.Issuer("heavy").PublicKey()), models.WithPayload(payload.NewGenericDataPayload(data)))
+		require.Equal(t, 5, blk.Work())
+		require.NoError(t, tf.Scheduler.Submit(blk))
+		tf.Scheduler.Ready(blk)
+	}
+
+	for i := 0; i < 100; i++ {
+		blk := tf.CreateSchedulerBlock(models.WithIssuer(tf.Issuer("light").PublicKey()), models.WithPayload(payload.NewGenericDataPayload([]byte{byte(i)})))
+		require.Equal(t, 1, blk.Work())
+		require.NoError(t, tf.Scheduler.Submit(blk))
+		tf.Scheduler.Ready(blk)
+	}
+
+	// issuers with the same mana get the same share of the work, regardless of the size of their blocks
+	scheduledWork := make(map[identity.ID]int)
+	for totalWork := 0; totalWork < 50; {
+		block := tf.Scheduler.schedule()
+		require.NotNil(t, block)
+
+		scheduledWork[block.IssuerID()] += block.Work()
+		totalWork += block.Work()
+	}
+
+	require.InDelta(t, scheduledWork[tf.Issuer("heavy").ID()], scheduledWork[tf.Issuer("light").ID()], 5)
+}
+
 func TestSchedulerParallelSubmit(t *testing.T) {
 	debug.SetEnabled(true)
 	const totalBlkCount = 200
@@ -9,11 +9,13 @@ import (
 	"github.com/iotaledger/hive.go/core/generics/event"
 	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/lru_cache"
 	"github.com/iotaledger/hive.go/core/workerpool"
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	"github.com/iotaledger/goshimmer/packages/core/eventticker"
+	"github.com/iotaledger/goshimmer/packages/core/snapshot"
 	"github.com/iotaledger/goshimmer/packages/core/traits"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/eviction"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledgerstate"
@@ -36,6 +38,9 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
+// committedWeightsCacheSize is the amount of epochs whose committed weights are cached.
+const committedWeightsCacheSize = 16
+
 // region Engine /////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type Engine struct {
@@ -56,13 +61,18 @@ type Engine struct {
 
 	workerPools map[string]*workerpool.UnboundedWorkerPool
 
+	committedWeightsCache *lrucache.LRUCache
+
 	isBootstrapped      bool
 	isBootstrappedMutex sync.Mutex
 
 	optsBootstrappedThreshold      time.Duration
 	optsEntryPointsDepth           int
 	optsSnapshotDepth              int
+	optsGenesisTime                int64
+	optsEpochDuration              int64
 	optsLedgerOptions              []options.Option[ledger.Ledger]
+	optsFilterOptions              []options.Option[filter.Filter]
 	optsNotarizationManagerOptions []options.Option[notarization.Manager]
 	optsTangleOptions              []options.Option[tangle.Tangle]
 	optsConsensusOptions           []options.Option[consensus.Consensus]
@@ -89,6 +99,8 @@ func New(
 
 			workerPools: map[string]*workerpool.UnboundedWorkerPool{},
 
+			committedWeightsCache: lrucache.NewLRUCache(committedWeightsCacheSize),
+
 			optsBootstrappedThreshold: 10 * time.Second,
 			optsSnapshotDepth:         5,
 		}, opts, func(e *Engine) {
@@ -202,6 +214,68 @@ func (e *Engine) Initialize(snapshot string) (err error) {
 	return
 }
 
+// RollbackTo rolls the persisted state of the engine back to the given committed epoch. It can only be used before the
+// engine is initialized (i.e. while it is not processing any blocks).
+func (e *Engine) RollbackTo(targetEpoch epoch.Index) (err error) {
+	if e.WasInitialized() {
+		return errors.New("engine needs to be stopped to roll back its state")
+	} else if !e.Storage.Settings.SnapshotImported() {
+		return errors.New("engine has no state to roll back")
+	}
+
+	if err = e.LedgerState.RollbackTo(targetEpoch); err != nil {
+		return errors.Wrapf(err, "failed to roll back ledger state to epoch %d", targetEpoch)
+	}
+
+	if e.NotarizationManager.Attestations.LastCommittedEpoch() > targetEpoch {
+		e.NotarizationManager.Attestations.SetLastCommittedEpoch(targetEpoch)
+	}
+	e.NotarizationManager.EpochMutations.RollbackTo(targetEpoch)
+
+	e.EvictionState.RollbackTo(targetEpoch)
+
+	// the attestations, roots and blocks of the abandoned epochs would otherwise leak into the epochs that are committed
+	// again
+	if err = e.Storage.DeleteAfterEpoch(targetEpoch); err != nil {
+		return errors.Wrapf(err, "failed to delete the data of the epochs after epoch %d", targetEpoch)
+	}
+	e.committedWeightsCache.DeleteAll()
+
+	return nil
+}
+
+// CommittedWeights returns the weights of the SybilProtection as they were committed at the given epoch by rolling back
+// the weight changes of all StateDiffs of later epochs on a copy of the current weights. The weights of a committed
+// epoch do not change anymore, so the results of the most recently requested epochs are cached (the returned map is
+// shared and must not be modified).
+func (e *Engine) CommittedWeights(index epoch.Index) (weights map[identity.ID]int64, err error) {
+	if cachedWeights := e.committedWeightsCache.Get(index); cachedWeights != nil {
+		return cachedWeights.(map[identity.ID]int64), nil
+	}
+
+	updateWeight := func(sign int64) func(*ledger.OutputWithMetadata) error {
+		return func(output *ledger.OutputWithMetadata) error {
+			if iotaBalance, exists := output.IOTABalance(); exists {
+				if weights[output.ConsensusManaPledgeID()] += sign * int64(iotaBalance); weights[output.ConsensusManaPledgeID()] == 0 {
+					delete(weights, output.ConsensusManaPledgeID())
+				}
+			}
+
+			return nil
+		}
+	}
+
+	if err = e.LedgerState.StreamRollback(index, func() (err error) {
+		weights, err = e.SybilProtection.Weights().Map()
+		return err
+	}, updateWeight(-1), updateWeight(1)); err != nil {
+		return nil, errors.Wrapf(err, "failed to roll back weights to epoch %d", index)
+	}
+	e.committedWeightsCache.Set(index, weights)
+
+	return weights, nil
+}
+
 func (e *Engine) Shutdown() {
 	e.Ledger.Shutdown()
 
@@ -228,19 +302,26 @@ func (e *Engine) WriteSnapshot(filePath string, targetEpoch ...epoch.Index) (err
 	return
 }
 
+// Import imports the state of the engine from the given snapshot. Versioned snapshots are completely verified against
+// their header before any state is written, while legacy snapshots (without header) are imported as they are.
 func (e *Engine) Import(reader io.ReadSeeker) (err error) {
-	if err = e.Storage.Settings.Import(reader); err != nil {
-		return errors.Wrap(err, "failed to import settings")
-	} else if err = e.Storage.Commitments.Import(reader); err != nil {
-		return errors.Wrap(err, "failed to import commitments")
-	} else if err = e.Storage.Settings.SetChainID(e.Storage.Settings.LatestCommitment().ID()); err != nil {
-		return errors.Wrap(err, "failed to set chainID")
-	} else if err = e.EvictionState.Import(reader); err != nil {
-		return errors.Wrap(err, "failed to import eviction state")
-	} else if err = e.LedgerState.Import(reader); err != nil {
-		return errors.Wrap(err, "failed to import ledger state")
-	} else if err = e.NotarizationManager.Import(reader); err != nil {
-		return errors.Wrap(err, "failed to import notarization state")
+	startOffset, err := reader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Wrap(err, "failed to read start offset of snapshot")
+	}
+
+	// legacy snapshots have no header but were all written with the first database version, whose attestations lack
+	// the public key of their issuer and can therefore not be imported anymore
+	if _, err = snapshot.ReadHeader(reader); errors.Is(err, snapshot.ErrLegacyFormat) {
+		return errors.Errorf("legacy snapshot has database version 1, node has database version %d", e.Storage.DatabaseVersion())
+	} else if err != nil {
+		return err
+	} else if _, err = reader.Seek(startOffset, io.SeekStart); err != nil {
+		return errors.Wrap(err, "failed to seek to start of snapshot")
+	}
+
+	if _, err = snapshot.Read(reader, e.verifySnapshotHeader, e.snapshotSections(0)...); err != nil {
+		return err
 	}
 
 	// We need to set the genesis time before we add the activity log as otherwise the calculation is based on the empty time value.
@@ -250,24 +331,113 @@ func (e *Engine) Import(reader io.ReadSeeker) (err error) {
 	return
 }
 
-func (e *Engine) Export(writer io.WriteSeeker, targetEpoch epoch.Index) (err error) {
-	if err = e.Storage.Settings.Export(writer); err != nil {
-		return errors.Wrap(err, "failed to export settings")
-	} else if err = e.Storage.Commitments.Export(writer, targetEpoch); err != nil {
-		return errors.Wrap(err, "failed to export commitments")
-	} else if err = e.EvictionState.Export(writer, targetEpoch); err != nil {
-		return errors.Wrap(err, "failed to export eviction state")
-	} else if err = e.LedgerState.Export(writer, targetEpoch); err != nil {
-		return errors.Wrap(err, "failed to export ledger state")
-	} else if err = e.NotarizationManager.Export(writer, targetEpoch); err != nil {
-		return errors.Wrap(err, "failed to export notarization state")
+// Export exports the state of the engine at the given epoch as a versioned snapshot (the writer needs to be readable,
+// as the written sections are read back to compute their hashes).
+func (e *Engine) Export(writer io.ReadWriteSeeker, targetEpoch epoch.Index) (err error) {
+	targetCommitment, err := e.Storage.Commitments.Load(targetEpoch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load commitment of target epoch %d", targetEpoch)
 	}
 
-	return
+	genesisTime, epochDuration := e.epochParameters()
+
+	return snapshot.Write(writer, &snapshot.Header{
+		DatabaseVersion:    e.Storage.DatabaseVersion(),
+		GenesisTime:        genesisTime,
+		EpochDuration:      epochDuration,
+		TargetEpoch:        targetEpoch,
+		TargetCommitmentID: targetCommitment.ID(),
+	}, e.snapshotSections(targetEpoch)...)
+}
+
+// snapshotSections returns the sections of a snapshot in the order in which they are written and read.
+func (e *Engine) snapshotSections(targetEpoch epoch.Index) []*snapshot.Section {
+	return []*snapshot.Section{
+		{
+			Name: "settings",
+			Export: func(writer io.WriteSeeker) error {
+				return errors.Wrap(e.Storage.Settings.Export(writer), "failed to export settings")
+			},
+			Import: func(reader io.ReadSeeker) error {
+				return errors.Wrap(e.Storage.Settings.Import(reader), "failed to import settings")
+			},
+		},
+		{
+			Name: "commitments",
+			Export: func(writer io.WriteSeeker) error {
+				return errors.Wrap(e.Storage.Commitments.Export(writer, targetEpoch), "failed to export commitments")
+			},
+			Import: func(reader io.ReadSeeker) (err error) {
+				if err = e.Storage.Commitments.Import(reader); err != nil {
+					return errors.Wrap(err, "failed to import commitments")
+				}
+
+				return errors.Wrap(e.Storage.Settings.SetChainID(e.Storage.Settings.LatestCommitment().ID()), "failed to set chainID")
+			},
+		},
+		{
+			Name: "eviction state",
+			Export: func(writer io.WriteSeeker) error {
+				return errors.Wrap(e.EvictionState.Export(writer, targetEpoch), "failed to export eviction state")
+			},
+			Import: func(reader io.ReadSeeker) error {
+				return errors.Wrap(e.EvictionState.Import(reader), "failed to import eviction state")
+			},
+		},
+		{
+			Name: "ledger state",
+			Export: func(writer io.WriteSeeker) error {
+				return errors.Wrap(e.LedgerState.Export(writer, targetEpoch), "failed to export ledger state")
+			},
+			Import: func(reader io.ReadSeeker) error {
+				return errors.Wrap(e.LedgerState.Import(reader), "failed to import ledger state")
+			},
+		},
+		{
+			Name: "notarization state",
+			Export: func(writer io.WriteSeeker) error {
+				return errors.Wrap(e.NotarizationManager.Export(writer, targetEpoch), "failed to export notarization state")
+			},
+			Import: func(reader io.ReadSeeker) error {
+				return errors.Wrap(e.NotarizationManager.Import(reader), "failed to import notarization state")
+			},
+		},
+	}
+}
+
+// verifySnapshotHeader checks that the snapshot described by the given header can be imported by this engine.
+func (e *Engine) verifySnapshotHeader(header *snapshot.Header) (err error) {
+	genesisTime, epochDuration := e.epochParameters()
+
+	if header.DatabaseVersion != e.Storage.DatabaseVersion() {
+		return errors.Errorf("snapshot has database version %d, node has database version %d", header.DatabaseVersion, e.Storage.DatabaseVersion())
+	} else if header.EpochDuration != epochDuration {
+		return errors.Errorf("snapshot has epoch duration %ds, node has epoch duration %ds", header.EpochDuration, epochDuration)
+	} else if header.TargetEpoch > 0 && header.GenesisTime != genesisTime {
+		// genesis snapshots are usually created before the genesis time of the network is known, so we only enforce
+		// matching genesis times for snapshots of later epochs
+		return errors.Errorf("snapshot has genesis time %d, node has genesis time %d", header.GenesisTime, genesisTime)
+	}
+
+	return nil
+}
+
+// epochParameters returns the genesis time and the epoch duration of the network that the engine belongs to (the
+// parameters of the epoch package are used unless the engine was configured with its own ones).
+func (e *Engine) epochParameters() (genesisTime int64, epochDuration int64) {
+	if e.optsEpochDuration == 0 {
+		return epoch.GenesisTime, epoch.Duration
+	}
+
+	return e.optsGenesisTime, e.optsEpochDuration
 }
 
 func (e *Engine) initFilter() {
-	e.Filter = filter.New(filter.WithMinCommittableEpochAge(e.NotarizationManager.MinCommittableEpochAge()))
+	e.Filter = filter.New(append([]options.Option[filter.Filter]{
+		filter.WithClock(e.Clock),
+		filter.WithMinCommittableEpochAge(e.NotarizationManager.MinCommittableEpochAge()),
+	}, e.optsFilterOptions...)...)
+	e.workerPools["Filter.SignatureVerification"] = e.Filter.WorkerPool()
 
 	e.Filter.Events.BlockFiltered.Attach(event.NewClosure(func(filteredEvent *filter.BlockFilteredEvent) {
 		e.Events.Error.Trigger(errors.Wrapf(filteredEvent.Reason, "block (%s) filtered", filteredEvent.Block.ID()))
@@ -512,12 +682,27 @@ func WithLedgerOptions(opts ...options.Option[ledger.Ledger]) options.Option[Eng
 	}
 }
 
+func WithFilterOptions(opts ...options.Option[filter.Filter]) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsFilterOptions = opts
+	}
+}
+
 func WithNotarizationManagerOptions(opts ...options.Option[notarization.Manager]) options.Option[Engine] {
 	return func(e *Engine) {
 		e.optsNotarizationManagerOptions = opts
 	}
 }
 
+// WithEpochParameters specifies the genesis time and the epoch duration (both in seconds) of the network that the
+// engine belongs to. Imported snapshots are verified against them and exported snapshots are marked with them.
+func WithEpochParameters(genesisTime int64, epochDuration int64) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsGenesisTime = genesisTime
+		e.optsEpochDuration = epochDuration
+	}
+}
+
 func WithSnapshotDepth(depth int) options.Option[Engine] {
 	return func(e *Engine) {
 		e.optsSnapshotDepth = depth
@@ -68,6 +68,17 @@ func (s *State) EvictUntil(index epoch.Index) {
 	}
 }
 
+// RollbackTo resets the last evicted epoch to the given epoch (it is used to roll back the persisted state of the engine
+// before it starts to process blocks).
+func (s *State) RollbackTo(index epoch.Index) {
+	s.evictionMutex.Lock()
+	defer s.evictionMutex.Unlock()
+
+	if index < s.lastEvictedEpoch {
+		s.lastEvictedEpoch = index
+	}
+}
+
 // LastEvictedEpoch returns the last evicted epoch.
 func (s *State) LastEvictedEpoch() (lastEvictedEpoch epoch.Index) {
 	s.evictionMutex.RLock()
@@ -2,6 +2,7 @@ package filter
 
 import (
 	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/identity"
 
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
@@ -22,5 +23,6 @@ var NewEvents = event.LinkableConstructor(func() *Events {
 
 type BlockFilteredEvent struct {
 	Block  *models.Block
+	Source identity.ID
 	Reason error
 }
@@ -1,44 +1,162 @@
 package filter
 
 import (
-	"errors"
+	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/workerpool"
+	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/clock"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+)
+
+var (
+	// ErrCommitmentNotCommittable is returned if a block commits to an epoch that cannot objectively be committable yet.
+	ErrCommitmentNotCommittable = errors.New("a block cannot commit to an epoch that cannot objectively be committable yet")
+
+	// ErrBlockTimeTooFarAheadInFuture is returned if a block is issued too far ahead of the local wall clock.
+	ErrBlockTimeTooFarAheadInFuture = errors.New("a block cannot be issued too far ahead in the future")
+
+	// ErrInvalidParents is returned if the parents of a block violate the rules of their ParentsType.
+	ErrInvalidParents = errors.New("invalid parents")
+
+	// ErrPayloadTooLarge is returned if the payload of a block exceeds the maximum payload size.
+	ErrPayloadTooLarge = errors.New("payload too large")
+
+	// ErrInvalidSignature is returned if the signature of a block does not match its issuer.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrSignatureVerificationQueueFull is returned if a block is dropped because too many blocks are already waiting
+	// for the verification of their signatures.
+	ErrSignatureVerificationQueueFull = errors.New("signature verification queue is full")
 )
 
 // Filter filters blocks.
 type Filter struct {
 	Events *Events
 
-	optsMinCommittableEpochAge time.Duration
+	signatureWorkerPool           *workerpool.UnboundedWorkerPool
+	pendingSignatureVerifications atomic.Int64
+
+	optsClock                          *clock.Clock
+	optsMinCommittableEpochAge         time.Duration
+	optsMaxAllowedWallClockDrift       time.Duration
+	optsSignatureWorkerCount           int
+	optsSignatureVerificationQueueSize int64
 }
 
 // New creates a new Filter.
 func New(opts ...options.Option[Filter]) (inbox *Filter) {
 	return options.Apply(&Filter{
-		Events: NewEvents(),
-	}, opts)
+		Events:                             NewEvents(),
+		optsMaxAllowedWallClockDrift:       5 * time.Second,
+		optsSignatureWorkerCount:           runtime.NumCPU(),
+		optsSignatureVerificationQueueSize: 10000,
+	}, opts, func(f *Filter) {
+		f.signatureWorkerPool = workerpool.NewUnboundedWorkerPool(f.optsSignatureWorkerCount).Start()
+	})
 }
 
-// ProcessReceivedBlock processes block from the given source.
+// ProcessReceivedBlock processes block from the given source. The cheap syntactic checks are executed right away while
+// the signature is verified on the bounded worker pool of the Filter. Blocks are dropped if too many blocks are already
+// waiting for the verification of their signatures (so that peers flooding blocks can not exhaust the memory).
 func (f *Filter) ProcessReceivedBlock(block *models.Block, source identity.ID) {
-	// fill heuristic + check if block is valid
-	// ...
+	if err := f.validate(block); err != nil {
+		f.filter(block, source, err)
+		return
+	}
 
-	if block.Commitment().Index() > block.ID().Index()-epoch.Index(int64(f.optsMinCommittableEpochAge.Seconds())/epoch.Duration) {
-		f.Events.BlockFiltered.Trigger(&BlockFilteredEvent{
-			Block:  block,
-			Reason: errors.New("a block cannot commit to an epoch that cannot objectively be committable yet"),
-		})
+	if pendingSignatureVerifications := f.pendingSignatureVerifications.Add(1); pendingSignatureVerifications > f.optsSignatureVerificationQueueSize {
+		f.pendingSignatureVerifications.Add(-1)
+		f.filter(block, source, errors.Wrapf(ErrSignatureVerificationQueueFull, "%d blocks are waiting for verification", f.optsSignatureVerificationQueueSize))
 		return
 	}
 
-	f.Events.BlockAllowed.Trigger(block)
+	f.signatureWorkerPool.Submit(func() {
+		defer f.pendingSignatureVerifications.Add(-1)
+
+		if err := verifySignature(block); err != nil {
+			f.filter(block, source, err)
+			return
+		}
+
+		f.Events.BlockAllowed.Trigger(block)
+	})
+}
+
+// WorkerPool returns the worker pool that is used to verify the signatures of the blocks.
+func (f *Filter) WorkerPool() *workerpool.UnboundedWorkerPool {
+	return f.signatureWorkerPool
+}
+
+// Shutdown shuts down the Filter.
+func (f *Filter) Shutdown() {
+	f.signatureWorkerPool.Shutdown()
+}
+
+// validate executes the checks that do not require to verify the signature of the block.
+func (f *Filter) validate(block *models.Block) (err error) {
+	if block.Commitment().Index() > block.ID().Index()-epoch.Index(int64(f.optsMinCommittableEpochAge.Seconds())/epoch.Duration) {
+		return ErrCommitmentNotCommittable
+	}
+
+	if maxIssuingTime := f.referenceTime().Add(f.optsMaxAllowedWallClockDrift); block.IssuingTime().After(maxIssuingTime) {
+		return errors.Wrapf(ErrBlockTimeTooFarAheadInFuture, "issuing time %s is later than %s", block.IssuingTime(), maxIssuingTime)
+	}
+
+	if err = block.M.Parents.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidParents, "%s", err)
+	}
+
+	if payloadSize := len(block.M.PayloadBytes); payloadSize > payload.MaxSize {
+		return errors.Wrapf(ErrPayloadTooLarge, "payload has %d bytes (maximum %d bytes)", payloadSize, payload.MaxSize)
+	}
+
+	return nil
+}
+
+// referenceTime returns the time that the issuing times of the blocks are compared against. It is the local wall clock
+// unless the clock of the engine was already advanced beyond it by the accepted blocks.
+func (f *Filter) referenceTime() (referenceTime time.Time) {
+	referenceTime = time.Now()
+	if f.optsClock == nil {
+		return referenceTime
+	}
+
+	if relativeAcceptedTime := f.optsClock.RelativeAcceptedTime(); relativeAcceptedTime.After(referenceTime) {
+		return relativeAcceptedTime
+	}
+
+	return referenceTime
+}
+
+// filter marks the block as filtered for the given reason.
+func (f *Filter) filter(block *models.Block, source identity.ID, reason error) {
+	f.Events.BlockFiltered.Trigger(&BlockFilteredEvent{
+		Block:  block,
+		Source: source,
+		Reason: reason,
+	})
+}
+
+// verifySignature checks that the block was signed by its issuer.
+func verifySignature(block *models.Block) (err error) {
+	valid, err := block.VerifySignature()
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "failed to verify signature: %s", err)
+	}
+
+	if !valid {
+		return errors.Wrapf(ErrInvalidSignature, "signature does not match issuer %s", block.IssuerID())
+	}
+
+	return nil
 }
 
 // WithMinCommittableEpochAge specifies how old an epoch has to be for it to be committable.
@@ -47,3 +165,32 @@ func WithMinCommittableEpochAge(d time.Duration) options.Option[Filter] {
 		filter.optsMinCommittableEpochAge = d
 	}
 }
+
+// WithMaxAllowedWallClockDrift specifies how far in the future blocks are allowed to be issued.
+func WithMaxAllowedWallClockDrift(d time.Duration) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsMaxAllowedWallClockDrift = d
+	}
+}
+
+// WithClock specifies the clock of the engine that the issuing times of the blocks are checked against.
+func WithClock(clock *clock.Clock) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsClock = clock
+	}
+}
+
+// WithSignatureWorkerCount specifies the amount of workers that verify the signatures of the blocks.
+func WithSignatureWorkerCount(workerCount int) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsSignatureWorkerCount = workerCount
+	}
+}
+
+// WithSignatureVerificationQueueSize specifies the maximum amount of blocks that wait for the verification of their
+// signatures (further blocks are filtered).
+func WithSignatureVerificationQueueSize(queueSize int64) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsSignatureVerificationQueueSize = queueSize
+	}
+}
@@ -0,0 +1,135 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/clock"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+)
+
+func TestFilter_ProcessReceivedBlock(t *testing.T) {
+	filter := New(WithMaxAllowedWallClockDrift(time.Minute), WithSignatureWorkerCount(2))
+	defer filter.Shutdown()
+
+	var allowed []*models.Block
+	reasons := make(map[string]error)
+	filter.Events.BlockAllowed.Hook(event.NewClosure(func(block *models.Block) {
+		allowed = append(allowed, block)
+	}))
+	filter.Events.BlockFiltered.Hook(event.NewClosure(func(filteredEvent *BlockFilteredEvent) {
+		reasons[string(filteredEvent.Block.Payload().(*payload.GenericDataPayload).Blob())] = filteredEvent.Reason
+	}))
+
+	keyPair := ed25519.GenerateKeyPair()
+	strongParents := models.NewBlockIDs(models.EmptyBlockID)
+
+	validBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("valid"))))
+	futureBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("future"))), models.WithIssuingTime(time.Now().Add(time.Hour)))
+
+	// blocks with invalid parents cannot be serialized, so we modify the parents after the blocks have been signed
+	noParentsBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("noParents"))))
+	noParentsBlock.M.Parents = models.NewParentBlockIDs()
+	duplicateParentsBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("duplicateParents"))))
+	duplicateParentsBlock.M.Parents.AddAll(models.WeakParentType, strongParents)
+
+	invalidSignatureBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("invalidSignature"))))
+	invalidSignatureBlock.SetSignature(ed25519.GenerateKeyPair().PrivateKey.Sign([]byte("invalidSignature")))
+
+	for _, block := range []*models.Block{validBlock, futureBlock, noParentsBlock, duplicateParentsBlock, invalidSignatureBlock} {
+		filter.ProcessReceivedBlock(block, identity.ID{})
+	}
+	filter.WorkerPool().PendingTasksCounter.WaitIsZero()
+
+	require.Equal(t, []*models.Block{validBlock}, allowed)
+	require.Len(t, reasons, 4)
+	require.ErrorIs(t, reasons["future"], ErrBlockTimeTooFarAheadInFuture)
+	require.ErrorIs(t, reasons["noParents"], ErrInvalidParents)
+	require.ErrorIs(t, reasons["duplicateParents"], ErrInvalidParents)
+	require.ErrorIs(t, reasons["invalidSignature"], ErrInvalidSignature)
+}
+
+func TestFilter_ClockBehindWallClock(t *testing.T) {
+	// the accepted time can not be set ahead of the wall clock, so a lagging clock must not make the filter stricter
+	engineClock := clock.New()
+	engineClock.SetAcceptedTime(time.Now().Add(-time.Hour))
+
+	filter := New(WithClock(engineClock), WithMaxAllowedWallClockDrift(time.Minute), WithSignatureWorkerCount(1))
+	defer filter.Shutdown()
+
+	var allowed []*models.Block
+	filter.Events.BlockAllowed.Hook(event.NewClosure(func(block *models.Block) {
+		allowed = append(allowed, block)
+	}))
+	var filtered []*models.Block
+	filter.Events.BlockFiltered.Hook(event.NewClosure(func(filteredEvent *BlockFilteredEvent) {
+		filtered = append(filtered, filteredEvent.Block)
+	}))
+
+	keyPair := ed25519.GenerateKeyPair()
+	strongParents := models.NewBlockIDs(models.EmptyBlockID)
+
+	acceptedBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithIssuingTime(time.Now().Add(30*time.Second)))
+	futureBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithIssuingTime(time.Now().Add(2*time.Minute)))
+
+	filter.ProcessReceivedBlock(acceptedBlock, identity.ID{})
+	filter.ProcessReceivedBlock(futureBlock, identity.ID{})
+	filter.WorkerPool().PendingTasksCounter.WaitIsZero()
+
+	require.Equal(t, []*models.Block{acceptedBlock}, allowed)
+	require.Equal(t, []*models.Block{futureBlock}, filtered)
+}
+
+func TestFilter_SignatureVerificationQueueFull(t *testing.T) {
+	filter := New(WithMaxAllowedWallClockDrift(time.Minute), WithSignatureWorkerCount(1), WithSignatureVerificationQueueSize(1))
+	defer filter.Shutdown()
+
+	var allowed []*models.Block
+	filter.Events.BlockAllowed.Hook(event.NewClosure(func(block *models.Block) {
+		allowed = append(allowed, block)
+	}))
+	var filteredEvents []*BlockFilteredEvent
+	filter.Events.BlockFiltered.Hook(event.NewClosure(func(filteredEvent *BlockFilteredEvent) {
+		filteredEvents = append(filteredEvents, filteredEvent)
+	}))
+
+	// occupy the only worker, so that the first block stays in the queue
+	releaseWorker := make(chan struct{})
+	filter.WorkerPool().Submit(func() { <-releaseWorker })
+
+	keyPair := ed25519.GenerateKeyPair()
+	strongParents := models.NewBlockIDs(models.EmptyBlockID)
+	queuedBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("queued"))))
+	droppedBlock := newSignedBlock(t, keyPair, models.WithStrongParents(strongParents), models.WithPayload(payload.NewGenericDataPayload([]byte("dropped"))))
+
+	filter.ProcessReceivedBlock(queuedBlock, identity.ID{})
+	filter.ProcessReceivedBlock(droppedBlock, identity.ID{})
+
+	require.Len(t, filteredEvents, 1)
+	require.Equal(t, droppedBlock, filteredEvents[0].Block)
+	require.ErrorIs(t, filteredEvents[0].Reason, ErrSignatureVerificationQueueFull)
+
+	close(releaseWorker)
+	filter.WorkerPool().PendingTasksCounter.WaitIsZero()
+
+	require.Equal(t, []*models.Block{queuedBlock}, allowed)
+}
+
+func newSignedBlock(t *testing.T, keyPair ed25519.KeyPair, opts ...options.Option[models.Block]) (block *models.Block) {
+	block = models.NewBlock(append(opts, models.WithIssuer(keyPair.PublicKey))...)
+
+	signingMessage, err := block.SigningMessage()
+	require.NoError(t, err)
+
+	block.SetSignature(keyPair.PrivateKey.Sign(signingMessage))
+	require.NoError(t, block.DetermineID())
+
+	return block
+}
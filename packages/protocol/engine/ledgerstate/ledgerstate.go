@@ -97,27 +97,77 @@ func (l *LedgerState) Import(reader io.ReadSeeker) (err error) {
 		}
 		stateDiffEpoch-- // we rolled back epoch n to get to epoch n-1
 
-		targetEpochCommitment, errLoad := l.storage.Commitments.Load(stateDiffEpoch)
-		if errLoad != nil {
-			return errors.Wrapf(errLoad, "failed to load commitment for target epoch %d", stateDiffEpoch)
+		if err = l.rewindSettings(stateDiffEpoch); err != nil {
+			return errors.Wrapf(err, "failed to rewind settings to epoch %d", stateDiffEpoch)
 		}
+	}
 
-		if err = l.storage.Settings.SetLatestCommitment(targetEpochCommitment); err != nil {
-			return errors.Wrap(err, "failed to set latest commitment")
-		}
+	l.TriggerInitialized()
 
-		if err = l.storage.Settings.SetLatestStateMutationEpoch(stateDiffEpoch); err != nil {
-			return errors.Wrap(err, "failed to set latest state mutation epoch")
+	return
+}
+
+// RollbackTo reverts the ledger state (and the state of all UnspentOutputsConsumers) to the given committed epoch by
+// rolling back the StateDiffs of the later epochs and rewinds the settings of the storage accordingly. It must only be
+// called while the engine is not processing any blocks.
+func (l *LedgerState) RollbackTo(targetEpoch epoch.Index) (err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err = l.checkRollbackTarget(targetEpoch); err != nil {
+		return err
+	}
+
+	for stateDiffEpoch := l.latestEpoch(); stateDiffEpoch > targetEpoch; stateDiffEpoch-- {
+		if err = l.rollbackStateDiff(stateDiffEpoch); err != nil {
+			return errors.Wrapf(err, "failed to roll back state diff %d", stateDiffEpoch)
 		}
 
-		if err = l.storage.Settings.SetLatestConfirmedEpoch(stateDiffEpoch); err != nil {
-			return errors.Wrap(err, "failed to set latest confirmed epoch")
+		if err = l.StateDiffs.Delete(stateDiffEpoch); err != nil {
+			return errors.Wrapf(err, "failed to delete state diff %d", stateDiffEpoch)
 		}
 	}
 
-	l.TriggerInitialized()
+	return errors.Wrapf(l.rewindSettings(targetEpoch), "failed to rewind settings to epoch %d", targetEpoch)
+}
 
-	return
+// CheckRollbackTarget checks if the ledger state can be rolled back to the given epoch.
+func (l *LedgerState) CheckRollbackTarget(targetEpoch epoch.Index) (err error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.checkRollbackTarget(targetEpoch)
+}
+
+// StreamRollback streams the outputs of the StateDiffs that separate the current ledger state from the state of the
+// given committed epoch without modifying anything. The currentState callback is executed first and no StateDiff is
+// applied until the streaming finished, so it can be used to capture a consistent view of the UnspentOutputsConsumers.
+func (l *LedgerState) StreamRollback(targetEpoch epoch.Index, currentState func() error, rollbackCreatedOutput, rollbackSpentOutput func(*ledger.OutputWithMetadata) error) (err error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	latestCommittedEpoch := l.UnspentOutputs.LastCommittedEpoch()
+	if targetEpoch > latestCommittedEpoch {
+		return errors.Errorf("target epoch %d is newer than the latest committed epoch %d", targetEpoch, latestCommittedEpoch)
+	} else if maxPrunedEpoch := l.storage.MaxPrunedEpoch(); targetEpoch < maxPrunedEpoch {
+		return errors.Errorf("state diffs of epochs until %d were already pruned", maxPrunedEpoch)
+	}
+
+	if err = currentState(); err != nil {
+		return errors.Wrap(err, "failed to capture current state")
+	}
+
+	for stateDiffEpoch := latestCommittedEpoch; stateDiffEpoch > targetEpoch; stateDiffEpoch-- {
+		if err = l.StateDiffs.StreamSpentOutputs(stateDiffEpoch, rollbackSpentOutput); err != nil {
+			return errors.Wrapf(err, "failed to stream spent outputs of epoch %d", stateDiffEpoch)
+		}
+
+		if err = l.StateDiffs.StreamCreatedOutputs(stateDiffEpoch, rollbackCreatedOutput); err != nil {
+			return errors.Wrapf(err, "failed to stream created outputs of epoch %d", stateDiffEpoch)
+		}
+	}
+
+	return nil
 }
 
 // Export exports the ledger state to the given writer.
@@ -136,8 +186,41 @@ func (l *LedgerState) Export(writer io.WriteSeeker, targetEpoch epoch.Index) (er
 	return
 }
 
+// checkRollbackTarget checks if the ledger state can be rolled back to the given epoch (without locking the mutex).
+func (l *LedgerState) checkRollbackTarget(targetEpoch epoch.Index) (err error) {
+	if targetEpoch < 1 {
+		return errors.New("the ledger state can not be rolled back to the genesis epoch")
+	} else if latestCommittedEpoch := l.storage.Settings.LatestCommitment().Index(); targetEpoch > latestCommittedEpoch {
+		return errors.Errorf("target epoch %d is newer than the latest committed epoch %d", targetEpoch, latestCommittedEpoch)
+	} else if latestEpoch := l.latestEpoch(); targetEpoch >= latestEpoch {
+		// the latest committed epoch is only a valid target if the node crashed while committing the next epoch (the
+		// unspent outputs are updated before the settings)
+		return errors.Errorf("target epoch %d is not older than the latest epoch %d of the ledger state", targetEpoch, latestEpoch)
+	} else if maxPrunedEpoch := l.storage.MaxPrunedEpoch(); targetEpoch < maxPrunedEpoch {
+		return errors.Errorf("state diffs of epochs until %d were already pruned", maxPrunedEpoch)
+	} else if _, err = l.storage.Commitments.Load(targetEpoch); err != nil {
+		return errors.Wrapf(err, "failed to load commitment of target epoch %d", targetEpoch)
+	}
+
+	return nil
+}
+
+// latestEpoch returns the latest epoch that was committed by the settings or the unspent outputs (the unspent outputs
+// lag behind the settings if the node crashed before the state diff of the latest commitment was applied).
+func (l *LedgerState) latestEpoch() epoch.Index {
+	if latestCommittedEpoch := l.storage.Settings.LatestCommitment().Index(); latestCommittedEpoch > l.UnspentOutputs.LastCommittedEpoch() {
+		return latestCommittedEpoch
+	}
+
+	return l.UnspentOutputs.LastCommittedEpoch()
+}
+
 // rollbackStateDiff rolls back the named stateDiff index to get to the previous epoch.
 func (l *LedgerState) rollbackStateDiff(index epoch.Index) (err error) {
+	if l.UnspentOutputs.LastCommittedEpoch() < index {
+		return l.rollbackConsumers(index)
+	}
+
 	targetEpoch := index - 1
 	lastCommittedEpoch, err := l.UnspentOutputs.Begin(targetEpoch)
 	if err != nil {
@@ -159,6 +242,83 @@ func (l *LedgerState) rollbackStateDiff(index epoch.Index) (err error) {
 	return
 }
 
+// rollbackConsumers rolls back the named stateDiff index of the UnspentOutputsConsumers that committed it although the
+// unspent outputs did not (the consumers are committed first, so a crash can leave them one epoch ahead).
+func (l *LedgerState) rollbackConsumers(index epoch.Index) (err error) {
+	pendingConsumers := make([]UnspentOutputsConsumer, 0)
+	for _, consumer := range l.UnspentOutputs.Consumers() {
+		consumerEpoch, beginErr := consumer.BeginBatchedStateTransition(index - 1)
+		if beginErr != nil {
+			return errors.Wrap(beginErr, "failed to start consumer transaction")
+		} else if consumerEpoch == index {
+			pendingConsumers = append(pendingConsumers, consumer)
+		} else if consumerEpoch != index-1 {
+			return errors.Errorf("consumer in unexpected epoch: %d", consumerEpoch)
+		}
+	}
+
+	if len(pendingConsumers) == 0 {
+		return nil
+	}
+
+	if err = l.StateDiffs.StreamSpentOutputs(index, func(output *ledger.OutputWithMetadata) (err error) {
+		for _, consumer := range pendingConsumers {
+			if err = consumer.RollbackSpentOutput(output); err != nil {
+				return errors.Wrap(err, "failed to roll back spent output of consumer")
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to roll back spent outputs")
+	}
+
+	if err = l.StateDiffs.StreamCreatedOutputs(index, func(output *ledger.OutputWithMetadata) (err error) {
+		for _, consumer := range pendingConsumers {
+			if err = consumer.RollbackCreatedOutput(output); err != nil {
+				return errors.Wrap(err, "failed to roll back created output of consumer")
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to roll back created outputs")
+	}
+
+	for _, consumer := range pendingConsumers {
+		<-consumer.CommitBatchedStateTransition().Done()
+	}
+
+	return nil
+}
+
+// rewindSettings sets the latest commitment of the storage to the commitment of the given epoch and makes sure that the
+// other epochs that are tracked in the settings do not exceed it.
+func (l *LedgerState) rewindSettings(targetEpoch epoch.Index) (err error) {
+	targetEpochCommitment, err := l.storage.Commitments.Load(targetEpoch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load commitment for target epoch %d", targetEpoch)
+	}
+
+	if err = l.storage.Settings.SetLatestCommitment(targetEpochCommitment); err != nil {
+		return errors.Wrap(err, "failed to set latest commitment")
+	}
+
+	if l.storage.Settings.LatestStateMutationEpoch() > targetEpoch {
+		if err = l.storage.Settings.SetLatestStateMutationEpoch(targetEpoch); err != nil {
+			return errors.Wrap(err, "failed to set latest state mutation epoch")
+		}
+	}
+
+	if l.storage.Settings.LatestConfirmedEpoch() > targetEpoch {
+		if err = l.storage.Settings.SetLatestConfirmedEpoch(targetEpoch); err != nil {
+			return errors.Wrap(err, "failed to set latest confirmed epoch")
+		}
+	}
+
+	return nil
+}
+
 // onTransactionAccepted is triggered when a transaction is accepted by the mempool.
 func (l *LedgerState) onTransactionAccepted(transactionEvent *ledger.TransactionEvent) {
 	if err := l.StateDiffs.addAcceptedTransaction(transactionEvent.Metadata); err != nil {
@@ -5,11 +5,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/iotaledger/hive.go/core/byteutils"
 	"github.com/iotaledger/hive.go/core/crypto/ed25519"
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/iotaledger/hive.go/core/serix"
 	"github.com/iotaledger/hive.go/core/types"
+	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
@@ -22,6 +24,7 @@ type Attestation struct {
 	CommitmentID     commitment.ID     `serix:"2"`
 	BlockContentHash types.Identifier  `serix:"3"`
 	Signature        ed25519.Signature `serix:"4"`
+	IssuerPublicKey  ed25519.PublicKey `serix:"5"`
 }
 
 func NewAttestation(block *models.Block) *Attestation {
@@ -31,6 +34,7 @@ func NewAttestation(block *models.Block) *Attestation {
 		block.Commitment().ID(),
 		lo.PanicOnErr(block.ContentHash()),
 		block.Signature(),
+		block.IssuerPublicKey(),
 	}
 }
 
@@ -51,6 +55,25 @@ func (a *Attestation) Compare(other *Attestation) int {
 	}
 }
 
+// VerifySignature verifies that the Attestation was signed by its issuer and that the issuer matches the public key.
+func (a *Attestation) VerifySignature() (valid bool, err error) {
+	if identity.NewID(a.IssuerPublicKey) != a.IssuerID {
+		return false, nil
+	}
+
+	issuingTimeBytes, err := serix.DefaultAPI.Encode(context.Background(), a.IssuingTime, serix.WithValidation())
+	if err != nil {
+		return false, errors.Wrap(err, "failed to serialize issuing time")
+	}
+
+	commitmentIDBytes, err := a.CommitmentID.Bytes()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to serialize commitment id")
+	}
+
+	return a.IssuerPublicKey.VerifySignature(byteutils.ConcatBytes(issuingTimeBytes, commitmentIDBytes, a.BlockContentHash[:]), a.Signature), nil
+}
+
 func (a *Attestation) ID() models.BlockID {
 	return models.NewBlockID(a.BlockContentHash, a.Signature, epoch.IndexFromTime(a.IssuingTime))
 }
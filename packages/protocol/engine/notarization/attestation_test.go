@@ -0,0 +1,46 @@
+package notarization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/byteutils"
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/serix"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
+
+func TestAttestation_VerifySignature(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+
+	block := models.NewBlock(
+		models.WithIssuer(keyPair.PublicKey),
+		models.WithIssuingTime(time.Now()),
+		models.WithCommitment(commitment.NewEmptyCommitment()),
+		models.WithStrongParents(models.NewBlockIDs(models.EmptyBlockID)),
+	)
+
+	contentHash := lo.PanicOnErr(block.ContentHash())
+	issuingTimeBytes := lo.PanicOnErr(serix.DefaultAPI.Encode(context.Background(), block.IssuingTime(), serix.WithValidation()))
+	block.SetSignature(keyPair.PrivateKey.Sign(byteutils.ConcatBytes(issuingTimeBytes, lo.PanicOnErr(block.Commitment().ID().Bytes()), contentHash[:])))
+
+	attestation := NewAttestation(block)
+	require.True(t, lo.PanicOnErr(attestation.VerifySignature()))
+
+	restoredAttestation := new(Attestation)
+	lo.PanicOnErr(restoredAttestation.FromBytes(lo.PanicOnErr(attestation.Bytes())))
+	require.True(t, lo.PanicOnErr(restoredAttestation.VerifySignature()))
+
+	forgedAttestation := NewAttestation(block)
+	forgedAttestation.IssuingTime = forgedAttestation.IssuingTime.Add(time.Second)
+	require.False(t, lo.PanicOnErr(forgedAttestation.VerifySignature()))
+
+	impersonatingAttestation := NewAttestation(block)
+	impersonatingAttestation.IssuerPublicKey = ed25519.GenerateKeyPair().PublicKey
+	require.False(t, lo.PanicOnErr(impersonatingAttestation.VerifySignature()))
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
 	"github.com/iotaledger/hive.go/core/marshalutil"
 	"github.com/iotaledger/hive.go/core/syncutils"
+	"github.com/iotaledger/hive.go/core/types"
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/ads"
@@ -49,8 +51,12 @@ func NewAttestations(persistentStorage func(optRealm ...byte) kvstore.KVStore, b
 }
 
 func (a *Attestations) Add(attestation *Attestation) (added bool, err error) {
-	epochIndex := epoch.IndexFromTime(attestation.IssuingTime)
+	return a.AddToEpoch(epoch.IndexFromTime(attestation.IssuingTime), attestation)
+}
 
+// AddToEpoch adds the attestation to the given epoch instead of the one of its issuing time (i.e. for attestations whose
+// issuing time refers to the epoch parameters of a different network).
+func (a *Attestations) AddToEpoch(epochIndex epoch.Index, attestation *Attestation) (added bool, err error) {
 	a.mutex.RLock(epochIndex)
 	defer a.mutex.RUnlock(epochIndex)
 
@@ -238,7 +244,12 @@ func (a *Attestations) flush(index epoch.Index) (err error) {
 }
 
 func (a *Attestations) attestations(index epoch.Index) (attestations *ads.Map[identity.ID, Attestation, *identity.ID, *Attestation], err error) {
-	if attestationsStorage, err := a.bucketedStorage(index).WithExtendedRealm([]byte{PrefixAttestations}); err != nil {
+	bucketedStorage := a.bucketedStorage(index)
+	if bucketedStorage == nil {
+		return nil, errors.Errorf("storage for attestors of epoch %d does not exist", index)
+	}
+
+	if attestationsStorage, err := bucketedStorage.WithExtendedRealm([]byte{PrefixAttestations}); err != nil {
 		return nil, errors.Wrapf(err, "failed to access storage for attestors of epoch %d", index)
 	} else {
 		return ads.NewMap[identity.ID, Attestation](attestationsStorage), nil
@@ -268,6 +279,16 @@ func (a *Attestations) setWeight(index epoch.Index, weight int64) (err error) {
 	return
 }
 
+// AttestationsRoot returns the root of the committed attestations of an epoch that consist of the given attestations.
+func AttestationsRoot(attestations []*Attestation) (root types.Identifier) {
+	attestationsMap := ads.NewMap[identity.ID, Attestation](mapdb.NewMapDB())
+	for _, attestation := range attestations {
+		attestationsMap.Set(attestation.IssuerID, attestation)
+	}
+
+	return attestationsMap.Root()
+}
+
 func latestAttestation(attestations *memstorage.Storage[models.BlockID, *Attestation]) (latestAttestation *Attestation) {
 	attestations.ForEach(func(blockID models.BlockID, attestation *Attestation) bool {
 		if attestation.Compare(latestAttestation) > 0 {
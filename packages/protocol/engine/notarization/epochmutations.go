@@ -138,6 +138,17 @@ func (m *EpochMutations) Evict(index epoch.Index) (acceptedBlocks *ads.Set[model
 	return m.acceptedBlocks(index), m.acceptedTransactions(index), nil
 }
 
+// RollbackTo resets the latest committed epoch to the given epoch (it is used to roll back the persisted state of the
+// engine before it starts to process blocks).
+func (m *EpochMutations) RollbackTo(index epoch.Index) {
+	m.evictionMutex.Lock()
+	defer m.evictionMutex.Unlock()
+
+	if index < m.latestCommittedIndex {
+		m.latestCommittedIndex = index
+	}
+}
+
 // acceptedBlocks returns the set of accepted blocks for the given epoch.
 func (m *EpochMutations) acceptedBlocks(index epoch.Index, createIfMissing ...bool) *ads.Set[models.BlockID, *models.BlockID] {
 	if len(createIfMissing) > 0 && createIfMissing[0] {
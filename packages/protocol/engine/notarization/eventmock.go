@@ -1,4 +1,4 @@
-package proofs
+package notarization
 
 import (
 	"sync/atomic"
@@ -11,11 +11,6 @@ import (
 	"github.com/iotaledger/hive.go/core/generics/event"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
-	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
-)
-
-const (
-	testingAcceptanceThreshold = 0.4
 )
 
 // EventMock acts as a container for event mocks.
@@ -32,7 +27,7 @@ type EventMock struct {
 }
 
 // NewEventMock creates a new EventMock.
-func NewEventMock(t *testing.T, notarizationManager *notarization.Manager) *EventMock {
+func NewEventMock(t *testing.T, notarizationManager *Manager) *EventMock {
 	e := &EventMock{
 		test: t,
 	}
@@ -80,7 +75,7 @@ func (e *EventMock) AssertExpectations(t mock.TestingT) bool {
 }
 
 // EpochCommittable is the mocked EpochCommittable event.
-func (e *EventMock) EpochCommittable(details *notarization.EpochCommittedDetails) {
+func (e *EventMock) EpochCommittable(details *EpochCommittedDetails) {
 	e.Called(details.Commitment.Index())
 	atomic.AddUint64(&e.calledEvents, 1)
 }
@@ -5,16 +5,21 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iotaledger/hive.go/core/generics/constraints"
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/generics/shrinkingmap"
+	"github.com/iotaledger/hive.go/core/kvstore"
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/goshimmer/packages/core/ads"
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	"github.com/iotaledger/goshimmer/packages/core/traits"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization/proofs"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/storage"
 )
@@ -219,16 +224,33 @@ func (m *Manager) createCommitment(index epoch.Index) (success bool) {
 		return false
 	}
 
+	roots := commitment.NewRoots(
+		acceptedBlocks.Root(),
+		acceptedTransactions.Root(),
+		attestations.Root(),
+		m.ledgerState.UnspentOutputs.Root(),
+		m.EpochMutations.weights.Root(),
+	)
+
+	if err = m.storage.Roots.Store(index, roots); err != nil {
+		m.Events.Error.Trigger(errors.Wrapf(err, "failed to store roots for epoch %d", index))
+		return false
+	}
+
+	if err = storeSet(acceptedBlocks, m.storage.AcceptedBlocks(index)); err != nil {
+		m.Events.Error.Trigger(errors.Wrapf(err, "failed to store accepted blocks for epoch %d", index))
+		return false
+	}
+
+	if err = storeSet(acceptedTransactions, m.storage.AcceptedTransactions(index)); err != nil {
+		m.Events.Error.Trigger(errors.Wrapf(err, "failed to store accepted transactions for epoch %d", index))
+		return false
+	}
+
 	newCommitment := commitment.New(
 		index,
 		latestCommitment.ID(),
-		commitment.NewRoots(
-			acceptedBlocks.Root(),
-			acceptedTransactions.Root(),
-			attestations.Root(),
-			m.ledgerState.UnspentOutputs.Root(),
-			m.EpochMutations.weights.Root(),
-		).ID(),
+		roots.ID(),
 		m.storage.Settings.LatestCommitment().CumulativeWeight()+attestationsWeight,
 	)
 
@@ -250,6 +272,155 @@ func (m *Manager) createCommitment(index epoch.Index) (success bool) {
 	return true
 }
 
+// AcceptedBlocks returns the set of accepted blocks of the given committed epoch.
+func (m *Manager) AcceptedBlocks(index epoch.Index) (acceptedBlocks *ads.Set[models.BlockID, *models.BlockID], err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	if index > m.storage.Settings.LatestCommitment().Index() {
+		return nil, errors.Errorf("epoch %d is not committed yet", index)
+	}
+
+	return m.acceptedBlocks(index)
+}
+
+// AcceptedTransactions returns the set of accepted transactions of the given committed epoch.
+func (m *Manager) AcceptedTransactions(index epoch.Index) (acceptedTransactions *ads.Set[utxo.TransactionID, *utxo.TransactionID], err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	if index > m.storage.Settings.LatestCommitment().Index() {
+		return nil, errors.Errorf("epoch %d is not committed yet", index)
+	}
+
+	return m.acceptedTransactions(index)
+}
+
+// BlockInclusionProof returns a Proof for the inclusion of the given accepted block in the commitment of its epoch.
+func (m *Manager) BlockInclusionProof(blockID models.BlockID) (proof *proofs.Proof, err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	epochCommitment, roots, err := m.committedEpoch(blockID.Index())
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedBlocks, err := m.acceptedBlocks(blockID.Index())
+	if err != nil {
+		return nil, err
+	}
+
+	merkleProof, err := acceptedBlocks.Proof(blockID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate proof for block %s", blockID)
+	} else if !merkleProof.IsInclusionProof() {
+		return nil, errors.Errorf("block %s was not accepted in epoch %d", blockID, blockID.Index())
+	}
+
+	return proofs.New(epochCommitment, roots, merkleProof), nil
+}
+
+// TransactionInclusionProof returns a Proof for the inclusion of the given accepted transaction in the commitment of
+// the epoch that it was included in.
+func (m *Manager) TransactionInclusionProof(transactionID utxo.TransactionID, inclusionEpoch epoch.Index) (proof *proofs.Proof, err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	epochCommitment, roots, err := m.committedEpoch(inclusionEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedTransactions, err := m.acceptedTransactions(inclusionEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	merkleProof, err := acceptedTransactions.Proof(transactionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate proof for transaction %s", transactionID)
+	} else if !merkleProof.IsInclusionProof() {
+		return nil, errors.Errorf("transaction %s was not accepted in epoch %d", transactionID, inclusionEpoch)
+	}
+
+	return proofs.New(epochCommitment, roots, merkleProof), nil
+}
+
+// OutputInclusionProof returns a Proof for the inclusion of the given unspent output in the commitment of the latest
+// committed epoch.
+func (m *Manager) OutputInclusionProof(outputID utxo.OutputID) (proof *proofs.Proof, err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	epochCommitment, roots, err := m.committedEpoch(m.storage.Settings.LatestCommitment().Index())
+	if err != nil {
+		return nil, err
+	}
+
+	merkleProof, err := m.ledgerState.UnspentOutputs.IDs.Proof(outputID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate proof for output %s", outputID)
+	} else if !merkleProof.IsInclusionProof() {
+		return nil, errors.Errorf("output %s is not part of the committed unspent outputs", outputID)
+	}
+
+	return proofs.New(epochCommitment, roots, merkleProof), nil
+}
+
+// committedEpoch returns the commitment and the roots of the given committed epoch.
+func (m *Manager) committedEpoch(index epoch.Index) (epochCommitment *commitment.Commitment, roots *commitment.Roots, err error) {
+	if index > m.storage.Settings.LatestCommitment().Index() {
+		return nil, nil, errors.Errorf("epoch %d is not committed yet", index)
+	}
+
+	if epochCommitment, err = m.storage.Commitments.Load(index); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to load commitment of epoch %d", index)
+	}
+
+	if roots, err = m.storage.Roots.Load(index); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to load roots of epoch %d", index)
+	} else if roots == nil {
+		return nil, nil, errors.Errorf("roots of epoch %d are not available", index)
+	}
+
+	return epochCommitment, roots, nil
+}
+
+// acceptedBlocks returns the persisted set of accepted blocks of the given epoch.
+func (m *Manager) acceptedBlocks(index epoch.Index) (acceptedBlocks *ads.Set[models.BlockID, *models.BlockID], err error) {
+	store := m.storage.AcceptedBlocks(index)
+	if store == nil {
+		return nil, errors.Errorf("accepted blocks of epoch %d are not available", index)
+	}
+
+	return ads.NewSet[models.BlockID](store), nil
+}
+
+// acceptedTransactions returns the persisted set of accepted transactions of the given epoch.
+func (m *Manager) acceptedTransactions(index epoch.Index) (acceptedTransactions *ads.Set[utxo.TransactionID, *utxo.TransactionID], err error) {
+	store := m.storage.AcceptedTransactions(index)
+	if store == nil {
+		return nil, errors.Errorf("accepted transactions of epoch %d are not available", index)
+	}
+
+	return ads.NewSet[utxo.TransactionID](store), nil
+}
+
+// storeSet copies the elements of the given set into a persistent set in the given store.
+func storeSet[K any, KPtr constraints.MarshalablePtr[K]](source *ads.Set[K, KPtr], store kvstore.KVStore) (err error) {
+	if store == nil {
+		return errors.Errorf("storage does not exist")
+	}
+
+	target := ads.NewSet[K, KPtr](store)
+
+	return source.Stream(func(key K) bool {
+		target.Add(key)
+		return true
+	})
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
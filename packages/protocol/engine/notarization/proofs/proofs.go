@@ -1,119 +1,114 @@
 package proofs
 
-/*
 import (
-	"github.com/celestiaorg/smt"
-	"github.com/pkg/errors"
+	"context"
+
 	"github.com/iotaledger/hive.go/core/generics/lo"
-	"golang.org/x/crypto/blake2b"
+	"github.com/iotaledger/hive.go/core/serix"
+	"github.com/pkg/errors"
 
-	"github.com/iotaledger/goshimmer/packages/core/epoch"
-	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/core/ads"
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
-// region proofs helpers ///////////////////////////////////////////////////////////////////////////////////////////////
+// region Proof ////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// CommitmentProof represents an inclusion proof for a specific epoch.
-type CommitmentProof struct {
-	EI    epoch.Index
-	proof smt.SparseMerkleProof
-	root  []byte
+// Proof is a merkle proof that links an element (block, transaction or output) to the commitment of an epoch.
+type Proof struct {
+	// Commitment is the commitment that the element is proven against.
+	Commitment *commitment.Commitment `serix:"0"`
+
+	// Roots are the roots that hash to the RootsID of the Commitment.
+	Roots *commitment.Roots `serix:"1"`
+
+	// MerkleProof is the proof that links the element to one of the Roots.
+	MerkleProof *ads.Proof `serix:"2"`
 }
 
-// GetBlockInclusionProof gets the proof of the inclusion (acceptance) of a block.
-func (m *Manager) GetBlockInclusionProof(blockID models.BlockID) (*CommitmentProof, error) {
-	var ei epoch.Index
-	block, exists := m.tangle.BlockDAG.Block(blockID)
-	if !exists {
-		return nil, errors.Errorf("cannot retrieve block with id %s", blockID)
-	}
-	t := block.IssuingTime()
-	ei = epoch.IndexFromTime(t)
-	proof, err := m.commitmentFactory.ProofTangleRoot(ei, blockID)
-	if err != nil {
-		return nil, err
+// New creates a new Proof.
+func New(commitment *commitment.Commitment, roots *commitment.Roots, merkleProof *ads.Proof) (newProof *Proof) {
+	return &Proof{
+		Commitment:  commitment,
+		Roots:       roots,
+		MerkleProof: merkleProof,
 	}
-	return proof, nil
 }
 
-// GetTransactionInclusionProof gets the proof of the inclusion (acceptance) of a transaction.
-func (m *Manager) GetTransactionInclusionProof(transactionID utxo.TransactionID) (*CommitmentProof, error) {
-	var ei epoch.Index
-	m.ledger.Storage.CachedTransactionMetadata(transactionID).Consume(func(txMeta *ledger.TransactionMetadata) {
-		ei = epoch.IndexFromTime(txMeta.InclusionTime())
-	})
-	proof, err := m.commitmentFactory.ProofStateMutationRoot(ei, transactionID)
-	if err != nil {
-		return nil, err
+// VerifyBlock checks if the Proof proves the inclusion of the given block in the TangleRoot of its Commitment.
+func (p *Proof) VerifyBlock(blockID models.BlockID) (err error) {
+	if blockID.Index() != p.Commitment.Index() {
+		return errors.Errorf("block %s is not part of epoch %d", blockID, p.Commitment.Index())
+	}
+
+	if err = p.verifyRoots(); err != nil {
+		return err
 	}
-	return proof, nil
-}
 
-func (f *commitmentFactory) verifyRoot(proof CommitmentProof, key []byte, value []byte) bool {
-	return smt.VerifyProof(proof.proof, proof.root, key, value, lo.PanicOnErr(blake2b.New256(nil)))
+	return VerifyBlockInclusion(p.Roots, blockID, p.MerkleProof)
 }
 
-// ProofStateRoot returns the merkle proof for the outputID against the state root.
-func (f *commitmentFactory) ProofStateRoot(ei epoch.Index, outID utxo.OutputID) (*CommitmentProof, error) {
-	key := outID.Bytes()
-	root, exists := f.commitmentTrees.Get(ei)
-	if !exists {
-		return nil, errors.Errorf("could not obtain commitment trees for epoch %d", ei)
+// VerifyTransaction checks if the Proof proves the inclusion of the given transaction in the StateMutationRoot of its
+// Commitment.
+func (p *Proof) VerifyTransaction(transactionID utxo.TransactionID) (err error) {
+	if err = p.verifyRoots(); err != nil {
+		return err
 	}
-	tangleRoot := root.tangleTree.Root()
-	proof, err := f.stateRootTree.ProveForRoot(key, tangleRoot)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not generate the state root proof")
-	}
-	return &CommitmentProof{ei, proof, tangleRoot}, nil
+
+	return VerifyTransactionInclusion(p.Roots, transactionID, p.MerkleProof)
 }
 
-// ProofStateMutationRoot returns the merkle proof for the transactionID against the state mutation root.
-func (f *commitmentFactory) ProofStateMutationRoot(ei epoch.Index, txID utxo.TransactionID) (*CommitmentProof, error) {
-	committmentTrees, err := f.getCommitmentTrees(ei)
-	if err != nil {
-		return nil, errors.Wrapf(err, "cannot get commitment trees for epoch %d", ei)
+// VerifyOutput checks if the Proof proves the inclusion of the given output in the StateRoot of its Commitment.
+func (p *Proof) VerifyOutput(outputID utxo.OutputID) (err error) {
+	if err = p.verifyRoots(); err != nil {
+		return err
 	}
 
-	key := txID.Bytes()
-	root := committmentTrees.stateMutationTree.Root()
-	proof, err := committmentTrees.stateMutationTree.ProveForRoot(key, root)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not generate the state mutation root proof")
-	}
-	return &CommitmentProof{ei, proof, root}, nil
+	return VerifyOutputInclusion(p.Roots, outputID, p.MerkleProof)
 }
 
-// ProofTangleRoot returns the merkle proof for the blockID against the tangle root.
-func (f *commitmentFactory) ProofTangleRoot(ei epoch.Index, blockID models.BlockID) (*CommitmentProof, error) {
-	committmentTrees, err := f.getCommitmentTrees(ei)
-	if err != nil {
-		return nil, errors.Wrapf(err, "cannot get commitment trees for epoch %d", ei)
+// Bytes returns a serialized version of the Proof.
+func (p *Proof) Bytes() (bytes []byte, err error) {
+	return serix.DefaultAPI.Encode(context.Background(), p, serix.WithValidation())
+}
+
+// FromBytes deserializes a Proof from the given bytes.
+func (p *Proof) FromBytes(bytes []byte) (consumedBytes int, err error) {
+	return serix.DefaultAPI.Decode(context.Background(), bytes, p, serix.WithValidation())
+}
+
+// verifyRoots checks if the Roots of the Proof match the Commitment.
+func (p *Proof) verifyRoots() (err error) {
+	if p.Commitment == nil || p.Roots == nil || p.MerkleProof == nil {
+		return errors.Errorf("proof is incomplete")
 	}
 
-	key, _ := blockID.Bytes()
-	root := committmentTrees.tangleTree.Root()
-	proof, err := committmentTrees.tangleTree.ProveForRoot(key, root)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not generate the tangle root proof")
+	if p.Roots.ID() != p.Commitment.RootsID() {
+		return errors.Errorf("roots do not match the RootsID of commitment %s", p.Commitment.ID())
 	}
-	return &CommitmentProof{ei, proof, root}, nil
+
+	return nil
 }
 
-// VerifyTangleRoot verify the provided merkle proof against the tangle root.
-func (f *commitmentFactory) VerifyTangleRoot(proof CommitmentProof, blockID models.BlockID) bool {
-	key, _ := blockID.Bytes()
-	return f.verifyRoot(proof, key, key)
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region verifiers ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// VerifyBlockInclusion checks if the merkle proof proves the inclusion of the given block in the TangleRoot.
+func VerifyBlockInclusion(roots *commitment.Roots, blockID models.BlockID, merkleProof *ads.Proof) (err error) {
+	return errors.Wrapf(merkleProof.VerifyInclusion(roots.TangleRoot(), lo.PanicOnErr(blockID.Bytes())), "failed to verify inclusion of block %s", blockID)
 }
 
-// VerifyStateMutationRoot verify the provided merkle proof against the state mutation root.
-func (f *commitmentFactory) VerifyStateMutationRoot(proof CommitmentProof, transactionID utxo.TransactionID) bool {
-	key := transactionID.Bytes()
-	return f.verifyRoot(proof, key, key)
+// VerifyTransactionInclusion checks if the merkle proof proves the inclusion of the given transaction in the
+// StateMutationRoot.
+func VerifyTransactionInclusion(roots *commitment.Roots, transactionID utxo.TransactionID, merkleProof *ads.Proof) (err error) {
+	return errors.Wrapf(merkleProof.VerifyInclusion(roots.StateMutationRoot(), lo.PanicOnErr(transactionID.Bytes())), "failed to verify inclusion of transaction %s", transactionID)
 }
 
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+// VerifyOutputInclusion checks if the merkle proof proves the inclusion of the given output in the StateRoot.
+func VerifyOutputInclusion(roots *commitment.Roots, outputID utxo.OutputID, merkleProof *ads.Proof) (err error) {
+	return errors.Wrapf(merkleProof.VerifyInclusion(roots.StateRoot(), lo.PanicOnErr(outputID.Bytes())), "failed to verify inclusion of output %s", outputID)
+}
 
-*/
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
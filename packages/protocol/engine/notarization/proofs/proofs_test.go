@@ -0,0 +1,37 @@
+package proofs
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/ads"
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
+
+func TestProof_VerifyBlock(t *testing.T) {
+	acceptedBlock := models.NewBlockID(types.NewIdentifier([]byte("accepted")), ed25519.EmptySignature, 1)
+	unknownBlock := models.NewBlockID(types.NewIdentifier([]byte("unknown")), ed25519.EmptySignature, 1)
+
+	acceptedBlocks := ads.NewSet[models.BlockID](mapdb.NewMapDB())
+	acceptedBlocks.Add(acceptedBlock)
+
+	roots := commitment.NewRoots(acceptedBlocks.Root(), types.Identifier{}, types.Identifier{}, types.Identifier{}, types.Identifier{})
+	epochCommitment := commitment.New(1, commitment.NewEmptyCommitment().ID(), roots.ID(), 0)
+
+	proof := New(epochCommitment, roots, lo.PanicOnErr(acceptedBlocks.Proof(acceptedBlock)))
+	require.NoError(t, proof.VerifyBlock(acceptedBlock))
+	require.Error(t, proof.VerifyBlock(unknownBlock))
+
+	restoredProof := new(Proof)
+	lo.PanicOnErr(restoredProof.FromBytes(lo.PanicOnErr(proof.Bytes())))
+	require.NoError(t, restoredProof.VerifyBlock(acceptedBlock))
+
+	forgedRoots := commitment.NewRoots(types.NewIdentifier([]byte("forged")), types.Identifier{}, types.Identifier{}, types.Identifier{}, types.Identifier{})
+	require.Error(t, New(epochCommitment, forgedRoots, proof.MerkleProof).VerifyBlock(acceptedBlock))
+}
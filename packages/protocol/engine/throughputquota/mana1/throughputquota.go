@@ -70,6 +70,12 @@ func New(engineInstance *engine.Engine, opts ...options.Option[ThroughputQuota])
 		}
 
 		m.engine.SubscribeConstructed(func() {
+			// the quotas follow the accepted ledger state, so they are always up to date with the latest commitment of
+			// an existing storage (which is required to roll back its state diffs before the engine is initialized).
+			if m.engine.Storage.Settings.SnapshotImported() {
+				m.SetLastCommittedEpoch(m.engine.Storage.Settings.LatestCommitment().Index())
+			}
+
 			m.engine.Storage.Settings.SubscribeInitialized(func() {
 				m.SetLastCommittedEpoch(m.engine.Storage.Settings.LatestCommitment().Index())
 			})
@@ -122,7 +128,8 @@ func (m *ThroughputQuota) applyCreatedOutput(output *ledger.OutputWithMetadata)
 	if iotaBalance, exists := output.IOTABalance(); exists {
 		m.updateMana(output.AccessManaPledgeID(), int64(iotaBalance))
 
-		if !m.engine.LedgerState.UnspentOutputs.WasInitialized() {
+		// the total balance only changes when the initial ledger state is imported (rolling back state diffs keeps it).
+		if !m.engine.LedgerState.UnspentOutputs.WasInitialized() && !m.BatchedStateTransitionStarted() {
 			totalBalanceBytes, serializationErr := storable.SerializableInt64(m.updateTotalBalance(int64(iotaBalance))).Bytes()
 			if serializationErr != nil {
 				return errors.Wrapf(serializationErr, "failed to serialize total balance")
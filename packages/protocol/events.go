@@ -4,13 +4,19 @@ import (
 	"github.com/iotaledger/hive.go/core/generics/event"
 	"github.com/iotaledger/hive.go/core/identity"
 
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/tipmanager"
 )
 
 type Events struct {
-	InvalidBlockReceived *event.Linkable[identity.ID]
+	InvalidBlockReceived        *event.Linkable[identity.ID]
+	InvalidAttestationsReceived *event.Linkable[*InvalidAttestationsEvent]
+	CandidateEngineActivated    *event.Linkable[*engine.Engine]
+	MainEngineSwitched          *event.Linkable[*engine.Engine]
+	WarpsyncStarted             *event.Linkable[*commitment.Commitment]
+	Error                       *event.Linkable[error]
 
 	Engine            *engine.Events
 	CongestionControl *congestioncontrol.Events
@@ -21,8 +27,22 @@ type Events struct {
 
 var NewEvents = event.LinkableConstructor(func() (newEvents *Events) {
 	return &Events{
+		InvalidBlockReceived:        event.NewLinkable[identity.ID](),
+		InvalidAttestationsReceived: event.NewLinkable[*InvalidAttestationsEvent](),
+		CandidateEngineActivated:    event.NewLinkable[*engine.Engine](),
+		MainEngineSwitched:          event.NewLinkable[*engine.Engine](),
+		WarpsyncStarted:             event.NewLinkable[*commitment.Commitment](),
+		Error:                       event.NewLinkable[error](),
+
 		Engine:            engine.NewEvents(),
 		CongestionControl: congestioncontrol.NewEvents(),
 		TipManager:        tipmanager.NewEvents(),
 	}
 })
+
+// InvalidAttestationsEvent is triggered when a peer sends attestations that do not back the claimed weight of a commitment.
+type InvalidAttestationsEvent struct {
+	Commitment *commitment.Commitment
+	Error      error
+	Source     identity.ID
+}
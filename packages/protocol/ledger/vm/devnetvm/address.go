@@ -3,6 +3,7 @@ package devnetvm
 import (
 	"bytes"
 	"context"
+	"sort"
 
 	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
@@ -27,7 +28,11 @@ func init() {
 	if err != nil {
 		panic(errors.Wrap(err, "error registering AliasAddress type settings"))
 	}
-	err = serix.DefaultAPI.RegisterInterfaceObjects((*Address)(nil), new(ED25519Address), new(BLSAddress), new(AliasAddress))
+	err = serix.DefaultAPI.RegisterTypeSettings(ThresholdAddress{}, serix.TypeSettings{}.WithObjectType(uint8(new(ThresholdAddress).Type())))
+	if err != nil {
+		panic(errors.Wrap(err, "error registering ThresholdAddress type settings"))
+	}
+	err = serix.DefaultAPI.RegisterInterfaceObjects((*Address)(nil), new(ED25519Address), new(BLSAddress), new(AliasAddress), new(ThresholdAddress))
 	if err != nil {
 		panic(errors.Wrap(err, "error registering Address interface implementations"))
 	}
@@ -44,6 +49,9 @@ const (
 
 	// AliasAddressType represents ID used in AliasOutput and AliasLockOutput.
 	AliasAddressType
+
+	// ThresholdAddressType represents an Address that is secured by M-of-N ED25519 signatures.
+	ThresholdAddressType
 )
 
 // AddressLength contains the length of an address (type length = 1, Digest2 length = 32).
@@ -58,6 +66,7 @@ func (a AddressType) String() string {
 		"AddressTypeED25519",
 		"AddressTypeBLS",
 		"AliasAddress",
+		"ThresholdAddress",
 	}[a]
 }
 
@@ -107,6 +116,8 @@ func AddressFromBytes(bytes []byte) (address Address, consumedBytes int, err err
 		return BLSAddressFromBytes(bytes)
 	case AliasAddressType:
 		return AliasAddressFromBytes(bytes)
+	case ThresholdAddressType:
+		return ThresholdAddressFromBytes(bytes)
 	default:
 		err = errors.WithMessagef(cerrors.ErrParseBytesFailed, "unsupported address type (%X)", addressType)
 		return
@@ -427,3 +438,129 @@ func (a *AliasAddress) IsNil() bool {
 var _ Address = &AliasAddress{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdAddress /////////////////////////////////////////////////////////////////////////////////////////////
+
+// MaxThresholdPublicKeys defines the maximum number of public keys that can be part of a ThresholdAddress.
+const MaxThresholdPublicKeys = 16
+
+// ThresholdAddress represents an Address that is secured by a set of ED25519 public keys of which at least a threshold
+// amount needs to sign a Transaction to unlock the Address (M-of-N multi-signature). The Address only contains the
+// digest of the threshold and the public keys, which are revealed in the ThresholdSignatureUnlockBlock.
+type ThresholdAddress struct {
+	thresholdAddressInner `serix:"0"`
+}
+type thresholdAddressInner struct {
+	Digest [blake2b.Size256]byte `serix:"0"`
+}
+
+// NewThresholdAddress creates a new ThresholdAddress that is unlocked by signatures of at least threshold of the given
+// public keys. The order of the public keys does not matter.
+func NewThresholdAddress(threshold uint8, publicKeys []ed25519.PublicKey) (address *ThresholdAddress, err error) {
+	sortedPublicKeys, err := sortThresholdPublicKeys(threshold, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	digestInput := make([]byte, 0, 1+len(sortedPublicKeys)*ed25519.PublicKeySize)
+	digestInput = append(digestInput, threshold)
+	for _, publicKey := range sortedPublicKeys {
+		digestInput = append(digestInput, publicKey[:]...)
+	}
+
+	return &ThresholdAddress{
+		thresholdAddressInner{
+			Digest: blake2b.Sum256(digestInput),
+		},
+	}, nil
+}
+
+// ThresholdAddressFromBytes unmarshals a ThresholdAddress from a sequence of bytes.
+func ThresholdAddressFromBytes(data []byte) (address *ThresholdAddress, consumedBytes int, err error) {
+	address = new(ThresholdAddress)
+	consumedBytes, err = serix.DefaultAPI.Decode(context.Background(), data, address, serix.WithValidation())
+	if err != nil {
+		return nil, consumedBytes, err
+	}
+	return
+}
+
+// Type returns the AddressType of the Address.
+func (t *ThresholdAddress) Type() AddressType {
+	return ThresholdAddressType
+}
+
+// Digest returns the hashed version of the threshold and the public keys of the Address.
+func (t *ThresholdAddress) Digest() []byte {
+	return t.thresholdAddressInner.Digest[:]
+}
+
+// Clone creates a copy of the Address.
+func (t *ThresholdAddress) Clone() Address {
+	return &ThresholdAddress{thresholdAddressInner{Digest: t.thresholdAddressInner.Digest}}
+}
+
+// Equals returns true if the two Addresses are equal.
+func (t *ThresholdAddress) Equals(other Address) bool {
+	return t.Type() == other.Type() && bytes.Equal(t.Digest(), other.Digest())
+}
+
+// Bytes returns a marshaled version of the Address.
+func (t *ThresholdAddress) Bytes() []byte {
+	objBytes, err := serix.DefaultAPI.Encode(context.Background(), t, serix.WithValidation())
+	if err != nil {
+		// TODO: what do?
+		return nil
+	}
+	return objBytes
+}
+
+// Array returns an array of bytes that contains the marshaled version of the Address.
+func (t *ThresholdAddress) Array() (array [AddressLength]byte) {
+	copy(array[:], t.Bytes())
+
+	return
+}
+
+// Base58 returns a base58 encoded version of the Address.
+func (t *ThresholdAddress) Base58() string {
+	return base58.Encode(t.Bytes())
+}
+
+// String returns a human readable version of the addresses for debug purposes.
+func (t *ThresholdAddress) String() string {
+	return stringify.Struct("ThresholdAddress",
+		stringify.NewStructField("Digest", t.Digest()),
+		stringify.NewStructField("Base58", t.Base58()),
+	)
+}
+
+// sortThresholdPublicKeys returns a sorted copy of the given public keys after checking that they form a valid
+// M-of-N signer set for the given threshold.
+func sortThresholdPublicKeys(threshold uint8, publicKeys []ed25519.PublicKey) (sortedPublicKeys []ed25519.PublicKey, err error) {
+	if len(publicKeys) == 0 || len(publicKeys) > MaxThresholdPublicKeys {
+		return nil, errors.Errorf("number of public keys (%d) must be between 1 and %d", len(publicKeys), MaxThresholdPublicKeys)
+	}
+	if threshold == 0 || int(threshold) > len(publicKeys) {
+		return nil, errors.Errorf("threshold (%d) must be between 1 and the number of public keys (%d)", threshold, len(publicKeys))
+	}
+
+	sortedPublicKeys = make([]ed25519.PublicKey, len(publicKeys))
+	copy(sortedPublicKeys, publicKeys)
+	sort.Slice(sortedPublicKeys, func(i, j int) bool {
+		return bytes.Compare(sortedPublicKeys[i][:], sortedPublicKeys[j][:]) < 0
+	})
+
+	for i := 1; i < len(sortedPublicKeys); i++ {
+		if sortedPublicKeys[i] == sortedPublicKeys[i-1] {
+			return nil, errors.Errorf("duplicate public key %s", sortedPublicKeys[i])
+		}
+	}
+
+	return sortedPublicKeys, nil
+}
+
+// code contract (make sure the struct implements all required methods).
+var _ Address = &ThresholdAddress{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
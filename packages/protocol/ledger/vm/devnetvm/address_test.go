@@ -81,3 +81,37 @@ func TestAliasAddressIsNil(t *testing.T) {
 	require.False(t, notNilAddr.IsNil())
 	require.True(t, nilAddr.Equals(&AliasAddress{}))
 }
+
+func TestThresholdAddress(t *testing.T) {
+	wallets := createWallets(3)
+	publicKeys := []ed25519.PublicKey{wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey()}
+
+	address, err := NewThresholdAddress(2, publicKeys)
+	require.NoError(t, err)
+
+	// the order of the public keys does not matter
+	reorderedAddress, err := NewThresholdAddress(2, []ed25519.PublicKey{publicKeys[2], publicKeys[0], publicKeys[1]})
+	require.NoError(t, err)
+	require.True(t, address.Equals(reorderedAddress))
+
+	// the threshold is part of the address
+	otherThresholdAddress, err := NewThresholdAddress(3, publicKeys)
+	require.NoError(t, err)
+	require.False(t, address.Equals(otherThresholdAddress))
+
+	// threshold address from base58 string using AddressFromBytes
+	addressFromBase58, err := AddressFromBase58EncodedString(address.Base58())
+	require.NoError(t, err)
+	require.Equal(t, ThresholdAddressType, addressFromBase58.Type())
+	require.True(t, address.Equals(addressFromBase58))
+
+	// invalid signer sets
+	_, err = NewThresholdAddress(0, publicKeys)
+	require.Error(t, err)
+	_, err = NewThresholdAddress(4, publicKeys)
+	require.Error(t, err)
+	_, err = NewThresholdAddress(2, []ed25519.PublicKey{publicKeys[0], publicKeys[0]})
+	require.Error(t, err)
+	_, err = NewThresholdAddress(1, nil)
+	require.Error(t, err)
+}
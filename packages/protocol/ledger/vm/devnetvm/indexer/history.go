@@ -0,0 +1,291 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/iotaledger/hive.go/core/byteutils"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/core/types/confirmation"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+// region transactionHistory ///////////////////////////////////////////////////////////////////////////////////////////
+
+// transactionHistory persists the transactions that created or spent the Outputs of the addresses ordered by their
+// inclusion epoch.
+type transactionHistory struct {
+	// store contains the KVStore that holds the history.
+	store kvstore.KVStore
+
+	// mutex is used to make the read-modify-write operations on the history atomic.
+	mutex sync.Mutex
+}
+
+// newTransactionHistory returns a new transactionHistory that persists its data in the given KVStore.
+func newTransactionHistory(store kvstore.KVStore) (newTransactionHistory *transactionHistory) {
+	return &transactionHistory{
+		store: store,
+	}
+}
+
+// record adds the given transaction to the history of the given addresses. The flags of existing entries are merged,
+// the confirmation state is only ever raised and all entries of the transaction are moved to the given inclusion epoch.
+// The entries are written to the given target (i.e. the store or the batch of a batched state transition).
+func (t *transactionHistory) record(target readWriter, txID utxo.TransactionID, inclusionEpoch epoch.Index, confirmationState confirmation.State, flags map[string]byte) (err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	previousEpoch, addresses, exists, err := loadAddresses(target, txID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if len(flags) == 0 {
+			return nil
+		}
+
+		previousEpoch = inclusionEpoch
+	}
+
+	entryFlags := make(map[string]byte)
+	for _, address := range addresses {
+		value, getErr := target.Get(historyKey(address, previousEpoch, txID))
+		if getErr != nil {
+			return errors.Wrapf(getErr, "failed to load history entry of transaction %s", txID)
+		}
+
+		entryFlags[string(address.Bytes())] = value[0]
+		if existingState := confirmation.State(value[1]); existingState > confirmationState {
+			confirmationState = existingState
+		}
+	}
+	for addressBytes, addressFlags := range flags {
+		if _, exists = entryFlags[addressBytes]; !exists {
+			address, _, parseErr := devnetvm.AddressFromBytes([]byte(addressBytes))
+			if parseErr != nil {
+				return errors.Wrapf(parseErr, "failed to parse address of transaction %s", txID)
+			}
+			addresses = append(addresses, address)
+		}
+
+		entryFlags[addressBytes] |= addressFlags
+	}
+
+	return batched(target, func(batch readWriter) (err error) {
+		for _, address := range addresses {
+			if previousEpoch != inclusionEpoch {
+				if err = batch.Delete(historyKey(address, previousEpoch, txID)); err != nil {
+					return errors.Wrapf(err, "failed to delete history entry of transaction %s", txID)
+				}
+			}
+
+			if err = batch.Set(historyKey(address, inclusionEpoch, txID), []byte{entryFlags[string(address.Bytes())], byte(confirmationState)}); err != nil {
+				return errors.Wrapf(err, "failed to store history entry of transaction %s", txID)
+			}
+		}
+
+		return errors.Wrapf(batch.Set(transactionKey(txID), transactionValue(inclusionEpoch, addresses)), "failed to store addresses of transaction %s", txID)
+	})
+}
+
+// move moves the existing entries of the given transaction to the given inclusion epoch. The entries are moved through
+// the given target (i.e. the store or the batch of a batched state transition).
+func (t *transactionHistory) move(target readWriter, txID utxo.TransactionID, inclusionEpoch epoch.Index) (err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	currentEpoch, addresses, exists, err := loadAddresses(target, txID)
+	if err != nil || !exists || currentEpoch == inclusionEpoch {
+		return err
+	}
+
+	return batched(target, func(batch readWriter) (err error) {
+		for _, address := range addresses {
+			value, getErr := batch.Get(historyKey(address, currentEpoch, txID))
+			if getErr != nil {
+				return errors.Wrapf(getErr, "failed to load history entry of transaction %s", txID)
+			}
+
+			if err = batch.Delete(historyKey(address, currentEpoch, txID)); err != nil {
+				return errors.Wrapf(err, "failed to delete history entry of transaction %s", txID)
+			}
+
+			if err = batch.Set(historyKey(address, inclusionEpoch, txID), value); err != nil {
+				return errors.Wrapf(err, "failed to store history entry of transaction %s", txID)
+			}
+		}
+
+		return errors.Wrapf(batch.Set(transactionKey(txID), transactionValue(inclusionEpoch, addresses)), "failed to store addresses of transaction %s", txID)
+	})
+}
+
+// delete removes the given transaction from the history of all of its addresses. The entries are removed through the
+// given target (i.e. the store or the batch of a batched state transition).
+func (t *transactionHistory) delete(target readWriter, txID utxo.TransactionID) (err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	inclusionEpoch, addresses, exists, err := loadAddresses(target, txID)
+	if err != nil || !exists {
+		return err
+	}
+
+	return batched(target, func(batch readWriter) (err error) {
+		for _, address := range addresses {
+			if err = batch.Delete(historyKey(address, inclusionEpoch, txID)); err != nil {
+				return errors.Wrapf(err, "failed to delete history entry of transaction %s", txID)
+			}
+		}
+
+		return errors.Wrapf(batch.Delete(transactionKey(txID)), "failed to delete addresses of transaction %s", txID)
+	})
+}
+
+// clear removes the complete history.
+func (t *transactionHistory) clear() (err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, prefix := range []byte{PrefixTransactionHistory, PrefixTransactionAddresses} {
+		if err = t.store.DeletePrefix([]byte{prefix}); err != nil {
+			return errors.Wrap(err, "failed to delete transaction history")
+		}
+	}
+
+	return nil
+}
+
+// stream streams the history of the given address in the given (inclusive) epoch range.
+func (t *transactionHistory) stream(address devnetvm.Address, startEpoch, endEpoch epoch.Index, callback func(entry *TransactionHistoryEntry) bool) (err error) {
+	prefix := byteutils.ConcatBytes([]byte{PrefixTransactionHistory}, address.Bytes())
+
+	if iterationErr := t.store.Iterate(prefix, func(key kvstore.Key, value kvstore.Value) bool {
+		entry := &TransactionHistoryEntry{InclusionEpoch: epoch.Index(binary.BigEndian.Uint64(key[len(prefix):]))}
+		if entry.InclusionEpoch < startEpoch {
+			return true
+		} else if entry.InclusionEpoch > endEpoch {
+			return false
+		}
+
+		if _, err = entry.TransactionID.FromBytes(key[len(prefix)+epochKeyLength:]); err != nil {
+			err = errors.Wrapf(err, "failed to parse transaction ID in history of address %s", address.Base58())
+			return false
+		}
+
+		entry.CreatedOutputs = value[0]&historyFlagCreated != 0
+		entry.SpentOutputs = value[0]&historyFlagSpent != 0
+		entry.ConfirmationState = confirmation.State(value[1])
+
+		return callback(entry)
+	}, kvstore.IterDirectionForward); iterationErr != nil {
+		return errors.Wrapf(iterationErr, "failed to iterate transaction history of address %s", address.Base58())
+	}
+
+	return err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utils ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// batched applies the given operation to the given target. A KVStore is wrapped in a batch that is committed after the
+// operation succeeded, so that the entries of a transaction are always updated atomically.
+func batched(target readWriter, operation func(batch readWriter) error) (err error) {
+	store, isStore := target.(kvstore.KVStore)
+	if !isStore {
+		return operation(target)
+	}
+
+	batch, err := newBatchWriter(store)
+	if err != nil {
+		return errors.Wrap(err, "failed to create batch")
+	}
+
+	if err = operation(batch); err != nil {
+		batch.Cancel()
+		return err
+	}
+
+	return errors.Wrap(batch.Commit(), "failed to commit batch")
+}
+
+// loadAddresses loads the inclusion epoch and the addresses of the entries of the given transaction from the given
+// source.
+func loadAddresses(source readWriter, txID utxo.TransactionID) (inclusionEpoch epoch.Index, addresses []devnetvm.Address, exists bool, err error) {
+	value, err := source.Get(transactionKey(txID))
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return 0, nil, false, nil
+		}
+
+		return 0, nil, false, errors.Wrapf(err, "failed to load addresses of transaction %s", txID)
+	}
+
+	inclusionEpoch = epoch.Index(binary.BigEndian.Uint64(value))
+	for offset := epochKeyLength; offset < len(value); offset += devnetvm.AddressLength {
+		address, _, parseErr := devnetvm.AddressFromBytes(value[offset:])
+		if parseErr != nil {
+			return 0, nil, false, errors.Wrapf(parseErr, "failed to parse address of transaction %s", txID)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return inclusionEpoch, addresses, true, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region keys /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// historyFlagCreated marks a history entry of a transaction that created Outputs of the address.
+	historyFlagCreated byte = 1 << iota
+
+	// historyFlagSpent marks a history entry of a transaction that spent Outputs of the address.
+	historyFlagSpent
+)
+
+// addressFlags returns the given flag for all addresses of the given Outputs.
+func addressFlags(flag byte, outputs ...*ledger.OutputWithMetadata) (flags map[string]byte) {
+	flags = make(map[string]byte)
+	for _, output := range outputs {
+		if devnetOutput, isDevnetOutput := output.Output().(devnetvm.Output); isDevnetOutput {
+			for _, address := range OutputAddresses(devnetOutput) {
+				flags[string(address.Bytes())] |= flag
+			}
+		}
+	}
+
+	return flags
+}
+
+// historyKey returns the key of the history entry of the given address and transaction.
+func historyKey(address devnetvm.Address, inclusionEpoch epoch.Index, txID utxo.TransactionID) (key []byte) {
+	return byteutils.ConcatBytes([]byte{PrefixTransactionHistory}, address.Bytes(), epochKey(inclusionEpoch), lo.PanicOnErr(txID.Bytes()))
+}
+
+// transactionKey returns the key of the inclusion epoch and the addresses of the history entries of a transaction.
+func transactionKey(txID utxo.TransactionID) (key []byte) {
+	return byteutils.ConcatBytes([]byte{PrefixTransactionAddresses}, lo.PanicOnErr(txID.Bytes()))
+}
+
+// transactionValue returns the value that stores the inclusion epoch and the addresses of a transaction.
+func transactionValue(inclusionEpoch epoch.Index, addresses []devnetvm.Address) (value []byte) {
+	value = epochKey(inclusionEpoch)
+	for _, address := range addresses {
+		value = append(value, address.Bytes()...)
+	}
+
+	return value
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
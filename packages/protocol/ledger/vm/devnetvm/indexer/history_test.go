@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/core/types/confirmation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func TestTransactionHistory(t *testing.T) {
+	store := mapdb.NewMapDB()
+	history := newTransactionHistory(store)
+	sender := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	receiver := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	var txID1, txID2 utxo.TransactionID
+	require.NoError(t, txID1.FromRandomness())
+	require.NoError(t, txID2.FromRandomness())
+
+	// txID1 was confirmed by the snapshot and created outputs of the sender
+	require.NoError(t, history.record(store, txID1, 1, confirmation.Confirmed, addressBytesFlags(historyFlagCreated, sender)))
+
+	// txID2 spends the outputs of the sender and sends them to the receiver
+	flags := addressBytesFlags(historyFlagSpent, sender)
+	flags[string(receiver.Bytes())] = historyFlagCreated
+	require.NoError(t, history.record(store, txID2, 2, confirmation.Accepted, flags))
+
+	assert.Equal(t, []*TransactionHistoryEntry{
+		{TransactionID: txID1, InclusionEpoch: 1, CreatedOutputs: true, ConfirmationState: confirmation.Confirmed},
+		{TransactionID: txID2, InclusionEpoch: 2, SpentOutputs: true, ConfirmationState: confirmation.Accepted},
+	}, historyEntries(t, history, sender, 0, 10))
+	assert.Equal(t, []*TransactionHistoryEntry{
+		{TransactionID: txID2, InclusionEpoch: 2, CreatedOutputs: true, ConfirmationState: confirmation.Accepted},
+	}, historyEntries(t, history, receiver, 0, 10))
+
+	// the inclusion epoch of txID2 is updated
+	require.NoError(t, history.move(store, txID2, 3))
+	assert.Empty(t, historyEntries(t, history, receiver, 0, 2))
+	assert.Equal(t, []*TransactionHistoryEntry{
+		{TransactionID: txID2, InclusionEpoch: 3, SpentOutputs: true, ConfirmationState: confirmation.Accepted},
+	}, historyEntries(t, history, sender, 2, 3))
+
+	// the commitment of epoch 3 confirms txID2 for all of its addresses
+	require.NoError(t, history.record(store, txID2, 3, confirmation.Confirmed, addressBytesFlags(historyFlagCreated, receiver)))
+	assert.Equal(t, []*TransactionHistoryEntry{
+		{TransactionID: txID2, InclusionEpoch: 3, SpentOutputs: true, ConfirmationState: confirmation.Confirmed},
+	}, historyEntries(t, history, sender, 3, 3))
+
+	// accepting an already confirmed transaction again does not downgrade it
+	require.NoError(t, history.record(store, txID2, 3, confirmation.Accepted, flags))
+	assert.Equal(t, confirmation.Confirmed, historyEntries(t, history, receiver, 3, 3)[0].ConfirmationState)
+
+	// rolling back txID2 removes it from the history of all addresses
+	require.NoError(t, history.delete(store, txID2))
+	assert.Empty(t, historyEntries(t, history, receiver, 0, 10))
+	assert.Len(t, historyEntries(t, history, sender, 0, 10), 1)
+
+	// clearing the history removes all entries
+	require.NoError(t, history.clear())
+	assert.Empty(t, historyEntries(t, history, sender, 0, 10))
+}
+
+func TestTransactionHistory_Batched(t *testing.T) {
+	store := mapdb.NewMapDB()
+	history := newTransactionHistory(store)
+	sender := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	receiver := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	var txID utxo.TransactionID
+	require.NoError(t, txID.FromRandomness())
+
+	// the outputs of the transaction are recorded one after the other in the batch of the commitment
+	batch, err := newBatchWriter(store)
+	require.NoError(t, err)
+	require.NoError(t, history.record(batch, txID, 1, confirmation.Accepted, addressBytesFlags(historyFlagCreated, sender)))
+	require.NoError(t, history.record(batch, txID, 1, confirmation.Accepted, addressBytesFlags(historyFlagCreated, receiver)))
+	assert.Empty(t, historyEntries(t, history, sender, 0, 10))
+	assert.Empty(t, historyEntries(t, history, receiver, 0, 10))
+
+	require.NoError(t, batch.Commit())
+	assert.Len(t, historyEntries(t, history, sender, 0, 10), 1)
+	assert.Len(t, historyEntries(t, history, receiver, 0, 10), 1)
+
+	// rolling back the transaction only removes it once the batch is committed
+	batch, err = newBatchWriter(store)
+	require.NoError(t, err)
+	require.NoError(t, history.delete(batch, txID))
+	assert.Len(t, historyEntries(t, history, receiver, 0, 10), 1)
+
+	require.NoError(t, batch.Commit())
+	assert.Empty(t, historyEntries(t, history, sender, 0, 10))
+	assert.Empty(t, historyEntries(t, history, receiver, 0, 10))
+}
+
+func addressBytesFlags(flag byte, addresses ...devnetvm.Address) (flags map[string]byte) {
+	flags = make(map[string]byte)
+	for _, address := range addresses {
+		flags[string(address.Bytes())] = flag
+	}
+
+	return flags
+}
+
+func historyEntries(t *testing.T, history *transactionHistory, address devnetvm.Address, startEpoch, endEpoch epoch.Index) (entries []*TransactionHistoryEntry) {
+	require.NoError(t, history.stream(address, startEpoch, endEpoch, func(entry *TransactionHistoryEntry) bool {
+		entries = append(entries, entry)
+		return true
+	}))
+
+	return entries
+}
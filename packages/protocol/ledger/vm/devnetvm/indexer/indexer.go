@@ -1,12 +1,18 @@
 package indexer
 
 import (
-	"github.com/pkg/errors"
+	"context"
+	"sync"
 
-	"github.com/iotaledger/hive.go/core/cerrors"
-	"github.com/iotaledger/hive.go/core/generics/objectstorage"
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/types/confirmation"
+	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
@@ -14,115 +20,552 @@ import (
 
 // region Indexer //////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// Indexer is a component that indexes the Outputs of a ledgerFunc for easier lookups.
+// Indexer is a component that indexes the Outputs of the ledger by their addresses. It follows the committed ledger
+// state as an UnspentOutputsConsumer (including rollbacks), keeps the spent output history of every address and
+// additionally tracks the Outputs that were booked by the mempool but not committed yet. Optionally, it also records
+// the transactions that created or spent the Outputs of every address.
 type Indexer struct {
-	// addressOutputMappingStorage is an object storage used to persist AddressOutputMapping objects.
-	addressOutputMappingStorage *objectstorage.ObjectStorage[*AddressOutputMapping]
+	// engine contains the engine whose ledger state is indexed.
+	engine *engine.Engine
+
+	// outputStorage contains the persisted index of the committed ledger state of the engine.
+	outputStorage *outputStorage
+
+	// transactionHistory contains the persisted transaction history of the addresses (nil if it is disabled).
+	transactionHistory *transactionHistory
+
+	// pendingOutputs contains the IDs of the booked but not yet committed Outputs per address.
+	pendingOutputs map[string]utxo.OutputIDs
+
+	// pendingAddresses contains the addresses of the booked but not yet committed Outputs.
+	pendingAddresses map[utxo.OutputID][]devnetvm.Address
 
-	// ledgerFunc contains the indexed Ledger.
-	ledgerFunc func() *ledger.Ledger
+	// unlink detaches the Indexer from the engine it is currently linked to.
+	unlink func()
 
-	// options is a dictionary for configuration parameters of the Indexer.
-	options *options
+	// optsTransactionHistory contains a flag that indicates whether the transaction history is recorded.
+	optsTransactionHistory bool
+
+	// mutex is used to synchronize access to the linked engine and the pending Outputs.
+	mutex sync.RWMutex
+}
+
+// New returns a new Indexer instance that needs to be linked to an engine before it can be used.
+func New(opts ...options.Option[Indexer]) (i *Indexer) {
+	return options.Apply(&Indexer{
+		pendingOutputs:   make(map[string]utxo.OutputIDs),
+		pendingAddresses: make(map[utxo.OutputID][]devnetvm.Address),
+	}, opts)
 }
 
-// New returns a new Indexer instance with the given options.
-func New(ledgerFunc func() *ledger.Ledger, options ...Option) (i *Indexer) {
-	i = &Indexer{
-		ledgerFunc: ledgerFunc,
-		options:    newOptions(options...),
+// LinkTo links the Indexer to the given engine (the index is rebuilt from its ledger state if it is not up to date).
+func (i *Indexer) LinkTo(engineInstance *engine.Engine) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if i.unlink != nil {
+		i.unlink()
 	}
 
-	i.addressOutputMappingStorage = objectstorage.NewStructStorage[AddressOutputMapping](
-		objectstorage.NewStoreWithRealm(i.options.store, database.PrefixIndexer, PrefixAddressOutputMappingStorage),
-		i.options.cacheTimeProvider.CacheTime(i.options.addressOutputMappingCacheTime),
-		objectstorage.LeakDetectionEnabled(false),
-		objectstorage.StoreOnCreation(true),
-		objectstorage.PartitionKey(devnetvm.AddressLength, utxo.OutputID{}.Length()),
-	)
+	i.engine = engineInstance
+	i.outputStorage = newOutputStorage(engineInstance.Storage.Indexer())
+	if i.optsTransactionHistory {
+		i.transactionHistory = newTransactionHistory(engineInstance.Storage.Indexer())
+	}
+	i.pendingOutputs = make(map[string]utxo.OutputIDs)
+	i.pendingAddresses = make(map[utxo.OutputID][]devnetvm.Address)
 
-	return i
+	onOutputCreated := event.NewClosure(i.onOutputCreated)
+	onOutputRejected := event.NewClosure(i.removePendingOutput)
+	onTransactionOrphaned := event.NewClosure(i.onTransactionOrphaned)
+	onTransactionAccepted := event.NewClosure(i.onTransactionAccepted)
+	onTransactionInclusionUpdated := event.NewClosure(i.onTransactionInclusionUpdated)
+
+	engineInstance.Ledger.Events.OutputCreated.Hook(onOutputCreated)
+	engineInstance.Ledger.Events.OutputRejected.Hook(onOutputRejected)
+	engineInstance.Ledger.Events.TransactionOrphaned.Hook(onTransactionOrphaned)
+	if i.transactionHistory != nil {
+		engineInstance.Ledger.Events.TransactionAccepted.Hook(onTransactionAccepted)
+		engineInstance.Ledger.Events.TransactionInclusionUpdated.Hook(onTransactionInclusionUpdated)
+	}
+	engineInstance.LedgerState.UnspentOutputs.Subscribe(i)
+	unsubscribeInitialized := engineInstance.LedgerState.UnspentOutputs.SubscribeInitialized(func() {
+		i.synchronize(engineInstance)
+	})
+
+	i.unlink = func() {
+		engineInstance.Ledger.Events.OutputCreated.Detach(onOutputCreated)
+		engineInstance.Ledger.Events.OutputRejected.Detach(onOutputRejected)
+		engineInstance.Ledger.Events.TransactionOrphaned.Detach(onTransactionOrphaned)
+		engineInstance.Ledger.Events.TransactionAccepted.Detach(onTransactionAccepted)
+		engineInstance.Ledger.Events.TransactionInclusionUpdated.Detach(onTransactionInclusionUpdated)
+		engineInstance.LedgerState.UnspentOutputs.Unsubscribe(i)
+		unsubscribeInitialized()
+	}
+
+	if engineInstance.LedgerState.UnspentOutputs.WasInitialized() {
+		go i.synchronize(engineInstance)
+	}
 }
 
-// IndexOutput stores the AddressOutputMapping dependent on which type of output it is.
-func (i *Indexer) IndexOutput(output devnetvm.Output) {
-	i.updateOutput(output, i.StoreAddressOutputMapping)
+// StreamUnspentOutputIDs streams the IDs of the unspent Outputs of the given address (the committed ones in their
+// storage order followed by the pending ones). Pending Outputs might already be spent by other pending transactions,
+// which needs to be checked in their metadata.
+func (i *Indexer) StreamUnspentOutputIDs(address devnetvm.Address, callback func(outputID utxo.OutputID) bool) (err error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	if i.outputStorage == nil {
+		return errors.New("indexer is not linked to an engine")
+	}
+
+	stopped := false
+	if err = i.outputStorage.streamUnspentOutputIDs(address, func(outputID utxo.OutputID) bool {
+		stopped = !callback(outputID)
+		return !stopped
+	}); err != nil || stopped {
+		return err
+	}
+
+	if pendingOutputIDs, exists := i.pendingOutputs[string(address.Bytes())]; exists {
+		for it := pendingOutputIDs.Iterator(); it.HasNext(); {
+			if !callback(it.Next()) {
+				break
+			}
+		}
+	}
+
+	return nil
 }
 
-// StoreAddressOutputMapping stores the address-output mapping.
-func (i *Indexer) StoreAddressOutputMapping(address devnetvm.Address, outputID utxo.OutputID) {
-	if result, stored := i.addressOutputMappingStorage.StoreIfAbsent(NewAddressOutputMapping(address, outputID)); stored {
-		result.Release()
+// StreamSpentOutputs streams the spent output history of the given address in the given (inclusive) epoch range
+// ordered by the epoch in which the Outputs were spent.
+func (i *Indexer) StreamSpentOutputs(address devnetvm.Address, startEpoch, endEpoch epoch.Index, callback func(spentOutput *SpentOutput) bool) (err error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	if i.outputStorage == nil {
+		return errors.New("indexer is not linked to an engine")
 	}
+
+	return i.outputStorage.streamSpentOutputs(address, startEpoch, endEpoch, callback)
 }
 
-// RemoveAddressOutputMapping removes the address-output mapping.
-func (i *Indexer) RemoveAddressOutputMapping(address devnetvm.Address, outputID utxo.OutputID) {
-	i.addressOutputMappingStorage.Delete(NewAddressOutputMapping(address, outputID).ObjectStorageKey())
+// SpentOutputs returns a page of the spent output history of the given address in the given (inclusive) epoch range
+// that skips the first offset elements and holds at most limit elements.
+func (i *Indexer) SpentOutputs(address devnetvm.Address, startEpoch, endEpoch epoch.Index, offset, limit int) (spentOutputs []*SpentOutput, hasMore bool, err error) {
+	spentOutputs = make([]*SpentOutput, 0)
+	err = i.StreamSpentOutputs(address, startEpoch, endEpoch, pageCollector(offset, limit, &spentOutputs, &hasMore))
+
+	return spentOutputs, hasMore, err
 }
 
-// CachedAddressOutputMappings retrieves all AddressOutputMappings for a particular address.
-func (i *Indexer) CachedAddressOutputMappings(address devnetvm.Address) (cachedAddressOutputMappings objectstorage.CachedObjects[*AddressOutputMapping]) {
-	i.addressOutputMappingStorage.ForEach(func(key []byte, cachedObject *objectstorage.CachedObject[*AddressOutputMapping]) bool {
-		cachedAddressOutputMappings = append(cachedAddressOutputMappings, cachedObject)
-		return true
-	}, objectstorage.WithIteratorPrefix(address.Bytes()))
+// StreamTransactions streams the transaction history of the given address in the given (inclusive) epoch range ordered
+// by the inclusion epoch of the transactions.
+func (i *Indexer) StreamTransactions(address devnetvm.Address, startEpoch, endEpoch epoch.Index, callback func(entry *TransactionHistoryEntry) bool) (err error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	if !i.optsTransactionHistory {
+		return ErrTransactionHistoryDisabled
+	} else if i.transactionHistory == nil {
+		return errors.New("indexer is not linked to an engine")
+	}
+
+	return i.transactionHistory.stream(address, startEpoch, endEpoch, callback)
+}
+
+// Transactions returns a page of the transaction history of the given address in the given (inclusive) epoch range
+// that skips the first offset elements and holds at most limit elements.
+func (i *Indexer) Transactions(address devnetvm.Address, startEpoch, endEpoch epoch.Index, offset, limit int) (entries []*TransactionHistoryEntry, hasMore bool, err error) {
+	entries = make([]*TransactionHistoryEntry, 0)
+	err = i.StreamTransactions(address, startEpoch, endEpoch, pageCollector(offset, limit, &entries, &hasMore))
+
+	return entries, hasMore, err
+}
+
+// Usage returns the size of the data of the index of the linked engine (by the names of its realms).
+func (i *Indexer) Usage() (usage map[string]int64, err error) {
+	usage = make(map[string]int64)
+
+	linkedOutputStorage := i.linkedOutputStorage()
+	if linkedOutputStorage == nil {
+		return usage, nil
+	}
+
+	prefixSizes, err := database.PrefixSizes(linkedOutputStorage.store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute the size of the index")
+	}
+	for prefix, size := range prefixSizes {
+		prefixName, exists := prefixNames[prefix]
+		if !exists {
+			prefixName = "other"
+		}
+		usage[prefixName] += size
+	}
 
-	return cachedAddressOutputMappings
+	return usage, nil
 }
 
-// Prune resets the database and deletes all entities.
-func (i *Indexer) Prune() (err error) {
-	for _, storagePrune := range []func() error{
-		i.addressOutputMappingStorage.Prune,
-	} {
-		if err = storagePrune(); err != nil {
-			return errors.WithMessagef(cerrors.ErrFatal, "failed to prune the object storage: %s", err.Error())
+// ApplyCreatedOutput adds the given Output to the committed unspent Outputs of its addresses and records the
+// transaction that created it in the transaction history (as part of the current batched state transition).
+func (i *Indexer) ApplyCreatedOutput(output *ledger.OutputWithMetadata) (err error) {
+	outputStorage := i.linkedOutputStorage()
+	if err = outputStorage.applyCreatedOutput(output); err != nil {
+		return errors.Wrapf(err, "failed to apply created output %s", output.ID())
+	}
+
+	if history := i.linkedTransactionHistory(); history != nil {
+		confirmationState := i.confirmationState(i.linkedEngine(), output.ID())
+		if err = outputStorage.write(func(target readWriter) error {
+			return history.record(target, output.ID().TransactionID, output.Index(), confirmationState, addressFlags(historyFlagCreated, output))
+		}); err != nil {
+			return errors.Wrapf(err, "failed to record transaction of created output %s", output.ID())
 		}
 	}
 
-	return
+	if !outputStorage.BatchedStateTransitionStarted() {
+		i.removePendingOutput(output.ID())
+	}
+
+	return nil
+}
+
+// ApplySpentOutput moves the given Output from the unspent Outputs of its addresses to their spent output history.
+func (i *Indexer) ApplySpentOutput(output *ledger.OutputWithMetadata) (err error) {
+	return errors.Wrapf(i.linkedOutputStorage().applySpentOutput(output), "failed to apply spent output %s", output.ID())
+}
+
+// RollbackCreatedOutput removes the given Output from the unspent Outputs of its addresses and the transaction that
+// created it from the transaction history.
+func (i *Indexer) RollbackCreatedOutput(output *ledger.OutputWithMetadata) (err error) {
+	outputStorage := i.linkedOutputStorage()
+	if err = outputStorage.rollbackCreatedOutput(output); err != nil {
+		return errors.Wrapf(err, "failed to roll back created output %s", output.ID())
+	}
+
+	if history := i.linkedTransactionHistory(); history != nil {
+		if err = outputStorage.write(func(target readWriter) error {
+			return history.delete(target, output.ID().TransactionID)
+		}); err != nil {
+			return errors.Wrapf(err, "failed to remove transaction of created output %s", output.ID())
+		}
+	}
+
+	return nil
+}
+
+// RollbackSpentOutput moves the given Output from the spent output history of its addresses back to their unspent
+// Outputs.
+func (i *Indexer) RollbackSpentOutput(output *ledger.OutputWithMetadata) (err error) {
+	return errors.Wrapf(i.linkedOutputStorage().rollbackSpentOutput(output), "failed to roll back spent output %s", output.ID())
+}
+
+// BeginBatchedStateTransition starts a batched state transition to the given epoch.
+func (i *Indexer) BeginBatchedStateTransition(targetEpoch epoch.Index) (currentEpoch epoch.Index, err error) {
+	return i.linkedOutputStorage().beginBatch(targetEpoch)
+}
+
+// CommitBatchedStateTransition commits the current batched state transition and removes the committed Outputs from the
+// pending ones.
+func (i *Indexer) CommitBatchedStateTransition() (ctx context.Context) {
+	ctx, done := context.WithCancel(context.Background())
+	go func() {
+		defer done()
+
+		i.mutex.Lock()
+		defer i.mutex.Unlock()
+
+		committedOutputIDs, err := i.outputStorage.commitBatch()
+		if err != nil {
+			i.engine.Events.Error.Trigger(errors.Wrap(err, "failed to commit batched state transition of indexer"))
+			return
+		}
+
+		for it := committedOutputIDs.Iterator(); it.HasNext(); {
+			i.removePendingOutputUnsafe(it.Next())
+		}
+	}()
+
+	return ctx
+}
+
+// linkedOutputStorage returns the outputStorage of the linked engine.
+func (i *Indexer) linkedOutputStorage() (linkedOutputStorage *outputStorage) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.outputStorage
+}
+
+// linkedEngine returns the engine that the Indexer is linked to.
+func (i *Indexer) linkedEngine() (linkedEngine *engine.Engine) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.engine
+}
+
+// linkedTransactionHistory returns the transactionHistory of the linked engine (nil if it is disabled).
+func (i *Indexer) linkedTransactionHistory() (linkedTransactionHistory *transactionHistory) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.transactionHistory
+}
+
+// synchronize makes sure that the index reflects the ledger state of the given engine once it was initialized.
+func (i *Indexer) synchronize(engineInstance *engine.Engine) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if i.engine != engineInstance {
+		return
+	}
+
+	if ledgerStateEpoch := engineInstance.LedgerState.UnspentOutputs.LastCommittedEpoch(); i.outputStorage.LastCommittedEpoch() == ledgerStateEpoch {
+		return
+	} else if i.outputStorage.imported {
+		i.outputStorage.SetLastCommittedEpoch(ledgerStateEpoch)
+		return
+	}
+
+	if err := i.rebuild(); err != nil {
+		engineInstance.Events.Error.Trigger(errors.Wrap(err, "failed to rebuild indexer"))
+	}
 }
 
-// Shutdown shuts down the KVStore used to persist data.
-func (i *Indexer) Shutdown() {
-	i.addressOutputMappingStorage.Shutdown()
+// rebuild rebuilds the index from the unspent outputs of the linked engine (i.e. the ledger state of the snapshot that
+// it was loaded from). The spent output history and the transaction history are lost in the process (the mutex needs to
+// be held by the caller).
+func (i *Indexer) rebuild() (err error) {
+	if i.engine == nil {
+		return errors.New("indexer is not linked to an engine")
+	}
+
+	if err = i.outputStorage.clear(); err != nil {
+		return errors.Wrap(err, "failed to clear indexer storage")
+	}
+
+	if i.transactionHistory != nil {
+		if err = i.transactionHistory.clear(); err != nil {
+			return errors.Wrap(err, "failed to clear transaction history")
+		}
+	}
+
+	unspentOutputs := i.engine.LedgerState.UnspentOutputs
+	if streamErr := unspentOutputs.IDs.Stream(func(outputID utxo.OutputID) bool {
+		if err = i.indexUnspentOutput(outputID); err == nil {
+			i.removePendingOutputUnsafe(outputID)
+		}
+
+		return err == nil
+	}); streamErr != nil {
+		return errors.Wrap(streamErr, "failed to stream unspent output IDs")
+	} else if err != nil {
+		return err
+	}
+
+	i.outputStorage.SetLastCommittedEpoch(unspentOutputs.LastCommittedEpoch())
+
+	return nil
 }
 
-// OnOutputCreated adds Transaction outputs to the indexer upon booking.
-func (i *Indexer) OnOutputCreated(outputID utxo.OutputID) {
-	i.ledgerFunc().Storage.CachedOutput(outputID).Consume(func(o utxo.Output) {
-		i.IndexOutput(o.(devnetvm.Output))
+// indexUnspentOutput loads the unspent Output with the given ID from the mempool storage and adds it to the index.
+func (i *Indexer) indexUnspentOutput(outputID utxo.OutputID) (err error) {
+	var outputWithMetadata *ledger.OutputWithMetadata
+	i.engine.Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
+		i.engine.Ledger.Storage.CachedOutputMetadata(outputID).Consume(func(metadata *ledger.OutputMetadata) {
+			outputWithMetadata = ledger.NewOutputWithMetadata(metadata.InclusionEpoch(), outputID, output, metadata.ConsensusManaPledgeID(), metadata.AccessManaPledgeID())
+		})
 	})
+
+	if outputWithMetadata == nil {
+		return errors.Errorf("failed to load unspent output %s", outputID)
+	}
+
+	if err = i.outputStorage.applyCreatedOutput(outputWithMetadata); err != nil || i.transactionHistory == nil {
+		return err
+	}
+
+	confirmationState := i.confirmationState(i.engine, outputID)
+
+	return i.outputStorage.write(func(target readWriter) error {
+		return i.transactionHistory.record(target, outputID.TransactionID, outputWithMetadata.Index(), confirmationState, addressFlags(historyFlagCreated, outputWithMetadata))
+	})
+}
+
+// confirmationState returns the confirmation state of the transaction that created the given Output. It falls back to
+// the confirmation state of the Output itself if the transaction is unknown (i.e. if the Output was loaded from a
+// snapshot) and to accepted if both are unknown, which is the least that applies to a committed Output.
+func (i *Indexer) confirmationState(engineInstance *engine.Engine, outputID utxo.OutputID) (confirmationState confirmation.State) {
+	confirmationState = confirmation.Accepted
+	if !engineInstance.Ledger.Storage.CachedTransactionMetadata(outputID.TransactionID).Consume(func(metadata *ledger.TransactionMetadata) {
+		confirmationState = metadata.ConfirmationState()
+	}) {
+		engineInstance.Ledger.Storage.CachedOutputMetadata(outputID).Consume(func(metadata *ledger.OutputMetadata) {
+			confirmationState = metadata.ConfirmationState()
+		})
+	}
+
+	return confirmationState
 }
 
-// OnOutputSpentRejected removes Transaction inputs from the indexer upon transaction acceptance.
-func (i *Indexer) OnOutputSpentRejected(outputID utxo.OutputID) {
-	i.ledgerFunc().Storage.CachedOutput(outputID).Consume(func(o utxo.Output) {
-		i.updateOutput(o.(devnetvm.Output), i.RemoveAddressOutputMapping)
+// onOutputCreated adds the Output that was booked by the mempool to the pending Outputs of its addresses.
+func (i *Indexer) onOutputCreated(outputID utxo.OutputID) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.engine.Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
+		devnetOutput, isDevnetOutput := output.(devnetvm.Output)
+		if !isDevnetOutput {
+			return
+		}
+
+		addresses := OutputAddresses(devnetOutput)
+		for _, address := range addresses {
+			pendingOutputIDs, exists := i.pendingOutputs[string(address.Bytes())]
+			if !exists {
+				pendingOutputIDs = utxo.NewOutputIDs()
+				i.pendingOutputs[string(address.Bytes())] = pendingOutputIDs
+			}
+
+			pendingOutputIDs.Add(outputID)
+		}
+
+		i.pendingAddresses[outputID] = addresses
 	})
 }
 
-// updateOutput applies the passed updateOperation to the provided output.
-func (i *Indexer) updateOutput(output devnetvm.Output, updateOperation func(address devnetvm.Address, outputID utxo.OutputID)) {
+// onTransactionOrphaned removes the Outputs of the orphaned transaction from the pending Outputs.
+func (i *Indexer) onTransactionOrphaned(event *ledger.TransactionEvent) {
+	for _, createdOutput := range event.CreatedOutputs {
+		i.removePendingOutput(createdOutput.ID())
+	}
+}
+
+// onTransactionAccepted adds the accepted transaction to the transaction history of the addresses of its inputs and
+// outputs (inside the current batched state transition if one was started).
+func (i *Indexer) onTransactionAccepted(event *ledger.TransactionEvent) {
+	history := i.linkedTransactionHistory()
+	if history == nil {
+		return
+	}
+
+	flags := addressFlags(historyFlagCreated, event.CreatedOutputs...)
+	for addressBytes, flag := range addressFlags(historyFlagSpent, event.SpentOutputs...) {
+		flags[addressBytes] |= flag
+	}
+
+	if err := i.linkedOutputStorage().write(func(target readWriter) error {
+		return history.record(target, event.Metadata.ID(), event.Metadata.InclusionEpoch(), confirmation.Accepted, flags)
+	}); err != nil {
+		i.engine.Events.Error.Trigger(errors.Wrapf(err, "failed to record accepted transaction %s", event.Metadata.ID()))
+	}
+}
+
+// onTransactionInclusionUpdated moves the history entries of the transaction to its new inclusion epoch (inside the
+// current batched state transition if one was started).
+func (i *Indexer) onTransactionInclusionUpdated(event *ledger.TransactionInclusionUpdatedEvent) {
+	history := i.linkedTransactionHistory()
+	if history == nil {
+		return
+	}
+
+	if err := i.linkedOutputStorage().write(func(target readWriter) error {
+		return history.move(target, event.TransactionID, event.InclusionEpoch)
+	}); err != nil {
+		i.engine.Events.Error.Trigger(errors.Wrapf(err, "failed to update inclusion epoch of transaction %s", event.TransactionID))
+	}
+}
+
+// removePendingOutput removes the Output with the given ID from the pending Outputs of its addresses.
+func (i *Indexer) removePendingOutput(outputID utxo.OutputID) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.removePendingOutputUnsafe(outputID)
+}
+
+// removePendingOutputUnsafe removes the Output with the given ID from the pending Outputs of its addresses (the mutex
+// needs to be held by the caller).
+func (i *Indexer) removePendingOutputUnsafe(outputID utxo.OutputID) {
+	addresses, exists := i.pendingAddresses[outputID]
+	if !exists {
+		return
+	}
+
+	for _, address := range addresses {
+		if pendingOutputIDs, pendingExists := i.pendingOutputs[string(address.Bytes())]; pendingExists {
+			if pendingOutputIDs.Delete(outputID); pendingOutputIDs.IsEmpty() {
+				delete(i.pendingOutputs, string(address.Bytes()))
+			}
+		}
+	}
+
+	delete(i.pendingAddresses, outputID)
+}
+
+// OutputAddresses returns the addresses that the given output is indexed by.
+func OutputAddresses(output devnetvm.Output) (addresses []devnetvm.Address) {
 	switch output.Type() {
 	case devnetvm.AliasOutputType:
 		castedOutput := output.(*devnetvm.AliasOutput)
 		// if it is an origin alias output, we don't have the AliasAddress from the parsed bytes.
 		// that happens in ledgerFunc output booking, so we calculate the alias address here
-		updateOperation(castedOutput.GetAliasAddress(), output.ID())
-		updateOperation(castedOutput.GetStateAddress(), output.ID())
+		addresses = append(addresses, castedOutput.GetAliasAddress(), castedOutput.GetStateAddress())
 		if !castedOutput.IsSelfGoverned() {
-			updateOperation(castedOutput.GetGoverningAddress(), output.ID())
+			addresses = append(addresses, castedOutput.GetGoverningAddress())
 		}
 	case devnetvm.ExtendedLockedOutputType:
 		castedOutput := output.(*devnetvm.ExtendedLockedOutput)
 		if castedOutput.FallbackAddress() != nil {
-			updateOperation(castedOutput.FallbackAddress(), output.ID())
+			addresses = append(addresses, castedOutput.FallbackAddress())
 		}
-		updateOperation(output.Address(), output.ID())
+		addresses = append(addresses, output.Address())
 	default:
-		updateOperation(output.Address(), output.ID())
+		addresses = append(addresses, output.Address())
+	}
+
+	return addresses
+}
+
+// pageCollector returns a stream callback that collects the elements of the page that skips the first offset elements
+// and holds at most limit elements.
+func pageCollector[T any](offset, limit int, elements *[]T, hasMore *bool) (callback func(element T) bool) {
+	skipped := 0
+	return func(element T) bool {
+		if skipped < offset {
+			skipped++
+			return true
+		}
+
+		if len(*elements) == limit {
+			*hasMore = true
+			return false
+		}
+
+		*elements = append(*elements, element)
+
+		return true
+	}
+}
+
+var _ ledgerstate.UnspentOutputsConsumer = &Indexer{}
+
+// ErrTransactionHistoryDisabled is returned when the transaction history is requested but was not enabled.
+var ErrTransactionHistoryDisabled = errors.New("transaction history is disabled")
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithTransactionHistory is an option for the Indexer that enables or disables the recording of the transaction history
+// of the addresses.
+func WithTransactionHistory(enabled bool) options.Option[Indexer] {
+	return func(i *Indexer) {
+		i.optsTransactionHistory = enabled
 	}
 }
 
@@ -131,8 +574,30 @@ func (i *Indexer) updateOutput(output devnetvm.Output, updateOperation func(addr
 // region db prefixes //////////////////////////////////////////////////////////////////////////////////////////////////
 
 const (
-	// PrefixAddressOutputMappingStorage defines the storage prefix for the AddressOutputMapping object storage.
-	PrefixAddressOutputMappingStorage byte = iota
+	// PrefixLastCommittedEpoch defines the storage prefix for the last committed epoch of the index.
+	PrefixLastCommittedEpoch byte = iota
+
+	// PrefixUnspentOutputs defines the storage prefix for the mappings from addresses to their unspent Outputs.
+	PrefixUnspentOutputs
+
+	// PrefixSpentOutputs defines the storage prefix for the spent output history of the addresses.
+	PrefixSpentOutputs
+
+	// PrefixTransactionHistory defines the storage prefix for the transaction history of the addresses.
+	PrefixTransactionHistory
+
+	// PrefixTransactionAddresses defines the storage prefix for the inclusion epoch and the addresses of the recorded
+	// transactions.
+	PrefixTransactionAddresses
 )
 
+// prefixNames contains the names of the realms of the index that are used to report its usage.
+var prefixNames = map[byte]string{
+	PrefixLastCommittedEpoch:   "lastCommittedEpoch",
+	PrefixUnspentOutputs:       "unspentOutputs",
+	PrefixSpentOutputs:         "spentOutputs",
+	PrefixTransactionHistory:   "transactionHistory",
+	PrefixTransactionAddresses: "transactionAddresses",
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,32 +1,47 @@
 package indexer
 
 import (
-	"github.com/iotaledger/hive.go/core/generics/model"
+	"github.com/iotaledger/hive.go/core/types/confirmation"
 
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
-	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
 )
 
-// region AddressOutputMapping /////////////////////////////////////////////////////////////////////////////////////////
+// region SpentOutput //////////////////////////////////////////////////////////////////////////////////////////////////
 
-// AddressOutputMapping is a mapping from an Address to an OutputID than enables lookups of stored Outputs.
-type AddressOutputMapping struct {
-	model.StorableReference[AddressOutputMapping, *AddressOutputMapping, devnetvm.Address, utxo.OutputID] `serix:"0"`
-}
+// SpentOutput is an entry of the spent output history of an address.
+type SpentOutput struct {
+	// OutputID contains the identifier of the spent Output.
+	OutputID utxo.OutputID
 
-// NewAddressOutputMapping creates a new AddressOutputMapping.
-func NewAddressOutputMapping(address devnetvm.Address, outputID utxo.OutputID) *AddressOutputMapping {
-	return model.NewStorableReference[AddressOutputMapping](address, outputID)
-}
+	// CreationEpoch contains the epoch in which the Output was created.
+	CreationEpoch epoch.Index
 
-// Address returns the Address of the AddressOutputMapping.
-func (a *AddressOutputMapping) Address() devnetvm.Address {
-	return a.SourceID()
+	// SpentEpoch contains the epoch in which the Output was spent.
+	SpentEpoch epoch.Index
 }
 
-// OutputID returns the OutputID of the AddressOutputMapping.
-func (a *AddressOutputMapping) OutputID() utxo.OutputID {
-	return a.TargetID()
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionHistoryEntry //////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionHistoryEntry is an entry of the transaction history of an address.
+type TransactionHistoryEntry struct {
+	// TransactionID contains the identifier of the Transaction.
+	TransactionID utxo.TransactionID
+
+	// InclusionEpoch contains the epoch in which the Transaction was included.
+	InclusionEpoch epoch.Index
+
+	// CreatedOutputs is true if the Transaction created Outputs of the address.
+	CreatedOutputs bool
+
+	// SpentOutputs is true if the Transaction spent Outputs of the address.
+	SpentOutputs bool
+
+	// ConfirmationState contains the confirmation state of the Transaction (it is Confirmed once its epoch was
+	// committed).
+	ConfirmationState confirmation.State
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
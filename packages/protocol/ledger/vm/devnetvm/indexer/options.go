@@ -1,86 +0,0 @@
-package indexer
-
-import (
-	"time"
-
-	"github.com/iotaledger/hive.go/core/kvstore"
-	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
-
-	"github.com/iotaledger/goshimmer/packages/core/database"
-)
-
-// region WithStore ////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// WithStore is an Option for the Ledger that allows to configure which KVStore is supposed to be used to persist data
-// (the default option is to use a MapDB).
-func WithStore(store kvstore.KVStore) (option Option) {
-	return func(options *options) {
-		options.store = store
-	}
-}
-
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// region WithCacheTimeProvider ////////////////////////////////////////////////////////////////////////////////////////
-
-// WithCacheTimeProvider is an Option for the Ledger that allows to configure which CacheTimeProvider is supposed to
-// be used.
-func WithCacheTimeProvider(cacheTimeProvider *database.CacheTimeProvider) (option Option) {
-	return func(options *options) {
-		options.cacheTimeProvider = cacheTimeProvider
-	}
-}
-
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// region WithAddressOutputMappingCacheTime ////////////////////////////////////////////////////////////////////////////
-
-// WithAddressOutputMappingCacheTime is an Option for the Ledger that allows to configure how long AddressOutputMapping
-// objects stay cached after they have been released.
-func WithAddressOutputMappingCacheTime(addressOutputMappingCacheTime time.Duration) (option Option) {
-	return func(options *options) {
-		options.addressOutputMappingCacheTime = addressOutputMappingCacheTime
-	}
-}
-
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// region options //////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// options is a container for all configurable parameters of the Indexer.
-type options struct {
-	// store contains the KVStore that is used to persist data.
-	store kvstore.KVStore
-
-	// cacheTimeProvider contains the cacheTimeProvider that overrides the local cache times.
-	cacheTimeProvider *database.CacheTimeProvider
-
-	// addressOutputMappingCacheTime contains the duration that AddressOutputMapping objects stay cached after they have
-	// been released.
-	addressOutputMappingCacheTime time.Duration
-}
-
-// newOptions returns a new options object that corresponds to the handed in options and which is derived from the
-// default options.
-func newOptions(option ...Option) *options {
-	return (&options{
-		store:                         mapdb.NewMapDB(),
-		cacheTimeProvider:             database.NewCacheTimeProvider(0),
-		addressOutputMappingCacheTime: 10 * time.Second,
-	}).apply(option...)
-}
-
-// apply modifies the options object by overriding the handed in options.
-func (o *options) apply(options ...Option) (self *options) {
-	for _, option := range options {
-		option(o)
-	}
-
-	return o
-}
-
-// Option represents the return type of optional parameters that can be handed into the constructor of the Ledger
-// to configure its behavior.
-type Option func(*options)
-
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
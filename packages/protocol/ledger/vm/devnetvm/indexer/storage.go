@@ -0,0 +1,329 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/iotaledger/hive.go/core/byteutils"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/traits"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+// region outputStorage ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// outputStorage persists the committed address->output mappings and the spent output history of the addresses.
+type outputStorage struct {
+	// store contains the KVStore that holds the mappings.
+	store kvstore.KVStore
+
+	// batch contains the mutations of the current batched state transition.
+	batch *batchWriter
+
+	// batchOutputIDs contains the IDs of the outputs that were changed by the current batched state transition.
+	batchOutputIDs utxo.OutputIDs
+
+	// imported is true if outputs were applied outside a batched state transition (i.e. by a snapshot import).
+	imported bool
+
+	// mutex is used to synchronize access to the batch.
+	mutex sync.Mutex
+
+	traits.BatchCommittable
+}
+
+// newOutputStorage returns a new outputStorage that persists its data in the given KVStore.
+func newOutputStorage(store kvstore.KVStore) (newOutputStorage *outputStorage) {
+	return &outputStorage{
+		store:            store,
+		BatchCommittable: traits.NewBatchCommittable(store, PrefixLastCommittedEpoch),
+	}
+}
+
+// beginBatch starts a batched state transition to the given epoch.
+func (o *outputStorage) beginBatch(newEpoch epoch.Index) (currentEpoch epoch.Index, err error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if currentEpoch, err = o.BeginBatchedStateTransition(newEpoch); err != nil {
+		return 0, errors.Wrap(err, "failed to begin batched state transition")
+	} else if currentEpoch == newEpoch {
+		return currentEpoch, nil
+	}
+
+	if o.batch, err = newBatchWriter(o.store); err != nil {
+		return 0, errors.Wrap(err, "failed to create batch")
+	}
+	o.batchOutputIDs = utxo.NewOutputIDs()
+
+	return currentEpoch, nil
+}
+
+// commitBatch writes the mutations of the current batched state transition and returns the IDs of the outputs that
+// were changed by it.
+func (o *outputStorage) commitBatch() (committedOutputIDs utxo.OutputIDs, err error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if err = o.batch.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit batch")
+	}
+
+	committedOutputIDs = o.batchOutputIDs
+	o.batch, o.batchOutputIDs = nil, nil
+
+	o.FinalizeBatchedStateTransition()
+
+	return committedOutputIDs, nil
+}
+
+// applyCreatedOutput adds the given output to the unspent outputs of its addresses.
+func (o *outputStorage) applyCreatedOutput(output *ledger.OutputWithMetadata) (err error) {
+	return o.update(output, func(target writer, address devnetvm.Address) error {
+		return target.Set(unspentOutputKey(address, output.ID()), output.Index().Bytes())
+	})
+}
+
+// applySpentOutput moves the given output from the unspent outputs of its addresses to their spent output history.
+func (o *outputStorage) applySpentOutput(output *ledger.OutputWithMetadata) (err error) {
+	return o.update(output, func(target writer, address devnetvm.Address) error {
+		if err := target.Delete(unspentOutputKey(address, output.ID())); err != nil {
+			return err
+		}
+
+		return target.Set(spentOutputKey(address, output.SpentInEpoch(), output.ID()), output.Index().Bytes())
+	})
+}
+
+// rollbackCreatedOutput removes the given output from the unspent outputs of its addresses.
+func (o *outputStorage) rollbackCreatedOutput(output *ledger.OutputWithMetadata) (err error) {
+	return o.update(output, func(target writer, address devnetvm.Address) error {
+		return target.Delete(unspentOutputKey(address, output.ID()))
+	})
+}
+
+// rollbackSpentOutput moves the given output from the spent output history of its addresses back to their unspent
+// outputs.
+func (o *outputStorage) rollbackSpentOutput(output *ledger.OutputWithMetadata) (err error) {
+	return o.update(output, func(target writer, address devnetvm.Address) error {
+		if err := target.Delete(spentOutputKey(address, output.SpentInEpoch(), output.ID())); err != nil {
+			return err
+		}
+
+		return target.Set(unspentOutputKey(address, output.ID()), output.Index().Bytes())
+	})
+}
+
+// clear removes all mappings from the storage.
+func (o *outputStorage) clear() (err error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.batch != nil {
+		return errors.New("cannot clear the storage during a batched state transition")
+	}
+
+	for _, prefix := range []byte{PrefixUnspentOutputs, PrefixSpentOutputs} {
+		if err = o.store.DeletePrefix([]byte{prefix}); err != nil {
+			return errors.Wrap(err, "failed to delete mappings")
+		}
+	}
+	o.imported = false
+
+	return nil
+}
+
+// streamUnspentOutputIDs streams the IDs of the committed unspent outputs of the given address.
+func (o *outputStorage) streamUnspentOutputIDs(address devnetvm.Address, callback func(outputID utxo.OutputID) bool) (err error) {
+	prefix := byteutils.ConcatBytes([]byte{PrefixUnspentOutputs}, address.Bytes())
+
+	if iterationErr := o.store.IterateKeys(prefix, func(key kvstore.Key) bool {
+		var outputID utxo.OutputID
+		if _, err = outputID.FromBytes(key[len(prefix):]); err != nil {
+			err = errors.Wrapf(err, "failed to parse output ID of address %s", address.Base58())
+			return false
+		}
+
+		return callback(outputID)
+	}, kvstore.IterDirectionForward); iterationErr != nil {
+		return errors.Wrapf(iterationErr, "failed to iterate unspent outputs of address %s", address.Base58())
+	}
+
+	return err
+}
+
+// streamSpentOutputs streams the spent output history of the given address in the given (inclusive) epoch range.
+func (o *outputStorage) streamSpentOutputs(address devnetvm.Address, startEpoch, endEpoch epoch.Index, callback func(spentOutput *SpentOutput) bool) (err error) {
+	prefix := byteutils.ConcatBytes([]byte{PrefixSpentOutputs}, address.Bytes())
+
+	if iterationErr := o.store.Iterate(prefix, func(key kvstore.Key, value kvstore.Value) bool {
+		spentOutput := &SpentOutput{SpentEpoch: epoch.Index(binary.BigEndian.Uint64(key[len(prefix):]))}
+		if spentOutput.SpentEpoch < startEpoch {
+			return true
+		} else if spentOutput.SpentEpoch > endEpoch {
+			return false
+		}
+
+		if _, err = spentOutput.OutputID.FromBytes(key[len(prefix)+epochKeyLength:]); err != nil {
+			err = errors.Wrapf(err, "failed to parse spent output ID of address %s", address.Base58())
+		} else if spentOutput.CreationEpoch, _, err = epoch.IndexFromBytes(value); err != nil {
+			err = errors.Wrapf(err, "failed to parse creation epoch of spent output %s", spentOutput.OutputID)
+		}
+
+		return err == nil && callback(spentOutput)
+	}, kvstore.IterDirectionForward); iterationErr != nil {
+		return errors.Wrapf(iterationErr, "failed to iterate spent outputs of address %s", address.Base58())
+	}
+
+	return err
+}
+
+// write applies the given operation to the current batch (or directly to the store if no batch was started), so that
+// the mutations of other storages are committed atomically with the ones of the outputs.
+func (o *outputStorage) write(operation func(target readWriter) error) (err error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.batch != nil {
+		return operation(o.batch)
+	}
+
+	return operation(o.store)
+}
+
+// update applies the given operation for all addresses of the output (inside the current batch if one was started).
+func (o *outputStorage) update(output *ledger.OutputWithMetadata, operation func(target writer, address devnetvm.Address) error) (err error) {
+	devnetOutput, isDevnetOutput := output.Output().(devnetvm.Output)
+	if !isDevnetOutput {
+		return nil
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	var target writer = o.store
+	if o.batch != nil {
+		target = o.batch
+		o.batchOutputIDs.Add(output.ID())
+	} else {
+		o.imported = true
+	}
+
+	for _, address := range OutputAddresses(devnetOutput) {
+		if err = operation(target, address); err != nil {
+			return errors.Wrapf(err, "failed to update output %s of address %s", output.ID(), address.Base58())
+		}
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region writer //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// writer is the subset of the write operations that is shared by a KVStore and a batch of mutations.
+type writer interface {
+	Set(key kvstore.Key, value kvstore.Value) error
+	Delete(key kvstore.Key) error
+}
+
+// readWriter is a writer that can also read the values that were written through it.
+type readWriter interface {
+	writer
+	Get(key kvstore.Key) (value kvstore.Value, err error)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region batchWriter //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// batchWriter collects the mutations of a batched state transition and keeps track of the written values, so that the
+// read-modify-write operations of the batched state transition see their own mutations before they are committed.
+type batchWriter struct {
+	kvstore.BatchedMutations
+
+	// store contains the KVStore that the batch is committed to.
+	store kvstore.KVStore
+
+	// values contains the values that were written by the batch (nil for deleted keys).
+	values map[string]kvstore.Value
+}
+
+// newBatchWriter returns a new batchWriter for the given KVStore.
+func newBatchWriter(store kvstore.KVStore) (newBatchWriter *batchWriter, err error) {
+	batch, err := store.Batched()
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchWriter{
+		BatchedMutations: batch,
+		store:            store,
+		values:           make(map[string]kvstore.Value),
+	}, nil
+}
+
+// Get returns the value of the given key as it is going to be after the batch was committed.
+func (b *batchWriter) Get(key kvstore.Key) (value kvstore.Value, err error) {
+	if value, exists := b.values[string(key)]; exists {
+		if value == nil {
+			return nil, kvstore.ErrKeyNotFound
+		}
+
+		return value, nil
+	}
+
+	return b.store.Get(key)
+}
+
+// Set adds the given value to the batch.
+func (b *batchWriter) Set(key kvstore.Key, value kvstore.Value) (err error) {
+	if err = b.BatchedMutations.Set(key, value); err == nil {
+		b.values[string(key)] = append(make(kvstore.Value, 0, len(value)), value...)
+	}
+
+	return err
+}
+
+// Delete adds the deletion of the given key to the batch.
+func (b *batchWriter) Delete(key kvstore.Key) (err error) {
+	if err = b.BatchedMutations.Delete(key); err == nil {
+		b.values[string(key)] = nil
+	}
+
+	return err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region keys /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// epochKeyLength is the length of the big endian encoded epochs in the keys of the histories.
+const epochKeyLength = 8
+
+// unspentOutputKey returns the key of the mapping from the given address to the given unspent output.
+func unspentOutputKey(address devnetvm.Address, outputID utxo.OutputID) (key []byte) {
+	return byteutils.ConcatBytes([]byte{PrefixUnspentOutputs}, address.Bytes(), lo.PanicOnErr(outputID.Bytes()))
+}
+
+// spentOutputKey returns the key of the spent output history entry of the given address and output.
+func spentOutputKey(address devnetvm.Address, spentEpoch epoch.Index, outputID utxo.OutputID) (key []byte) {
+	return byteutils.ConcatBytes([]byte{PrefixSpentOutputs}, address.Bytes(), epochKey(spentEpoch), lo.PanicOnErr(outputID.Bytes()))
+}
+
+// epochKey returns the big endian encoding of the given epoch that keeps the keys that contain it ordered by epoch.
+func epochKey(index epoch.Index) (key []byte) {
+	key = make([]byte, epochKeyLength)
+	binary.BigEndian.PutUint64(key, uint64(index))
+
+	return key
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,147 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func TestOutputStorage(t *testing.T) {
+	storage := newOutputStorage(mapdb.NewMapDB())
+	address := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	output1 := newTestOutput(t, address, 1)
+	output2 := newTestOutput(t, address, 1)
+	output3 := newTestOutput(t, address, 2)
+
+	// import the initial ledger state
+	require.NoError(t, storage.applyCreatedOutput(output1))
+	require.NoError(t, storage.applyCreatedOutput(output2))
+	assert.True(t, storage.imported)
+	storage.SetLastCommittedEpoch(1)
+	assert.ElementsMatch(t, []utxo.OutputID{output1.ID(), output2.ID()}, unspentOutputIDs(t, storage, address))
+
+	// epoch 2 spends output1 and creates output3
+	applyBatch(t, storage, 2, func() {
+		output1.SetSpentInEpoch(2)
+		require.NoError(t, storage.applyCreatedOutput(output3))
+		require.NoError(t, storage.applySpentOutput(output1))
+
+		// the changes are only visible after the batch was committed
+		assert.ElementsMatch(t, []utxo.OutputID{output1.ID(), output2.ID()}, unspentOutputIDs(t, storage, address))
+	}, output1.ID(), output3.ID())
+	assert.ElementsMatch(t, []utxo.OutputID{output2.ID(), output3.ID()}, unspentOutputIDs(t, storage, address))
+	assert.Equal(t, []*SpentOutput{{OutputID: output1.ID(), CreationEpoch: 1, SpentEpoch: 2}}, spentOutputs(t, storage, address, 0, 2))
+
+	// epoch 3 spends output2
+	applyBatch(t, storage, 3, func() {
+		output2.SetSpentInEpoch(3)
+		require.NoError(t, storage.applySpentOutput(output2))
+	}, output2.ID())
+	assert.Equal(t, []utxo.OutputID{output3.ID()}, unspentOutputIDs(t, storage, address))
+	assert.Equal(t, []*SpentOutput{{OutputID: output1.ID(), CreationEpoch: 1, SpentEpoch: 2}}, spentOutputs(t, storage, address, 0, 2))
+	assert.Equal(t, []*SpentOutput{{OutputID: output2.ID(), CreationEpoch: 1, SpentEpoch: 3}}, spentOutputs(t, storage, address, 3, 3))
+	assert.Len(t, spentOutputs(t, storage, address, 0, 3), 2)
+
+	// roll back epoch 3 and 2
+	applyBatch(t, storage, 2, func() {
+		require.NoError(t, storage.rollbackSpentOutput(output2))
+	}, output2.ID())
+	applyBatch(t, storage, 1, func() {
+		require.NoError(t, storage.rollbackSpentOutput(output1))
+		require.NoError(t, storage.rollbackCreatedOutput(output3))
+	}, output1.ID(), output3.ID())
+	assert.ElementsMatch(t, []utxo.OutputID{output1.ID(), output2.ID()}, unspentOutputIDs(t, storage, address))
+	assert.Empty(t, spentOutputs(t, storage, address, 0, 3))
+	assert.Equal(t, epoch.Index(1), storage.LastCommittedEpoch())
+
+	// clearing the storage removes all mappings
+	require.NoError(t, storage.clear())
+	assert.Empty(t, unspentOutputIDs(t, storage, address))
+	assert.False(t, storage.imported)
+}
+
+func TestIndexer_Usage(t *testing.T) {
+	indexer := New()
+
+	// an indexer that is not linked to an engine has no data
+	usage, err := indexer.Usage()
+	require.NoError(t, err)
+	assert.Empty(t, usage)
+
+	indexer.outputStorage = newOutputStorage(mapdb.NewMapDB())
+	require.NoError(t, indexer.outputStorage.applyCreatedOutput(newTestOutput(t, devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey), 1)))
+
+	usage, err = indexer.Usage()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unspentOutputs"}, lo.Keys(usage))
+	assert.Positive(t, usage["unspentOutputs"])
+}
+
+func TestPageCollector(t *testing.T) {
+	var elements []int
+	var hasMore bool
+
+	collect := pageCollector(2, 3, &elements, &hasMore)
+	for i := 0; i < 10 && collect(i); i++ {
+	}
+	assert.Equal(t, []int{2, 3, 4}, elements)
+	assert.True(t, hasMore)
+
+	elements, hasMore = nil, false
+	collect = pageCollector(8, 3, &elements, &hasMore)
+	for i := 0; i < 10 && collect(i); i++ {
+	}
+	assert.Equal(t, []int{8, 9}, elements)
+	assert.False(t, hasMore)
+}
+
+func newTestOutput(t *testing.T, address devnetvm.Address, creationEpoch epoch.Index) *ledger.OutputWithMetadata {
+	var outputID utxo.OutputID
+	require.NoError(t, outputID.FromRandomness())
+
+	output := devnetvm.NewSigLockedSingleOutput(100, address)
+	output.SetID(outputID)
+
+	return ledger.NewOutputWithMetadata(creationEpoch, outputID, output, identity.ID{}, identity.ID{})
+}
+
+func applyBatch(t *testing.T, storage *outputStorage, targetEpoch epoch.Index, apply func(), expectedOutputIDs ...utxo.OutputID) {
+	_, err := storage.beginBatch(targetEpoch)
+	require.NoError(t, err)
+
+	apply()
+
+	committedOutputIDs, err := storage.commitBatch()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expectedOutputIDs, committedOutputIDs.Slice())
+	assert.Equal(t, targetEpoch, storage.LastCommittedEpoch())
+}
+
+func unspentOutputIDs(t *testing.T, storage *outputStorage, address devnetvm.Address) (outputIDs []utxo.OutputID) {
+	require.NoError(t, storage.streamUnspentOutputIDs(address, func(outputID utxo.OutputID) bool {
+		outputIDs = append(outputIDs, outputID)
+		return true
+	}))
+
+	return outputIDs
+}
+
+func spentOutputs(t *testing.T, storage *outputStorage, address devnetvm.Address, startEpoch, endEpoch epoch.Index) (spentOutputs []*SpentOutput) {
+	require.NoError(t, storage.streamSpentOutputs(address, startEpoch, endEpoch, func(spentOutput *SpentOutput) bool {
+		spentOutputs = append(spentOutputs, spentOutput)
+		return true
+	}))
+
+	return spentOutputs
+}
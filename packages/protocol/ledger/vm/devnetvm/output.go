@@ -3,6 +3,7 @@ package devnetvm
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"sort"
 	"strconv"
@@ -365,7 +366,7 @@ func (s *SigLockedSingleOutput) Balances() *ColoredBalances {
 // UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
 func (s *SigLockedSingleOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inputs []Output) (unlockValid bool, err error) {
 	switch blk := unlockBlock.(type) {
-	case *SignatureUnlockBlock:
+	case signatureUnlockBlock:
 		// unlocking by signature
 		txBytes, bytesErr := tx.Essence().Bytes()
 		if bytesErr != nil {
@@ -464,7 +465,7 @@ func (s *SigLockedColoredOutput) Balances() *ColoredBalances {
 // UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
 func (s *SigLockedColoredOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inputs []Output) (unlockValid bool, err error) {
 	switch blk := unlockBlock.(type) {
-	case *SignatureUnlockBlock:
+	case signatureUnlockBlock:
 		txBytes, bytesErr := tx.Essence().Bytes()
 		if bytesErr != nil {
 			return false, errors.Wrap(bytesErr, "could not get essence bytes")
@@ -1160,7 +1161,7 @@ func (a *AliasOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inpu
 		return false, err
 	}
 	switch blk := unlockBlock.(type) {
-	case *SignatureUnlockBlock:
+	case signatureUnlockBlock:
 		// check signatures and validate transition
 		if chained != nil {
 			// chained output is present
@@ -1559,7 +1560,9 @@ var _ Output = new(AliasOutput)
 // - fallback address and fallback timeout
 // - can be unlocked by AliasUnlockBlock (if address is of AliasAddress type)
 // - can be time locked until deadline
-// - data payload for arbitrary metadata (size limits apply).
+// - data payload for arbitrary metadata (size limits apply)
+// - can be hash locked, so that the address has to reveal the preimage of the hash lock to unlock it before the
+// fallback deadline (hash-time-locked contract for atomic swaps).
 type ExtendedLockedOutput struct {
 	id       utxo.OutputID
 	idMutex  sync.RWMutex
@@ -1577,6 +1580,9 @@ type ExtendedLockedOutput struct {
 	// any attached data (subject to size limits)
 	payload []byte
 
+	// SHA-256 hash of the preimage that needs to be revealed to unlock the output. If zero, hash lock not set
+	hashLock [HashLockSize]byte
+
 	objectstorage.StorableObjectFlags
 }
 
@@ -1584,8 +1590,29 @@ const (
 	flagExtendedLockedOutputFallbackPresent = uint(iota)
 	flagExtendedLockedOutputTimeLockPresent
 	flagExtendedLockedOutputPayloadPresent
+	flagExtendedLockedOutputHashLockPresent
+)
+
+const (
+	// HashLockSize defines the length of the hash lock of an ExtendedLockedOutput (SHA-256).
+	HashLockSize = sha256.Size
+
+	// PreimageSize defines the length of the preimage that unlocks a hash lock.
+	PreimageSize = 32
 )
 
+// NewHashLock returns the hash lock that is unlocked by revealing the given preimage.
+func NewHashLock(preimage [PreimageSize]byte) [HashLockSize]byte {
+	return sha256.Sum256(preimage[:])
+}
+
+// HashLockableAddress returns true if outputs that are sent to the given address can be hash locked. The preimage is
+// revealed in a PreimageUnlockBlock, which only carries a single signature, so the address needs to be unlockable by
+// one (i.e. threshold and alias addresses can not be the recipient of a hash locked output).
+func HashLockableAddress(address Address) bool {
+	return address.Type() == ED25519AddressType || address.Type() == BLSAddressType
+}
+
 // NewExtendedLockedOutput is the constructor for a ExtendedLockedOutput.
 func NewExtendedLockedOutput(balances map[Color]uint64, address Address) *ExtendedLockedOutput {
 	return &ExtendedLockedOutput{
@@ -1611,6 +1638,13 @@ func (o *ExtendedLockedOutput) WithTimeLock(timelock time.Time) *ExtendedLockedO
 	return o
 }
 
+// WithHashLock adds a hash lock to the output and returns the updated version. Until the fallback deadline, the output
+// can only be unlocked by revealing the preimage of the hash lock in a PreimageUnlockBlock.
+func (o *ExtendedLockedOutput) WithHashLock(hashLock [HashLockSize]byte) *ExtendedLockedOutput {
+	o.hashLock = hashLock
+	return o
+}
+
 // SetPayload sets the payload field of the output.
 func (o *ExtendedLockedOutput) SetPayload(data []byte) error {
 	if len(data) > MaxOutputPayloadSize {
@@ -1647,6 +1681,9 @@ func (o *ExtendedLockedOutput) Encode() ([]byte, error) {
 		ret.WriteUint16(uint16(len(o.payload))).
 			WriteBytes(o.payload)
 	}
+	if flags.HasBit(flagExtendedLockedOutputHashLockPresent) {
+		ret.WriteBytes(o.hashLock[:])
+	}
 	return ret.Bytes(), nil
 }
 
@@ -1756,6 +1793,19 @@ func (o *ExtendedLockedOutput) fromMarshalUtil(marshalUtil *marshalutil.MarshalU
 			return
 		}
 	}
+	if flags.HasBit(flagExtendedLockedOutputHashLockPresent) {
+		var hashLockBytes []byte
+		if hashLockBytes, err = marshalUtil.ReadBytes(HashLockSize); err != nil {
+			err = errors.WithMessagef(cerrors.ErrParseBytesFailed, "failed to parse hash lock: %s", err.Error())
+			return
+		}
+		copy(output.hashLock[:], hashLockBytes)
+
+		if !HashLockableAddress(output.address) {
+			err = errors.WithMessagef(cerrors.ErrParseBytesFailed, "outputs that are sent to %s addresses can not be hash locked", output.address.Type())
+			return
+		}
+	}
 	return output, nil
 }
 
@@ -1771,6 +1821,9 @@ func (o *ExtendedLockedOutput) compressFlags() bitmask.BitMask {
 	if len(o.payload) > 0 {
 		ret = ret.SetBit(flagExtendedLockedOutputPayloadPresent)
 	}
+	if o.HashLocked() {
+		ret = ret.SetBit(flagExtendedLockedOutputHashLockPresent)
+	}
 	return ret
 }
 
@@ -1810,8 +1863,19 @@ func (o *ExtendedLockedOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBl
 	}
 	addr := o.UnlockAddressNow(tx.Essence().Timestamp())
 
+	if o.HashLockedNow(tx.Essence().Timestamp()) {
+		// unlocking before the fallback deadline requires the preimage of the hash lock
+		preimageUnlockBlock, isPreimageUnlockBlock := unlockBlock.(*PreimageUnlockBlock)
+		if !isPreimageUnlockBlock {
+			return false, errors.New("extendedLockedOutput: hash locked output can only be unlocked by revealing the preimage")
+		}
+		if NewHashLock(preimageUnlockBlock.Preimage()) != o.hashLock {
+			return false, errors.New("extendedLockedOutput: preimage does not match the hash lock")
+		}
+	}
+
 	switch blk := unlockBlock.(type) {
-	case *SignatureUnlockBlock:
+	case signatureUnlockBlock:
 		txBytes, txBytesErr := tx.Essence().Bytes()
 		if txBytesErr != nil {
 			return false, errors.Wrap(txBytesErr, "could not get essence bytes")
@@ -1883,6 +1947,7 @@ func (o *ExtendedLockedOutput) Clone() Output {
 		ret.payload = make([]byte, len(o.payload))
 		copy(ret.payload, o.payload)
 	}
+	ret.hashLock = o.hashLock
 	return ret
 }
 
@@ -1896,7 +1961,8 @@ func (o *ExtendedLockedOutput) UpdateMintingColor() Output {
 	}
 	updatedOutput := NewExtendedLockedOutput(coloredBalances, o.Address()).
 		WithFallbackOptions(o.fallbackAddress, o.fallbackDeadline).
-		WithTimeLock(o.timelock)
+		WithTimeLock(o.timelock).
+		WithHashLock(o.hashLock)
 	if err := updatedOutput.SetPayload(o.payload); err != nil {
 		panic(errors.Errorf("UpdateMintingColor: %v", err))
 	}
@@ -1920,6 +1986,7 @@ func (o *ExtendedLockedOutput) String() string {
 		stringify.NewStructField("fallbackAddress", o.fallbackAddress),
 		stringify.NewStructField("fallbackDeadline", o.fallbackDeadline),
 		stringify.NewStructField("timelock", o.timelock),
+		stringify.NewStructField("hashLock", o.hashLock),
 	)
 }
 
@@ -1938,6 +2005,22 @@ func (o *ExtendedLockedOutput) TimeLockedNow(nowis time.Time) bool {
 	return o.TimeLock().After(nowis)
 }
 
+// HashLock returns the hash lock of the output (it is zero if the output is not hash locked).
+func (o *ExtendedLockedOutput) HashLock() [HashLockSize]byte {
+	return o.hashLock
+}
+
+// HashLocked returns true if the output has a hash lock.
+func (o *ExtendedLockedOutput) HashLocked() bool {
+	return o.hashLock != [HashLockSize]byte{}
+}
+
+// HashLockedNow checks if the preimage of the hash lock needs to be revealed to unlock the output at the specific
+// moment (which is the case until the fallback deadline).
+func (o *ExtendedLockedOutput) HashLockedNow(nowis time.Time) bool {
+	return o.HashLocked() && (o.fallbackAddress == nil || !nowis.After(o.fallbackDeadline))
+}
+
 // FallbackOptions returns fallback options of the output. The address is nil if fallback options are not set.
 func (o *ExtendedLockedOutput) FallbackOptions() (Address, time.Time) {
 	return o.fallbackAddress, o.fallbackDeadline
@@ -2108,6 +2108,37 @@ func TestExtendedOutputFromMarshalUtil(t *testing.T) {
 		_, err := OutputFromBytes(outputBytes)
 		assert.NoError(t, err)
 	})
+
+	t.Run("CASE: Hash lock present", func(t *testing.T) {
+		output := dummyExtendedLockedOutput().WithHashLock(NewHashLock([PreimageSize]byte{1, 2, 3}))
+		outputBytes := lo.PanicOnErr(output.Bytes())
+		restored := new(ExtendedLockedOutput)
+		err := restored.FromBytes(outputBytes)
+		assert.NoError(t, err)
+		assert.Equal(t, output.HashLock(), restored.HashLock())
+		assert.Equal(t, outputBytes, lo.PanicOnErr(restored.Bytes()))
+	})
+
+	t.Run("CASE: Hash lock with threshold address recipient", func(t *testing.T) {
+		thresholdAddress, err := NewThresholdAddress(1, []ed25519.PublicKey{ed25519.GenerateKeyPair().PublicKey})
+		require.NoError(t, err)
+
+		output := dummyExtendedLockedOutput().WithHashLock(NewHashLock([PreimageSize]byte{1, 2, 3}))
+		output.address = thresholdAddress
+		_, err = OutputFromBytes(lo.PanicOnErr(output.Bytes()))
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Hash-lock flag provided, missing data", func(t *testing.T) {
+		output := dummyExtendedLockedOutput()
+		outputBytes := lo.PanicOnErr(output.Bytes())
+		flags := output.compressFlags()
+		flags = flags.SetBit(flagExtendedLockedOutputHashLockPresent)
+		outputBytes[1+len(output.balances.Bytes())+AddressLength] = byte(flags)
+		_, err := OutputFromBytes(outputBytes)
+		t.Log(err)
+		assert.Error(t, err)
+	})
 }
 
 func TestExtendedLockedOutput_UnlockValid(t *testing.T) {
@@ -2306,6 +2337,71 @@ func TestExtendedLockedOutput_UnlockValid(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, valid)
 	})
+
+	t.Run("CASE: Hash lock present", func(t *testing.T) {
+		destWallet := genRandomWallet()
+		myWallet := genRandomWallet()
+		nowIs := time.Now()
+		preimage := [PreimageSize]byte{1, 2, 3}
+		// until now is +30 minutes, only destWallet can spend it by revealing the preimage, after that, only myWallet
+		input := NewExtendedLockedOutput(map[Color]uint64{ColorIOTA: 1}, destWallet.address).
+			WithFallbackOptions(myWallet.address, nowIs.Add(30*time.Minute)).
+			WithHashLock(NewHashLock(preimage))
+		input.SetID(randOutputID())
+		output := NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 1}), randEd25119Address())
+
+		// t =< now is + 30 minutes, destWallet can spend it with the preimage
+		essence := NewTransactionEssence(0, nowIs, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		var unlockBlock UnlockBlock = NewPreimageUnlockBlock(destWallet.sign(essence), preimage)
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		// t =< now is + 30 minutes, destWallet can't spend it with a wrong preimage
+		unlockBlock = NewPreimageUnlockBlock(destWallet.sign(essence), [PreimageSize]byte{3, 2, 1})
+		tx = NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err = input.UnlockValid(tx, unlockBlock, Outputs{input})
+		t.Log(err)
+		assert.Error(t, err)
+		assert.False(t, valid)
+
+		// t =< now is + 30 minutes, destWallet can't spend it without the preimage
+		unlockBlock = NewSignatureUnlockBlock(destWallet.sign(essence))
+		tx = NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err = input.UnlockValid(tx, unlockBlock, Outputs{input})
+		t.Log(err)
+		assert.Error(t, err)
+		assert.False(t, valid)
+
+		// t =< now is + 30 minutes, myWallet can't spend it even with the preimage
+		unlockBlock = NewPreimageUnlockBlock(myWallet.sign(essence), preimage)
+		tx = NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err = input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.False(t, valid)
+
+		// t > now is + 30 minutes, destWallet can't spend it even with the preimage
+		essence = NewTransactionEssence(0, nowIs.Add(30*time.Minute).Add(time.Nanosecond), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock = NewPreimageUnlockBlock(destWallet.sign(essence), preimage)
+		tx = NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err = input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.False(t, valid)
+
+		// t > now is + 30 minutes, myWallet can spend it without the preimage
+		unlockBlock = NewSignatureUnlockBlock(myWallet.sign(essence))
+		tx = NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err = input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
 }
 
 func TestExtendedLockedOutput_Clone(t *testing.T) {
@@ -85,7 +85,7 @@ func validateTransaction(_ context.Context, tx Transaction) (err error) {
 	maxReferencedUnlockIndex := len(tx.Essence().Inputs()) - 1
 	for i, unlockBlock := range tx.UnlockBlocks() {
 		switch unlockBlock.Type() {
-		case SignatureUnlockBlockType:
+		case SignatureUnlockBlockType, ThresholdSignatureUnlockBlockType, PreimageUnlockBlockType:
 			continue
 		case ReferenceUnlockBlockType:
 			if unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex() > uint16(maxReferencedUnlockIndex) {
@@ -114,6 +114,88 @@ func TestTransaction_Complex(t *testing.T) {
 	// require.True(t, utxoDAG.TransactionValid(transaction))
 }
 
+func TestUnlockBlocksErrors(t *testing.T) {
+	signer := genRandomWallet()
+	stranger := genRandomWallet()
+
+	outputsByID := make(OutputsByID)
+	for _, output := range []Output{
+		NewSigLockedSingleOutput(10, signer.address),
+		NewSigLockedSingleOutput(20, signer.address),
+		NewSigLockedSingleOutput(30, stranger.address),
+	} {
+		output.SetID(randOutputID())
+		outputsByID[output.ID()] = output
+	}
+
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		outputsByID.Inputs(),
+		NewOutputs(NewSigLockedSingleOutput(60, signer.address)),
+	)
+
+	// the inputs are sorted by the essence, so we resolve them in the same order
+	inputs := make(Outputs, 0, len(essence.Inputs()))
+	strangerIndex := -1
+	for i, input := range essence.Inputs() {
+		inputs = append(inputs, outputsByID[input.(*UTXOInput).ReferencedOutputID()])
+		if inputs[i].Address().Equals(stranger.address) {
+			strangerIndex = i
+		}
+	}
+
+	// unlockBlocks signs the first input of the signer and references it from all the other inputs of the signer
+	unlockBlocks := func(strangerUnlockBlock func(signerIndex uint16) UnlockBlock) (unlockBlocks UnlockBlocks) {
+		signerIndex := -1
+		for i := range inputs {
+			switch {
+			case i == strangerIndex:
+				unlockBlocks = append(unlockBlocks, nil)
+			case signerIndex == -1:
+				signerIndex = i
+				unlockBlocks = append(unlockBlocks, NewSignatureUnlockBlock(signer.sign(essence)))
+			default:
+				unlockBlocks = append(unlockBlocks, NewReferenceUnlockBlock(uint16(signerIndex)))
+			}
+		}
+		unlockBlocks[strangerIndex] = strangerUnlockBlock(uint16(signerIndex))
+
+		return unlockBlocks
+	}
+
+	t.Run("CASE: Some unlock blocks invalid", func(t *testing.T) {
+		tx := NewTransaction(essence, unlockBlocks(func(signerIndex uint16) UnlockBlock {
+			return NewReferenceUnlockBlock(signerIndex)
+		}))
+
+		unlockErrors, err := UnlockBlocksErrors(inputs, tx)
+		require.NoError(t, err)
+		require.Len(t, unlockErrors, 1)
+		require.Error(t, unlockErrors[strangerIndex])
+		t.Log(unlockErrors[strangerIndex])
+	})
+
+	t.Run("CASE: All unlock blocks valid", func(t *testing.T) {
+		tx := NewTransaction(essence, unlockBlocks(func(uint16) UnlockBlock {
+			return NewSignatureUnlockBlock(stranger.sign(essence))
+		}))
+
+		unlockErrors, err := UnlockBlocksErrors(inputs, tx)
+		require.NoError(t, err)
+		require.Empty(t, unlockErrors)
+	})
+
+	t.Run("CASE: Unlock blocks semantically invalid", func(t *testing.T) {
+		tx := NewTransaction(essence, UnlockBlocks{
+			NewReferenceUnlockBlock(1),
+			NewReferenceUnlockBlock(0),
+			NewReferenceUnlockBlock(0),
+		})
+
+		_, err := UnlockBlocksErrors(inputs, tx)
+		require.Error(t, err)
+	})
+}
+
 // setupKeyChainAndAddresses generates keys and addresses that are used by the test case.
 func setupKeyChainAndAddresses(t *testing.T) (keyChain map[Address]ed25519.KeyPair, sourceAddr Address, destAddr Address, remainderAddr Address) {
 	keyChain = make(map[Address]ed25519.KeyPair)
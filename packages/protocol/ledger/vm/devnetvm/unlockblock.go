@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
 	"github.com/iotaledger/hive.go/core/generics/model"
 	"github.com/iotaledger/hive.go/core/serix"
 	"github.com/iotaledger/hive.go/core/stringify"
@@ -23,6 +24,14 @@ func init() {
 	if err != nil {
 		panic(errors.Wrap(err, "error registering SignatureUnlockBlock type settings"))
 	}
+	err = serix.DefaultAPI.RegisterTypeSettings(ThresholdSignatureUnlockBlock{}, serix.TypeSettings{}.WithObjectType(uint8(new(ThresholdSignatureUnlockBlock).Type())))
+	if err != nil {
+		panic(errors.Wrap(err, "error registering ThresholdSignatureUnlockBlock type settings"))
+	}
+	err = serix.DefaultAPI.RegisterTypeSettings(PreimageUnlockBlock{}, serix.TypeSettings{}.WithObjectType(uint8(new(PreimageUnlockBlock).Type())))
+	if err != nil {
+		panic(errors.Wrap(err, "error registering PreimageUnlockBlock type settings"))
+	}
 	err = serix.DefaultAPI.RegisterTypeSettings(UnlockBlocks{}, serix.TypeSettings{}.WithLengthPrefixType(serix.LengthPrefixTypeAsUint16).WithArrayRules(&serix.ArrayRules{
 		// TODO: Avoid failing on duplicated unlock blocks. They seem to have been wrongly generated in the old snapshot.
 		// ValidationMode: serializer.ArrayValidationModeNoDuplicates,
@@ -30,7 +39,7 @@ func init() {
 	if err != nil {
 		panic(errors.Wrap(err, "error registering SignatureUnlockBlock type settings"))
 	}
-	err = serix.DefaultAPI.RegisterInterfaceObjects((*UnlockBlock)(nil), new(AliasUnlockBlock), new(ReferenceUnlockBlock), new(SignatureUnlockBlock))
+	err = serix.DefaultAPI.RegisterInterfaceObjects((*UnlockBlock)(nil), new(AliasUnlockBlock), new(ReferenceUnlockBlock), new(SignatureUnlockBlock), new(ThresholdSignatureUnlockBlock), new(PreimageUnlockBlock))
 	if err != nil {
 		panic(errors.Wrap(err, "error registering UnlockBlock interface implementations"))
 	}
@@ -46,6 +55,12 @@ const (
 
 	// AliasUnlockBlockType represents the type of a AliasUnlockBlock.
 	AliasUnlockBlockType
+
+	// ThresholdSignatureUnlockBlockType represents the type of a ThresholdSignatureUnlockBlock.
+	ThresholdSignatureUnlockBlockType
+
+	// PreimageUnlockBlockType represents the type of a PreimageUnlockBlock.
+	PreimageUnlockBlockType
 )
 
 // UnlockBlockType represents the type of the UnlockBlock. Different types of UnlockBlocks can unlock different types of
@@ -58,6 +73,8 @@ func (a UnlockBlockType) String() string {
 		"SignatureUnlockBlockType",
 		"ReferenceUnlockBlockType",
 		"AliasUnlockBlockType",
+		"ThresholdSignatureUnlockBlockType",
+		"PreimageUnlockBlockType",
 	}[a]
 }
 
@@ -78,6 +95,14 @@ type UnlockBlock interface {
 	String() string
 }
 
+// signatureUnlockBlock is implemented by the UnlockBlocks that unlock an Output by providing signatures for its Address.
+type signatureUnlockBlock interface {
+	UnlockBlock
+
+	// AddressSignatureValid returns true if the UnlockBlock correctly signs the given Address.
+	AddressSignatureValid(address Address, signedData []byte) bool
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region UnlockBlocks /////////////////////////////////////////////////////////////////////////////////////////////////
@@ -151,7 +176,7 @@ func (s *SignatureUnlockBlock) Signature() Signature {
 }
 
 // code contract (make sure the type implements all required methods).
-var _ UnlockBlock = &SignatureUnlockBlock{}
+var _ signatureUnlockBlock = &SignatureUnlockBlock{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -229,3 +254,127 @@ func (r *AliasUnlockBlock) Type() UnlockBlockType {
 var _ UnlockBlock = &AliasUnlockBlock{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdSignatureUnlockBlock ////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdSignatureUnlockBlock represents an UnlockBlock that unlocks a ThresholdAddress. It reveals the threshold and
+// the public keys that the Address was derived from and contains the ED25519Signatures of the co-signers.
+type ThresholdSignatureUnlockBlock struct {
+	model.Immutable[ThresholdSignatureUnlockBlock, *ThresholdSignatureUnlockBlock, thresholdSignatureUnlockBlockModel] `serix:"0"`
+}
+type thresholdSignatureUnlockBlockModel struct {
+	Threshold  uint8               `serix:"0"`
+	PublicKeys []ed25519.PublicKey `serix:"1,lengthPrefixType=uint8"`
+	Signatures []*ED25519Signature `serix:"2,lengthPrefixType=uint8"`
+}
+
+// NewThresholdSignatureUnlockBlock is the constructor for ThresholdSignatureUnlockBlock objects.
+func NewThresholdSignatureUnlockBlock(threshold uint8, publicKeys []ed25519.PublicKey, signatures []*ED25519Signature) *ThresholdSignatureUnlockBlock {
+	return model.NewImmutable[ThresholdSignatureUnlockBlock](&thresholdSignatureUnlockBlockModel{
+		Threshold:  threshold,
+		PublicKeys: publicKeys,
+		Signatures: signatures,
+	})
+}
+
+// AddressSignatureValid returns true if the UnlockBlock correctly signs the given Address. This is the case if the
+// revealed threshold and public keys match the ThresholdAddress and if it contains valid signatures of at least
+// threshold distinct public keys of the Address.
+func (t *ThresholdSignatureUnlockBlock) AddressSignatureValid(address Address, signedData []byte) bool {
+	if address.Type() != ThresholdAddressType {
+		return false
+	}
+
+	revealedAddress, err := NewThresholdAddress(t.Threshold(), t.PublicKeys())
+	if err != nil || !revealedAddress.Equals(address) {
+		return false
+	}
+
+	if len(t.Signatures()) < int(t.Threshold()) || len(t.Signatures()) > len(t.PublicKeys()) {
+		return false
+	}
+
+	signers := make(map[ed25519.PublicKey]bool, len(t.PublicKeys()))
+	for _, publicKey := range t.PublicKeys() {
+		signers[publicKey] = false
+	}
+
+	for _, signature := range t.Signatures() {
+		if signed, isSigner := signers[signature.PublicKey]; !isSigner || signed || !signature.SignatureValid(signedData) {
+			return false
+		}
+		signers[signature.PublicKey] = true
+	}
+
+	return true
+}
+
+// Type returns the UnlockBlockType of the UnlockBlock.
+func (t *ThresholdSignatureUnlockBlock) Type() UnlockBlockType {
+	return ThresholdSignatureUnlockBlockType
+}
+
+// Threshold returns the number of signatures that are required to unlock the ThresholdAddress.
+func (t *ThresholdSignatureUnlockBlock) Threshold() uint8 {
+	return t.M.Threshold
+}
+
+// PublicKeys returns the public keys that the ThresholdAddress was derived from.
+func (t *ThresholdSignatureUnlockBlock) PublicKeys() []ed25519.PublicKey {
+	return t.M.PublicKeys
+}
+
+// Signatures returns the signatures of the co-signers.
+func (t *ThresholdSignatureUnlockBlock) Signatures() []*ED25519Signature {
+	return t.M.Signatures
+}
+
+// code contract (make sure the type implements all required methods).
+var _ signatureUnlockBlock = &ThresholdSignatureUnlockBlock{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PreimageUnlockBlock //////////////////////////////////////////////////////////////////////////////////////////
+
+// PreimageUnlockBlock represents an UnlockBlock that contains a Signature for an Address together with the preimage of
+// the hash lock of a hash locked ExtendedLockedOutput.
+type PreimageUnlockBlock struct {
+	model.Immutable[PreimageUnlockBlock, *PreimageUnlockBlock, preimageUnlockBlockModel] `serix:"0"`
+}
+type preimageUnlockBlockModel struct {
+	Signature Signature          `serix:"0"`
+	Preimage  [PreimageSize]byte `serix:"1"`
+}
+
+// NewPreimageUnlockBlock is the constructor for PreimageUnlockBlock objects.
+func NewPreimageUnlockBlock(signature Signature, preimage [PreimageSize]byte) *PreimageUnlockBlock {
+	return model.NewImmutable[PreimageUnlockBlock](&preimageUnlockBlockModel{
+		Signature: signature,
+		Preimage:  preimage,
+	})
+}
+
+// AddressSignatureValid returns true if the UnlockBlock correctly signs the given Address.
+func (p *PreimageUnlockBlock) AddressSignatureValid(address Address, signedData []byte) bool {
+	return p.Signature().AddressSignatureValid(address, signedData)
+}
+
+// Type returns the UnlockBlockType of the UnlockBlock.
+func (p *PreimageUnlockBlock) Type() UnlockBlockType {
+	return PreimageUnlockBlockType
+}
+
+// Signature return the signature itself.
+func (p *PreimageUnlockBlock) Signature() Signature {
+	return p.M.Signature
+}
+
+// Preimage returns the revealed preimage of the hash lock.
+func (p *PreimageUnlockBlock) Preimage() [PreimageSize]byte {
+	return p.M.Preimage
+}
+
+// code contract (make sure the type implements all required methods).
+var _ signatureUnlockBlock = &PreimageUnlockBlock{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,10 +2,12 @@ package devnetvm
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/identity"
 )
 
 func TestUnlockBlockFromBytes(t *testing.T) {
@@ -16,6 +18,10 @@ func TestUnlockBlockFromBytes(t *testing.T) {
 		unlockBlocks := UnlockBlocks{
 			NewSignatureUnlockBlock(NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign([]byte("testdata")))),
 			NewReferenceUnlockBlock(0),
+			NewThresholdSignatureUnlockBlock(1, []ed25519.PublicKey{keyPair.PublicKey}, []*ED25519Signature{
+				NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign([]byte("testdata"))),
+			}),
+			NewPreimageUnlockBlock(NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign([]byte("testdata"))), [PreimageSize]byte{1, 2, 3}),
 		}
 		marshaledUnlockBlocks := unlockBlocks.Bytes()
 		parsedUnlockBlocks, consumedBytes, err := UnlockBlocksFromBytes(marshaledUnlockBlocks)
@@ -36,3 +42,58 @@ func TestUnlockBlockFromBytes(t *testing.T) {
 	// 	require.Error(t, err)
 	// }
 }
+
+func TestThresholdSignatureUnlockBlock_AddressSignatureValid(t *testing.T) {
+	wallets := createWallets(3)
+	publicKeys := []ed25519.PublicKey{wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey()}
+	address, err := NewThresholdAddress(2, publicKeys)
+	require.NoError(t, err)
+
+	input := NewSigLockedSingleOutput(1, address)
+	input.SetID(randOutputID())
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(NewSigLockedSingleOutput(1, randEd25119Address())))
+
+	unlockValid := func(unlockBlock UnlockBlock) bool {
+		return UnlockBlocksValid(Outputs{input}, NewTransaction(essence, UnlockBlocks{unlockBlock}))
+	}
+
+	t.Run("CASE: Threshold reached", func(t *testing.T) {
+		require.True(t, unlockValid(NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[2].sign(essence), wallets[0].sign(essence)})))
+		require.True(t, unlockValid(NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[0].sign(essence), wallets[1].sign(essence), wallets[2].sign(essence)})))
+	})
+
+	t.Run("CASE: Threshold not reached", func(t *testing.T) {
+		require.False(t, unlockValid(NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[0].sign(essence)})))
+	})
+
+	t.Run("CASE: Duplicate signer", func(t *testing.T) {
+		require.False(t, unlockValid(NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[0].sign(essence), wallets[0].sign(essence)})))
+	})
+
+	t.Run("CASE: Signer not part of the address", func(t *testing.T) {
+		require.False(t, unlockValid(NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[0].sign(essence), genRandomWallet().sign(essence)})))
+	})
+
+	t.Run("CASE: Invalid signature", func(t *testing.T) {
+		invalidSignature := NewED25519Signature(wallets[1].publicKey(), wallets[1].privateKey().Sign([]byte("testdata")))
+		require.False(t, unlockValid(NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[0].sign(essence), invalidSignature})))
+	})
+
+	t.Run("CASE: Revealed threshold does not match the address", func(t *testing.T) {
+		require.False(t, unlockValid(NewThresholdSignatureUnlockBlock(1, publicKeys, []*ED25519Signature{wallets[0].sign(essence)})))
+	})
+
+	t.Run("CASE: Single signature unlock block", func(t *testing.T) {
+		require.False(t, unlockValid(NewSignatureUnlockBlock(wallets[0].sign(essence))))
+	})
+
+	t.Run("CASE: Serialized transaction", func(t *testing.T) {
+		tx := NewTransaction(essence, UnlockBlocks{NewThresholdSignatureUnlockBlock(2, publicKeys, []*ED25519Signature{wallets[0].sign(essence), wallets[1].sign(essence)})})
+		txBytes, err := tx.Bytes()
+		require.NoError(t, err)
+
+		parsedTx := new(Transaction)
+		require.NoError(t, parsedTx.FromBytes(txBytes))
+		require.True(t, UnlockBlocksValid(Outputs{input}, parsedTx))
+	})
+}
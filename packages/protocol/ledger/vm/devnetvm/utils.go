@@ -165,6 +165,36 @@ func UnlockBlocksValidWithError(inputs Outputs, transaction *Transaction) (bool,
 	return true, nil
 }
 
+// UnlockBlocksErrors is an internal utility function that checks the UnlockBlocks of all Inputs instead of stopping at
+// the first invalid one. It returns the reasons why UnlockBlocks are invalid indexed by the position of their Input, or an
+// error if the UnlockBlocks are semantically invalid as a whole.
+func UnlockBlocksErrors(inputs Outputs, transaction *Transaction) (unlockErrors map[int]error, err error) {
+	unlockBlocks := transaction.UnlockBlocks()
+	cyclePresent, err := checkReferenceCycle(unlockBlocks)
+	if err != nil {
+		return nil, errors.Wrap(err, "unlock blocks are semantically invalid")
+	}
+	if cyclePresent {
+		return nil, errors.New("unlock blocks contain cyclic dependency, no signature present for an unlock path")
+	}
+
+	unlockErrors = make(map[int]error)
+	for i, input := range inputs {
+		currentUnlockBlock := unlockBlocks[i]
+		if currentUnlockBlock.Type() == ReferenceUnlockBlockType {
+			currentUnlockBlock = unlockBlocks[unlockBlocks[i].(*ReferenceUnlockBlock).ReferencedIndex()]
+		}
+
+		if unlockValid, unlockErr := input.UnlockValid(transaction, currentUnlockBlock, inputs); unlockErr != nil {
+			unlockErrors[i] = unlockErr
+		} else if !unlockValid {
+			unlockErrors[i] = errors.Errorf("%s is not authorized to spend %s", currentUnlockBlock.Type(), input.ID())
+		}
+	}
+
+	return unlockErrors, nil
+}
+
 // checkReferenceCycle builds a graph from the unlock block references and detects circular referencing. It returns an error
 // if unlock block references are semantically invalid.
 func checkReferenceCycle(blocks UnlockBlocks) (bool, error) {
@@ -201,6 +231,8 @@ func NewUnlockGraph(blocks UnlockBlocks) (*UnlockGraph, error) {
 		switch block.Type() {
 		case SignatureUnlockBlockType:
 			// no adjacent vertex as a SignatureUnlockBlockType can't reference an other one
+		case ThresholdSignatureUnlockBlockType, PreimageUnlockBlockType:
+			// no adjacent vertex as a ThresholdSignatureUnlockBlockType or a PreimageUnlockBlockType can't reference an other one
 		case ReferenceUnlockBlockType:
 			// a reference unlock block can not point to another reference unlock block
 			refIndex := block.(*ReferenceUnlockBlock).ReferencedIndex()
@@ -34,8 +34,11 @@ const (
 	// MaxBlockSize defines the maximum size of a block in bytes.
 	MaxBlockSize = 64 * 1024
 
-	// MaxBlockSize defines the maximum work of a block.
-	MaxBlockWork = 1
+	// WorkUnitSize defines the amount of payload bytes that are charged as an additional unit of work.
+	WorkUnitSize = 1024
+
+	// MaxBlockWork defines the maximum work of a block.
+	MaxBlockWork = 1 + payload.MaxSize/WorkUnitSize
 
 	// BlockIDLength defines the length of an BlockID.
 	BlockIDLength = types.IdentifierLength + 8
@@ -119,19 +122,29 @@ func (b *Block) ContentHash() (contentHash types.Identifier, err error) {
 	return blake2b.Sum256(blkBytes[:len(blkBytes)-ed25519.SignatureSize]), nil
 }
 
-// VerifySignature verifies the Signature of the block.
-func (b *Block) VerifySignature() (valid bool, err error) {
+// SigningMessage returns the message that is signed by the issuer of the block.
+func (b *Block) SigningMessage() (message []byte, err error) {
 	contentHash, err := b.ContentHash()
 	if err != nil {
-		return false, err
+		return nil, errors.Wrap(err, "failed to obtain block content's hash")
 	}
 
 	issuingTimeBytes, err := serix.DefaultAPI.Encode(context.Background(), b.IssuingTime(), serix.WithValidation())
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "failed to serialize block's issuing time")
+	}
+
+	return byteutils.ConcatBytes(issuingTimeBytes, lo.PanicOnErr(b.Commitment().ID().Bytes()), contentHash[:]), nil
+}
+
+// VerifySignature verifies the Signature of the block.
+func (b *Block) VerifySignature() (valid bool, err error) {
+	message, err := b.SigningMessage()
+	if err != nil {
+		return false, err
 	}
 
-	return b.M.IssuerPublicKey.VerifySignature(byteutils.ConcatBytes(issuingTimeBytes, lo.PanicOnErr(b.Commitment().ID().Bytes()), contentHash[:]), b.Signature()), nil
+	return b.M.IssuerPublicKey.VerifySignature(message, b.Signature()), nil
 }
 
 // Version returns the block Version.
@@ -275,10 +288,15 @@ func (b *Block) Size() int {
 	return len(lo.PanicOnErr(b.Bytes()))
 }
 
-// Work returns the work units required to process this block.
-// Currently to 1 for all blocks, but could be improved.
+// Work returns the work units required to process this block. Every block costs one unit of work and every full
+// WorkUnitSize bytes of payload add another unit, so that large blocks are charged proportionally to their size.
 func (b *Block) Work() int {
-	return 1
+	return PayloadWork(len(b.M.PayloadBytes))
+}
+
+// PayloadWork returns the work units required to process a block that carries a payload of the given size in bytes.
+func PayloadWork(payloadSize int) int {
+	return 1 + payloadSize/WorkUnitSize
 }
 
 func (b *Block) String() string {
@@ -9,6 +9,9 @@ var (
 	// ErrBlockTypeIsUnknown is triggered when the block type is unknown.
 	ErrBlockTypeIsUnknown = errors.Errorf("block types must range from %d-%d", 1, LastValidBlockType)
 
+	// ErrInvalidParentsCount is triggered if the number of parents of a ParentsType is out of bounds.
+	ErrInvalidParentsCount = errors.Errorf("the number of parents of each type must range from %d-%d", MinParentsCount, MaxParentsCount)
+
 	// ErrConflictingReferenceAcrossBlocks is triggered if there conflicting references across blocks.
 	ErrConflictingReferenceAcrossBlocks = errors.New("different blocks have conflicting references")
 )
@@ -1,6 +1,10 @@
 package models
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
 
 // region Parent ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -69,6 +73,26 @@ func (p ParentBlockIDs) IsEmpty() bool {
 	return len(p) == 0
 }
 
+// Validate checks that the parents follow the rules of their ParentsType.
+func (p ParentBlockIDs) Validate() (err error) {
+	if strongParents, strongParentsExist := p[StrongParentType]; len(p) == 0 || !strongParentsExist ||
+		len(strongParents) < MinStrongParentsCount {
+		return ErrNoStrongParents
+	}
+	for parentsType, parents := range p {
+		if parentsType == UndefinedParentType || parentsType > LastValidBlockType {
+			return ErrBlockTypeIsUnknown
+		}
+		if len(parents) < MinParentsCount || len(parents) > MaxParentsCount {
+			return errors.Wrapf(ErrInvalidParentsCount, "%s has %d parents", parentsType, len(parents))
+		}
+	}
+	if areReferencesConflictingAcrossBlocks(p) {
+		return ErrConflictingReferenceAcrossBlocks
+	}
+	return nil
+}
+
 // Clone returns a copy of map.
 func (p ParentBlockIDs) Clone() ParentBlockIDs {
 	pCloned := NewParentBlockIDs()
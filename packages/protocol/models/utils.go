@@ -40,20 +40,7 @@ func init() {
 }
 
 func validateParentBlockIDs(_ context.Context, parents ParentBlockIDs) (err error) {
-	// Validate strong parent block
-	if strongParents, strongParentsExist := parents[StrongParentType]; len(parents) == 0 || !strongParentsExist ||
-		len(strongParents) < MinStrongParentsCount {
-		return ErrNoStrongParents
-	}
-	for parentsType := range parents {
-		if parentsType > LastValidBlockType {
-			return ErrBlockTypeIsUnknown
-		}
-	}
-	if areReferencesConflictingAcrossBlocks(parents) {
-		return ErrConflictingReferenceAcrossBlocks
-	}
-	return nil
+	return parents.Validate()
 }
 
 // validate blocksIDs are unique across blocks
@@ -1,7 +1,9 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -10,12 +12,15 @@ import (
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/ioutils"
+	"github.com/iotaledger/hive.go/core/logger"
 	"github.com/iotaledger/hive.go/core/workerpool"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	"github.com/iotaledger/goshimmer/packages/network"
+	networkwarpsync "github.com/iotaledger/goshimmer/packages/network/warpsync"
 	"github.com/iotaledger/goshimmer/packages/protocol/chainmanager"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol/icca/scheduler"
@@ -28,14 +33,19 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/requester/requestrouter"
+	"github.com/iotaledger/goshimmer/packages/protocol/requester/warpsync"
 	"github.com/iotaledger/goshimmer/packages/protocol/tipmanager"
 	"github.com/iotaledger/goshimmer/packages/storage"
 	"github.com/iotaledger/goshimmer/packages/storage/utils"
 )
 
 const (
-	mainBaseDir      = "main"
-	candidateBaseDir = "candidate"
+	mainBaseDir           = "main"
+	candidateBaseDir      = "candidate"
+	candidateSnapshotFile = "candidate-snapshot.bin"
+	scheduledRollbackFile = "scheduled-rollback.bin"
+	appliedRollbackFile   = "applied-rollback.bin"
 )
 
 // region Protocol /////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -46,27 +56,41 @@ type Protocol struct {
 	TipManager        *tipmanager.TipManager
 	chainManager      *chainmanager.Manager
 
-	dispatcher           network.Endpoint
-	networkProtocol      *network.Protocol
-	directory            *utils.Directory
-	activeEngineMutex    sync.RWMutex
-	engine               *engine.Engine
-	candidateEngine      *engine.Engine
-	storage              *storage.Storage
-	candidateStorage     *storage.Storage
-	mainChain            commitment.ID
-	candidateChain       commitment.ID
-	optsBaseDirectory    string
-	optsSnapshotPath     string
-	optsPruningThreshold uint64
+	dispatcher              network.Endpoint
+	networkProtocol         *network.Protocol
+	blockRequestRouter      *requestrouter.Router[models.BlockID]
+	commitmentRequestRouter *requestrouter.Router[commitment.ID]
+	warpsyncManager         *warpsync.Manager
+	warpsyncTarget          *commitment.Commitment
+	warpsyncPeer            identity.ID
+	warpsyncRunning         bool
+	warpsyncMutex           sync.Mutex
+	directory               *utils.Directory
+	activeEngineMutex       sync.RWMutex
+	engine                  *engine.Engine
+	candidateEngine         *engine.Engine
+	storage                 *storage.Storage
+	candidateStorage        *storage.Storage
+	storageBaseDir          string
+	evaluatedFork           *forkEvaluation
+	evaluatedForkMutex      sync.Mutex
+	retiredEngines          sync.WaitGroup
+	optsBaseDirectory       string
+	optsSnapshotPath        string
+	optsPruningThreshold    uint64
+	optsLogger              *logger.Logger
 
 	// optsSolidificationOptions []options.Option[solidification.Requester]
-	optsCongestionControlOptions      []options.Option[congestioncontrol.CongestionControl]
-	optsEngineOptions                 []options.Option[engine.Engine]
-	optsTipManagerOptions             []options.Option[tipmanager.TipManager]
-	optsStorageDatabaseManagerOptions []options.Option[database.Manager]
-	optsSybilProtectionProvider       engine.ModuleProvider[sybilprotection.SybilProtection]
-	optsThroughputQuotaProvider       engine.ModuleProvider[throughputquota.ThroughputQuota]
+	optsCongestionControlOptions       []options.Option[congestioncontrol.CongestionControl]
+	optsEngineOptions                  []options.Option[engine.Engine]
+	optsTipManagerOptions              []options.Option[tipmanager.TipManager]
+	optsStorageDatabaseManagerOptions  []options.Option[database.Manager]
+	optsWarpsyncOptions                []options.Option[warpsync.Manager]
+	optsChainManagerOptions            []options.Option[chainmanager.Manager]
+	optsBlockRequestRouterOptions      []options.Option[requestrouter.Router[models.BlockID]]
+	optsCommitmentRequestRouterOptions []options.Option[requestrouter.Router[commitment.ID]]
+	optsSybilProtectionProvider        engine.ModuleProvider[sybilprotection.SybilProtection]
+	optsThroughputQuotaProvider        engine.ModuleProvider[throughputquota.ThroughputQuota]
 }
 
 func New(dispatcher network.Endpoint, opts ...options.Option[Protocol]) (protocol *Protocol) {
@@ -79,11 +103,13 @@ func New(dispatcher network.Endpoint, opts ...options.Option[Protocol]) (protoco
 
 		optsBaseDirectory:    "",
 		optsPruningThreshold: 6 * 60, // 1 hour given that epoch duration is 10 seconds
+		optsLogger:           logger.NewNopLogger(),
 	}, opts,
 		(*Protocol).initDirectory,
 		(*Protocol).initCongestionControl,
 		(*Protocol).initMainChainStorage,
 		(*Protocol).initMainEngine,
+		(*Protocol).initScheduledRollback,
 		(*Protocol).initChainManager,
 		(*Protocol).initTipManager,
 	)
@@ -101,8 +127,39 @@ func (p *Protocol) Run() {
 	p.initNetworkProtocol()
 }
 
+// ScheduleRollback schedules a rollback of the ledger state of the main engine to the given committed epoch. The
+// rollback is applied the next time the protocol is created (before the engine starts to process blocks).
+func (p *Protocol) ScheduleRollback(targetEpoch epoch.Index) (err error) {
+	p.activeEngineMutex.RLock()
+	defer p.activeEngineMutex.RUnlock()
+
+	if err = p.engine.LedgerState.CheckRollbackTarget(targetEpoch); err != nil {
+		return errors.Wrapf(err, "can not roll back to epoch %d", targetEpoch)
+	}
+
+	return errors.Wrap(os.WriteFile(p.directory.Path(scheduledRollbackFile), targetEpoch.Bytes(), 0o600), "failed to persist scheduled rollback")
+}
+
+// RollbackApplied returns true if the most recently applied rollback targeted the given epoch.
+func (p *Protocol) RollbackApplied(targetEpoch epoch.Index) (applied bool) {
+	appliedEpochBytes, err := os.ReadFile(p.directory.Path(appliedRollbackFile))
+	if err != nil {
+		return false
+	}
+
+	appliedEpoch, _, err := epoch.IndexFromBytes(appliedEpochBytes)
+
+	return err == nil && appliedEpoch == targetEpoch
+}
+
 // Shutdown shuts down the protocol.
 func (p *Protocol) Shutdown() {
+	if p.warpsyncManager != nil {
+		p.warpsyncManager.Stop()
+	}
+
+	p.retiredEngines.Wait()
+
 	p.CongestionControl.Shutdown()
 	p.engine.Shutdown()
 	p.storage.Shutdown()
@@ -127,7 +184,15 @@ func (p *Protocol) initDirectory() {
 }
 
 func (p *Protocol) initMainChainStorage() {
-	p.storage = storage.New(p.directory.Path(mainBaseDir), DatabaseVersion, p.optsStorageDatabaseManagerOptions...)
+	// a previous chain switch leaves the active storage in the candidate directory, while an unfinished candidate is
+	// discarded as it will be re-evaluated once the fork is detected again.
+	if p.storageBaseDir = mainBaseDir; !directoryExists(p.directory.Path(mainBaseDir)) && directoryExists(p.directory.Path(candidateBaseDir)) {
+		p.storageBaseDir = candidateBaseDir
+	} else if err := os.RemoveAll(p.directory.Path(candidateBaseDir)); err != nil {
+		panic(err)
+	}
+
+	p.storage = storage.New(p.directory.Path(p.storageBaseDir), DatabaseVersion, p.optsStorageDatabaseManagerOptions...)
 
 	p.Events.Engine.Consensus.EpochGadget.EpochConfirmed.Attach(event.NewClosure(func(epochIndex epoch.Index) {
 		p.storage.PruneUntilEpoch(epochIndex - epoch.Index(p.optsPruningThreshold))
@@ -143,6 +208,8 @@ func (p *Protocol) initCongestionControl() {
 func (p *Protocol) initNetworkProtocol() {
 	p.networkProtocol = network.NewProtocol(p.dispatcher)
 
+	p.initRequestRouters()
+
 	p.networkProtocol.Events.BlockRequestReceived.Attach(event.NewClosure(func(event *network.BlockRequestReceivedEvent) {
 		if block, exists := p.Engine().Block(event.BlockID); exists {
 			p.networkProtocol.SendBlock(block, event.Source)
@@ -150,12 +217,21 @@ func (p *Protocol) initNetworkProtocol() {
 	}))
 
 	p.networkProtocol.Events.BlockReceived.Attach(event.NewClosure(func(event *network.BlockReceivedEvent) {
+		p.blockRequestRouter.RegisterResponse(event.Block.ID(), event.Source)
+		for _, parentID := range event.Block.Parents() {
+			p.blockRequestRouter.RegisterHint(parentID, event.Source)
+		}
+		p.commitmentRequestRouter.RegisterHint(event.Block.Commitment().ID(), event.Source)
+
 		if err := p.ProcessBlock(event.Block, event.Source); err != nil {
 			fmt.Print(err)
 		}
 	}))
 
 	p.networkProtocol.Events.EpochCommitmentReceived.Attach(event.NewClosure(func(event *network.EpochCommitmentReceivedEvent) {
+		p.commitmentRequestRouter.RegisterResponse(event.Commitment.ID(), event.Source)
+		p.commitmentRequestRouter.RegisterHint(event.Commitment.PrevID(), event.Source)
+
 		p.chainManager.ProcessCommitment(event.Commitment)
 	}))
 
@@ -170,11 +246,11 @@ func (p *Protocol) initNetworkProtocol() {
 	}))
 
 	p.Events.Engine.BlockRequester.Tick.Attach(event.NewClosure(func(blockID models.BlockID) {
-		p.networkProtocol.RequestBlock(blockID)
+		p.networkProtocol.RequestBlock(blockID, p.blockRequestRouter.Route(blockID)...)
 	}))
 
 	p.chainManager.CommitmentRequester.Events.Tick.Attach(event.NewClosure(func(commitmentID commitment.ID) {
-		p.networkProtocol.RequestCommitment(commitmentID)
+		p.networkProtocol.RequestCommitment(commitmentID, p.commitmentRequestRouter.Route(commitmentID)...)
 	}))
 
 	p.networkProtocol.Events.AttestationsRequestReceived.Attach(event.NewClosure(func(event *network.AttestationsRequestReceivedEvent) {
@@ -182,16 +258,48 @@ func (p *Protocol) initNetworkProtocol() {
 	}))
 
 	p.networkProtocol.Events.AttestationsReceived.Attach(event.NewClosure(func(event *network.AttestationsReceivedEvent) {
-		p.ProcessAttestations(event.Source)
+		p.ProcessAttestations(event.Commitment, event.Roots, event.Attestations, event.Source)
 	}))
+
+	p.chainManager.Events.ForkDetected.Attach(event.NewClosure(p.onForkDetected))
+
+	warpsyncLogger := p.optsLogger.Named("Warpsync")
+	p.warpsyncManager = warpsync.NewManager(networkwarpsync.New(p.dispatcher, warpsyncLogger), p.Engine, warpsyncLogger, p.optsWarpsyncOptions...)
+}
+
+// initRequestRouters creates the routers that decide which neighbors are asked for missing blocks and commitments.
+func (p *Protocol) initRequestRouters() {
+	var neighborsFunc func() []identity.ID
+	if neighborsProvider, isNeighborsProvider := p.dispatcher.(network.NeighborsProvider); isNeighborsProvider {
+		neighborsFunc = neighborsProvider.AllNeighborsIDs
+	}
+
+	p.blockRequestRouter = requestrouter.New(neighborsFunc, p.optsBlockRequestRouterOptions...)
+	p.commitmentRequestRouter = requestrouter.New(neighborsFunc, p.optsCommitmentRequestRouterOptions...)
+
+	p.Events.Engine.BlockRequester.TickerStopped.Hook(event.NewClosure(p.blockRequestRouter.StopRequest))
+	p.Events.Engine.BlockRequester.TickerFailed.Hook(event.NewClosure(p.blockRequestRouter.StopRequest))
+	p.Events.Engine.EvictionState.EpochEvicted.Hook(event.NewClosure(p.blockRequestRouter.EvictUntil))
+
+	p.chainManager.CommitmentRequester.Events.TickerStopped.Hook(event.NewClosure(p.commitmentRequestRouter.StopRequest))
+	p.chainManager.CommitmentRequester.Events.TickerFailed.Hook(event.NewClosure(p.commitmentRequestRouter.StopRequest))
+	p.Events.Engine.Consensus.EpochGadget.EpochConfirmed.Attach(event.NewClosure(p.commitmentRequestRouter.EvictUntil))
 }
 
 func (p *Protocol) initMainEngine() {
 	p.engine = engine.New(p.storage, p.optsSybilProtectionProvider, p.optsThroughputQuotaProvider, p.optsEngineOptions...)
 }
 
+// initScheduledRollback applies a scheduled rollback before the chain manager is created, so that the chain manager is
+// rooted at the commitment that the engine was rolled back to.
+func (p *Protocol) initScheduledRollback() {
+	if err := p.applyScheduledRollback(); err != nil {
+		panic(err)
+	}
+}
+
 func (p *Protocol) initChainManager() {
-	p.chainManager = chainmanager.NewManager(p.Engine().Storage.Settings.LatestCommitment())
+	p.chainManager = chainmanager.NewManager(p.Engine().Storage.Settings.LatestCommitment(), p.optsChainManagerOptions...)
 
 	p.Events.Engine.NotarizationManager.EpochCommitted.Attach(event.NewClosure(func(details *notarization.EpochCommittedDetails) {
 		p.chainManager.ProcessCommitment(details.Commitment)
@@ -248,29 +356,114 @@ func (p *Protocol) ProcessBlock(block *models.Block, src identity.ID) error {
 	}
 
 	if mainChain := p.storage.Settings.ChainID(); chain.ForkingPoint.ID() == mainChain {
+		if latestIndex := p.Engine().Storage.Settings.LatestCommitment().Index(); block.Commitment().Index() > latestIndex+1 {
+			p.requestWarpsync(block.Commitment(), src)
+		}
+
 		p.Engine().ProcessBlockFromPeer(block, src)
 		return nil
 	}
 
-	if p.Engine().IsBootstrapped() {
-		panic(fmt.Sprintln("different commitment", block))
-	}
-
 	if candidateEngine, candidateStorage := p.CandidateEngine(), p.CandidateStorage(); candidateEngine != nil && candidateStorage != nil {
 		if candidateChain := candidateStorage.Settings.ChainID(); chain.ForkingPoint.ID() == candidateChain {
 			candidateEngine.ProcessBlockFromPeer(block, src)
 			return nil
 		}
 	}
-	return errors.Errorf("block was not processed.")
+
+	return errors.Errorf("block %s is on a fork (forking point %s) that is not being followed", block.ID(), chain.ForkingPoint.ID())
 }
 
+// ProcessAttestationsRequest answers a request for the attestations of the given epoch with the commitment, the roots
+// and the attestations of the main chain.
 func (p *Protocol) ProcessAttestationsRequest(epochIndex epoch.Index, src identity.ID) {
-	// p.networkProtocol.SendAttestations(p.Engine().SybilProtection.Attestations(epochIndex), src)
+	activeEngine := p.Engine()
+
+	if epochIndex > activeEngine.Storage.Settings.LatestCommitment().Index() {
+		return
+	}
+
+	requestedCommitment, err := activeEngine.Storage.Commitments.Load(epochIndex)
+	if err != nil {
+		return
+	}
+
+	roots, err := activeEngine.Storage.Roots.Load(epochIndex)
+	if err != nil || roots == nil {
+		return
+	}
+
+	attestations, err := activeEngine.NotarizationManager.Attestations.Get(epochIndex)
+	if err != nil {
+		return
+	}
+
+	attestationsList := make([]*notarization.Attestation, 0)
+	if err = attestations.Stream(func(_ identity.ID, attestation *notarization.Attestation) bool {
+		attestationsList = append(attestationsList, attestation)
+		return true
+	}); err != nil {
+		return
+	}
+
+	p.networkProtocol.SendAttestations(requestedCommitment, roots, attestationsList, src)
 }
 
-func (p *Protocol) ProcessAttestations(src identity.ID) {
-	// TODO: process attestations and evluate chain switch!
+// ProcessAttestations verifies the attestations of a forking chain and spins up a candidate engine if the verified
+// cumulative weight of the fork is higher than the one of the main chain.
+func (p *Protocol) ProcessAttestations(forkingCommitment *commitment.Commitment, roots *commitment.Roots, attestations []*notarization.Attestation, src identity.ID) {
+	p.evaluatedForkMutex.Lock()
+	defer p.evaluatedForkMutex.Unlock()
+
+	fork := p.evaluatedFork
+	if fork == nil {
+		return
+	}
+
+	chainCommitment := fork.chain.Commitment(forkingCommitment.Index())
+	if chainCommitment == nil || chainCommitment.ID() != forkingCommitment.ID() || fork.isVerified(forkingCommitment.Index()) {
+		return
+	}
+
+	weights, err := p.forkingPointWeights(fork)
+	if err != nil {
+		p.evaluatedFork = nil
+		p.Events.Error.Trigger(errors.Wrapf(err, "failed to retrieve the weights of the forking point of fork %s", fork.chain.ForkingPoint.ID()))
+
+		return
+	}
+
+	if err = p.verifyAttestations(forkingCommitment, roots, attestations, weights); err != nil {
+		p.evaluatedFork = nil
+		p.Events.InvalidAttestationsReceived.Trigger(&InvalidAttestationsEvent{
+			Commitment: forkingCommitment,
+			Error:      err,
+			Source:     src,
+		})
+
+		return
+	}
+
+	fork.markVerified(forkingCommitment.Index())
+
+	if nextCommitment := fork.chain.Commitment(forkingCommitment.Index() + 1); nextCommitment != nil {
+		p.networkProtocol.RequestAttestations(nextCommitment.Commitment().Index())
+	}
+
+	latestVerifiedIndex, forkingPointVerified := fork.latestVerifiedIndex()
+	if !forkingPointVerified {
+		return
+	}
+
+	if verifiedCommitment := fork.chain.Commitment(latestVerifiedIndex); verifiedCommitment.Commitment().CumulativeWeight() <= p.Engine().Storage.Settings.LatestCommitment().CumulativeWeight() {
+		return
+	}
+
+	if err = p.activateCandidateEngine(fork.chain); err != nil {
+		p.Events.Error.Trigger(errors.Wrapf(err, "failed to activate candidate engine for fork %s", fork.chain.ForkingPoint.ID()))
+	}
+
+	p.evaluatedFork = nil
 }
 
 func (p *Protocol) Engine() (instance *engine.Engine) {
@@ -303,18 +496,339 @@ func (p *Protocol) CandidateStorage() (chainstorage *storage.Storage) {
 	return p.candidateStorage
 }
 
+// requestWarpsync makes sure that the blocks up to the given target commitment are fetched from the given peer. There is
+// only a single warpsync running at a time, targets that are not newer than the current one are ignored.
+func (p *Protocol) requestWarpsync(targetCommitment *commitment.Commitment, src identity.ID) {
+	p.warpsyncMutex.Lock()
+	defer p.warpsyncMutex.Unlock()
+
+	if p.warpsyncTarget != nil && targetCommitment.Index() <= p.warpsyncTarget.Index() {
+		return
+	}
+
+	p.warpsyncTarget, p.warpsyncPeer = targetCommitment, src
+	if !p.warpsyncRunning {
+		p.warpsyncRunning = true
+
+		go p.runWarpsync()
+	}
+}
+
+// runWarpsync warpsyncs to the latest requested target until no newer target was requested in the meantime.
+func (p *Protocol) runWarpsync() {
+	for {
+		p.warpsyncMutex.Lock()
+		targetCommitment, src := p.warpsyncTarget, p.warpsyncPeer
+		p.warpsyncMutex.Unlock()
+
+		err := p.warpsync(p.Engine().Storage.Settings.LatestCommitment().Index(), targetCommitment, src)
+
+		p.warpsyncMutex.Lock()
+		if p.warpsyncTarget == targetCommitment {
+			// forget about failed targets, so they can be requested again
+			if err != nil {
+				p.warpsyncTarget = nil
+			}
+			p.warpsyncRunning = false
+			p.warpsyncMutex.Unlock()
+
+			return
+		}
+		p.warpsyncMutex.Unlock()
+	}
+}
+
+// warpsync fetches the blocks of all epochs after the given index up to the target commitment from the given peer.
+func (p *Protocol) warpsync(latestIndex epoch.Index, targetCommitment *commitment.Commitment, src identity.ID) (err error) {
+	if targetCommitment.Index() <= latestIndex+1 {
+		return nil
+	}
+
+	p.Events.WarpsyncStarted.Trigger(targetCommitment)
+
+	commitments, err := p.chainManager.Commitments(targetCommitment.ID(), int(targetCommitment.Index()-latestIndex))
+	if err != nil {
+		p.Events.Error.Trigger(errors.Wrapf(err, "failed to warpsync to commitment %s", targetCommitment.ID()))
+
+		return err
+	}
+
+	ecChain := make(map[epoch.Index]*commitment.Commitment)
+	for _, chainCommitment := range commitments {
+		ecChain[chainCommitment.Commitment().Index()] = chainCommitment.Commitment()
+	}
+
+	if err = p.warpsyncManager.WarpRange(context.Background(), latestIndex, targetCommitment.Index(), ecChain, src); err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to warpsync"))
+	}
+
+	return err
+}
+
+func (p *Protocol) onForkDetected(fork *chainmanager.Chain) {
+	p.evaluatedForkMutex.Lock()
+	defer p.evaluatedForkMutex.Unlock()
+
+	if p.CandidateEngine() != nil {
+		return
+	}
+
+	// only replace a running evaluation if the new fork claims to be heavier
+	if p.evaluatedFork != nil && fork.LatestCommitment().Commitment().CumulativeWeight() <= p.evaluatedFork.chain.LatestCommitment().Commitment().CumulativeWeight() {
+		return
+	}
+
+	p.evaluatedFork = newForkEvaluation(fork)
+
+	p.networkProtocol.RequestAttestations(fork.ForkingPoint.Commitment().Index())
+}
+
+// verifyAttestations checks that the attestations are the ones that were committed by the given commitment (via its
+// roots) and that they prove the weight that the commitment claims.
+func (p *Protocol) verifyAttestations(forkingCommitment *commitment.Commitment, roots *commitment.Roots, attestations []*notarization.Attestation, weights map[identity.ID]int64) (err error) {
+	parentCommitment, _ := p.chainManager.Commitment(forkingCommitment.PrevID())
+	if parentCommitment == nil || parentCommitment.Commitment() == nil {
+		return errors.Errorf("parent of commitment %s is unknown", forkingCommitment.ID())
+	}
+
+	if roots == nil || roots.ID() != forkingCommitment.RootsID() {
+		return errors.Errorf("roots do not match the RootsID of commitment %s", forkingCommitment.ID())
+	}
+	attestedIssuers := make(map[identity.ID]bool)
+
+	var attestedWeight int64
+	for _, attestation := range attestations {
+		if epoch.IndexFromTime(attestation.IssuingTime) != forkingCommitment.Index() {
+			return errors.Errorf("attestation of %s is not part of epoch %d", attestation.IssuerID, forkingCommitment.Index())
+		}
+
+		if valid, err := attestation.VerifySignature(); err != nil {
+			return errors.Wrapf(err, "failed to verify signature of attestation of %s", attestation.IssuerID)
+		} else if !valid {
+			return errors.Errorf("invalid signature in attestation of %s", attestation.IssuerID)
+		}
+
+		if attestedIssuers[attestation.IssuerID] {
+			return errors.Errorf("duplicate attestation of %s", attestation.IssuerID)
+		}
+		attestedIssuers[attestation.IssuerID] = true
+
+		attestedWeight += weights[attestation.IssuerID]
+	}
+
+	if notarization.AttestationsRoot(attestations) != roots.AttestationsRoot() {
+		return errors.Errorf("attestations do not match the attestations root of commitment %s", forkingCommitment.ID())
+	}
+
+	if claimedWeight := forkingCommitment.CumulativeWeight() - parentCommitment.Commitment().CumulativeWeight(); claimedWeight > attestedWeight {
+		return errors.Errorf("commitment %s claims weight %d but attestations only prove %d", forkingCommitment.ID(), claimedWeight, attestedWeight)
+	}
+
+	return nil
+}
+
+// forkingPointWeights returns the weights that were committed by the main chain right before the forking point, which
+// is the latest state that both chains agree on.
+func (p *Protocol) forkingPointWeights(fork *forkEvaluation) (weights map[identity.ID]int64, err error) {
+	if fork.weights == nil {
+		mainEngine := p.Engine()
+
+		// a fork can be detected before the main engine committed all epochs up to the forking point
+		weightsEpoch := fork.chain.ForkingPoint.Commitment().Index() - 1
+		if latestEpoch := mainEngine.Storage.Settings.LatestCommitment().Index(); weightsEpoch > latestEpoch {
+			weightsEpoch = latestEpoch
+		}
+
+		if fork.weights, err = mainEngine.CommittedWeights(weightsEpoch); err != nil {
+			return nil, err
+		}
+	}
+
+	return fork.weights, nil
+}
+
+func (p *Protocol) activateCandidateEngine(fork *chainmanager.Chain) (err error) {
+	snapshotPath := p.directory.Path(candidateSnapshotFile)
+	defer os.Remove(snapshotPath)
+
+	if err = p.Engine().WriteSnapshot(snapshotPath, fork.ForkingPoint.Commitment().Index()-1); err != nil {
+		return errors.Wrap(err, "failed to write snapshot of the forking point")
+	}
+
+	candidateBaseDirectory := lo.Cond(p.storageBaseDir == mainBaseDir, candidateBaseDir, mainBaseDir)
+	if err = os.RemoveAll(p.directory.Path(candidateBaseDirectory)); err != nil {
+		return errors.Wrap(err, "failed to clean up candidate directory")
+	}
+
+	candidateStorage := storage.New(p.directory.Path(candidateBaseDirectory), DatabaseVersion, p.optsStorageDatabaseManagerOptions...)
+	candidateEngine := engine.New(candidateStorage, p.optsSybilProtectionProvider, p.optsThroughputQuotaProvider, p.optsEngineOptions...)
+
+	if err = candidateEngine.Initialize(snapshotPath); err != nil {
+		candidateEngine.Shutdown()
+		candidateStorage.Shutdown()
+
+		return errors.Wrap(err, "failed to initialize candidate engine")
+	}
+
+	if err = candidateStorage.Settings.SetChainID(fork.ForkingPoint.ID()); err != nil {
+		candidateEngine.Shutdown()
+		candidateStorage.Shutdown()
+
+		return errors.Wrap(err, "failed to set chain id of candidate storage")
+	}
+
+	candidateEngine.Events.BlockRequester.Tick.Attach(event.NewClosure(func(blockID models.BlockID) {
+		p.networkProtocol.RequestBlock(blockID, p.blockRequestRouter.Route(blockID)...)
+	}))
+	candidateEngine.Events.BlockRequester.TickerStopped.Hook(event.NewClosure(p.blockRequestRouter.StopRequest))
+	candidateEngine.Events.BlockRequester.TickerFailed.Hook(event.NewClosure(p.blockRequestRouter.StopRequest))
+
+	candidateEngine.Events.NotarizationManager.EpochCommitted.Attach(event.NewClosure(func(details *notarization.EpochCommittedDetails) {
+		p.chainManager.ProcessCommitment(details.Commitment)
+
+		if details.Commitment.CumulativeWeight() > p.Engine().Storage.Settings.LatestCommitment().CumulativeWeight() {
+			p.switchToCandidateEngine(candidateEngine, candidateBaseDirectory)
+		}
+	}))
+
+	p.activeEngineMutex.Lock()
+	p.candidateEngine = candidateEngine
+	p.candidateStorage = candidateStorage
+	p.activeEngineMutex.Unlock()
+
+	p.Events.CandidateEngineActivated.Trigger(candidateEngine)
+
+	return nil
+}
+
+func (p *Protocol) switchToCandidateEngine(candidateEngine *engine.Engine, candidateBaseDirectory string) {
+	p.activeEngineMutex.Lock()
+	if p.candidateEngine != candidateEngine {
+		p.activeEngineMutex.Unlock()
+		return
+	}
+
+	oldEngine, oldStorage, oldBaseDirectory := p.engine, p.storage, p.storageBaseDir
+	p.engine, p.storage, p.storageBaseDir = p.candidateEngine, p.candidateStorage, candidateBaseDirectory
+	p.candidateEngine, p.candidateStorage = nil, nil
+	p.activeEngineMutex.Unlock()
+
+	p.linkTo(candidateEngine)
+
+	// the storage can only be shut down once all pending event handlers (including the one that triggered the switch)
+	// are done, so the previous engine is torn down in the background
+	p.retiredEngines.Add(1)
+	go func() {
+		defer p.retiredEngines.Done()
+
+		oldEngine.Shutdown()
+		oldStorage.Shutdown()
+
+		if err := os.RemoveAll(p.directory.Path(oldBaseDirectory)); err != nil {
+			p.Events.Error.Trigger(errors.Wrapf(err, "failed to remove storage of previous main chain"))
+		}
+	}()
+
+	p.Events.MainEngineSwitched.Trigger(candidateEngine)
+}
+
+// applyScheduledRollback rolls the main engine back to the epoch of a previously scheduled rollback (if there is one).
+func (p *Protocol) applyScheduledRollback() (err error) {
+	scheduledRollbackPath := p.directory.Path(scheduledRollbackFile)
+
+	targetEpochBytes, err := os.ReadFile(scheduledRollbackPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "failed to read scheduled rollback")
+	}
+
+	targetEpoch, _, err := epoch.IndexFromBytes(targetEpochBytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse target epoch of scheduled rollback")
+	}
+
+	p.optsLogger.Infof("Rolling back the ledger state to epoch %d", targetEpoch)
+
+	if err = p.engine.RollbackTo(targetEpoch); err != nil {
+		return errors.Wrapf(err, "failed to roll back to epoch %d", targetEpoch)
+	}
+
+	if err = os.WriteFile(p.directory.Path(appliedRollbackFile), targetEpochBytes, 0o600); err != nil {
+		return errors.Wrap(err, "failed to persist applied rollback")
+	}
+
+	return errors.Wrap(os.Remove(scheduledRollbackPath), "failed to remove scheduled rollback")
+}
+
 func (p *Protocol) linkTo(engine *engine.Engine) {
 	p.Events.Engine.LinkTo(engine.Events)
 	p.TipManager.LinkTo(engine)
 	p.CongestionControl.LinkTo(engine)
 }
 
+// RemoveNeighbor removes the request statistics of the given neighbor from the request routers (after it was dropped).
+func (p *Protocol) RemoveNeighbor(neighborID identity.ID) {
+	p.blockRequestRouter.RemoveNeighbor(neighborID)
+	p.commitmentRequestRouter.RemoveNeighbor(neighborID)
+}
+
 func (p *Protocol) Network() *network.Protocol {
 	return p.networkProtocol
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region forkEvaluation ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// forkEvaluation keeps track of the epochs of a forking chain whose attestations have already been verified.
+type forkEvaluation struct {
+	chain          *chainmanager.Chain
+	verifiedEpochs map[epoch.Index]bool
+	weights        map[identity.ID]int64
+}
+
+func newForkEvaluation(chain *chainmanager.Chain) *forkEvaluation {
+	return &forkEvaluation{
+		chain:          chain,
+		verifiedEpochs: make(map[epoch.Index]bool),
+	}
+}
+
+func (f *forkEvaluation) isVerified(index epoch.Index) bool {
+	return f.verifiedEpochs[index]
+}
+
+func (f *forkEvaluation) markVerified(index epoch.Index) {
+	f.verifiedEpochs[index] = true
+}
+
+// latestVerifiedIndex returns the highest index up to which all epochs since the forking point were verified (and
+// false if the forking point itself was not verified, yet).
+func (f *forkEvaluation) latestVerifiedIndex() (index epoch.Index, forkingPointVerified bool) {
+	if index = f.chain.ForkingPoint.Commitment().Index(); !f.verifiedEpochs[index] {
+		return 0, false
+	}
+
+	for ; f.verifiedEpochs[index+1]; index++ {
+	}
+
+	return index, true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utils ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func directoryExists(path string) bool {
+	exists, isDirectory, err := ioutils.PathExists(path)
+	return err == nil && exists && isDirectory
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func WithBaseDirectory(baseDirectory string) options.Option[Protocol] {
@@ -329,6 +843,12 @@ func WithPruningThreshold(pruningThreshold uint64) options.Option[Protocol] {
 	}
 }
 
+func WithLogger(logger *logger.Logger) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsLogger = logger
+	}
+}
+
 func WithSnapshotPath(snapshot string) options.Option[Protocol] {
 	return func(n *Protocol) {
 		n.optsSnapshotPath = snapshot
@@ -371,6 +891,33 @@ func WithEngineOptions(opts ...options.Option[engine.Engine]) options.Option[Pro
 	}
 }
 
+func WithWarpsyncOptions(opts ...options.Option[warpsync.Manager]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsWarpsyncOptions = opts
+	}
+}
+
+func WithChainManagerOptions(opts ...options.Option[chainmanager.Manager]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsChainManagerOptions = opts
+	}
+}
+
+// WithBlockRequestRouterOptions configures the router that decides which neighbors are asked for missing blocks.
+func WithBlockRequestRouterOptions(opts ...options.Option[requestrouter.Router[models.BlockID]]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsBlockRequestRouterOptions = opts
+	}
+}
+
+// WithCommitmentRequestRouterOptions configures the router that decides which neighbors are asked for missing
+// commitments.
+func WithCommitmentRequestRouterOptions(opts ...options.Option[requestrouter.Router[commitment.ID]]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsCommitmentRequestRouterOptions = opts
+	}
+}
+
 func WithStorageDatabaseManagerOptions(opts ...options.Option[database.Manager]) options.Option[Protocol] {
 	return func(p *Protocol) {
 		p.optsStorageDatabaseManagerOptions = opts
@@ -3,6 +3,8 @@ package protocol
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	"github.com/iotaledger/goshimmer/packages/core/snapshotcreator"
 	"github.com/iotaledger/goshimmer/packages/network"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
@@ -28,6 +31,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/requester/warpsync"
 	"github.com/iotaledger/goshimmer/packages/storage"
 	"github.com/iotaledger/goshimmer/packages/storage/utils"
 )
@@ -109,6 +113,197 @@ func TestProtocol(t *testing.T) {
 	tf2.WaitUntilAllTasksProcessed()
 }
 
+func TestProtocol_ForkEvaluation(t *testing.T) {
+	protocol, validator := newForkEvaluationTestProtocol(t)
+
+	switchedEngines := make(chan *engine.Engine, 1)
+	protocol.Events.MainEngineSwitched.Hook(event.NewClosure(func(switchedEngine *engine.Engine) {
+		switchedEngines <- switchedEngine
+	}))
+
+	genesisCommitment := protocol.Engine().Storage.Settings.LatestCommitment()
+	attestations := map[string]*notarization.Attestation{
+		"1*": newTestAttestation(validator, 1, genesisCommitment),
+		"2*": newTestAttestation(validator, 2, genesisCommitment),
+	}
+	roots := map[string]*commitment.Roots{
+		"1*": newTestRoots(2, attestations["1*"]),
+		"2*": newTestRoots(3, attestations["2*"]),
+	}
+	commitments := make(map[string]*commitment.Commitment)
+	commitments["1"] = commitment.New(1, genesisCommitment.ID(), types.Identifier{1}, 0)
+	commitments["1*"] = commitment.New(1, genesisCommitment.ID(), roots["1*"].ID(), 50)
+	commitments["2*"] = commitment.New(2, commitments["1*"].ID(), roots["2*"].ID(), 100)
+	commitments["3*"] = commitment.New(3, commitments["2*"].ID(), types.Identifier{4}, 150)
+
+	protocol.chainManager.ProcessCommitment(commitments["1"])
+	protocol.chainManager.ProcessCommitment(commitments["1*"])
+	protocol.chainManager.ProcessCommitment(commitments["2*"])
+	requireForkEvaluation(t, protocol)
+
+	// attestations of a later epoch do not activate the candidate engine as long as the forking point is not verified
+	protocol.ProcessAttestations(commitments["2*"], roots["2*"], []*notarization.Attestation{attestations["2*"]}, identity.ID{})
+	require.Nil(t, protocol.CandidateEngine())
+
+	protocol.ProcessAttestations(commitments["1*"], roots["1*"], []*notarization.Attestation{attestations["1*"]}, identity.ID{})
+	candidateEngine := protocol.CandidateEngine()
+	require.NotNil(t, candidateEngine)
+
+	candidateEngine.NotarizationManager.Events.EpochCommitted.Trigger(&notarization.EpochCommittedDetails{
+		Commitment: commitments["3*"],
+	})
+
+	select {
+	case switchedEngine := <-switchedEngines:
+		require.Equal(t, candidateEngine, switchedEngine)
+		require.Equal(t, candidateEngine, protocol.Engine())
+		require.Nil(t, protocol.CandidateEngine())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the protocol did not switch to the heavier chain")
+	}
+}
+
+func TestProtocol_ForkEvaluationInvalidAttestations(t *testing.T) {
+	protocol, validator := newForkEvaluationTestProtocol(t)
+	invalidAttestations := invalidAttestationsEvents(protocol)
+
+	genesisCommitment := protocol.Engine().Storage.Settings.LatestCommitment()
+	attestation := newTestAttestation(validator, 1, genesisCommitment)
+	roots := newTestRoots(2, attestation)
+	commitments := make(map[string]*commitment.Commitment)
+	commitments["1"] = commitment.New(1, genesisCommitment.ID(), types.Identifier{1}, 0)
+	commitments["1*"] = commitment.New(1, genesisCommitment.ID(), roots.ID(), 200)
+
+	protocol.chainManager.ProcessCommitment(commitments["1"])
+	protocol.chainManager.ProcessCommitment(commitments["1*"])
+	requireForkEvaluation(t, protocol)
+
+	// the validator only had a weight of 100 at the forking point, so it can not back a claimed weight of 200
+	protocol.ProcessAttestations(commitments["1*"], roots, []*notarization.Attestation{attestation}, identity.ID{})
+	require.Nil(t, protocol.CandidateEngine())
+	requireInvalidAttestations(t, invalidAttestations, commitments["1*"])
+}
+
+func TestProtocol_ForkEvaluationReplayedAttestations(t *testing.T) {
+	protocol, validator := newForkEvaluationTestProtocol(t)
+	invalidAttestations := invalidAttestationsEvents(protocol)
+
+	genesisCommitment := protocol.Engine().Storage.Settings.LatestCommitment()
+	mainAttestation := newTestAttestation(validator, 1, genesisCommitment)
+	roots := map[string]*commitment.Roots{
+		"1":  newTestRoots(1, mainAttestation),
+		"1*": newTestRoots(2),
+	}
+	commitments := make(map[string]*commitment.Commitment)
+	commitments["1"] = commitment.New(1, genesisCommitment.ID(), roots["1"].ID(), 0)
+	commitments["1*"] = commitment.New(1, genesisCommitment.ID(), roots["1*"].ID(), 100)
+
+	protocol.chainManager.ProcessCommitment(commitments["1"])
+	protocol.chainManager.ProcessCommitment(commitments["1*"])
+	requireForkEvaluation(t, protocol)
+
+	// the attestations of the main chain prove the claimed weight but they are not the ones committed by the fork
+	protocol.ProcessAttestations(commitments["1*"], roots["1*"], []*notarization.Attestation{mainAttestation}, identity.ID{})
+	require.Nil(t, protocol.CandidateEngine())
+	requireInvalidAttestations(t, invalidAttestations, commitments["1*"])
+
+	// the roots of the main chain do not belong to the forking commitment
+	forkingCommitment, _ := protocol.chainManager.Commitment(commitments["1*"].ID())
+	protocol.onForkDetected(forkingCommitment.Chain())
+	requireForkEvaluation(t, protocol)
+	protocol.ProcessAttestations(commitments["1*"], roots["1"], []*notarization.Attestation{mainAttestation}, identity.ID{})
+	require.Nil(t, protocol.CandidateEngine())
+	requireInvalidAttestations(t, invalidAttestations, commitments["1*"])
+}
+
+func TestProtocol_Warpsync(t *testing.T) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	epoch.GenesisTime = time.Now().Unix() - epoch.Duration*15
+
+	testNetwork := network.NewMockedNetwork()
+	tempDir := utils.NewDirectory(t.TempDir())
+
+	identitiesWeights := map[identity.ID]uint64{
+		identity.New(ed25519.GenerateKeyPair().PublicKey).ID(): 100,
+	}
+
+	snapshotcreator.CreateSnapshot(DatabaseVersion, tempDir.Path("snapshot.bin"), 100, make([]byte, 32), identitiesWeights, lo.Keys(identitiesWeights))
+
+	protocol := New(testNetwork.Join(identity.GenerateIdentity().ID()), WithBaseDirectory(tempDir.Path()), WithSnapshotPath(tempDir.Path("snapshot.bin")), WithWarpsyncOptions(
+		warpsync.WithSyncRangeTimeout(500*time.Millisecond),
+	))
+	protocol.Run()
+
+	t.Cleanup(func() {
+		protocol.Shutdown()
+		protocol.CongestionControl.WorkerPool().ShutdownComplete.Wait()
+		for _, pool := range protocol.Engine().WorkerPools() {
+			pool.ShutdownComplete.Wait()
+		}
+	})
+
+	warpsyncTargets := make(chan *commitment.Commitment, 10)
+	protocol.Events.WarpsyncStarted.Hook(event.NewClosure(func(targetCommitment *commitment.Commitment) {
+		warpsyncTargets <- targetCommitment
+	}))
+
+	genesisCommitment := protocol.Engine().Storage.Settings.LatestCommitment()
+	commitments := make(map[string]*commitment.Commitment)
+	commitments["1"] = commitment.New(1, genesisCommitment.ID(), types.Identifier{1}, 0)
+	commitments["2"] = commitment.New(2, commitments["1"].ID(), types.Identifier{2}, 0)
+	commitments["3"] = commitment.New(3, commitments["2"].ID(), types.Identifier{3}, 0)
+	for _, alias := range []string{"1", "2", "3"} {
+		protocol.chainManager.ProcessCommitment(commitments[alias])
+	}
+
+	// the peer never answers the requests, so the warpsync fails after the timeout of the sync range
+	peerID := identity.GenerateIdentity().ID()
+	testNetwork.Join(peerID)
+
+	newBlock := func(issuingTime time.Time) *models.Block {
+		block := models.NewBlock(
+			models.WithIssuingTime(issuingTime),
+			models.WithCommitment(commitments["3"]),
+			models.WithStrongParents(models.NewBlockIDs(models.EmptyBlockID)),
+		)
+		require.NoError(t, block.DetermineID())
+
+		return block
+	}
+
+	// blocks that commit to the same epoch only trigger a single warpsync
+	for i := 0; i < 5; i++ {
+		require.NoError(t, protocol.ProcessBlock(newBlock(time.Now().Add(time.Duration(i)*time.Millisecond)), peerID))
+	}
+
+	select {
+	case targetCommitment := <-warpsyncTargets:
+		require.Equal(t, commitments["3"].ID(), targetCommitment.ID())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the warpsync was not started")
+	}
+
+	require.Eventually(t, func() bool {
+		protocol.warpsyncMutex.Lock()
+		defer protocol.warpsyncMutex.Unlock()
+
+		return !protocol.warpsyncRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, warpsyncTargets)
+
+	// a failed warpsync can be retried
+	require.NoError(t, protocol.ProcessBlock(newBlock(time.Now()), peerID))
+
+	select {
+	case targetCommitment := <-warpsyncTargets:
+		require.Equal(t, commitments["3"].ID(), targetCommitment.ID())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the failed warpsync was not retried")
+	}
+}
+
 func TestEngine_NonEmptyInitialValidators(t *testing.T) {
 	debug.SetEnabled(true)
 	defer debug.SetEnabled(false)
@@ -260,6 +455,18 @@ func TestEngine_BlocksForwardAndRollback(t *testing.T) {
 		return tf.Engine.Storage.Settings.LatestCommitment().Index() == epoch.Index(4)
 	}, time.Second, 100*time.Millisecond)
 
+	// Accepted blocks of committed epochs can be proven against the commitment of their epoch.
+	{
+		proof, err := tf.Engine.NotarizationManager.BlockInclusionProof(tf.Tangle.BlockDAGTestFramework.Block("1.A").ID())
+		require.NoError(t, err)
+		require.Equal(t, lo.PanicOnErr(tf.Engine.Storage.Commitments.Load(1)).ID(), proof.Commitment.ID())
+		require.NoError(t, proof.VerifyBlock(tf.Tangle.BlockDAGTestFramework.Block("1.A").ID()))
+		require.Error(t, proof.VerifyBlock(tf.Tangle.BlockDAGTestFramework.Block("1.B").ID()))
+
+		_, err = tf.Engine.NotarizationManager.BlockInclusionProof(tf.Tangle.BlockDAGTestFramework.Block("11.A").ID())
+		require.Error(t, err)
+	}
+
 	// Dump snapshot for latest committable epoch 4 and check engine equivalence
 	{
 		require.NoError(t, tf.Engine.WriteSnapshot(tempDir.Path("snapshot_epoch4.bin")))
@@ -663,3 +870,263 @@ func TestEngine_ShutdownResume(t *testing.T) {
 
 	tf2.AssertEpochState(0)
 }
+
+func TestEngine_ImportLegacySnapshot(t *testing.T) {
+	tf := NewEngineTestFramework(t, WithStorage(storage.New(t.TempDir(), DatabaseVersion)))
+
+	// legacy snapshots start directly with the settings instead of the magic bytes of the header
+	legacySnapshotPath := filepath.Join(t.TempDir(), "legacy_snapshot.bin")
+	require.NoError(t, os.WriteFile(legacySnapshotPath, make([]byte, 64), 0o600))
+
+	require.ErrorContains(t, tf.Engine.Initialize(legacySnapshotPath), "legacy snapshot has database version 1")
+}
+
+func TestEngine_RollbackTo(t *testing.T) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	epoch.GenesisTime = time.Now().Unix() - epoch.Duration*15
+
+	// the engine uses the storage directory of the main engine of a protocol, so that a protocol can apply the rollback
+	protocolDir := utils.NewDirectory(t.TempDir())
+	storageInstance := storage.New(protocolDir.Path(mainBaseDir), DatabaseVersion, database.WithDBProvider(database.NewPebbleDB))
+
+	tf := NewEngineTestFramework(t, WithStorage(storageInstance), WithTangleOptions(
+		tangle.WithBookerOptions(
+			booker.WithMarkerManagerOptions(
+				markermanager.WithSequenceManagerOptions[models.BlockID, *booker.Block](markers.WithMaxPastMarkerDistance(1)),
+			),
+		),
+	))
+	tempDir := utils.NewDirectory(t.TempDir())
+
+	tf.Engine.NotarizationManager.Events.Error.Attach(event.NewClosure(func(err error) {
+		panic(err)
+	}))
+
+	identitiesMap := map[string]ed25519.PublicKey{
+		"A": identity.GenerateIdentity().PublicKey(),
+		"B": identity.GenerateIdentity().PublicKey(),
+		"C": identity.GenerateIdentity().PublicKey(),
+		"D": identity.GenerateIdentity().PublicKey(),
+		"Z": identity.GenerateIdentity().PublicKey(),
+	}
+
+	identitiesWeights := map[identity.ID]uint64{
+		identity.New(identitiesMap["A"]).ID(): 25,
+		identity.New(identitiesMap["B"]).ID(): 25,
+		identity.New(identitiesMap["C"]).ID(): 25,
+		identity.New(identitiesMap["D"]).ID(): 25,
+		identity.New(identitiesMap["Z"]).ID(): 0,
+	}
+
+	snapshotcreator.CreateSnapshot(DatabaseVersion, tempDir.Path("genesis_snapshot.bin"), 1, make([]byte, 32), identitiesWeights, lo.Keys(identitiesWeights))
+
+	require.NoError(t, tf.Engine.Initialize(tempDir.Path("genesis_snapshot.bin")))
+
+	// ///////////////////////////////////////////////////////////
+	// Accept a transaction in epoch 1 and commit epoch 4.
+	// ///////////////////////////////////////////////////////////
+
+	{
+		epoch1IssuingTime := time.Unix(epoch.GenesisTime, 0)
+		tf.Tangle.CreateBlock("1.Z", models.WithStrongParents(tf.Tangle.BlockIDs("Genesis")), models.WithPayload(tf.Tangle.CreateTransaction("Tx1", 2, "Genesis")), models.WithIssuer(identitiesMap["Z"]), models.WithIssuingTime(epoch1IssuingTime))
+		tf.Tangle.CreateBlock("1.A", models.WithStrongParents(tf.Tangle.BlockIDs("1.Z")), models.WithIssuer(identitiesMap["A"]), models.WithIssuingTime(epoch1IssuingTime))
+		tf.Tangle.CreateBlock("1.B", models.WithStrongParents(tf.Tangle.BlockIDs("1.A")), models.WithIssuer(identitiesMap["B"]), models.WithIssuingTime(epoch1IssuingTime))
+		tf.Tangle.CreateBlock("1.C", models.WithStrongParents(tf.Tangle.BlockIDs("1.B")), models.WithIssuer(identitiesMap["C"]), models.WithIssuingTime(epoch1IssuingTime))
+
+		epoch11IssuingTime := time.Unix(epoch.GenesisTime+epoch.Duration*10, 0)
+		tf.Tangle.CreateBlock("11.A", models.WithStrongParents(tf.Tangle.BlockIDs("1.C")), models.WithIssuer(identitiesMap["A"]), models.WithIssuingTime(epoch11IssuingTime))
+		tf.Tangle.CreateBlock("11.B", models.WithStrongParents(tf.Tangle.BlockIDs("11.A")), models.WithIssuer(identitiesMap["B"]), models.WithIssuingTime(epoch11IssuingTime))
+		tf.Tangle.CreateBlock("11.C", models.WithStrongParents(tf.Tangle.BlockIDs("11.B")), models.WithIssuer(identitiesMap["C"]), models.WithIssuingTime(epoch11IssuingTime))
+		tf.Tangle.IssueBlocks("1.Z", "1.A", "1.B", "1.C", "11.A", "11.B", "11.C")
+		tf.WaitUntilAllTasksProcessed()
+
+		require.Equal(t, epoch.Index(4), tf.Engine.Storage.Settings.LatestCommitment().Index())
+	}
+
+	// ///////////////////////////////////////////////////////////
+	// Spend an output of Tx1 in epoch 5 and commit epoch 5.
+	// ///////////////////////////////////////////////////////////
+
+	{
+		epoch5IssuingTime := time.Unix(epoch.GenesisTime+epoch.Duration*4, 0)
+		tf.Tangle.CreateBlock("5.Z", models.WithStrongParents(tf.Tangle.BlockIDs("1.C")), models.WithPayload(tf.Tangle.CreateTransaction("Tx5", 2, "Tx1.0")), models.WithIssuer(identitiesMap["Z"]), models.WithIssuingTime(epoch5IssuingTime))
+
+		epoch12IssuingTime := time.Unix(epoch.GenesisTime+epoch.Duration*11, 0)
+		tf.Tangle.CreateBlock("12.A", models.WithStrongParents(tf.Tangle.BlockIDs("5.Z", "11.C")), models.WithIssuer(identitiesMap["A"]), models.WithIssuingTime(epoch12IssuingTime))
+		tf.Tangle.CreateBlock("12.B", models.WithStrongParents(tf.Tangle.BlockIDs("12.A")), models.WithIssuer(identitiesMap["B"]), models.WithIssuingTime(epoch12IssuingTime))
+		tf.Tangle.CreateBlock("12.C", models.WithStrongParents(tf.Tangle.BlockIDs("12.B")), models.WithIssuer(identitiesMap["C"]), models.WithIssuingTime(epoch12IssuingTime))
+		tf.Tangle.IssueBlocks("5.Z", "12.A", "12.B", "12.C")
+		tf.WaitUntilAllTasksProcessed()
+
+		require.Equal(t, epoch.Index(5), tf.Engine.Storage.Settings.LatestCommitment().Index())
+		require.False(t, tf.Engine.LedgerState.UnspentOutputs.IDs.Has(tf.Tangle.OutputID("Tx1.0")))
+		require.True(t, tf.Engine.LedgerState.UnspentOutputs.IDs.Has(tf.Tangle.OutputID("Tx5.0")))
+
+		require.Error(t, tf.Engine.RollbackTo(4), "a running engine can not be rolled back")
+	}
+
+	// ///////////////////////////////////////////////////////////
+	// Stop the engine and roll it back to epoch 4 before restarting it.
+	// ///////////////////////////////////////////////////////////
+
+	{
+		expectedTotalBalance := tf.Engine.ThroughputQuota.TotalBalance()
+		expectedRootCommitment := lo.PanicOnErr(tf.Engine.Storage.Commitments.Load(4))
+
+		tf.Engine.Shutdown()
+		storageInstance.Shutdown()
+
+		storageInstance := storage.New(protocolDir.Path(mainBaseDir), DatabaseVersion, database.WithDBProvider(database.NewPebbleDB))
+
+		tf2 := NewEngineTestFramework(t, WithStorage(storageInstance), WithTangleOptions(tf.optsTangleOptions...))
+		require.Error(t, tf2.Engine.RollbackTo(5), "the latest committed epoch is not a valid target")
+
+		tf2.Engine.Shutdown()
+		storageInstance.Shutdown()
+
+		require.NoError(t, os.WriteFile(protocolDir.Path(scheduledRollbackFile), epoch.Index(4).Bytes(), 0o600))
+
+		protocol := New(network.NewMockedNetwork().Join(identity.GenerateIdentity().ID()),
+			WithBaseDirectory(protocolDir.Path()),
+			WithStorageDatabaseManagerOptions(database.WithDBProvider(database.NewPebbleDB)),
+			WithEngineOptions(engine.WithTangleOptions(tf.optsTangleOptions...)),
+		)
+		t.Cleanup(protocol.Shutdown)
+
+		require.True(t, protocol.RollbackApplied(4))
+		require.Equal(t, expectedRootCommitment.ID(), protocol.chainManager.SnapshotCommitment.ID())
+
+		// the commitment and the prunable data of the abandoned epoch 5 were deleted
+		require.Error(t, lo.Return2(protocol.Engine().Storage.Commitments.Load(5)))
+		require.Nil(t, lo.PanicOnErr(protocol.Engine().Storage.Roots.Load(5)))
+
+		protocol.Run()
+
+		tf3 := NewEngineTestFramework(t, WithEngine(protocol.Engine()))
+		tf3.Engine.NotarizationManager.Events.Error.Attach(event.NewClosure(func(err error) {
+			panic(err)
+		}))
+		tf3.AssertEpochState(4)
+
+		require.True(t, tf3.Engine.LedgerState.UnspentOutputs.IDs.Has(tf.Tangle.OutputID("Tx1.0")))
+		require.True(t, tf3.Engine.LedgerState.UnspentOutputs.IDs.Has(tf.Tangle.OutputID("Tx1.1")))
+		require.False(t, tf3.Engine.LedgerState.UnspentOutputs.IDs.Has(tf.Tangle.OutputID("Tx5.0")))
+		require.False(t, tf3.Engine.LedgerState.UnspentOutputs.IDs.Has(tf.Tangle.OutputID("Tx5.1")))
+
+		require.Equal(t, expectedTotalBalance, tf3.Engine.ThroughputQuota.TotalBalance())
+
+		// ///////////////////////////////////////////////////////////
+		// Commit epoch 5 again with a different attestation.
+		// ///////////////////////////////////////////////////////////
+
+		epoch5IssuingTime := time.Unix(epoch.GenesisTime+epoch.Duration*4, 0)
+		tf3.Tangle.CreateBlock("5.A", models.WithStrongParents(tf3.Tangle.BlockIDs("Genesis")), models.WithIssuer(identitiesMap["A"]), models.WithIssuingTime(epoch5IssuingTime))
+
+		epoch12IssuingTime := time.Unix(epoch.GenesisTime+epoch.Duration*11, 0)
+		tf3.Tangle.CreateBlock("12.A", models.WithStrongParents(tf3.Tangle.BlockIDs("5.A")), models.WithIssuer(identitiesMap["A"]), models.WithIssuingTime(epoch12IssuingTime))
+		tf3.Tangle.CreateBlock("12.B", models.WithStrongParents(tf3.Tangle.BlockIDs("12.A")), models.WithIssuer(identitiesMap["B"]), models.WithIssuingTime(epoch12IssuingTime))
+		tf3.Tangle.CreateBlock("12.C", models.WithStrongParents(tf3.Tangle.BlockIDs("12.B")), models.WithIssuer(identitiesMap["C"]), models.WithIssuingTime(epoch12IssuingTime))
+		tf3.Tangle.IssueBlocks("5.A", "12.A", "12.B", "12.C")
+
+		// the blocks are also processed by the worker pools of the protocol, so we wait for the commitment explicitly
+		require.Eventually(t, func() bool {
+			return tf3.Engine.Storage.Settings.LatestCommitment().Index() == 5
+		}, 5*time.Second, 10*time.Millisecond)
+		tf3.WaitUntilAllTasksProcessed()
+
+		require.Equal(t, epoch.Index(5), tf3.Engine.NotarizationManager.Attestations.LastCommittedEpoch())
+		require.Equal(t, epoch.Index(5), tf3.Engine.LedgerState.UnspentOutputs.LastCommittedEpoch())
+
+		// only the attestation of the new chain is part of the epoch (the one of block 5.Z was discarded)
+		attestations := lo.PanicOnErr(tf3.Engine.NotarizationManager.Attestations.Get(5))
+		require.True(t, attestations.Has(identity.New(identitiesMap["A"]).ID()))
+		require.False(t, attestations.Has(identity.New(identitiesMap["Z"]).ID()))
+
+		roots := lo.PanicOnErr(tf3.Engine.Storage.Roots.Load(5))
+		require.NotNil(t, roots)
+		require.Equal(t, attestations.Root(), roots.AttestationsRoot())
+		require.Equal(t, roots.ID(), lo.PanicOnErr(tf3.Engine.Storage.Commitments.Load(5)).RootsID())
+	}
+}
+
+// newForkEvaluationTestProtocol returns a running protocol whose genesis snapshot gives a weight of 100 to the returned
+// validator.
+func newForkEvaluationTestProtocol(t *testing.T) (protocol *Protocol, validator ed25519.KeyPair) {
+	debug.SetEnabled(true)
+	t.Cleanup(func() { debug.SetEnabled(false) })
+
+	epoch.GenesisTime = time.Now().Unix() - epoch.Duration*15
+
+	testNetwork := network.NewMockedNetwork()
+	tempDir := utils.NewDirectory(t.TempDir())
+
+	validator = ed25519.GenerateKeyPair()
+	identitiesWeights := map[identity.ID]uint64{
+		identity.NewID(validator.PublicKey): 100,
+	}
+
+	snapshotcreator.CreateSnapshot(DatabaseVersion, tempDir.Path("snapshot.bin"), 100, make([]byte, 32), identitiesWeights, lo.Keys(identitiesWeights))
+
+	protocol = New(testNetwork.Join(identity.GenerateIdentity().ID()), WithBaseDirectory(tempDir.Path()), WithSnapshotPath(tempDir.Path("snapshot.bin")))
+	protocol.Run()
+
+	t.Cleanup(func() {
+		protocol.Shutdown()
+		protocol.CongestionControl.WorkerPool().ShutdownComplete.Wait()
+		for _, pool := range protocol.Engine().WorkerPools() {
+			pool.ShutdownComplete.Wait()
+		}
+	})
+
+	return protocol, validator
+}
+
+// requireForkEvaluation waits until the protocol evaluates a fork.
+func requireForkEvaluation(t *testing.T, protocol *Protocol) {
+	require.Eventually(t, func() bool {
+		protocol.evaluatedForkMutex.Lock()
+		defer protocol.evaluatedForkMutex.Unlock()
+
+		return protocol.evaluatedFork != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// invalidAttestationsEvents returns a channel that receives the InvalidAttestationsReceived events of the protocol.
+func invalidAttestationsEvents(protocol *Protocol) (invalidAttestations chan *InvalidAttestationsEvent) {
+	invalidAttestations = make(chan *InvalidAttestationsEvent, 1)
+	protocol.Events.InvalidAttestationsReceived.Hook(event.NewClosure(func(invalidAttestationsEvent *InvalidAttestationsEvent) {
+		invalidAttestations <- invalidAttestationsEvent
+	}))
+
+	return invalidAttestations
+}
+
+// requireInvalidAttestations checks that the attestations of the given commitment were rejected.
+func requireInvalidAttestations(t *testing.T, invalidAttestations chan *InvalidAttestationsEvent, rejectedCommitment *commitment.Commitment) {
+	select {
+	case invalidAttestationsEvent := <-invalidAttestations:
+		require.Equal(t, rejectedCommitment.ID(), invalidAttestationsEvent.Commitment.ID())
+	default:
+		t.Fatal("the attestations were not rejected")
+	}
+}
+
+// newTestRoots returns the roots of a commitment that commit to the given attestations.
+func newTestRoots(tangleRoot byte, attestations ...*notarization.Attestation) *commitment.Roots {
+	return commitment.NewRoots(types.Identifier{tangleRoot}, types.Identifier{}, notarization.AttestationsRoot(attestations), types.Identifier{}, types.Identifier{})
+}
+
+func newTestAttestation(keyPair ed25519.KeyPair, index epoch.Index, referencedCommitment *commitment.Commitment) *notarization.Attestation {
+	block := models.NewBlock(
+		models.WithIssuer(keyPair.PublicKey),
+		models.WithIssuingTime(index.StartTime()),
+		models.WithCommitment(referencedCommitment),
+		models.WithStrongParents(models.NewBlockIDs(models.EmptyBlockID)),
+	)
+
+	block.SetSignature(keyPair.PrivateKey.Sign(lo.PanicOnErr(block.SigningMessage())))
+
+	return notarization.NewAttestation(block)
+}
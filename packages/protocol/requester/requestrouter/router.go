@@ -0,0 +1,302 @@
+package requestrouter
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/iotaledger/hive.go/core/crypto"
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/generics/set"
+	"github.com/iotaledger/hive.go/core/identity"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/memstorage"
+)
+
+// region Router ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Router decides which neighbors are asked for a requested entity. The first request is sent to the neighbor that
+// sent us the entity referencing it, all following requests fan out to a growing random subset of the neighbors that
+// prefers neighbors with a high success rate.
+type Router[T epoch.IndexedID] struct {
+	neighborsFunc    func() []identity.ID
+	hints            *memstorage.EpochStorage[T, identity.ID]
+	requests         *memstorage.EpochStorage[T, *request]
+	neighborStats    map[identity.ID]*NeighborStats
+	mutex            sync.Mutex
+	lastEvictedEpoch epoch.Index
+	evictionMutex    sync.RWMutex
+
+	optsFanout    int
+	optsMaxFanout int
+}
+
+// New creates a new Router that selects the recipients of requests from the neighbors returned by the given function.
+// If the function is nil, all requests are broadcast.
+func New[T epoch.IndexedID](neighborsFunc func() []identity.ID, opts ...options.Option[Router[T]]) *Router[T] {
+	return options.Apply(&Router[T]{
+		neighborsFunc: neighborsFunc,
+		hints:         memstorage.NewEpochStorage[T, identity.ID](),
+		requests:      memstorage.NewEpochStorage[T, *request](),
+		neighborStats: make(map[identity.ID]*NeighborStats),
+
+		optsFanout:    2,
+		optsMaxFanout: 8,
+	}, opts)
+}
+
+// RegisterHint registers the neighbor that referenced the given entity (the first hint for an entity wins).
+func (r *Router[T]) RegisterHint(id T, source identity.ID) {
+	r.evictionMutex.RLock()
+	defer r.evictionMutex.RUnlock()
+
+	if id.Index() <= r.lastEvictedEpoch {
+		return
+	}
+
+	r.hints.Get(id.Index(), true).StoreIfAbsent(id, source)
+}
+
+// Route returns the neighbors that the next request for the given entity should be sent to. It returns nil (which
+// results in a broadcast) if the Router is not aware of the neighbors.
+func (r *Router[T]) Route(id T) (recipients []identity.ID) {
+	if r.neighborsFunc == nil {
+		return nil
+	}
+
+	neighbors := r.neighborsFunc()
+	if len(neighbors) == 0 {
+		return nil
+	}
+
+	r.evictionMutex.RLock()
+	defer r.evictionMutex.RUnlock()
+
+	// requests for entities of evicted epochs are not tracked
+	req := newRequest()
+	if id.Index() > r.lastEvictedEpoch {
+		req, _ = r.requests.Get(id.Index(), true).RetrieveOrCreate(id, newRequest)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if req.attempts++; req.attempts == 1 {
+		if hint, exists := r.hint(id); exists && containsNeighbor(neighbors, hint) {
+			recipients = []identity.ID{hint}
+		}
+	}
+
+	if recipients == nil {
+		recipients = r.selectNeighbors(neighbors, req, r.fanout(req.attempts))
+	}
+
+	for _, recipient := range recipients {
+		req.askedNeighbors.Add(recipient)
+		r.stats(recipient).Requests++
+	}
+
+	return recipients
+}
+
+// RegisterResponse registers that the given neighbor sent us the requested entity.
+func (r *Router[T]) RegisterResponse(id T, source identity.ID) {
+	r.evictionMutex.RLock()
+	defer r.evictionMutex.RUnlock()
+
+	storage := r.requests.Get(id.Index())
+	if storage == nil {
+		return
+	}
+
+	req, exists := storage.Get(id)
+	if !exists {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if req.askedNeighbors.Has(source) && req.respondedNeighbors.Add(source) {
+		r.stats(source).Responses++
+	}
+}
+
+// StopRequest removes the state of the request for the given entity (after it was received or the request failed).
+func (r *Router[T]) StopRequest(id T) {
+	r.evictionMutex.RLock()
+	defer r.evictionMutex.RUnlock()
+
+	if storage := r.requests.Get(id.Index()); storage != nil {
+		storage.Delete(id)
+	}
+}
+
+// NeighborStats returns a snapshot of the request statistics of all neighbors that were asked for entities.
+func (r *Router[T]) NeighborStats() (neighborStats map[identity.ID]NeighborStats) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	neighborStats = make(map[identity.ID]NeighborStats, len(r.neighborStats))
+	for neighborID, stats := range r.neighborStats {
+		neighborStats[neighborID] = *stats
+	}
+
+	return neighborStats
+}
+
+// RemoveNeighbor removes the request statistics of the given neighbor (after it was dropped).
+func (r *Router[T]) RemoveNeighbor(neighborID identity.ID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.neighborStats, neighborID)
+}
+
+// EvictUntil removes the hints and the requests of all entities up to (and including) the given epoch.
+func (r *Router[T]) EvictUntil(index epoch.Index) {
+	r.evictionMutex.Lock()
+	defer r.evictionMutex.Unlock()
+
+	for currentIndex := r.lastEvictedEpoch + 1; currentIndex <= index; currentIndex++ {
+		r.hints.Evict(currentIndex)
+		r.requests.Evict(currentIndex)
+	}
+
+	if index > r.lastEvictedEpoch {
+		r.lastEvictedEpoch = index
+	}
+}
+
+// hint returns the neighbor that referenced the given entity.
+func (r *Router[T]) hint(id T) (hint identity.ID, exists bool) {
+	if storage := r.hints.Get(id.Index()); storage != nil {
+		return storage.Get(id)
+	}
+
+	return hint, false
+}
+
+// fanout returns the amount of neighbors that are asked in the given attempt (it doubles with every attempt).
+func (r *Router[T]) fanout(attempt int) int {
+	if attempt <= 2 {
+		return r.optsFanout
+	}
+
+	return int(math.Min(float64(r.optsFanout)*math.Exp2(float64(attempt-2)), float64(r.optsMaxFanout)))
+}
+
+// selectNeighbors selects count neighbors that were not asked before (if possible), where neighbors with a high
+// success rate are more likely to be selected (weighted random sampling by Efraimidis and Spirakis).
+func (r *Router[T]) selectNeighbors(neighbors []identity.ID, req *request, count int) (selected []identity.ID) {
+	type candidate struct {
+		id     identity.ID
+		asked  bool
+		weight float64
+	}
+
+	candidates := make([]*candidate, len(neighbors))
+	for i, neighborID := range neighbors {
+		candidates[i] = &candidate{
+			id:     neighborID,
+			asked:  req.askedNeighbors.Has(neighborID),
+			weight: math.Pow(crypto.Randomness.Float64(), 1/r.stats(neighborID).SuccessRate()),
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].asked != candidates[j].asked {
+			return !candidates[i].asked
+		}
+
+		return candidates[i].weight > candidates[j].weight
+	})
+
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	selected = make([]identity.ID, count)
+	for i := range selected {
+		selected[i] = candidates[i].id
+	}
+
+	return selected
+}
+
+// stats returns the statistics of the given neighbor (and creates them if they don't exist, yet).
+func (r *Router[T]) stats(neighborID identity.ID) (stats *NeighborStats) {
+	stats, exists := r.neighborStats[neighborID]
+	if !exists {
+		stats = new(NeighborStats)
+		r.neighborStats[neighborID] = stats
+	}
+
+	return stats
+}
+
+func containsNeighbor(neighbors []identity.ID, neighborID identity.ID) bool {
+	for _, id := range neighbors {
+		if id == neighborID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NeighborStats ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NeighborStats contains the statistics of the requests that were sent to a neighbor.
+type NeighborStats struct {
+	// Requests is the amount of requests that were sent to the neighbor.
+	Requests uint64
+	// Responses is the amount of requests that were answered by the neighbor.
+	Responses uint64
+}
+
+// SuccessRate returns the (smoothed) ratio of answered requests, which is 0.5 for neighbors that were never asked.
+func (n NeighborStats) SuccessRate() float64 {
+	return (float64(n.Responses) + 1) / (float64(n.Requests) + 2)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region request //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// request contains the state of the requests for a single entity.
+type request struct {
+	attempts           int
+	askedNeighbors     set.Set[identity.ID]
+	respondedNeighbors set.Set[identity.ID]
+}
+
+func newRequest() *request {
+	return &request{
+		askedNeighbors:     set.New[identity.ID](),
+		respondedNeighbors: set.New[identity.ID](),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithFanout sets the amount of neighbors that are asked once the referencing neighbor did not answer.
+func WithFanout[T epoch.IndexedID](fanout int) options.Option[Router[T]] {
+	return func(r *Router[T]) {
+		r.optsFanout = fanout
+	}
+}
+
+// WithMaxFanout sets the maximum amount of neighbors that are asked in a single attempt.
+func WithMaxFanout[T epoch.IndexedID](maxFanout int) options.Option[Router[T]] {
+	return func(r *Router[T]) {
+		r.optsMaxFanout = maxFanout
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,127 @@
+package requestrouter
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
+
+func TestRouter_Route(t *testing.T) {
+	neighbors := generateNeighbors(10)
+	router := New[models.BlockID](func() []identity.ID { return neighbors }, WithFanout[models.BlockID](2), WithMaxFanout[models.BlockID](5))
+
+	blockID := newBlockID(1)
+	router.RegisterHint(blockID, neighbors[3])
+	router.RegisterHint(blockID, neighbors[4])
+
+	// the first request is sent to the neighbor that referenced the block first
+	require.Equal(t, []identity.ID{neighbors[3]}, router.Route(blockID))
+
+	// the following requests fan out to a growing subset of the neighbors that were not asked before
+	asked := map[identity.ID]bool{neighbors[3]: true}
+	for _, expectedFanout := range []int{2, 4} {
+		recipients := router.Route(blockID)
+		require.Len(t, recipients, expectedFanout)
+
+		for _, recipient := range recipients {
+			require.False(t, asked[recipient], "neighbor %s was asked twice", recipient)
+			asked[recipient] = true
+		}
+	}
+
+	// the fanout is capped by the maximum fanout and neighbors that were already asked are only used to fill it up
+	recipients := router.Route(blockID)
+	require.Len(t, recipients, 5)
+	for _, recipient := range recipients[:3] {
+		require.False(t, asked[recipient], "neighbor %s was asked twice", recipient)
+	}
+	for _, recipient := range recipients[3:] {
+		require.True(t, asked[recipient], "neighbor %s was asked before the remaining neighbors", recipient)
+	}
+
+	// requests without a hint start with the fanout right away
+	require.Len(t, router.Route(newBlockID(2)), 2)
+}
+
+func TestRouter_RouteWithoutNeighbors(t *testing.T) {
+	blockID := newBlockID(1)
+
+	require.Nil(t, New[models.BlockID](nil).Route(blockID))
+	require.Nil(t, New[models.BlockID](func() []identity.ID { return nil }).Route(blockID))
+
+	// hints of neighbors that are no longer connected are ignored
+	neighbors := generateNeighbors(3)
+	router := New[models.BlockID](func() []identity.ID { return neighbors[:2] }, WithFanout[models.BlockID](1))
+	router.RegisterHint(blockID, neighbors[2])
+
+	recipients := router.Route(blockID)
+	require.Len(t, recipients, 1)
+	require.NotEqual(t, neighbors[2], recipients[0])
+}
+
+func TestRouter_NeighborStats(t *testing.T) {
+	neighbors := generateNeighbors(2)
+	router := New[models.BlockID](func() []identity.ID { return neighbors })
+
+	blockID := newBlockID(1)
+	router.RegisterHint(blockID, neighbors[0])
+	router.Route(blockID)
+
+	// responses are only counted once and only for neighbors that were asked
+	router.RegisterResponse(blockID, neighbors[0])
+	router.RegisterResponse(blockID, neighbors[0])
+	router.RegisterResponse(blockID, neighbors[1])
+
+	neighborStats := router.NeighborStats()
+	assert.Equal(t, NeighborStats{Requests: 1, Responses: 1}, neighborStats[neighbors[0]])
+	assert.NotContains(t, neighborStats, neighbors[1])
+	assert.Equal(t, float64(2)/3, neighborStats[neighbors[0]].SuccessRate())
+
+	// responses for stopped requests are ignored
+	router.StopRequest(blockID)
+	router.RegisterResponse(blockID, neighbors[0])
+	assert.Equal(t, NeighborStats{Requests: 1, Responses: 1}, router.NeighborStats()[neighbors[0]])
+
+	// the statistics of dropped neighbors are removed
+	router.RemoveNeighbor(neighbors[0])
+	assert.Empty(t, router.NeighborStats())
+}
+
+func TestRouter_EvictUntil(t *testing.T) {
+	neighbors := generateNeighbors(5)
+	router := New[models.BlockID](func() []identity.ID { return neighbors }, WithFanout[models.BlockID](3))
+
+	evictedBlockID := newBlockID(1)
+	router.RegisterHint(evictedBlockID, neighbors[0])
+	router.EvictUntil(1)
+
+	// hints of evicted epochs are dropped and not registered again
+	router.RegisterHint(evictedBlockID, neighbors[0])
+	require.Len(t, router.Route(evictedBlockID), 3)
+
+	blockID := newBlockID(2)
+	router.RegisterHint(blockID, neighbors[0])
+	require.Equal(t, []identity.ID{neighbors[0]}, router.Route(blockID))
+}
+
+func generateNeighbors(count int) (neighbors []identity.ID) {
+	for i := 0; i < count; i++ {
+		neighbors = append(neighbors, identity.GenerateIdentity().ID())
+	}
+
+	return neighbors
+}
+
+func newBlockID(index epoch.Index) models.BlockID {
+	var identifier types.Identifier
+	_ = identifier.FromRandomness()
+
+	return models.NewBlockID(identifier, ed25519.EmptySignature, index)
+}
@@ -1,45 +1,39 @@
 package warpsync
 
 import (
+	"context"
 	"sync"
+	"time"
 
+	"github.com/iotaledger/hive.go/core/generics/event"
 	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/iotaledger/hive.go/core/logger"
 	"github.com/iotaledger/hive.go/core/typeutils"
+	"github.com/pkg/errors"
 
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
-	"github.com/iotaledger/goshimmer/packages/network/p2p"
 	"github.com/iotaledger/goshimmer/packages/network/warpsync"
-	"github.com/iotaledger/goshimmer/packages/protocol/chainmanager"
-	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 )
 
-const minimumWindowSize = 10
+// EngineFunc defines a function that returns the engine that blocks are served from and processed by.
+type EngineFunc func() *engine.Engine
 
-// LoadBlockFunc defines a function that returns the block for the given id.
-type LoadBlockFunc func(models.BlockID) (*models.Block, bool)
-
-// ProcessBlockFunc defines a function that processes block's bytes from a given peer.
-type ProcessBlockFunc func(*p2p.Neighbor, *models.Block)
-
-// The Manager handles the connected neighbors.
+// The Manager requests whole epochs of blocks from peers and answers their requests for our epochs.
 type Manager struct {
-	protocol          *warpsync.Protocol
-	commitmentManager *chainmanager.Manager
+	protocol   *warpsync.Protocol
+	engineFunc EngineFunc
 
 	log *logger.Logger
 
 	active  typeutils.AtomicBool
 	stopped typeutils.AtomicBool
 
-	blockLoaderFunc    LoadBlockFunc
-	blockProcessorFunc ProcessBlockFunc
-
-	concurrency    int
-	blockBatchSize int
-
-	validationInProgress bool
-	validationLock       sync.RWMutex
+	concurrency      int
+	blockBatchSize   int
+	syncRangeTimeout time.Duration
 
 	syncingInProgress bool
 	syncingLock       sync.RWMutex
@@ -52,6 +46,7 @@ type Manager struct {
 
 type epochChannels struct {
 	sync.RWMutex
+	peer      identity.ID
 	startChan chan *epochSyncStart
 	blockChan chan *epochSyncBlock
 	endChan   chan *epochSyncEnd
@@ -60,48 +55,39 @@ type epochChannels struct {
 }
 
 // NewManager creates a new Manager.
-func NewManager(blockLoaderFunc LoadBlockFunc, blockProcessorFunc ProcessBlockFunc, log *logger.Logger, opts ...options.Option[Manager]) *Manager {
-	m := &Manager{
-		log:                log,
-		blockLoaderFunc:    blockLoaderFunc,
-		blockProcessorFunc: blockProcessorFunc,
-	}
-
-	options.Apply(m, opts)
-
-	return m
-}
-
-// WithConcurrency allows to set how many epochs can be requested at once.
-func WithConcurrency(concurrency int) options.Option[Manager] {
-	return func(m *Manager) {
-		m.concurrency = concurrency
-	}
+func NewManager(protocol *warpsync.Protocol, engineFunc EngineFunc, log *logger.Logger, opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
+		protocol:         protocol,
+		engineFunc:       engineFunc,
+		log:              log,
+		concurrency:      10,
+		blockBatchSize:   100,
+		syncRangeTimeout: 5 * time.Minute,
+	}, opts, func(m *Manager) {
+		m.protocol.Events.EpochBlocksRequestReceived.Attach(event.NewClosure(m.processEpochBlocksRequest))
+		m.protocol.Events.EpochBlocksStart.Attach(event.NewClosure(m.processEpochBlocksStart))
+		m.protocol.Events.EpochBlock.Attach(event.NewClosure(m.processEpochBlock))
+		m.protocol.Events.EpochBlocksEnd.Attach(event.NewClosure(m.processEpochBlocksEnd))
+	})
 }
 
-// WithBlockBatchSize allows to set the size of the block batch returned as part of epoch blocks response.
-func WithBlockBatchSize(blockBatchSize int) options.Option[Manager] {
-	return func(m *Manager) {
-		m.blockBatchSize = blockBatchSize
-	}
-}
-
-/*
-func (m *Manager) WarpRange(ctx context.Context, start, end epoch.Index, startEC commitment.ID, endPrevEC commitment.ID) (err error) {
+// WarpRange requests the blocks of all epochs in the range (start, end] from the given peers, verifies them against
+// the commitments in the ecChain and hands them to the engine.
+func (m *Manager) WarpRange(ctx context.Context, start, end epoch.Index, ecChain map[epoch.Index]*commitment.Commitment, peers ...identity.ID) (err error) {
 	if m.IsStopped() {
 		return errors.Errorf("warpsync manager is stopped")
 	}
 
 	if m.active.IsSet() {
-		m.log.Debugf("WarpRange: already syncing or validating")
+		m.log.Debugf("WarpRange: already syncing")
 		return nil
 	}
 
 	m.Lock()
 	defer m.Unlock()
 
-	// Skip warpsyncing if the requested range overlaps with a previous run.
-	if end-m.successfulSyncEpoch < minimumWindowSize {
+	// Skip warpsyncing if the requested range was already covered by a previous run.
+	if end <= m.successfulSyncEpoch {
 		m.log.Debugf("WarpRange: already synced to %d", m.successfulSyncEpoch)
 		return nil
 	}
@@ -109,28 +95,53 @@ func (m *Manager) WarpRange(ctx context.Context, start, end epoch.Index, startEC
 	m.active.Set()
 	defer m.active.UnSet()
 
-	m.log.Infof("warpsyncing range %d-%d on chain %s -> %s", start, end, startEC.Base58(), endPrevEC.Base58())
+	ctx, cancel := context.WithTimeout(ctx, m.syncRangeTimeout)
+	defer cancel()
 
-	lowestProcessedEpoch, syncRangeErr := m.syncRange(ctx, start, end, startEC, ecChain, validPeers)
-	if syncRangeErr != nil {
-		return errors.Wrapf(syncRangeErr, "failed to sync range %d-%d with peers %s", start, end, validPeers)
+	m.log.Infof("warpsyncing range %d-%d on chain %s", start, end, ecChain[end].ID())
+
+	completedEpoch, syncRangeErr := m.syncRange(ctx, start, end, ecChain, peers)
+	if completedEpoch > m.successfulSyncEpoch {
+		m.successfulSyncEpoch = completedEpoch
 	}
 
-	m.log.Infof("range %d-%d synced", start, lowestProcessedEpoch)
+	if syncRangeErr != nil {
+		return errors.Wrapf(syncRangeErr, "failed to sync range %d-%d with peers %s", start, end, peers)
+	}
 
-	m.successfulSyncEpoch = lowestProcessedEpoch + 1
+	m.log.Infof("range %d-%d synced", start, completedEpoch)
 
 	return nil
 }
-*/
 
 // IsStopped returns true if the manager is stopped.
 func (m *Manager) IsStopped() bool {
 	return m.stopped.IsSet()
 }
 
-// Stop stops the manager and closes all established connections.
+// Stop stops the manager and unregisters the underlying protocol.
 func (m *Manager) Stop() {
 	m.stopped.Set()
 	m.protocol.Stop()
 }
+
+// WithConcurrency allows to set how many epochs can be requested at once.
+func WithConcurrency(concurrency int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.concurrency = concurrency
+	}
+}
+
+// WithBlockBatchSize allows to set the size of the block batch returned as part of epoch blocks response.
+func WithBlockBatchSize(blockBatchSize int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.blockBatchSize = blockBatchSize
+	}
+}
+
+// WithSyncRangeTimeout allows to set the time after which a sync range is considered as failed.
+func WithSyncRangeTimeout(syncRangeTimeout time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.syncRangeTimeout = syncRangeTimeout
+	}
+}
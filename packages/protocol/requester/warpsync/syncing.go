@@ -3,23 +3,18 @@ package warpsync
 import (
 	"context"
 
-	"github.com/celestiaorg/smt"
-	"github.com/iotaledger/hive.go/core/autopeering/peer"
 	"github.com/iotaledger/hive.go/core/generics/dataflow"
-	"github.com/iotaledger/hive.go/core/generics/lo"
-	"github.com/iotaledger/hive.go/core/generics/orderedmap"
 	"github.com/iotaledger/hive.go/core/generics/set"
 	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
 	"github.com/iotaledger/hive.go/core/types"
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/blake2b"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/iotaledger/goshimmer/packages/core/ads"
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
-	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
-	"github.com/iotaledger/goshimmer/packages/network/p2p"
-	wp "github.com/iotaledger/goshimmer/packages/network/warpsync/proto"
+	"github.com/iotaledger/goshimmer/packages/network/warpsync"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
@@ -33,7 +28,7 @@ type epochSyncBlock struct {
 	ei    epoch.Index
 	ec    commitment.ID
 	block *models.Block
-	peer  *peer.Peer
+	peer  identity.ID
 }
 
 type epochSyncEnd struct {
@@ -42,14 +37,13 @@ type epochSyncEnd struct {
 	roots *commitment.Roots
 }
 
-type blockReceived struct {
-	block *models.Block
-	peer  *peer.Peer
-}
-
-func (m *Manager) syncRange(ctx context.Context, start, end epoch.Index, startEC commitment.ID, ecChain map[epoch.Index]commitment.ID, validPeers *orderedmap.OrderedMap[identity.ID, *p2p.Neighbor]) (completedEpoch epoch.Index, err error) {
+func (m *Manager) syncRange(ctx context.Context, start, end epoch.Index, ecChain map[epoch.Index]*commitment.Commitment, peers []identity.ID) (completedEpoch epoch.Index, err error) {
 	startRange := start + 1
-	endRange := end - 1
+	endRange := end
+
+	if startRange > endRange {
+		return start, nil
+	}
 
 	m.startSyncing(startRange, endRange)
 	defer m.endSyncing()
@@ -60,7 +54,7 @@ func (m *Manager) syncRange(ctx context.Context, start, end epoch.Index, startEC
 	epochProcessedChan := make(chan epoch.Index)
 	discardedPeers := set.NewAdvancedSet[identity.ID]()
 
-	workerFunc := m.syncEpochFunc(errCtx, eg, validPeers, discardedPeers, ecChain, epochProcessedChan)
+	workerFunc := m.syncEpochFunc(errCtx, eg, peers, discardedPeers, ecChain, epochProcessedChan)
 	completedEpoch = m.queueSlidingEpochs(errCtx, startRange, endRange, workerFunc, epochProcessedChan)
 
 	if err := eg.Wait(); err != nil {
@@ -69,24 +63,28 @@ func (m *Manager) syncRange(ctx context.Context, start, end epoch.Index, startEC
 	return completedEpoch, nil
 }
 
-func (m *Manager) syncEpochFunc(errCtx context.Context, eg *errgroup.Group, validPeers *orderedmap.OrderedMap[identity.ID, *p2p.Neighbor], discardedPeers *set.AdvancedSet[identity.ID], ecChain map[epoch.Index]commitment.ID, epochProcessedChan chan epoch.Index) func(targetEpoch epoch.Index) {
+func (m *Manager) syncEpochFunc(errCtx context.Context, eg *errgroup.Group, peers []identity.ID, discardedPeers *set.AdvancedSet[identity.ID], ecChain map[epoch.Index]*commitment.Commitment, epochProcessedChan chan epoch.Index) func(targetEpoch epoch.Index) {
 	return func(targetEpoch epoch.Index) {
 		eg.Go(func() (err error) {
-			if !validPeers.ForEach(func(peerID identity.ID, neighbor *p2p.Neighbor) (success bool) {
+			targetCommitment, exists := ecChain[targetEpoch]
+			if !exists {
+				return errors.Errorf("commitment of epoch %d is unknown", targetEpoch)
+			}
+
+			for _, peerID := range peers {
 				if discardedPeers.Has(peerID) {
 					m.log.Debugw("skipping discarded peer", "peer", peerID)
-					return true
+					continue
 				}
 
-				db, _ := database.NewMemDB("")
-				tangleTree := smt.NewSparseMerkleTree(db.NewStore(), db.NewStore(), lo.PanicOnErr(blake2b.New256(nil)))
+				success := false
 
-				epochChannels := m.startEpochSyncing(targetEpoch)
+				epochChannels := m.startEpochSyncing(targetEpoch, peerID)
 				epochChannels.RLock()
 
-				m.protocol.RequestEpochBlocks(targetEpoch, ecChain[targetEpoch], peerID)
+				m.protocol.RequestEpochBlocks(targetEpoch, targetCommitment.ID(), peerID)
 
-				err = dataflow.New(
+				if flowErr := dataflow.New(
 					m.epochStartCommand,
 					m.epochBlockCommand,
 					m.epochEndCommand,
@@ -102,32 +100,31 @@ func (m *Manager) syncEpochFunc(errCtx context.Context, eg *errgroup.Group, vali
 						return
 					case epochProcessedChan <- params.targetEpoch:
 					}
-					m.log.Infow("synced epoch", "epoch", params.targetEpoch, "peer", params.neighbor)
+					m.log.Infow("synced epoch", "epoch", params.targetEpoch, "peer", params.peer)
 				}).WithErrorCallback(func(flowErr error, params *syncingFlowParams) {
-					discardedPeers.Add(params.neighbor.ID())
-					m.log.Warnf("error while syncing epoch %d from peer %s: %s", params.targetEpoch, params.neighbor, flowErr)
+					discardedPeers.Add(params.peer)
+					m.log.Warnf("error while syncing epoch %d from peer %s: %s", params.targetEpoch, params.peer, flowErr)
 				}).Run(&syncingFlowParams{
-					ctx:           errCtx,
-					targetEpoch:   targetEpoch,
-					targetEC:      ecChain[targetEpoch],
-					targetPrevEC:  ecChain[targetEpoch-1],
-					epochChannels: epochChannels,
-					neighbor:      neighbor,
-					tangleTree:    tangleTree,
-					epochBlocks:   make(map[models.BlockID]*models.Block),
-				})
-
-				return !success
-			}) {
-				err = errors.Errorf("unable to sync epoch %d", targetEpoch)
+					ctx:              errCtx,
+					targetEpoch:      targetEpoch,
+					targetCommitment: targetCommitment,
+					epochChannels:    epochChannels,
+					peer:             peerID,
+					tangleTree:       ads.NewSet[models.BlockID](mapdb.NewMapDB()),
+					epochBlocks:      make(map[models.BlockID]*models.Block),
+				}); flowErr == nil && success {
+					return nil
+				}
 			}
 
-			return
+			return errors.Errorf("unable to sync epoch %d", targetEpoch)
 		})
 	}
 }
 
 func (m *Manager) queueSlidingEpochs(errCtx context.Context, startRange, endRange epoch.Index, workerFunc func(epoch.Index), epochProcessedChan chan epoch.Index) (completedEpoch epoch.Index) {
+	completedEpoch = startRange - 1
+
 	processedEpochs := make(map[epoch.Index]types.Empty)
 	for ei := startRange; ei < startRange+epoch.Index(m.concurrency) && ei <= endRange; ei++ {
 		workerFunc(ei)
@@ -178,7 +175,7 @@ func (m *Manager) endSyncing() {
 	m.epochsChannels = nil
 }
 
-func (m *Manager) startEpochSyncing(ei epoch.Index) (epochChannels *epochChannels) {
+func (m *Manager) startEpochSyncing(ei epoch.Index, peer identity.ID) (epochChannels *epochChannels) {
 	m.syncingLock.Lock()
 	defer m.syncingLock.Unlock()
 
@@ -187,6 +184,7 @@ func (m *Manager) startEpochSyncing(ei epoch.Index) (epochChannels *epochChannel
 	epochChannels.Lock()
 	defer epochChannels.Unlock()
 
+	epochChannels.peer = peer
 	epochChannels.startChan = make(chan *epochSyncStart, 1)
 	epochChannels.blockChan = make(chan *epochSyncBlock, 1)
 	epochChannels.endChan = make(chan *epochSyncEnd, 1)
@@ -212,163 +210,109 @@ func (m *Manager) endEpochSyncing(ei epoch.Index) {
 	close(epochChannels.endChan)
 }
 
-func (m *Manager) processEpochBlocksRequestPacket(packetEpochRequest *wp.Packet_EpochBlocksRequest, nbr *p2p.Neighbor) {
-	ei := epoch.Index(packetEpochRequest.EpochBlocksRequest.GetEI())
-	ec := new(commitment.Commitment)
-	if _, err := ec.FromBytes(packetEpochRequest.EpochBlocksRequest.GetEC()); err != nil {
-		m.log.Errorw("epoch blocks request rejected: unable to deserialize commitment", "err", err)
-		return
-	}
-	ecID := ec.ID()
-
-	// m.log.Debugw("received epoch blocks request", "peer", nbr.Peer.ID(), "Index", ei, "ID", ec)
+func (m *Manager) processEpochBlocksRequest(event *warpsync.EpochBlocksRequestReceivedEvent) {
+	engineInstance := m.engineFunc()
 
-	cm, _ := m.commitmentManager.Commitment(ecID)
-	if cm == nil {
-		m.log.Debugw("epoch blocks request rejected: unknown commitment", "peer", nbr.Peer.ID(), "Index", ei, "ID", ec)
+	if event.EI > engineInstance.Storage.Settings.LatestCommitment().Index() {
+		m.log.Debugw("epoch blocks request rejected: epoch not committed yet", "peer", event.ID, "Index", event.EI)
 		return
 	}
 
-	chain := cm.Chain()
-	if chain == nil {
-		m.log.Debugw("epoch blocks request rejected: unknown chain", "peer", nbr.Peer.ID(), "Index", ei, "ID", ec)
+	requestedCommitment, err := engineInstance.Storage.Commitments.Load(event.EI)
+	if err != nil || requestedCommitment.ID() != event.EC {
+		m.log.Debugw("epoch blocks request rejected: unknown commitment", "peer", event.ID, "Index", event.EI, "EC", event.EC)
 		return
 	}
 
-	blocksCount := chain.BlocksCount(ei)
-
-	// Send epoch starter.
-	m.protocol.SendEpochStarter(ei, ecID, blocksCount, nbr.ID())
-	m.log.Debugw("sent epoch start", "peer", nbr.Peer.ID(), "Index", ei, "blocksCount", blocksCount)
-
-	if err := chain.StreamEpochBlocks(ei, func(blocks []*models.Block) {
-		m.protocol.SendBlocksBatch(ei, ecID, blocks, nbr.ID())
-		m.log.Debugw("sent epoch blocks batch", "peer", nbr.ID(), "Index", ei, "blocksLen", len(blocks))
-	}, m.blockBatchSize); err != nil {
-		m.log.Errorw("epoch blocks request rejected: unable to stream blocks", "peer", nbr.Peer.ID(), "Index", ei, "ID", ec, "err", err)
+	roots, err := engineInstance.Storage.Roots.Load(event.EI)
+	if err != nil || roots == nil {
+		m.log.Debugw("epoch blocks request rejected: unknown roots", "peer", event.ID, "Index", event.EI, "EC", event.EC)
 		return
 	}
 
-	// Send epoch terminator.
-	// TODO: m.protocol.SendEpochEnd(ei, ec, commitment.Roots(), nbr.ID())
-	m.log.Debugw("sent epoch blocks end", "peer", nbr.ID(), "Index", ei, "ID", ecID.Base58())
-}
-
-func (m *Manager) processEpochBlocksStartPacket(packetEpochBlocksStart *wp.Packet_EpochBlocksStart, nbr *p2p.Neighbor) {
-	epochBlocksStart := packetEpochBlocksStart.EpochBlocksStart
-	ei := epoch.Index(epochBlocksStart.GetEI())
-
-	epochChannels := m.getEpochChannels(ei)
-	if epochChannels == nil {
+	blocks := make([]*models.Block, 0)
+	if err = engineInstance.Storage.Blocks.ForEachBlockInEpoch(event.EI, func(block *models.Block) bool {
+		blocks = append(blocks, block)
+		return true
+	}); err != nil {
+		m.log.Errorw("epoch blocks request rejected: unable to load blocks", "peer", event.ID, "Index", event.EI, "EC", event.EC, "err", err)
 		return
 	}
 
-	epochChannels.RLock()
-	defer epochChannels.RUnlock()
-
-	if !epochChannels.active {
-		return
-	}
+	// Send epoch starter.
+	m.protocol.SendEpochStarter(event.EI, event.EC, len(blocks), event.ID)
+	m.log.Debugw("sent epoch start", "peer", event.ID, "Index", event.EI, "blocksCount", len(blocks))
 
-	m.log.Debugw("received epoch blocks start", "peer", nbr.Peer.ID(), "Index", ei, "blocksCount", epochBlocksStart.GetBlocksCount())
+	for batchStart := 0; batchStart < len(blocks); batchStart += m.blockBatchSize {
+		batchEnd := batchStart + m.blockBatchSize
+		if batchEnd > len(blocks) {
+			batchEnd = len(blocks)
+		}
 
-	ec := new(commitment.Commitment)
-	if _, err := ec.FromBytes(epochBlocksStart.GetEC()); err != nil {
-		m.log.Errorw("received epoch blocks start: unable to deserialize commitment", "err", err)
-		return
+		m.protocol.SendBlocksBatch(event.EI, event.EC, blocks[batchStart:batchEnd], event.ID)
+		m.log.Debugw("sent epoch blocks batch", "peer", event.ID, "Index", event.EI, "blocksLen", batchEnd-batchStart)
 	}
 
-	epochChannels.startChan <- &epochSyncStart{
-		ei:          ei,
-		ec:          ec.ID(),
-		blocksCount: epochBlocksStart.GetBlocksCount(),
-	}
+	// Send epoch terminator.
+	m.protocol.SendEpochEnd(event.EI, event.EC, roots, event.ID)
+	m.log.Debugw("sent epoch blocks end", "peer", event.ID, "Index", event.EI, "EC", event.EC.Base58())
 }
 
-func (m *Manager) processEpochBlocksBatchPacket(packetEpochBlocksBatch *wp.Packet_EpochBlocksBatch, nbr *p2p.Neighbor) {
-	epochBlocksBatch := packetEpochBlocksBatch.EpochBlocksBatch
-	ei := epoch.Index(epochBlocksBatch.GetEI())
-
-	epochChannels := m.getEpochChannels(ei)
+func (m *Manager) processEpochBlocksStart(event *warpsync.EpochBlocksStartEvent) {
+	epochChannels := m.getEpochChannels(event.EI, event.ID)
 	if epochChannels == nil {
 		return
 	}
-
-	epochChannels.RLock()
 	defer epochChannels.RUnlock()
 
-	if !epochChannels.active {
-		return
-	}
-
-	blocksBytes := epochBlocksBatch.GetBlocks()
-	m.log.Debugw("received epoch blocks", "peer", nbr.Peer.ID(), "Index", ei, "blocksLen", len(blocksBytes))
-
-	for _, blockBytes := range blocksBytes {
-		block := new(models.Block)
-		if _, err := block.FromBytes(blockBytes); err != nil {
-			m.log.Errorw("failed to deserialize block", "peer", nbr.Peer.ID(), "err", err)
-			return
-		}
-
-		ec := new(commitment.Commitment)
-		if _, err := ec.FromBytes(epochBlocksBatch.GetEC()); err != nil {
-			m.log.Errorw("failed to deserialize commitment", "peer", nbr.Peer.ID(), "err", err)
-			return
-		}
-
-		select {
-		case <-epochChannels.stopChan:
-			return
-		case epochChannels.blockChan <- &epochSyncBlock{
-			ei:    ei,
-			ec:    ec.ID(),
-			peer:  nbr.Peer,
-			block: block,
-		}:
-		}
+	select {
+	case <-epochChannels.stopChan:
+	case epochChannels.startChan <- &epochSyncStart{
+		ei:          event.EI,
+		ec:          event.EC,
+		blocksCount: event.BlocksCount,
+	}:
 	}
 }
 
-func (m *Manager) processEpochBlocksEndPacket(packetEpochBlocksEnd *wp.Packet_EpochBlocksEnd, nbr *p2p.Neighbor) {
-	epochBlocksBatch := packetEpochBlocksEnd.EpochBlocksEnd
-	ei := epoch.Index(epochBlocksBatch.GetEI())
-
-	epochChannels := m.getEpochChannels(ei)
+func (m *Manager) processEpochBlock(event *warpsync.EpochBlockEvent) {
+	epochChannels := m.getEpochChannels(event.EI, event.ID)
 	if epochChannels == nil {
 		return
 	}
-
-	epochChannels.RLock()
 	defer epochChannels.RUnlock()
 
-	if !epochChannels.active {
-		return
+	select {
+	case <-epochChannels.stopChan:
+	case epochChannels.blockChan <- &epochSyncBlock{
+		ei:    event.EI,
+		ec:    event.EC,
+		peer:  event.ID,
+		block: event.Block,
+	}:
 	}
+}
 
-	m.log.Debugw("received epoch blocks end", "peer", nbr.Peer.ID(), "Index", ei)
-
-	ec := new(commitment.Commitment)
-	if _, err := ec.FromBytes(packetEpochBlocksEnd.EpochBlocksEnd.GetEC()); err != nil {
-		m.log.Errorw("received epoch blocks end: unable to deserialize commitment", "err", err)
+func (m *Manager) processEpochBlocksEnd(event *warpsync.EpochBlocksEndEvent) {
+	epochChannels := m.getEpochChannels(event.EI, event.ID)
+	if epochChannels == nil {
 		return
 	}
+	defer epochChannels.RUnlock()
 
-	epochSyncEnd := &epochSyncEnd{
-		ei:    ei,
-		ec:    ec.ID(),
-		roots: new(commitment.Roots),
-	}
-
-	if _, err := epochSyncEnd.roots.FromBytes(packetEpochBlocksEnd.EpochBlocksEnd.GetRoots()); err != nil {
-		m.log.Errorw("received epoch blocks end: unable to deserialize roots", "err", err)
-		return
+	select {
+	case <-epochChannels.stopChan:
+	case epochChannels.endChan <- &epochSyncEnd{
+		ei:    event.EI,
+		ec:    event.EC,
+		roots: event.Roots,
+	}:
 	}
-
-	epochChannels.endChan <- epochSyncEnd
 }
 
-func (m *Manager) getEpochChannels(ei epoch.Index) *epochChannels {
+// getEpochChannels returns the read-locked channels of the given epoch if it is currently being synced from the given
+// peer.
+func (m *Manager) getEpochChannels(ei epoch.Index, peer identity.ID) *epochChannels {
 	m.syncingLock.RLock()
 	defer m.syncingLock.RUnlock()
 
@@ -376,5 +320,16 @@ func (m *Manager) getEpochChannels(ei epoch.Index) *epochChannels {
 		return nil
 	}
 
-	return m.epochsChannels[ei]
+	epochChannels, exists := m.epochsChannels[ei]
+	if !exists {
+		return nil
+	}
+
+	epochChannels.RLock()
+	if !epochChannels.active || epochChannels.peer != peer {
+		epochChannels.RUnlock()
+		return nil
+	}
+
+	return epochChannels
 }
@@ -3,29 +3,28 @@ package warpsync
 import (
 	"context"
 
-	"github.com/celestiaorg/smt"
 	"github.com/iotaledger/hive.go/core/generics/dataflow"
-	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/types"
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/goshimmer/packages/core/ads"
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
-	"github.com/iotaledger/goshimmer/packages/network/p2p"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
 // syncingFlowParams is a container for parameters to be used in the warpsyncing of an epoch.
 type syncingFlowParams struct {
-	ctx             context.Context
-	targetEpoch     epoch.Index
-	targetEC        commitment.ID
-	targetPrevEC    commitment.ID
-	epochChannels   *epochChannels
-	neighbor        *p2p.Neighbor
-	tangleTree      *smt.SparseMerkleTree
-	epochBlocksLeft int64
-	epochBlocks     map[models.BlockID]*models.Block
-	roots           *commitment.Roots
+	ctx              context.Context
+	targetEpoch      epoch.Index
+	targetCommitment *commitment.Commitment
+	epochChannels    *epochChannels
+	peer             identity.ID
+	tangleTree       *ads.Set[models.BlockID, *models.BlockID]
+	epochBlocksLeft  int64
+	epochBlocks      map[models.BlockID]*models.Block
+	roots            *commitment.Roots
 }
 
 func (m *Manager) epochStartCommand(params *syncingFlowParams, next dataflow.Next[*syncingFlowParams]) (err error) {
@@ -63,15 +62,17 @@ func (m *Manager) epochBlockCommand(params *syncingFlowParams, next dataflow.Nex
 			}
 
 			block := epochBlock.block
+			if block.ID().Index() != params.targetEpoch {
+				return errors.Errorf("received block %s that is not part of epoch %d", block.ID(), params.targetEpoch)
+			}
+
 			if _, exists := params.epochBlocks[block.ID()]; exists {
 				return errors.Errorf("received duplicate block %s for epoch %d", block.ID(), params.targetEpoch)
 			}
 
-			m.log.Debugw("read block", "peer", params.neighbor, "Index", epochBlock.ei, "blockID", block.ID())
+			m.log.Debugw("read block", "peer", params.peer, "Index", epochBlock.ei, "blockID", block.ID())
 
-			if _, err := params.tangleTree.Update(lo.PanicOnErr(block.ID().Bytes()), lo.PanicOnErr(block.ID().Bytes())); err != nil {
-				return errors.Wrap(err, "error updating tangleTree")
-			}
+			params.tangleTree.Add(block.ID())
 			params.epochBlocks[block.ID()] = block
 			params.epochBlocksLeft--
 
@@ -105,21 +106,26 @@ func (m *Manager) epochEndCommand(params *syncingFlowParams, next dataflow.Next[
 }
 
 func (m *Manager) epochVerifyCommand(params *syncingFlowParams, next dataflow.Next[*syncingFlowParams]) (err error) {
-	// rootID := commitment.NewRootsID(commitment.NewMerkleRoot(params.tangleTree.Root()), params.stateMutationRoot, params.stateRoot, params.manaRoot)
-	//
-	// syncedECRecord := commitment.New(commitment.NewID(params.targetEpoch, rootID, params.targetPrevEC))
-	// syncedECRecord.PublishData(params.targetPrevEC, params.targetEpoch, rootID)
-	//
-	// if syncedECRecord.ID() != params.targetEC {
-	// 	return errors.Errorf("epoch %d EC record is not correct", params.targetEpoch)
-	// }
+	if params.roots.ID() != params.targetCommitment.RootsID() {
+		return errors.Errorf("roots of epoch %d do not match the commitment", params.targetEpoch)
+	}
+
+	tangleRoot := params.tangleTree.Root()
+	if len(params.epochBlocks) == 0 {
+		tangleRoot = types.Identifier{}
+	}
+
+	if tangleRoot != params.roots.TangleRoot() {
+		return errors.Errorf("blocks of epoch %d do not match the tangle root", params.targetEpoch)
+	}
 
 	return next(params)
 }
 
 func (m *Manager) epochProcessBlocksCommand(params *syncingFlowParams, next dataflow.Next[*syncingFlowParams]) (err error) {
-	for _, blk := range params.epochBlocks {
-		m.blockProcessorFunc(params.neighbor, blk)
+	engineInstance := m.engineFunc()
+	for _, block := range params.epochBlocks {
+		engineInstance.ProcessBlockFromPeer(block, params.peer)
 	}
 
 	return next(params)
@@ -129,7 +135,7 @@ func isOnTargetChain(ei epoch.Index, ec commitment.ID, params *syncingFlowParams
 	if ei != params.targetEpoch {
 		return false, errors.Errorf("received epoch %d while we expected epoch %d", ei, params.targetEpoch)
 	}
-	if ec != params.targetEC {
+	if ec != params.targetCommitment.ID() {
 		return false, errors.Errorf("received on wrong EC chain for epoch %d", params.targetEpoch)
 	}
 
@@ -102,7 +102,7 @@ func NewEngineTestFramework(test *testing.T, opts ...options.Option[EngineTestFr
 	}, opts, func(t *EngineTestFramework) {
 		if t.Engine == nil {
 			if t.optsStorage == nil {
-				t.optsStorage = storage.New(t.test.TempDir(), 1)
+				t.optsStorage = storage.New(t.test.TempDir(), DatabaseVersion)
 				test.Cleanup(t.optsStorage.Shutdown)
 			}
 
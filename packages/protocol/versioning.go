@@ -4,4 +4,6 @@ import (
 	"github.com/iotaledger/goshimmer/packages/core/database"
 )
 
-const DatabaseVersion database.Version = 1
+// DatabaseVersion is the version of the database and snapshot format of the node (version 2 added the public key of
+// the issuer to the attestations, which changed their encoding and the AttestationsRoot of the commitments).
+const DatabaseVersion database.Version = 2
@@ -55,6 +55,15 @@ func (c *Commitments) Load(index epoch.Index) (commitment *commitment.Commitment
 	return commitment, nil
 }
 
+// DeleteAfter deletes the commitments of all epochs after the given one.
+func (c *Commitments) DeleteAfter(index epoch.Index) (err error) {
+	if err = c.slice.Truncate(int(index) + 1); err != nil {
+		return errors.Wrapf(err, "failed to delete commitments after epoch %d", index)
+	}
+
+	return nil
+}
+
 func (c *Commitments) Close() (err error) {
 	return c.slice.Close()
 }
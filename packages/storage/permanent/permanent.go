@@ -16,8 +16,19 @@ const (
 	consensusWeightsPrefix
 	attestationsPrefix
 	throughputQuotaPrefix
+	indexerPrefix
 )
 
+// realmNames contains the names of the components that store their data in the realms of the permanent storage (the
+// indexer breaks down the usage of its realm itself).
+var realmNames = map[byte]string{
+	unspentOutputsPrefix:   "unspentOutputs",
+	unspentOutputIDsPrefix: "unspentOutputIDs",
+	consensusWeightsPrefix: "sybilProtection",
+	attestationsPrefix:     "attestations",
+	throughputQuotaPrefix:  "throughputQuota",
+}
+
 type Permanent struct {
 	Settings       *Settings
 	Commitments    *Commitments
@@ -27,6 +38,7 @@ type Permanent struct {
 	attestations     kvstore.KVStore
 	sybilProtection  kvstore.KVStore
 	throughputQuota  kvstore.KVStore
+	indexer          kvstore.KVStore
 }
 
 // New returns a new permanent storage instance.
@@ -40,6 +52,7 @@ func New(dir *utils.Directory, db *database.Manager) (p *Permanent) {
 		attestations:     lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{attestationsPrefix})),
 		sybilProtection:  lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{consensusWeightsPrefix})),
 		throughputQuota:  lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{throughputQuotaPrefix})),
+		indexer:          lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{indexerPrefix})),
 	}
 }
 
@@ -79,6 +92,31 @@ func (p *Permanent) ThroughputQuota(optRealm ...byte) kvstore.KVStore {
 	return lo.PanicOnErr(p.throughputQuota.WithExtendedRealm(optRealm))
 }
 
+// Indexer returns the storage of the address indexer (or a specialized sub-storage if a realm is provided).
+func (p *Permanent) Indexer(optRealm ...byte) kvstore.KVStore {
+	if len(optRealm) == 0 {
+		return p.indexer
+	}
+
+	return lo.PanicOnErr(p.indexer.WithExtendedRealm(optRealm))
+}
+
+// RealmName returns the name of the component that stores its data in the realm with the given prefix ("other" for data
+// that does not belong to a component).
+func RealmName(prefix byte) (name string) {
+	name, exists := realmNames[prefix]
+	if !exists {
+		return "other"
+	}
+
+	return name
+}
+
+// IsIndexerRealm returns true if the realm with the given prefix holds the data of the indexer.
+func IsIndexerRealm(prefix byte) (isIndexerRealm bool) {
+	return prefix == indexerPrefix
+}
+
 // SettingsAndCommitmentsSize returns the total size of the binary files.
 func (p *Permanent) SettingsAndCommitmentsSize() int64 {
 	var sum int64
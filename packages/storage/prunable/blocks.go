@@ -69,3 +69,32 @@ func (b *Blocks) Delete(id models.BlockID) (err error) {
 
 	return nil
 }
+
+// ForEachBlockInEpoch calls the consumer for every block that is stored in the given epoch.
+func (b *Blocks) ForEachBlockInEpoch(index epoch.Index, consumer func(block *models.Block) bool) (err error) {
+	storage := b.Storage(index)
+	if storage == nil {
+		return errors.Errorf("storage does not exist for epoch %s", index)
+	}
+
+	if iterationErr := storage.Iterate([]byte{}, func(key kvstore.Key, value kvstore.Value) bool {
+		blockID := new(models.BlockID)
+		if _, err = blockID.FromBytes(key); err != nil {
+			err = errors.Wrapf(err, "failed to parse block id %s", key)
+			return false
+		}
+
+		block := new(models.Block)
+		if _, err = block.FromBytes(value); err != nil {
+			err = errors.Wrapf(err, "failed to parse block %s", blockID)
+			return false
+		}
+		block.SetID(*blockID)
+
+		return consumer(block)
+	}); iterationErr != nil {
+		return errors.Wrapf(iterationErr, "failed to iterate over blocks of epoch %s", index)
+	}
+
+	return err
+}
@@ -13,13 +13,35 @@ const (
 	rootBlocksPrefix
 	attestationsPrefix
 	ledgerStateDiffsPrefix
+	rootsPrefix
+	acceptedBlocksPrefix
+	acceptedTransactionsPrefix
 )
 
+// realmNames contains the names of the components that store their data in the realms of the prunable storage.
+var realmNames = map[byte]string{
+	blocksPrefix:               "blocks",
+	rootBlocksPrefix:           "rootBlocks",
+	attestationsPrefix:         "attestations",
+	ledgerStateDiffsPrefix:     "ledgerStateDiffs",
+	rootsPrefix:                "roots",
+	acceptedBlocksPrefix:       "acceptedBlocks",
+	acceptedTransactionsPrefix: "acceptedTransactions",
+}
+
 type Prunable struct {
 	Blocks           *Blocks
 	RootBlocks       *RootBlocks
 	Attestations     func(index epoch.Index) kvstore.KVStore
 	LedgerStateDiffs func(index epoch.Index) kvstore.KVStore
+	Roots            *Roots
+
+	// AcceptedBlocks holds the sets of accepted blocks of committed epochs (used to generate proofs of inclusion).
+	AcceptedBlocks func(index epoch.Index) kvstore.KVStore
+
+	// AcceptedTransactions holds the sets of accepted transactions of committed epochs (used to generate proofs of
+	// inclusion).
+	AcceptedTransactions func(index epoch.Index) kvstore.KVStore
 }
 
 func New(dbManager *database.Manager) (newPrunable *Prunable) {
@@ -28,5 +50,20 @@ func New(dbManager *database.Manager) (newPrunable *Prunable) {
 		RootBlocks:       NewRootBlocks(dbManager, rootBlocksPrefix),
 		Attestations:     lo.Bind([]byte{attestationsPrefix}, dbManager.Get),
 		LedgerStateDiffs: lo.Bind([]byte{ledgerStateDiffsPrefix}, dbManager.Get),
+		Roots:            NewRoots(dbManager, rootsPrefix),
+
+		AcceptedBlocks:       lo.Bind([]byte{acceptedBlocksPrefix}, dbManager.Get),
+		AcceptedTransactions: lo.Bind([]byte{acceptedTransactionsPrefix}, dbManager.Get),
 	}
 }
+
+// RealmName returns the name of the component that stores its data in the realm with the given prefix ("other" for data
+// that does not belong to a component).
+func RealmName(prefix byte) (name string) {
+	name, exists := realmNames[prefix]
+	if !exists {
+		return "other"
+	}
+
+	return name
+}
@@ -0,0 +1,64 @@
+package prunable
+
+import (
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+)
+
+// rootsKey is the key under which the roots are stored in the bucket of their epoch.
+var rootsKey = []byte{0}
+
+// Roots is the storage for the commitment roots of committed epochs.
+type Roots struct {
+	Storage func(index epoch.Index) kvstore.KVStore
+}
+
+// NewRoots creates a new Roots instance.
+func NewRoots(dbManager *database.Manager, storagePrefix byte) (newRoots *Roots) {
+	return &Roots{
+		Storage: lo.Bind([]byte{storagePrefix}, dbManager.Get),
+	}
+}
+
+// Store stores the roots of the given epoch.
+func (r *Roots) Store(index epoch.Index, roots *commitment.Roots) (err error) {
+	storage := r.Storage(index)
+	if storage == nil {
+		return errors.Errorf("storage does not exist for epoch %s", index)
+	}
+
+	if err = storage.Set(rootsKey, lo.PanicOnErr(roots.Bytes())); err != nil {
+		return errors.Wrapf(err, "failed to store roots for epoch %s", index)
+	}
+
+	return nil
+}
+
+// Load loads the roots of the given epoch.
+func (r *Roots) Load(index epoch.Index) (roots *commitment.Roots, err error) {
+	storage := r.Storage(index)
+	if storage == nil {
+		return nil, errors.Errorf("storage does not exist for epoch %s", index)
+	}
+
+	rootsBytes, err := storage.Get(rootsKey)
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get roots for epoch %s", index)
+	}
+
+	roots = new(commitment.Roots)
+	if _, err = roots.FromBytes(rootsBytes); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse roots for epoch %s", index)
+	}
+
+	return roots, nil
+}
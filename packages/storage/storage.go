@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"sync"
+
 	"github.com/iotaledger/hive.go/core/generics/event"
 	"github.com/iotaledger/hive.go/core/generics/options"
 
@@ -21,6 +23,10 @@ type Storage struct {
 
 	// databaseManager is the database manager.
 	databaseManager *database.Manager
+
+	// lastUsage is the most recently computed disk usage of the storage.
+	lastUsage      *Usage
+	lastUsageMutex sync.RWMutex
 }
 
 // New creates a new storage instance with the named database version in the given directory.
@@ -35,21 +41,58 @@ func New(directory string, version database.Version, opts ...options.Option[data
 	}
 }
 
+// DatabaseVersion returns the database schema version of the storage.
+func (s *Storage) DatabaseVersion() database.Version {
+	return s.databaseManager.Version()
+}
+
 // PruneUntilEpoch prunes storage epochs less than and equal to the given index.
 func (s *Storage) PruneUntilEpoch(epochIndex epoch.Index) {
 	s.databaseManager.PruneUntilEpoch(epochIndex)
 }
 
+// DeleteAfterEpoch deletes the commitments and the prunable data of all epochs after the given one (i.e. the data of
+// epochs that are rolled back and committed again).
+func (s *Storage) DeleteAfterEpoch(epochIndex epoch.Index) (err error) {
+	if err = s.Permanent.Commitments.DeleteAfter(epochIndex); err != nil {
+		return err
+	}
+
+	return s.databaseManager.DeleteAfterEpoch(epochIndex)
+}
+
+// MaxPrunedEpoch returns the latest epoch whose data was already pruned (-1 if nothing was pruned yet).
+func (s *Storage) MaxPrunedEpoch() epoch.Index {
+	return s.databaseManager.MaxPrunedEpoch()
+}
+
+// PrunableDatabaseInstances returns the base indexes of the prunable database instances that exist on disk (ordered
+// from the latest to the oldest) and the number of epochs that are stored in each of them.
+func (s *Storage) PrunableDatabaseInstances() (baseIndexes []epoch.Index, granularity int64) {
+	return s.databaseManager.PrunableDBBaseIndexes(), s.databaseManager.Granularity()
+}
+
 // PrunableDatabaseSize returns the size of the underlying prunable databases.
 func (s *Storage) PrunableDatabaseSize() int64 {
 	return s.databaseManager.PrunableStorageSize()
 }
 
+// PrunableDatabaseSizes returns the size on disk of each prunable database instance (by the first epoch that it holds).
+func (s *Storage) PrunableDatabaseSizes() map[epoch.Index]int64 {
+	return s.databaseManager.PrunableStorageSizes()
+}
+
 // PermanentDatabaseSize returns the size of the underlying permanent database and files.
 func (s *Storage) PermanentDatabaseSize() int64 {
 	return s.Permanent.SettingsAndCommitmentsSize() + s.databaseManager.PermanentStorageSize()
 }
 
+// GC runs the garbage collection of the databases of the storage whose providers require it (i.e. to reclaim the disk
+// space of pruned and deleted data).
+func (s *Storage) GC() (err error) {
+	return s.databaseManager.GC()
+}
+
 // Shutdown shuts down the storage.
 func (s *Storage) Shutdown() {
 	event.Loop.PendingTasksCounter.WaitIsZero()
@@ -39,3 +39,32 @@ func Test(t *testing.T) {
 
 	storage.Shutdown()
 }
+
+func TestStorage_Usage(t *testing.T) {
+	storage := New(t.TempDir(), 1)
+	defer storage.Shutdown()
+
+	storage.Settings.SetLatestStateMutationEpoch(1)
+	storage.Commitments.Store(commitment.New(0, commitment.ID{}, types.Identifier{}, 0))
+	require.NoError(t, storage.SybilProtection().Set([]byte{1}, []byte{1, 2}))
+	require.NoError(t, storage.Indexer().Set([]byte{1}, []byte{1, 2}))
+
+	// the indexer reports the usage of its realm itself
+	usage, err := storage.Usage(mockUsageReporter{"unspentOutputs": 3}, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, usage.Permanent["sybilProtection"])
+	require.NotContains(t, usage.Permanent, "indexer")
+	require.Equal(t, map[string]int64{"unspentOutputs": 3}, usage.Indexer)
+	require.Empty(t, usage.Retainer)
+	require.Equal(t, usage, storage.LastUsage())
+
+	_, err = storage.Usage(nil, mockUsageReporter{})
+	require.NoError(t, err)
+}
+
+// mockUsageReporter is a UsageReporter that reports a fixed usage.
+type mockUsageReporter map[string]int64
+
+func (m mockUsageReporter) Usage() (usage map[string]int64, err error) {
+	return m, nil
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/storage/permanent"
+	"github.com/iotaledger/goshimmer/packages/storage/prunable"
+)
+
+// UsageReporter is a component that breaks down the disk usage of its data by realm itself (i.e. because its data is not
+// stored in the realms of the components of the storage).
+type UsageReporter interface {
+	// Usage returns the size of the data of the component (by the names of its realms).
+	Usage() (usage map[string]int64, err error)
+}
+
+// Usage contains the disk usage of the storage broken down by the components that store their data in it.
+type Usage struct {
+	// Permanent contains the size of the data of the components of the permanent storage (by their name).
+	Permanent map[string]int64
+
+	// Prunable contains the size of the data of the components of the prunable storage (by their name).
+	Prunable map[string]int64
+
+	// PrunableBuckets contains the size on disk of the DB instances of the prunable storage (by the first epoch that
+	// they hold).
+	PrunableBuckets map[epoch.Index]int64
+
+	// Indexer contains the size of the data of the indexer in the permanent storage (by the names of its realms).
+	Indexer map[string]int64
+
+	// Retainer contains the size of the data of the retainer in its own databases (by the names of its realms).
+	Retainer map[string]int64
+
+	// ComputedAt is the time at which the computation of the usage started.
+	ComputedAt time.Time
+}
+
+// Usage returns the disk usage of the storage and of the given components (that are nil if they are disabled). The size
+// of the components is the size of their keys and values (without the compression and the overhead of the database
+// engine), so it requires to iterate over all stored data and should only be computed periodically (the result of the
+// most recent computation is cached, see LastUsage).
+func (s *Storage) Usage(indexer, retainer UsageReporter) (usage *Usage, err error) {
+	usage = &Usage{
+		Permanent: map[string]int64{
+			"settingsAndCommitments": s.Permanent.SettingsAndCommitmentsSize(),
+		},
+		Prunable:        make(map[string]int64),
+		PrunableBuckets: s.PrunableDatabaseSizes(),
+		Indexer:         make(map[string]int64),
+		Retainer:        make(map[string]int64),
+		ComputedAt:      time.Now(),
+	}
+
+	permanentSizes, err := s.databaseManager.PermanentPrefixSizes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute the usage of the permanent storage")
+	}
+	for prefix, size := range permanentSizes {
+		if !permanent.IsIndexerRealm(prefix) {
+			usage.Permanent[permanent.RealmName(prefix)] += size
+		}
+	}
+
+	prunableSizes, err := s.databaseManager.PrunablePrefixSizes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute the usage of the prunable storage")
+	}
+	for prefix, size := range prunableSizes {
+		usage.Prunable[prunable.RealmName(prefix)] += size
+	}
+
+	if indexer != nil {
+		if usage.Indexer, err = indexer.Usage(); err != nil {
+			return nil, errors.Wrap(err, "failed to compute the usage of the indexer")
+		}
+	}
+
+	if retainer != nil {
+		if usage.Retainer, err = retainer.Usage(); err != nil {
+			return nil, errors.Wrap(err, "failed to compute the usage of the retainer")
+		}
+	}
+
+	s.lastUsageMutex.Lock()
+	defer s.lastUsageMutex.Unlock()
+
+	s.lastUsage = usage
+
+	return usage, nil
+}
+
+// LastUsage returns the result of the most recent call to Usage (or nil if it was never computed).
+func (s *Storage) LastUsage() (usage *Usage) {
+	s.lastUsageMutex.RLock()
+	defer s.lastUsageMutex.RUnlock()
+
+	return s.lastUsage
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/core/daemon"
+	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/node"
 	"github.com/iotaledger/hive.go/core/timeutil"
 	"go.uber.org/dig"
@@ -13,6 +14,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 )
 
@@ -39,8 +41,9 @@ func configure(plugin *node.Plugin) {
 // broadcastActivityBlock broadcasts a sync beacon via communication layer.
 func broadcastActivityBlock() {
 	activityPayload := payload.NewGenericDataPayload([]byte("activity"))
+	activityWork := models.PayloadWork(len(lo.PanicOnErr(activityPayload.Bytes())))
 	for {
-		if estimate := deps.BlockIssuer.Estimate(); estimate > 0 {
+		if estimate := deps.BlockIssuer.EstimateWork(activityWork); estimate > 0 {
 			time.Sleep(estimate)
 		} else {
 			break
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/dashboardmetrics"
 	"github.com/iotaledger/goshimmer/plugins/faucet"
+	"github.com/iotaledger/goshimmer/plugins/firewall"
 	"github.com/iotaledger/goshimmer/plugins/gracefulshutdown"
 	"github.com/iotaledger/goshimmer/plugins/indexer"
 	"github.com/iotaledger/goshimmer/plugins/logger"
@@ -23,6 +24,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/profilingrecorder"
 	"github.com/iotaledger/goshimmer/plugins/protocol"
 	"github.com/iotaledger/goshimmer/plugins/retainer"
+	"github.com/iotaledger/goshimmer/plugins/snapshotter"
 	"github.com/iotaledger/goshimmer/plugins/spammer"
 	"github.com/iotaledger/goshimmer/plugins/warpsync"
 )
@@ -43,9 +45,10 @@ var Core = node.Plugins(
 	p2p.Plugin,
 	protocol.Plugin,
 	retainer.Plugin,
+	snapshotter.Plugin,
 	indexer.Plugin,
 	warpsync.Plugin,
-	// firewall.Plugin,
+	firewall.Plugin,
 	faucet.Plugin,
 	dashboardmetrics.Plugin,
 	metrics.Plugin,
@@ -17,7 +17,6 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
-	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm/indexer"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 
@@ -274,18 +273,17 @@ func findAddress(strAddress string) (*ExplorerAddress, error) {
 	outputs := make([]ExplorerOutput, 0)
 
 	// get outputids by address
-	// deps.Indexer.CachedOutputsOnAddress(address).Consume(func(output ledgerstate.Output) {
-	deps.Indexer.CachedAddressOutputMappings(address).Consume(func(addressOutputMapping *indexer.AddressOutputMapping) {
+	if err = deps.Indexer.StreamUnspentOutputIDs(address, func(outputID utxo.OutputID) bool {
 		var metaData *ledger.OutputMetadata
 		var timestamp int64
 
 		// get output metadata + confirmation status from conflict of the output
-		deps.Protocol.Engine().Ledger.Storage.CachedOutputMetadata(addressOutputMapping.OutputID()).Consume(func(outputMetadata *ledger.OutputMetadata) {
+		deps.Protocol.Engine().Ledger.Storage.CachedOutputMetadata(outputID).Consume(func(outputMetadata *ledger.OutputMetadata) {
 			metaData = outputMetadata
 		})
 
 		var txID utxo.TransactionID
-		deps.Protocol.Engine().Ledger.Storage.CachedOutput(addressOutputMapping.OutputID()).Consume(func(output utxo.Output) {
+		deps.Protocol.Engine().Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
 			if output, ok := output.(devnetvm.Output); ok {
 				// get the inclusion state info from the transaction that created this output
 				txID = output.ID().TransactionID
@@ -308,7 +306,11 @@ func findAddress(strAddress string) (*ExplorerAddress, error) {
 				})
 			}
 		})
-	})
+
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve outputs of address %s", strAddress)
+	}
 
 	if len(outputs) == 0 {
 		return nil, errors.WithMessagef(ErrNotFound, "address %s", strAddress)
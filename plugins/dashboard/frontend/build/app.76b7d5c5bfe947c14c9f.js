@@ -0,0 +1 @@
+(window.webpackJsonp=window.webpackJsonp||[]).push([[0],{287:function(e,t){e.exports="data:image/svg+xml;base64,PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCFET0NUWVBFIHN2ZyBQVUJMSUMgIi0vL1czQy8vRFREIFNWRyAxLjEvL0VOIiAiaHR0cDovL3d3dy53My5vcmcvR3JhcGhpY3MvU1ZHLzEuMS9EVEQvc3ZnMTEuZHRkIj4KPHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHhtbG5zOnhsaW5rPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5L3hsaW5rIiB2ZXJzaW9uPSIxLjEiIHdpZHRoPSIyMjFweCIgaGVpZ2h0PSIyMjFweCIgdmlld0JveD0iLTAuNSAtMC41IDIyMSAyMjEiPjxkZWZzLz48Zz48ZWxsaXBzZSBjeD0iMTEwIiBjeT0iMTEwIiByeD0iMTEwIiByeT0iMTEwIiBmaWxsPSIjMDA5OTAwIiBzdHJva2U9IiM4MmIzNjYiIHBvaW50ZXItZXZlbnRzPSJhbGwiLz48cGF0aCBkPSJNIDEyMiAxNjggTCA5NyAxNjggTCA5NyAxMTMgTCA2OCAxMTMgTCAxMDkuNSA1MiBMIDE1MSAxMTMgTCAxMjIgMTEzIFoiIGZpbGw9IiNmZmZmZmYiIHN0cm9rZT0ibm9uZSIgcG9pbnRlci1ldmVudHM9ImFsbCIvPjwvZz48L3N2Zz4="},288:function(e,t){e.exports="data:image/svg+xml;base64,PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCFET0NUWVBFIHN2ZyBQVUJMSUMgIi0vL1czQy8vRFREIFNWRyAxLjEvL0VOIiAiaHR0cDovL3d3dy53My5vcmcvR3JhcGhpY3MvU1ZHLzEuMS9EVEQvc3ZnMTEuZHRkIj4KPHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHhtbG5zOnhsaW5rPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5L3hsaW5rIiB2ZXJzaW9uPSIxLjEiIHdpZHRoPSIyMjFweCIgaGVpZ2h0PSIyMjFweCIgdmlld0JveD0iLTAuNSAtMC41IDIyMSAyMjEiPjxkZWZzLz48Zz48ZWxsaXBzZSBjeD0iMTEwIiBjeT0iMTEwIiByeD0iMTEwIiByeT0iMTEwIiBmaWxsPSIjOTkwMDAwIiBzdHJva2U9IiM4MmIzNjYiIHRyYW5zZm9ybT0icm90YXRlKC0xODAsMTEwLDExMCkiIHBvaW50ZXItZXZlbnRzPSJhbGwiLz48cGF0aCBkPSJNIDk4LjUgNTIuMzkgTCAxMjMuNSA1Mi42MSBMIDEyMy4wMyAxMDcuNjEgTCAxNTIuMDIgMTA3Ljg2IEwgMTEwIDE2OC41IEwgNjkuMDMgMTA3LjE1IEwgOTguMDMgMTA3LjQgWiIgZmlsbD0iI2ZmZmZmZiIgc3Ryb2tlPSJub25lIiBwb2ludGVyLWV2ZW50cz0iYWxsIi8+PC9nPjwvc3ZnPg=="},290:function(e,t){e.exports="data:image/svg+xml;base64,PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCFET0NUWVBFIHN2ZyBQVUJMSUMgIi0vL1czQy8vRFREIFNWRyAxLjEvL0VOIiAiaHR0cDovL3d3dy53My5vcmcvR3JhcGhpY3MvU1ZHLzEuMS9EVEQvc3ZnMTEuZHRkIj4KPHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHhtbG5zOnhsaW5rPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5L3hsaW5rIiB2ZXJzaW9uPSIxLjEiIHdpZHRoPSI2N3B4IiBoZWlnaHQ9IjY2cHgiIHZpZXdCb3g9Ii0wLjUgLTAuNSA2NyA2NiI+PGRlZnMvPjxnPjxlbGxpcHNlIGN4PSIzMi41IiBjeT0iMzMiIHJ4PSIzMS41IiByeT0iMzEuNSIgZmlsbD0ibm9uZSIgc3Ryb2tlPSIjZmZmZmZmIiBzdHJva2Utd2lkdGg9IjMiIHBvaW50ZXItZXZlbnRzPSJhbGwiLz48cGF0aCBkPSJNIDE3IDMyLjg4IEwgNDcgMzIuODgiIGZpbGw9Im5vbmUiIHN0cm9rZT0iI2ZmZmZmZiIgc3Ryb2tlLXdpZHRoPSI2IiBzdHJva2UtbWl0ZXJsaW1pdD0iMTAiIHBvaW50ZXItZXZlbnRzPSJzdHJva2UiLz48cGF0aCBkPSJNIDMyLjM4IDE4IEwgMzIuMzggNDgiIGZpbGw9Im5vbmUiIHN0cm9rZT0iI2ZmZmZmZiIgc3Ryb2tlLXdpZHRoPSI2IiBzdHJva2UtbWl0ZXJsaW1pdD0iMTAiIHBvaW50ZXItZXZlbnRzPSJzdHJva2UiLz48L2c+PC9zdmc+"},291:function(e,t){e.exports="data:image/svg+xml;base64,PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCFET0NUWVBFIHN2ZyBQVUJMSUMgIi0vL1czQy8vRFREIFNWRyAxLjEvL0VOIiAiaHR0cDovL3d3dy53My5vcmcvR3JhcGhpY3MvU1ZHLzEuMS9EVEQvc3ZnMTEuZHRkIj4KPHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHhtbG5zOnhsaW5rPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5L3hsaW5rIiB2ZXJzaW9uPSIxLjEiIHdpZHRoPSI2NnB4IiBoZWlnaHQ9IjY2cHgiIHZpZXdCb3g9Ii0wLjUgLTAuNSA2NiA2NiI+PGRlZnMvPjxnPjxlbGxpcHNlIGN4PSIzMi41IiBjeT0iMzMiIHJ4PSIzMS41IiByeT0iMzEuNSIgZmlsbD0ibm9uZSIgc3Ryb2tlPSIjZmZmZmZmIiBzdHJva2Utd2lkdGg9IjMiIHBvaW50ZXItZXZlbnRzPSJhbGwiLz48cGF0aCBkPSJNIDE3IDMyLjg4IEwgNDcgMzIuODgiIGZpbGw9Im5vbmUiIHN0cm9rZT0iI2ZmZmZmZiIgc3Ryb2tlLXdpZHRoPSI2IiBzdHJva2UtbWl0ZXJsaW1pdD0iMTAiIHBvaW50ZXItZXZlbnRzPSJzdHJva2UiLz48L2c+PC9zdmc+"},302:function(e,t,n){var a={"./af":108,"./af.js":108,"./ar":109,"./ar-dz":110,"./ar-dz.js":110,"./ar-kw":111,"./ar-kw.js":111,"./ar-ly":112,"./ar-ly.js":112,"./ar-ma":113,"./ar-ma.js":113,"./ar-sa":114,"./ar-sa.js":114,"./ar-tn":115,"./ar-tn.js":115,"./ar.js":109,"./az":116,"./az.js":116,"./be":117,"./be.js":117,"./bg":118,"./bg.js":118,"./bm":119,"./bm.js":119,"./bn":120,"./bn-bd":121,"./bn-bd.js":121,"./bn.js":120,"./bo":122,"./bo.js":122,"./br":123,"./br.js":123,"./bs":124,"./bs.js":124,"./ca":125,"./ca.js":125,"./cs":126,"./cs.js":126,"./cv":127,"./cv.js":127,"./cy":128,"./cy.js":128,"./da":129,"./da.js":129,"./de":130,"./de-at":131,"./de-at.js":131,"./de-ch":132,"./de-ch.js":132,"./de.js":130,"./dv":133,"./dv.js":133,"./el":134,"./el.js":134,"./en-au":135,"./en-au.js":135,"./en-ca":136,"./en-ca.js":136,"./en-gb":137,"./en-gb.js":137,"./en-ie":138,"./en-ie.js":138,"./en-il":139,"./en-il.js":139,"./en-in":140,"./en-in.js":140,"./en-nz":141,"./en-nz.js":141,"./en-sg":142,"./en-sg.js":142,"./eo":143,"./eo.js":143,"./es":144,"./es-do":145,"./es-do.js":145,"./es-mx":146,"./es-mx.js":146,"./es-us":147,"./es-us.js":147,"./es.js":144,"./et":148,"./et.js":148,"./eu":149,"./eu.js":149,"./fa":150,"./fa.js":150,"./fi":151,"./fi.js":151,"./fil":152,"./fil.js":152,"./fo":153,"./fo.js":153,"./fr":154,"./fr-ca":155,"./fr-ca.js":155,"./fr-ch":156,"./fr-ch.js":156,"./fr.js":154,"./fy":157,"./fy.js":157,"./ga":158,"./ga.js":158,"./gd":159,"./gd.js":159,"./gl":160,"./gl.js":160,"./gom-deva":161,"./gom-deva.js":161,"./gom-latn":162,"./gom-latn.js":162,"./gu":163,"./gu.js":163,"./he":164,"./he.js":164,"./hi":165,"./hi.js":165,"./hr":166,"./hr.js":166,"./hu":167,"./hu.js":167,"./hy-am":168,"./hy-am.js":168,"./id":169,"./id.js":169,"./is":170,"./is.js":170,"./it":171,"./it-ch":172,"./it-ch.js":172,"./it.js":171,"./ja":173,"./ja.js":173,"./jv":174,"./jv.js":174,"./ka":175,"./ka.js":175,"./kk":176,"./kk.js":176,"./km":177,"./km.js":177,"./kn":178,"./kn.js":178,"./ko":179,"./ko.js":179,"./ku":180,"./ku.js":180,"./ky":181,"./ky.js":181,"./lb":182,"./lb.js":182,"./lo":183,"./lo.js":183,"./lt":184,"./lt.js":184,"./lv":185,"./lv.js":185,"./me":186,"./me.js":186,"./mi":187,"./mi.js":187,"./mk":188,"./mk.js":188,"./ml":189,"./ml.js":189,"./mn":190,"./mn.js":190,"./mr":191,"./mr.js":191,"./ms":192,"./ms-my":193,"./ms-my.js":193,"./ms.js":192,"./mt":194,"./mt.js":194,"./my":195,"./my.js":195,"./nb":196,"./nb.js":196,"./ne":197,"./ne.js":197,"./nl":198,"./nl-be":199,"./nl-be.js":199,"./nl.js":198,"./nn":200,"./nn.js":200,"./oc-lnc":201,"./oc-lnc.js":201,"./pa-in":202,"./pa-in.js":202,"./pl":203,"./pl.js":203,"./pt":204,"./pt-br":205,"./pt-br.js":205,"./pt.js":204,"./ro":206,"./ro.js":206,"./ru":207,"./ru.js":207,"./sd":208,"./sd.js":208,"./se":209,"./se.js":209,"./si":210,"./si.js":210,"./sk":211,"./sk.js":211,"./sl":212,"./sl.js":212,"./sq":213,"./sq.js":213,"./sr":214,"./sr-cyrl":215,"./sr-cyrl.js":215,"./sr.js":214,"./ss":216,"./ss.js":216,"./sv":217,"./sv.js":217,"./sw":218,"./sw.js":218,"./ta":219,"./ta.js":219,"./te":220,"./te.js":220,"./tet":221,"./tet.js":221,"./tg":222,"./tg.js":222,"./th":223,"./th.js":223,"./tk":224,"./tk.js":224,"./tl-ph":225,"./tl-ph.js":225,"./tlh":226,"./tlh.js":226,"./tr":227,"./tr.js":227,"./tzl":228,"./tzl.js":228,"./tzm":229,"./tzm-latn":230,"./tzm-latn.js":230,"./tzm.js":229,"./ug-cn":231,"./ug-cn.js":231,"./uk":232,"./uk.js":232,"./ur":233,"./ur.js":233,"./uz":234,"./uz-latn":235,"./uz-latn.js":235,"./uz.js":234,"./vi":236,"./vi.js":236,"./x-pseudo":237,"./x-pseudo.js":237,"./yo":238,"./yo.js":238,"./zh-cn":239,"./zh-cn.js":239,"./zh-hk":240,"./zh-hk.js":240,"./zh-mo":241,"./zh-mo.js":241,"./zh-tw":242,"./zh-tw.js":242};function r(e){var t=l(e);return n(t)}function l(e){if(!n.o(a,e)){var t=new Error("Cannot find module '"+e+"'");throw t.code="MODULE_NOT_FOUND",t}return a[e]}r.keys=function(){return Object.keys(a)},r.resolve=l,e.exports=r,r.id=302},438:function(e,t,n){"use strict";n.r(t);var a=n(1),r=n(24),l=n(4),s=n(26),o=(n(301),n(289)),i=n(57),c=n(32),d=n(13),p=n(10),u=n(9),m=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let h=class extends a.Component{render(){return a.createElement(a.Fragment,null,"Uptime: ",this.props.nodeStore.uptime)}};h=m([Object(l.b)("nodeStore"),l.c],h);var f=h,y=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let b=class extends a.Component{render(){return a.createElement(a.Fragment,null,"Version: ",this.props.nodeStore.status.version)}};b=y([Object(l.b)("nodeStore"),l.c],b);var g=b,E=n(12),v=n(25),I={elements:{line:{tension:0}},animation:{duration:0},hover:{animationDuration:0},responsiveAnimationDuration:0},j=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const k=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return Math.abs(e)},fontSize:10,maxTicksLimit:4,beginAtZero:!0}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${Math.abs(e.value)}`}}}},I);let S=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Blocks Per Second"),a.createElement("small",null,"BPS: ",this.props.nodeStore.last_mps_metric.mps,"."),a.createElement(v.Line,{height:50,data:this.props.nodeStore.mpsSeries,options:k})))}};S=j([Object(l.b)("nodeStore"),l.c],S);var x=S,O=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const D=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return Math.abs(e)},fontSize:10,maxTicksLimit:4,beginAtZero:!0}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${Math.abs(e.value)}`}}}},I);let w=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Current Tips"),a.createElement("small",null,"Tips: ",this.props.nodeStore.last_tips_metric.totaltips,"."),a.createElement(v.Line,{height:50,data:this.props.nodeStore.tipsSeries,options:D})))}};w=O([Object(l.b)("nodeStore"),l.c],w);var C=w,R=n(3),T=n(29),N=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const M=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},showXLabels:10,gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return T(Math.abs(e))},maxTicksLimit:3,fontSize:10}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${T(Math.abs(e.value))}`}}}},I);let _=class extends a.Component{render(){let e=this.props.nodeStore.status.mem;return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Memory Usage"," ",T(e.heap_alloc)),a.createElement("small",null,"GC Cycles: ",e.num_gc," (Last Cycle: ",e.last_pause_gc/1e6,"ms) - "," ","Heap: "," ","[Obj: ",e.heap_objects,", In-Use: ",T(e.heap_sys-e.heap_idle),", Retained: ",T(e.heap_idle-e.heap_released),"]"),a.createElement(v.Line,{height:50,data:this.props.nodeStore.memSeries,options:M})))}};_=N([Object(l.b)("nodeStore"),l.c],_);var L=_,P=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const A=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return Math.abs(e)},fontSize:10,maxTicksLimit:4,beginAtZero:!0}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${Math.abs(e.value)}`}}}},I);let B=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Component Counter Blocks Per Second"),a.createElement("div",{style:{display:"flex",flexDirection:"column"}},a.createElement("small",null,"BPS: ",this.props.nodeStore.last_component_counter_metric.store,"."),a.createElement("small",null,"Rate Setter - Estimate: ",this.props.nodeStore.last_rate_setter_metric.estimate)),a.createElement(v.Line,{height:50,data:this.props.nodeStore.componentSeries,options:A})))}};B=P([Object(l.b)("nodeStore"),l.c],B);var z=B,H=n(15),F=n(20),Z=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let V=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"TangleTime Synced: ",this.props.nodeStore.status.tangleTime.synced?"Yes":"No"),a.createElement("small",null,a.createElement("div",null,a.createElement("hr",null),a.createElement("div",{className:"row"},a.createElement("div",{className:"col-12"},"Last Accepted Block: ",a.createElement(H.Link,{to:"/explorer/block/"+this.props.nodeStore.status.tangleTime.acceptedBlockID},this.props.nodeStore.status.tangleTime.acceptedBlockID))),a.createElement("div",{className:"row"},a.createElement("div",{className:"col-12"},"Last Confirmed Block: ",a.createElement(H.Link,{to:"/explorer/block/"+this.props.nodeStore.status.tangleTime.confirmedBlockID},this.props.nodeStore.status.tangleTime.confirmedBlockID))),a.createElement("div",{className:"row"},a.createElement("div",{className:"col-12"},"Last Confirmed Epoch:",this.props.nodeStore.status.tangleTime.confirmedEpoch)),a.createElement("hr",null),a.createElement("div",{className:"row"},a.createElement("div",{className:"col-3"},"Acceptance Time:"),a.createElement("div",{className:"col-3"},F(new Date(this.props.nodeStore.status.tangleTime.ATT/1e6),"dd.mm.yyyy HH:MM:ss")),a.createElement("div",{className:"col-3"},"Confirmation Time:"),a.createElement("div",{className:"col-3"},F(new Date(this.props.nodeStore.status.tangleTime.CTT/1e6),"dd.mm.yyyy HH:MM:ss"))),a.createElement("div",{className:"row"},a.createElement("div",{className:"col-3"},"Relative Acceptance Time:"),a.createElement("div",{className:"col-3"},F(new Date(this.props.nodeStore.status.tangleTime.RATT/1e6),"dd.mm.yyyy HH:MM:ss")),a.createElement("div",{className:"col-3"},"Relative Confirmation Time:"),a.createElement("div",{className:"col-3"},F(new Date(this.props.nodeStore.status.tangleTime.RCTT/1e6),"dd.mm.yyyy HH:MM:ss")))))))}};V=Z([Object(l.b)("nodeStore"),l.c],V);var W=V,U=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const G=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return Math.abs(e)},fontSize:10,maxTicksLimit:4,beginAtZero:!0}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${Math.abs(e.value)}`}}}},I);let J=class extends a.Component{render(){return a.createElement("div",{className:"row"},a.createElement("div",{className:"col"},a.createElement("div",{style:{display:"flex",flexDirection:"column"}},a.createElement("small",null,"Current Buffer Size: ",this.props.nodeStore.status.scheduler.currentBufferSize,".")),a.createElement(v.Line,{height:50,data:this.props.nodeStore.bufferSizeSeries,options:G})))}};J=U([Object(l.b)("nodeStore"),l.c],J);var Q=J,q=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const X=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return Math.abs(e)},fontSize:10,maxTicksLimit:4,beginAtZero:!0}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${Math.abs(e.value)}`}}}},I);let K=class extends a.Component{render(){return a.createElement("div",{className:"row"},a.createElement("div",{className:"col"},a.createElement("div",{style:{display:"flex",flexDirection:"column"}},a.createElement("small",null,"Current deficit: ",this.props.nodeStore.status.scheduler.deficit,".")),a.createElement(v.Line,{height:50,data:this.props.nodeStore.deficitSeries,options:X})))}};K=q([Object(l.b)("nodeStore"),l.c],K);var Y=K,$=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let ee=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Scheduler Metrics "),a.createElement("small",null,a.createElement("hr",null),a.createElement("div",{className:"row"},a.createElement("div",{className:"col-3"},"Rate:"),a.createElement("div",{className:"col-3"},this.props.nodeStore.status.scheduler.rate),a.createElement("div",{className:"col-3"},"Max buffer size:"),a.createElement("div",{className:"col-3"},this.props.nodeStore.status.scheduler.maxBufferSize))),a.createElement("hr",null),a.createElement(Q,null),a.createElement("hr",null),a.createElement(Y,null)))}};ee=$([Object(l.b)("nodeStore"),l.c],ee);var te=ee,ne=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let ae=class extends a.Component{render(){return a.createElement(a.Fragment,null,"Bootstrapped: ",this.props.nodeStore.status.tangleTime.bootstrapped?"Yes":"No")}};ae=ne([Object(l.b)("nodeStore"),l.c],ae);var re=ae,le=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let se=class extends a.Component{render(){return a.createElement(d.a,null,a.createElement("h3",null,"Dashboard"),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Node: ",this.props.nodeStore.status.id),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement(R.a,{variant:"flush"},a.createElement(R.a.Item,null,a.createElement(f,null)))),a.createElement(u.a,null,a.createElement(R.a,{variant:"flush"},a.createElement(R.a.Item,null,a.createElement(re,null)))),a.createElement(u.a,null,a.createElement(R.a,{variant:"flush"},a.createElement(R.a.Item,null,a.createElement(g,null))))))))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(W,null))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(x,null))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(C,null))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(te,null))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(z,null))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(L,null))))}};se=le([Object(l.b)("nodeStore"),l.c],se);var oe=n(16),ie=n(23),ce=n(22),de=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let pe=class extends a.Component{constructor(){super(...arguments),this.updateSearch=e=>{this.props.explorerStore.updateSearch(e.target.value)},this.executeSearch=e=>{"Enter"===e.key&&this.props.explorerStore.searchAny()}}render(){let{search:e,searching:t}=this.props.explorerStore;return a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(ce.a,{className:"mb-3"},a.createElement(ie.a,{placeholder:"Address or Block ID","aria-label":"Address or Block ID","aria-describedby":"basic-addon1",value:e,onChange:this.updateSearch,onKeyUp:this.executeSearch,disabled:t})))))}};pe=de([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],pe);var ue=n(39),me=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let he=class extends a.Component{render(){let{blksLiveFeed:e}=this.props.explorerStore;return a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Live Feed"),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,{xs:12,style:{height:"500px",overflow:"auto"}},a.createElement("h6",null,"Blocks"),a.createElement(ue.a,null,a.createElement("thead",null,a.createElement("tr",null,a.createElement("td",null,"Id"),a.createElement("td",null,"Payload Type"))),a.createElement("tbody",null,e))))))))}};he=me([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],he);var fe=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let ye=class extends a.Component{constructor(){super(...arguments),this.updateSearch=e=>{this.txID=e.target.value},this.executeSearch=e=>{"Enter"===e.key&&this.props.explorerStore.routerStore.push("/explorer/transaction/"+this.txID)}}render(){let{searching:e}=this.props.explorerStore;return a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(ce.a,{className:"mb-3"},a.createElement(ie.a,{placeholder:"Transaction ID","aria-label":"Transaction ID","aria-describedby":"basic-addon1",value:this.txID,onChange:this.updateSearch,onKeyUp:this.executeSearch,disabled:e})))))}};ye=fe([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],ye);var be=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let ge=class extends a.Component{constructor(){super(...arguments),this.updateSearch=e=>{this.outputID=e.target.value},this.executeSearch=e=>{"Enter"===e.key&&this.props.explorerStore.routerStore.push("/explorer/output/"+this.outputID)}}render(){let{searching:e}=this.props.explorerStore;return a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(ce.a,{className:"mb-3"},a.createElement(ie.a,{placeholder:"Output ID","aria-label":"Output ID","aria-describedby":"basic-addon1",value:this.outputID,onChange:this.updateSearch,onKeyUp:this.executeSearch,disabled:e})))))}};ge=be([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],ge);var Ee=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let ve=class extends a.Component{constructor(){super(...arguments),this.updateSearch=e=>{this.conflictID=e.target.value},this.executeSearch=e=>{"Enter"===e.key&&this.props.explorerStore.routerStore.push("/explorer/conflict/"+this.conflictID)}}render(){let{searching:e}=this.props.explorerStore;return a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(ce.a,{className:"mb-3"},a.createElement(ie.a,{placeholder:"Conflict ID","aria-label":"Conflict ID","aria-describedby":"basic-addon1",value:this.conflictID,onChange:this.updateSearch,onKeyUp:this.executeSearch,disabled:e})))))}};ve=Ee([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],ve);var Ie=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let je=class extends a.Component{render(){return a.createElement(d.a,null,a.createElement("h3",null,"Tangle Explorer"),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("p",null,"Search for addresses, blocks, transactions, outputs and conflicts."))),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement(pe,null)),a.createElement(u.a,null,a.createElement(ye,null))),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement(ge,null)),a.createElement(u.a,null,a.createElement(ve,null))),a.createElement(he,null),a.createElement("small",null,"This explorer implementation is heavily inspired by ",a.createElement("a",{href:"https://thetangle.org"},"thetangle.org"),"."))}};je=Ie([Object(l.b)("nodeStore"),l.c],je);var ke=n(292),Se=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let xe=class extends a.Component{constructor(){super(...arguments),this.updateSearch=e=>{this.props.explorerStore.updateSearch(e.target.value)},this.executeSearch=e=>{"Enter"===e.key&&this.props.explorerStore.searchAny()}}render(){let{search:e,searching:t}=this.props.explorerStore;return a.createElement(ke.a,{inline:!0,as:"div"},a.createElement(ie.a,{type:"text",onChange:this.updateSearch,placeholder:"Search the Tangle...",value:e,className:" mr-sm-2",disabled:t,onKeyUp:this.executeSearch}))}};xe=Se([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],xe);var Oe,De=n(17),we=n(37),Ce=n(2);!function(e){e[e.Status=0]="Status",e[e.BPSMetrics=1]="BPSMetrics",e[e.Block=2]="Block",e[e.NeighborStats=3]="NeighborStats",e[e.ComponentCounterMetrics=4]="ComponentCounterMetrics",e[e.TipsMetrics=5]="TipsMetrics",e[e.Vertex=6]="Vertex",e[e.TipInfo=7]="TipInfo",e[e.Mana=8]="Mana",e[e.ManaMapOverall=9]="ManaMapOverall",e[e.ManaMapOnline=10]="ManaMapOnline",e[e.ManaAllowedPledge=11]="ManaAllowedPledge",e[e.ManaPledge=12]="ManaPledge",e[e.ManaInitPledge=13]="ManaInitPledge",e[e.ManaRevoke=14]="ManaRevoke",e[e.ManaInitRevoke=15]="ManaInitRevoke",e[e.ManaInitDone=16]="ManaInitDone",e[e.BlkManaDashboardAddress=17]="BlkManaDashboardAddress",e[e.Chat=18]="Chat",e[e.RateSetter=19]="RateSetter",e[e.ConflictSet=20]="ConflictSet",e[e.Conflict=21]="Conflict"}(Oe||(Oe={}));let Re={};function Te(e,t){Re[e]=t}function Ne(e){delete Re[e]}var Me;!function(e){e[e.Data=0]="Data",e[e.Transaction=1337]="Transaction",e[e.Faucet=2]="Faucet",e[e.Statement=3]="Statement",e[e.Chat=989]="Chat"}(Me||(Me={}));class _e{}class Le{}function Pe(e){switch(e){case Me.Data:return"Data";case Me.Transaction:return"Transaction";case Me.Statement:return"Statement";case Me.Faucet:return"Faucet";case Me.Chat:return"Chat";default:return"Unknown"}}var Ae=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},Be=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},ze=function(e,t,n,a){return new(n||(n=Promise))((function(r,l){function s(e){try{i(a.next(e))}catch(e){l(e)}}function o(e){try{i(a.throw(e))}catch(e){l(e)}}function i(e){var t;e.done?r(e.value):(t=e.value,t instanceof n?t:new n((function(e){e(t)}))).then(s,o)}i((a=a.apply(e,t||[])).next())}))};const He="1111111111111111111111111111111111111111111111111111111111111111";var Fe;!function(e){e[e.NotFound=1]="NotFound",e[e.BadRequest=2]="BadRequest"}(Fe||(Fe={}));class Ze{constructor(e){this.latest_blocks=[],this.blk=null,this.addr=null,this.tx=null,this.txMetadata=null,this.txAttachments=[],this.output=null,this.outputMetadata=null,this.outputConsumers=null,this.pendingMana=null,this.conflict=null,this.conflictChildren=null,this.conflictConflicts=null,this.conflictVoters=null,this.tips=null,this.query_loading=!1,this.query_err=null,this.search="",this.search_result=null,this.searching=!1,this.searchAny=()=>ze(this,void 0,void 0,(function*(){this.updateSearching(!0);try{let e=yield fetch("/api/search/"+this.search),t=yield e.json();this.updateSearchResult(t)}catch(e){this.updateQueryError(e)}})),this.resetSearch=()=>{this.search_result=null,this.searching=!1},this.updateSearchResult=e=>{this.search_result=e,this.searching=!1;let t=this.search;this.search="",this.search_result.block?this.routerStore.push("/explorer/block/"+t):this.search_result.address?this.routerStore.push("/explorer/address/"+t):this.routerStore.push("/explorer/404/"+t)},this.updateSearch=e=>{this.search=e},this.updateSearching=e=>this.searching=e,this.searchBlock=e=>ze(this,void 0,void 0,(function*(){this.updateQueryLoading(!0);try{let t=yield fetch("/api/block/"+e);if(404===t.status)return void this.updateQueryError(Fe.NotFound);let n=yield t.json();this.updateBlock(n)}catch(e){this.updateQueryError(e)}})),this.searchAddress=e=>ze(this,void 0,void 0,(function*(){this.updateQueryLoading(!0);try{let t=yield fetch("/api/address/"+e);if(404===t.status)return void this.updateQueryError(Fe.NotFound);let n=yield t.json();this.updateAddress(n)}catch(e){this.updateQueryError(e)}})),this.getTransaction=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch("/api/transaction/"+e);if(404===t.status)return void this.updateQueryError(Fe.NotFound);let n=yield t.json();for(let e=0;e<n.inputs.length;e++){let t=n.inputs[e]?n.inputs[e].referencedOutputID.base58:He;try{let a=yield fetch("/api/output/"+t);if(404===a.status){let t=new _e;t.output=new Le,t.output.balance=0,t.output.address="LOADED FROM SNAPSHOT",t.type="SigLockedSingleOutputType",t.outputID=n.inputs[e].referencedOutputID,n.inputs[e].output=t}200===a.status&&(n.inputs[e].output=yield a.json())}catch(e){}}this.updateTransaction(n)}catch(e){this.updateQueryError(e)}})),this.getTransactionAttachments=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/transaction/${e}/attachments`);if(404===t.status)return void this.updateQueryError(Fe.NotFound);let n=yield t.json();this.updateTransactionAttachments(n)}catch(e){this.updateQueryError(e)}})),this.getTransactionMetadata=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/transaction/${e}/metadata`);if(404===t.status)return void this.updateQueryError(Fe.NotFound);let n=yield t.json();this.updateTransactionMetadata(n)}catch(e){this.updateQueryError(e)}})),this.getOutput=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch("/api/output/"+e);if(404===t.status)return void this.updateQueryError(Fe.NotFound);if(400===t.status)return void this.updateQueryError(Fe.BadRequest);let n=yield t.json();if(n.error)return void this.updateQueryError(n.error);this.updateOutput(n)}catch(e){this.updateQueryError(e)}})),this.getOutputMetadata=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/output/${e}/metadata`);if(404===t.status)return;if(400===t.status)return;let n=yield t.json();this.updateOutputMetadata(n)}catch(e){}})),this.getOutputConsumers=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/output/${e}/consumers`);if(404===t.status)return;if(400===t.status)return;let n=yield t.json();this.updateOutputConsumers(n)}catch(e){}})),this.getPendingMana=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch("/api/mana/pending?OutputID="+e);if(404===t.status)return;if(400===t.status)return;let n=yield t.json();this.updatePendingMana(n)}catch(e){}})),this.getConflict=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch("/api/conflict/"+e);if(404===t.status)return void this.updateQueryError(Fe.NotFound);if(400===t.status)return void this.updateQueryError(Fe.BadRequest);let n=yield t.json();this.updateConflict(n)}catch(e){this.updateQueryError(e)}})),this.getConflictChildren=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/conflict/${e}/children`);if(404===t.status)return;let n=yield t.json();this.updateConflictChildren(n)}catch(e){}})),this.getConflictConflicts=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/conflict/${e}/conflicts`);if(404===t.status)return;let n=yield t.json();this.updateConflictConflicts(n)}catch(e){}})),this.getConflictVoters=e=>ze(this,void 0,void 0,(function*(){try{let t=yield fetch(`/api/conflict/${e}/voters`);if(404===t.status)return;let n=yield t.json();this.updateConflictVoters(n)}catch(e){}})),this.getTips=()=>ze(this,void 0,void 0,(function*(){try{let e=yield fetch("/api/tips");if(404===e.status)return;let t=yield e.json();this.updateTips(t)}catch(e){}})),this.reset=()=>{this.blk=null,this.query_err=null,this.tx=null,this.txMetadata=null,this.txAttachments=[],this.output=null,this.outputMetadata=null,this.outputConsumers=null,this.pendingMana=null,this.conflict=null,this.conflictChildren=null,this.conflictConflicts=null,this.tips=null},this.updateAddress=e=>{this.addr=e,this.query_err=null,this.query_loading=!1},this.updateTransaction=e=>{this.tx=e},this.updateTransactionAttachments=e=>{this.txAttachments=e},this.updateTransactionMetadata=e=>{this.txMetadata=e},this.updateOutput=e=>{this.output=e},this.updateOutputMetadata=e=>{this.outputMetadata=e},this.updateOutputConsumers=e=>{this.outputConsumers=e},this.updatePendingMana=e=>{this.pendingMana=e},this.updateConflict=e=>{this.conflict=e},this.updateConflictChildren=e=>{this.conflictChildren=e},this.updateConflictConflicts=e=>{this.conflictConflicts=e},this.updateConflictVoters=e=>{this.conflictVoters=e},this.updateTips=e=>{this.tips=e},this.updateBlock=e=>{switch(this.blk=e,this.blk.conflictIDs=this.blk.conflictIDs?this.blk.conflictIDs:[],this.blk.addedConflictIDs=this.blk.addedConflictIDs?this.blk.addedConflictIDs:[],this.blk.subtractedConflictIDs=this.blk.subtractedConflictIDs?this.blk.subtractedConflictIDs:[],this.blk.strongChildren=this.blk.strongChildren?this.blk.strongChildren:[],this.blk.weakChildren=this.blk.weakChildren?this.blk.weakChildren:[],this.blk.shallowLikeChildren=this.blk.shallowLikeChildren?this.blk.shallowLikeChildren:[],this.blk.parentsByType=this.blk.parentsByType?this.blk.parentsByType:new Map,this.query_err=null,this.query_loading=!1,e.payload_type){case Me.Transaction:case Me.Data:this.payload=e.payload;break;case Me.Faucet:default:this.payload=e.payload}},this.updateQueryLoading=e=>this.query_loading=e,this.updateQueryError=e=>{this.query_err=e,this.query_loading=!1,this.searching=!1},this.addLiveFeedBlock=e=>{-1===this.latest_blocks.findIndex(t=>t.id==e.id)&&(this.latest_blocks.length>=50&&this.latest_blocks.shift(),this.latest_blocks.push(e))},this.routerStore=e,Te(Oe.Block,this.addLiveFeedBlock)}get blksLiveFeed(){let e=[];for(let t=this.latest_blocks.length-1;t>=0;t--){let n=this.latest_blocks[t];e.push(a.createElement("tr",{key:n.id},a.createElement("td",null,a.createElement(H.Link,{to:"/explorer/block/"+n.id},n.id)),a.createElement("td",null,Pe(n.payload_type))))}return e}get tipsList(){let e=[];if(this.tips)for(let t=0;t<this.tips.tips.length;t++){let n=this.tips.tips[t];e.push(a.createElement("tr",{key:n},a.createElement("td",null,a.createElement(H.Link,{to:"/explorer/block/"+n},n))))}return e}}Ae([Ce.observable,Be("design:type",Array)],Ze.prototype,"latest_blocks",void 0),Ae([Ce.observable,Be("design:type",class{constructor(){this.parentsByType=new Map,this.strongChildren=[],this.weakChildren=[],this.shallowLikeChildren=[],this.conflictIDs=[],this.addedConflictIDs=[],this.subtractedConflictIDs=[]}})],Ze.prototype,"blk",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"addr",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"tx",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"txMetadata",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"txAttachments",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"output",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"outputMetadata",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"outputConsumers",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"pendingMana",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"conflict",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"conflictChildren",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"conflictConflicts",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"conflictVoters",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"tips",void 0),Ae([Ce.observable,Be("design:type",Boolean)],Ze.prototype,"query_loading",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"query_err",void 0),Ae([Ce.observable,Be("design:type",String)],Ze.prototype,"search",void 0),Ae([Ce.observable,Be("design:type",class{})],Ze.prototype,"search_result",void 0),Ae([Ce.observable,Be("design:type",Boolean)],Ze.prototype,"searching",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"payload",void 0),Ae([Ce.observable,Be("design:type",Object)],Ze.prototype,"subpayload",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"resetSearch",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateSearchResult",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateSearch",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateSearching",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"reset",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateAddress",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateTransaction",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateTransactionAttachments",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateTransactionMetadata",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateOutput",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateOutputMetadata",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateOutputConsumers",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updatePendingMana",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateConflict",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateConflictChildren",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateConflictConflicts",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateConflictVoters",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateTips",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateBlock",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateQueryLoading",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"updateQueryError",void 0),Ae([Ce.action,Be("design:type",Object)],Ze.prototype,"addLiveFeedBlock",void 0),Ae([Ce.computed,Be("design:type",Object),Be("design:paramtypes",[])],Ze.prototype,"blksLiveFeed",null),Ae([Ce.computed,Be("design:type",Object),Be("design:paramtypes",[])],Ze.prototype,"tipsList",null);var Ve=Ze,We=n(72),Ue=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Ge=class extends a.Component{render(){let{payload:e}=this.props.explorerStore;return e&&a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,e.content_title,": "," ",e.content)))}};Ge=Ue([Object(l.b)("explorerStore"),l.c],Ge);class Je extends a.Component{render(){let e=this.props.output,t=this.props.id;return a.createElement("div",{className:"mb-2",key:this.props.id.base58},a.createElement(R.a,null,a.createElement(R.a.Item,null,"Type: SigLockedSingleOutput"),a.createElement(R.a.Item,null,"Balances:",a.createElement("div",null,a.createElement("div",null,a.createElement(oe.a,{variant:"success"},(new Intl.NumberFormat).format(e.balance)," IOTA")))),a.createElement(R.a.Item,null,"OutputID: ",a.createElement("a",{href:"/explorer/output/"+t.base58},t.base58)),a.createElement(R.a.Item,null,"Address: ",a.createElement("a",{href:"/explorer/address/"+e.address}," ",e.address)),a.createElement(R.a.Item,null,"Transaction: ",a.createElement("a",{href:"/explorer/transaction/"+t.transactionID}," ",t.transactionID)),a.createElement(R.a.Item,null,"Output Index: ",t.outputIndex)))}}function Qe(e){switch(e){case qe:return"IOTA";case Xe:return"MINT";default:return e}}const qe="11111111111111111111111111111111",Xe="JEKNVnkbo3jma5nREBBJCDoXFVeKkD56V3xKrvRmWxFG";class Ke extends a.Component{render(){let e=Object.keys(this.props.output.balances).map(e=>({color:e,value:this.props.output.balances[e]}));return a.createElement("div",{className:"mb-2",key:this.props.id.base58},a.createElement(R.a,null,a.createElement(R.a.Item,null,"Type: SigLockerColoredOutput"),a.createElement(R.a.Item,null,"Balances:",a.createElement("div",null,e.map((e,t)=>a.createElement("div",{key:t},a.createElement(oe.a,{variant:"success"},(new Intl.NumberFormat).format(e.value)," ",Qe(e.color)))))),a.createElement(R.a.Item,null,"OutputID: ",a.createElement("a",{href:"/explorer/output/"+this.props.id.base58},this.props.id.base58)),a.createElement(R.a.Item,null,"Address: ",a.createElement("a",{href:"/explorer/address/"+this.props.output.address}," ",this.props.output.address)),a.createElement(R.a.Item,null,"Transaction: ",a.createElement("a",{href:"/explorer/transaction/"+this.props.id.transactionID}," ",this.props.id.transactionID)),a.createElement(R.a.Item,null,"Output Index: ",this.props.id.outputIndex)))}}class Ye extends a.Component{render(){let e=Object.keys(this.props.output.balances).map(e=>({color:e,value:this.props.output.balances[e]}));return a.createElement("div",{className:"mb-2",key:this.props.id.base58},a.createElement(R.a,{style:{wordBreak:"break-word"}},a.createElement(R.a.Item,null,"Type: AliasOutput"),a.createElement(R.a.Item,null,"Balances:",a.createElement("div",null,e.map((e,t)=>a.createElement("div",{key:t},a.createElement(oe.a,{variant:"success"},(new Intl.NumberFormat).format(e.value)," ",Qe(e.color)))))),a.createElement(R.a.Item,null,"OutputID: ",a.createElement("a",{href:"/explorer/output/"+this.props.id.base58},this.props.id.base58)),a.createElement(R.a.Item,null,"AliasAddress: ",a.createElement("a",{href:"/explorer/address/"+this.props.output.aliasAddress}," ",this.props.output.aliasAddress)),a.createElement(R.a.Item,null,"StateAddress: ",a.createElement("a",{href:"/explorer/address/"+this.props.output.stateAddress}," ",this.props.output.stateAddress)),a.createElement(R.a.Item,null,"Governing Address:  ",this.props.output.governingAddress?a.createElement("a",{href:"/explorer/address/"+this.props.output.governingAddress}," ",this.props.output.governingAddress):"Self-governed"," "),a.createElement(R.a.Item,null,"State Index: ",this.props.output.stateIndex),this.props.output.stateData&&a.createElement(R.a.Item,null,"State Data: ",this.props.output.stateData),this.props.output.governanceMetadata&&a.createElement(R.a.Item,null,"Governance Metadata: ",this.props.output.governanceMetadata),this.props.output.immutableData&&a.createElement(R.a.Item,null,"Immutable Data: ",this.props.output.immutableData),a.createElement(R.a.Item,null,"Governance Update: ",this.props.output.isGovernanceUpdate.toString()),a.createElement(R.a.Item,null,"Origin: ",this.props.output.isOrigin.toString()),a.createElement(R.a.Item,null,"Delegated: ",this.props.output.isDelegated.toString()),this.props.output.delegationTimelock&&a.createElement(R.a.Item,null,"Delegation Timelocked Until: ",new Date(1e3*this.props.output.delegationTimelock).toLocaleString()),a.createElement(R.a.Item,null,"Transaction: ",a.createElement("a",{href:"/explorer/transaction/"+this.props.id.transactionID}," ",this.props.id.transactionID)),a.createElement(R.a.Item,null,"Output Index: ",this.props.id.outputIndex)))}}class $e extends a.Component{render(){let e=Object.keys(this.props.output.balances).map(e=>({color:e,value:this.props.output.balances[e]}));return a.createElement("div",{className:"mb-2",key:this.props.id.base58},a.createElement(R.a,null,a.createElement(R.a.Item,null,"Type: ExtendedLockedOutput"),a.createElement(R.a.Item,null,"Balances:",a.createElement("div",null,e.map((e,t)=>a.createElement("div",{key:t},a.createElement(oe.a,{variant:"success"},(new Intl.NumberFormat).format(e.value)," ",Qe(e.color)))))),a.createElement(R.a.Item,null,"OutputID: ",a.createElement("a",{href:"/explorer/output/"+this.props.id.base58},this.props.id.base58)),a.createElement(R.a.Item,null,"Address: ",a.createElement("a",{href:"/explorer/address/"+this.props.output.address}," ",this.props.output.address)),this.props.output.fallbackAddress&&a.createElement(R.a.Item,null,"Fallback Address: ",a.createElement("a",{href:"/explorer/address/"+this.props.output.fallbackAddress}," ",this.props.output.fallbackAddress)),this.props.output.fallbackDeadline&&a.createElement(R.a.Item,null,"Fallback Deadline: ",new Date(1e3*this.props.output.fallbackDeadline).toLocaleString()),this.props.output.timelock&&a.createElement(R.a.Item,null,"Timelocked Until: ",new Date(1e3*this.props.output.timelock).toLocaleString()),this.props.output.hashLock&&a.createElement(R.a.Item,null,"Hash Lock (SHA-256): ",this.props.output.hashLock),a.createElement(R.a.Item,null,"Transaction: ",a.createElement("a",{href:"/explorer/transaction/"+this.props.id.transactionID}," ",this.props.id.transactionID)),a.createElement(R.a.Item,null,"Output Index: ",this.props.id.outputIndex)))}}var et;function tt(e){switch(e){case et.Undefined:return"Undefined";case et.Rejected:return"Rejected";case et.Pending:return"Pending";case et.Accepted:return"Accepted";case et.Confirmed:return"Confirmed";default:return"Undefined Confirmation State"}}function nt(e){switch(e.type){case"SigLockedSingleOutputType":return a.createElement(Je,{output:e.output,id:e.outputID});case"SigLockedColoredOutputType":return a.createElement(Ke,{output:e.output,id:e.outputID});case"AliasOutputType":return a.createElement(Ye,{output:e.output,id:e.outputID});case"ExtendedLockedOutputType":return a.createElement($e,{output:e.output,id:e.outputID});default:return}}!function(e){e[e.Undefined=0]="Undefined",e[e.Rejected=1]="Rejected",e[e.Pending=2]="Pending",e[e.Accepted=3]="Accepted",e[e.Confirmed=4]="Confirmed"}(et||(et={}));let at=(e,t)=>{let n=Object.keys(e.output.output.balances);for(let a=0;a<n.length;a++){let r=n[a],l=e.output.output.balances[r],s=Qe(r),o=t.get(s);void 0===o&&(o=0),t.set(s,l+o)}};var rt=n(0),lt=n(283);class st extends a.Component{render(){let e=this.props.block;return a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,"Index: ",a.createElement(oe.a,{variant:"primary"},this.props.index),a.createElement(R.a,null,a.createElement(R.a.Item,null,"Type: ",e.type),e.referencedIndex&&a.createElement(R.a.Item,null,"Referenced Index: ",e.referencedIndex),e.signatureType&&a.createElement(R.a.Item,null,"Signature Type: ",function(e){switch(e){case 0:return"Ed25519 Signature";case 1:return"BLS Signature";default:return"Unknown Signature Type"}}(e.signatureType)),e.signature&&a.createElement(R.a.Item,null,"Signature: ",e.signature),e.publicKey&&a.createElement(R.a.Item,null,"Public Key: ",e.publicKey),e.preimage&&a.createElement(R.a.Item,null,"Preimage: ",e.preimage))))}}const ot={maxHeight:"1000px",overflow:"auto",width:"47%",fontSize:"85%"};class it extends a.Component{render(){let e=this.props.txID,t=this.props.tx;return t&&e&&a.createElement("div",null,a.createElement("h4",null,"Transaction"),a.createElement("p",null," ",e," "),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("div",{style:{marginTop:"10px",marginBottom:"20px",paddingBottom:"10px",borderBottom:"2px solid #eee"}},a.createElement("h5",null,"Transaction Essence")),a.createElement(R.a,null,a.createElement(R.a.Item,null,"ID: ",a.createElement("a",{href:"/explorer/transaction/"+e}," ",e)),a.createElement(R.a.Item,null,"Version: ",t.version),a.createElement(R.a.Item,null,"Timestamp: ",new Date(1e3*t.timestamp).toLocaleString()),a.createElement(R.a.Item,null,"Access pledge ID: ",t.accessPledgeID),a.createElement(R.a.Item,null,"Consensus pledge ID: ",t.consensusPledgeID),a.createElement(R.a.Item,null,a.createElement("div",{className:"d-flex justify-content-between align-items-center"},a.createElement("div",{className:"align-self-start input-output-list",style:ot},a.createElement("span",null,"Inputs"),a.createElement("hr",null),t.inputs.map((e,t)=>a.createElement("div",{className:"mb-2",key:t},a.createElement("span",{className:"mb-2"},"Index: ",a.createElement(oe.a,{variant:"primary"},t)),nt(e.output)))),a.createElement(rt.b.Provider,{value:{color:"#00a0ff",size:"2em"}},a.createElement("div",null,a.createElement(lt.a,null))),a.createElement("div",{style:ot},a.createElement("span",null,"Outputs"),a.createElement("hr",null),t.outputs.map((e,t)=>a.createElement("div",{className:"mb-2",key:t},a.createElement("span",{className:"mb-2"},"Index: ",a.createElement(oe.a,{variant:"primary"},t)),nt(e)))))),t.dataPayload&&a.createElement(R.a.Item,null,"Data payload: ",t.dataPayload)))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("div",{style:{marginBottom:"20px",paddingBottom:"10px",borderBottom:"2px solid #eee"}},a.createElement("h5",null,"Unlock Blocks")),a.createElement(a.Fragment,null,t.unlockBlocks.map((e,t)=>a.createElement(st,{block:e,index:t,key:t}))))))}}function ct(e){switch(e){case dt:return"MasterConflictID";case pt:return"UndefinedConflictID";case ut:return"LazyBookedConflictsConflictID";case mt:return"InvalidConflictID";default:return e}}const dt="4uQeVj5tqViQh7yWWGStvkEG1Zmhx6uasJtWCJziofM",pt="11111111111111111111111111111111",ut="JEKNVnkbo3jma5nREBBJCDoXFVeKkD56V3xKrvRmWxFF",mt="JEKNVnkbo3jma5nREBBJCDoXFVeKkD56V3xKrvRmWxFG";var ht=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let ft=class extends a.Component{componentDidMount(){this.props.explorerStore.getTransactionMetadata(this.props.txId)}componentWillUnmount(){this.props.explorerStore.reset()}render(){let{txId:e}=this.props,{query_err:t,txMetadata:n}=this.props.explorerStore;return t?a.createElement(d.a,null,a.createElement("h4",null,"Metadata"),a.createElement("p",null," Metadata for transaction ID ",e," not found.")):a.createElement("div",{style:{marginTop:"20px",marginBottom:"20px"}},a.createElement("h4",null,"Metadata"),n&&a.createElement(R.a,null,"ConflictIDs:",a.createElement(R.a,null,n.conflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:"ConflictID"+t+1,className:"text-break"},a.createElement(H.Link,{to:"/explorer/conflict/"+e},ct(e))))),a.createElement(R.a.Item,null,"Booked: ",n.booked.toString()),a.createElement(R.a.Item,null,"Booked time: ",new Date(1e3*n.bookedTime).toLocaleString()),a.createElement(R.a.Item,null,"Confirmation State: ",tt(n.confirmationState)),a.createElement(R.a.Item,null,"Confirmation State Time: ",new Date(1e3*n.confirmationStateTime).toLocaleString())))}};ft=ht([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],ft);var yt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let bt=class extends a.Component{render(){let{payload:e}=this.props.explorerStore,t=e.txID,n=e.transaction;return a.createElement(d.a,null,a.createElement(ft,{txId:t}),a.createElement(it,{txID:t,tx:n}))}};bt=yt([Object(l.b)("explorerStore"),l.c],bt);var gt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Et=class extends a.Component{render(){let{payload:e}=this.props.explorerStore;return e&&a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,null,a.createElement(R.a.Item,null,"From: ",e.from," "))),a.createElement(u.a,null,a.createElement(R.a,null,a.createElement(R.a.Item,null,"To: ",e.to," "))),a.createElement(u.a,null,a.createElement(R.a,null,a.createElement(R.a.Item,null,"Block: ",e.block," ")))))}};Et=gt([Object(l.b)("explorerStore"),l.c],Et);var vt=n(282),It=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let jt=class extends a.Component{render(){let{payload:e}=this.props.explorerStore;return e&&a.createElement(a.Fragment,null,e.conflicts&&a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,"Conflicts",a.createElement(R.a,null,e.conflicts.map(e=>a.createElement(vt.a,null,"Transaction ID: ",e.tx_id,a.createElement("ul",null,a.createElement("li",null,"Opinion: ",e.opinion.value),a.createElement("li",null,"Round: ",e.opinion.round))))))),e.timestamps&&a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,"Timestamps",a.createElement(R.a,null,e.timestamps.map(e=>a.createElement(vt.a,null,"Block ID: ",e.blk_id,a.createElement("ul",null,a.createElement("li",null,"Opinion: ",e.opinion.value),a.createElement("li",null,"Round: ",e.opinion.round))))))))}};jt=It([Object(l.b)("explorerStore"),l.c],jt);var kt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let St=class extends a.Component{componentDidMount(){this.props.explorerStore.resetSearch(),this.props.explorerStore.searchBlock(this.props.match.params.id)}componentWillUnmount(){this.props.explorerStore.reset()}getSnapshotBeforeUpdate(e,t){return e.match.params.id!==this.props.match.params.id&&this.props.explorerStore.searchBlock(this.props.match.params.id),null}getPayloadType(){return Pe(this.props.explorerStore.blk.payload_type)}renderPayload(){switch(this.props.explorerStore.blk.payload_type){case Me.Transaction:return this.props.explorerStore.blk.objectivelyInvalid?a.createElement(Ge,null):a.createElement(bt,null);case Me.Statement:return a.createElement(jt,null);case Me.Data:return a.createElement(Ge,null);case Me.Faucet:case Me.Chat:return a.createElement(Et,null);default:return a.createElement(Ge,null)}}render(){let{id:e}=this.props.match.params,{blk:t,query_loading:n,query_err:r}=this.props.explorerStore;return e===He?a.createElement(d.a,null,a.createElement("h3",null,"Genesis Block"),a.createElement("p",null,"In the beginning there was the genesis.")):r?a.createElement(d.a,null,a.createElement("h3",null,"Block not available - 404"),a.createElement("p",null,"Block with ID ",e," not found.")):a.createElement(d.a,null,a.createElement("h3",null,"Block"),a.createElement("p",null,e," "," ",t&&a.createElement(a.Fragment,null,a.createElement("br",null),a.createElement("span",null,a.createElement(oe.a,{variant:"light",style:{marginRight:10}},"Issuance Time: ",F(new Date(1e3*t.issuance_timestamp),"dd.mm.yyyy HH:MM:ss")),a.createElement(oe.a,{variant:"light"},"Solidification Time: ",F(new Date(1e3*t.solidification_timestamp),"dd.mm.yyyy HH:MM:ss"))))),t&&a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,null,a.createElement(R.a.Item,null,"Payload Type: ",this.getPayloadType()),a.createElement(R.a.Item,null,"Sequence Number: ",t.sequence_number),a.createElement(R.a.Item,null,"ConflictIDs:",a.createElement(R.a,null,t.conflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:"ConflictID"+t+1,className:"text-break"},a.createElement(H.Link,{to:"/explorer/conflict/"+e},ct(e)))))),a.createElement(R.a.Item,null,"AddedConflictIDs:",a.createElement(R.a,null,t.addedConflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:"AddedConflictID"+t+1,className:"text-break"},a.createElement(H.Link,{to:"/explorer/conflict/"+e},ct(e)))))),a.createElement(R.a.Item,null,"SubtractedConflictIDs:",a.createElement(R.a,null,t.subtractedConflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:"SubtractedConflictID"+t+1,className:"text-break"},a.createElement(H.Link,{to:"/explorer/conflict/"+e},ct(e)))))),a.createElement(R.a.Item,null,"Solid: ",t.solid?"Yes":"No"),a.createElement(R.a.Item,null,"Scheduled: ",t.scheduled?"Yes":"No"),a.createElement(R.a.Item,null,"Booked: ",t.booked?"Yes":"No"),a.createElement(R.a.Item,null,"Orphaned: ",t.orphaned?"Yes":"No"),a.createElement(R.a.Item,null,"Objectively Invalid: ",t.objectivelyInvalid?"Yes":"No"),a.createElement(R.a.Item,null,"Subjectively Invalid: ",t.subjectivelyInvalid?"Yes":"No"),a.createElement(R.a.Item,null,"Acceptance: ",t.acceptance?"Yes":"No"),a.createElement(R.a.Item,null,"Acceptance Time: ",F(new Date(1e3*t.acceptanceTime),"dd.mm.yyyy HH:MM:ss")),a.createElement(R.a.Item,null,"Confirmation: ",t.confirmation?"Yes":"No"),a.createElement(R.a.Item,null,"Confirmation Time: ",F(new Date(1e3*t.confirmationTime),"dd.mm.yyyy HH:MM:ss")),a.createElement(R.a.Item,null,"Confirmation by epoch: ",t.confirmationByEpoch?"Yes":"No"),a.createElement(R.a.Item,null,"Confirmation by epoch time: ",F(new Date(1e3*t.confirmationByEpochTime),"dd.mm.yyyy HH:MM:ss"))))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("h5",null,"Epoch Commitment"),a.createElement(R.a,null,a.createElement(R.a.Item,null,"EC: ",t.ec),a.createElement(R.a.Item,null,a.createElement(R.a,null,a.createElement(R.a.Item,null,"EI: ",t.ei),a.createElement(R.a.Item,null,"ECR: ",t.ecr),a.createElement(R.a.Item,null,"PrevEC: ",t.prevEC),a.createElement(R.a.Item,null,"Cumulative Weight: ",t.cumulativeWeight))),a.createElement(R.a.Item,null,"LatestConfirmedEpoch: ",t.latestConfirmedEpoch)))),!!t.rank&&a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("h5",null,"Markers"),a.createElement(R.a,null,a.createElement(R.a.Item,null,"Rank: ",t.rank),a.createElement(R.a.Item,null,"SequenceID: ",t.sequenceID),a.createElement(R.a.Item,null,"PastMarkerGap: ",t.pastMarkerGap),a.createElement(R.a.Item,null,"IsPastMarker: ",t.isPastMarker?"Yes":"No"),a.createElement(R.a.Item,null,"Past markers: ",t.pastMarkers)))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,null,a.createElement(R.a.Item,null,"Issuer Public Key: ",t.issuer_public_key),a.createElement(R.a.Item,null,"Issuer NodeID: ",t.issuer_short_id),a.createElement(R.a.Item,null,"Block Signature: ",t.signature)))),Object.keys(t.parentsByType).map(e=>a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,null,t.parentsByType[e].map((n,r)=>a.createElement(R.a.Item,{className:"text-break"},e," ",r+1,": "," ",a.createElement(H.Link,{to:"/explorer/block/"+t.parentsByType[e][r]},t.parentsByType[e][r]))))))),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement(R.a,null,t.strongChildren.map((e,n)=>a.createElement(R.a.Item,{key:"Strong Child"+n+1,className:"text-break"},"Strong Child ",n+1,": "," ",a.createElement(H.Link,{to:"/explorer/block/"+t.strongChildren[n]},t.strongChildren[n])))))),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement(R.a,null,t.weakChildren.map((e,n)=>a.createElement(R.a.Item,{key:"Weak Child"+n+1,className:"text-break"},"Weak Child ",n+1,": "," ",a.createElement(H.Link,{to:"/explorer/block/"+t.weakChildren[n]},t.weakChildren[n])))))),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement(R.a,null,t.shallowLikeChildren.map((e,n)=>a.createElement(R.a.Item,{key:"ShallowLike Child"+n+1,className:"text-break"},"ShallowLike Child ",n+1,": "," ",a.createElement(H.Link,{to:"/explorer/block/"+t.shallowLikeChildren[n]},t.shallowLikeChildren[n])))))),a.createElement(p.a,{className:"mb-3",style:{marginTop:"20px",marginBottom:"20px"}},a.createElement(u.a,null,a.createElement("h3",null,"Payload"))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,this.renderPayload()))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,n&&a.createElement(We.a,{animation:"border"}))))}};St=kt([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],St);var xt=n(293);function Ot(e){let t="",n=Math.round(e);return t=n<1e3?n.toString(10)+" m":n<1e6?(n/1e3).toFixed(3)+" Km":n<1e9?(n/1e6).toFixed(3)+" Mm":n<1e12?(n/1e9).toFixed(3)+" Gm":n<1e15?(n/1e12).toFixed(3)+" Tm":(n/1e15).toFixed(3)+" Pm",t}var Dt=n(100),wt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Ct=class extends a.Component{componentDidMount(){this.props.explorerStore.resetSearch(),this.props.explorerStore.searchAddress(this.props.match.params.id)}getSnapshotBeforeUpdate(e,t){return e.match.params.id!==this.props.match.params.id&&this.props.explorerStore.searchAddress(this.props.match.params.id),null}render(){let{id:e}=this.props.match.params,{addr:t,query_loading:n,query_err:r}=this.props.explorerStore,l=[],s=[],o=[];if(r)return a.createElement(d.a,null,a.createElement("h3",null,"Address not available - 404"),a.createElement("p",null,"Address ",e," not found."));if(t){t.explorerOutputs.forEach(e=>{e.metadata.consumerCount>0?l.push(e):s.push(e)});let e=(e,t)=>t.txTimestamp===e.txTimestamp?t.id.transactionID==e.id.transactionID?t.id.outputIndex-e.id.outputIndex:t.id.transactionID.localeCompare(e.id.transactionID):t.txTimestamp-e.txTimestamp;s.sort(e),l.sort(e),function(e,t){let n=new Map;if(0===e.length)return n;for(let a=0;a<e.length;a++){let r=e[a];if(!(r.metadata.confirmationState<et.Accepted))switch(r.output.type){case"SigLockedSingleOutputType":let e=r.output.output,a=Qe(qe),l=n.get(a);void 0===l&&(l=0),n.set(a,e.balance+l);break;case"ExtendedLockedOutputType":let s=r.output.output;if(void 0===s.fallbackAddress){at(r,n);break}{let e=(new Date).getTime()/1e3;if(s.fallbackAddress===t){if(e>s.fallbackDeadline){at(r,n);break}break}if(e<s.fallbackDeadline){at(r,n);break}}break;default:if(null===r.output.output.balances)return;at(r,n)}}return n}(s,t.address).forEach((e,t)=>{o.push(a.createElement(R.a.Item,{key:t,style:{textAlign:"center"}},a.createElement(p.a,null,a.createElement(u.a,{xs:9},t),a.createElement(u.a,null,(new Intl.NumberFormat).format(e)))))})}return a.createElement(d.a,null,a.createElement("h3",{style:{marginBottom:"40px"}},"Address ",a.createElement("strong",null,e)," ",null!==t&&a.createElement("span",null,"(",t.explorerOutputs.length," Outputs)")),null!==t?a.createElement(a.Fragment,null,null!==t.explorerOutputs&&100===t.explorerOutputs.length&&a.createElement(xt.a,{variant:"warning"},"Max. 100 outputs are shown."),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,{xs:7},a.createElement(R.a,null,a.createElement("h4",null,"Available Balances"),0===o.length?"There are no balances currently available.":a.createElement("div",null,a.createElement(vt.a,{style:{textAlign:"center"},key:"header"},a.createElement(p.a,null,a.createElement(u.a,{xs:9},a.createElement("strong",null,"Color")),a.createElement(u.a,null,a.createElement("strong",null,"Balance")))),o)))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,{variant:"flush"},a.createElement("h4",null,"Unspent Outputs"),0===s.length?"There are no unspent outputs currently available.":a.createElement("div",null,s.map(e=>a.createElement(Rt,{output:e})))))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,{variant:"flush"},a.createElement("h4",null,"Spent Outputs"),0===l.length?"There are no spent outputs currently available.":a.createElement("div",null,l.map(e=>a.createElement(Rt,{output:e}))))))):a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,n&&a.createElement(We.a,{animation:"border"}))))}};Ct=wt([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],Ct);class Rt extends a.Component{constructor(e){super(e),this.state={enabled:!1}}render(){return a.createElement(R.a.Item,null,a.createElement(Dt.a,{variant:Nt(this.props.output.output.type),onClick:()=>{this.setState({enabled:!this.state.enabled})},block:!0},a.createElement(p.a,null,a.createElement(u.a,{xs:6,style:{textAlign:"left"}},this.props.output.id.base58," "),a.createElement(u.a,{style:{textAlign:"left"}},this.props.output.output.type.replace("Type","")," "),a.createElement(u.a,{style:{textAlign:"left"}},new Date(1e3*this.props.output.txTimestamp).toLocaleString()))),a.createElement(p.a,{style:{fontSize:"90%"}},a.createElement(u.a,null,this.state.enabled?nt(this.props.output.output):null),a.createElement(u.a,null,this.state.enabled?a.createElement(Tt,{metadata:this.props.output.metadata,timestamp:this.props.output.txTimestamp,pendingMana:this.props.output.pendingMana}):null)))}}class Tt extends a.Component{render(){let e=this.props.metadata,t=this.props.timestamp,n=this.props.pendingMana;return a.createElement(R.a,null,"ConflictIDs:",a.createElement(R.a,null,e.conflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:"ConflictID"+t+1,className:"text-break"},a.createElement(H.Link,{to:"/explorer/conflict/"+e},ct(e))))),a.createElement(R.a.Item,null,"Pending mana: ",Ot(n)),a.createElement(R.a.Item,null,"Timestamp: ",new Date(1e3*t).toLocaleString()),a.createElement(R.a.Item,null,"Consumer Count: ",e.consumerCount),e.confirmedConsumer&&a.createElement(R.a.Item,null,"Confirmed Consumer: ",a.createElement("a",{href:"/explorer/transaction/"+e.confirmedConsumer},e.confirmedConsumer)," "))}}let Nt=e=>{switch(e){case"SigLockedSingleOutputType":case"SigLockedColoredOutputType":return"light";case"AliasOutputType":return"success";case"ExtendedLockedOutputType":return"info";default:return"danger"}};var Mt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let _t=class extends a.Component{render(){let{search:e}=this.props.match.params;return a.createElement(d.a,null,a.createElement("h3",null,"Tangle Explorer 404"),a.createElement("p",null,"The search via '",e,"' did not yield any results."))}};_t=Mt([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],_t);var Lt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};const Pt=Object.assign({scales:{xAxes:[{ticks:{autoSkip:!0,maxTicksLimit:8,fontSize:8,minRotation:0,maxRotation:0},showXLabels:10,gridLines:{display:!1}}],yAxes:[{gridLines:{display:!1},ticks:{callback:function(e,t,n){return T(Math.abs(e))},maxTicksLimit:3,fontSize:10}}]},tooltips:{callbacks:{label:function(e,t){return`${t.datasets[e.datasetIndex].label} ${T(Math.abs(e.value))}`}}}},I);let At=class extends a.Component{render(){let e=this.props.nodeStore.neighbor_metrics.get(this.props.identity),t=e.current;return a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,a.createElement("h5",null,t.id)),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,{variant:"flush",as:"small"},a.createElement(R.a.Item,null,"Origin:"," ",t.connection_origin))),a.createElement(u.a,null,a.createElement(R.a,{variant:"flush",as:"small"},a.createElement(R.a.Item,null,"Address: ",t.address)))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("h6",null,"Network (Tx/Rx)"),a.createElement(oe.a,{pill:!0,variant:"light"},"Total: ",t.packets_written," / ",t.packets_read)," ",a.createElement(oe.a,{pill:!0,variant:"light"},"Current: ",T(e.currentNetIO&&e.currentNetIO.tx)," / ",T(e.currentNetIO&&e.currentNetIO.rx)),a.createElement(v.Line,{height:30,data:e.netIOSeries,options:Pt})))))))}};At=Lt([Object(l.b)("nodeStore"),l.c],At);var Bt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let zt=class extends a.Component{render(){let e=[];return this.props.nodeStore.neighbor_metrics.forEach((t,n)=>{e.push(a.createElement(At,{key:n,identity:n}))}),a.createElement(d.a,null,a.createElement("h3",null,"Neighbors ",e.length>0&&a.createElement("span",null,"(",e.length,")")),a.createElement("p",null,"Currently connected neighbors."),e)}};zt=Bt([Object(l.b)("nodeStore"),l.c],zt);var Ht=n(58),Ft=n(104),Zt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Vt=class extends a.Component{constructor(){super(...arguments),this.updateVerticesLimit=e=>{this.props.visualizerStore.updateVerticesLimit(e.target.value)},this.pauseResumeVisualizer=e=>{this.props.visualizerStore.pauseResume()},this.updateSearch=e=>{this.props.visualizerStore.updateSearch(e.target.value)},this.searchAndHighlight=e=>{"Enter"===e.key&&this.props.visualizerStore.searchAndHighlight()},this.toggleBackgroundDataCollection=()=>{this.props.nodeStore.collecting?this.props.nodeStore.unregisterHandlers():this.props.nodeStore.registerHandlers()}}componentDidMount(){this.props.visualizerStore.start()}componentWillUnmount(){this.props.visualizerStore.stop(),this.props.nodeStore.registerHandlers()}render(){let{vertices:e,finalized_count:t,selected:n,verticesLimit:r,tips_count:l,paused:s,search:o}=this.props.visualizerStore,{last_mps_metric:i,collecting:c}=this.props.nodeStore;return a.createElement(d.a,null,a.createElement("h3",null,"Visualizer"),a.createElement(p.a,{className:"mb-1"},a.createElement(u.a,{xs:5},a.createElement(ce.a,{className:"mb-1",size:"sm"},a.createElement(ce.a.Prepend,null,a.createElement(ce.a.Text,{id:"vertices-limit"},"Vertices Limit")),a.createElement(ie.a,{placeholder:"limit",type:"number",value:r.toString(),onChange:this.updateVerticesLimit,"aria-label":"vertices-limit","aria-describedby":"vertices-limit"})),a.createElement(ce.a,{className:"mb-1",size:"sm"},a.createElement(ce.a.Prepend,null,a.createElement(ce.a.Text,{id:"vertices-limit"},"Search Vertex")),a.createElement(ie.a,{placeholder:"search",type:"text",value:o,onChange:this.updateSearch,"aria-label":"vertices-search",onKeyUp:this.searchAndHighlight,"aria-describedby":"vertices-search"})),a.createElement(ce.a,{className:"mb-1",size:"sm"},a.createElement(Ft.a,{trigger:["hover","focus"],placement:"right",overlay:a.createElement(Ht.a,{id:"popover-basic"},a.createElement(Ht.a.Content,null,"Ensures that only data needed for the visualizer is collected."))},a.createElement(Dt.a,{variant:"outline-secondary",onClick:this.toggleBackgroundDataCollection,size:"sm"},c?"Stop Background Data Collection":"Collect Background data")),a.createElement("br",null)),a.createElement(ce.a,{className:"mb-1",size:"sm"},a.createElement(Ft.a,{trigger:["hover","focus"],placement:"right",overlay:a.createElement(Ht.a,{id:"popover-basic"},a.createElement(Ht.a.Content,null,"Pauses/resumes rendering the graph."))},a.createElement(Dt.a,{onClick:this.pauseResumeVisualizer,size:"sm",variant:"outline-secondary"},s?"Resume Rendering":"Pause Rendering")))),a.createElement(u.a,{xs:{span:5,offset:2}},a.createElement("p",null,a.createElement(oe.a,{pill:!0,style:{background:"#b9b7bd",color:"white"}},"BLK Pending")," ",a.createElement(oe.a,{pill:!0,style:{background:"#6c71c4",color:"white"}},"BLK Confirmed")," ",a.createElement(oe.a,{pill:!0,style:{background:"#393e46",color:"white"}},"TX Pending")," ",a.createElement(oe.a,{pill:!0,style:{background:"#fad02c",color:"white"}},"TX Confirmed")," ",a.createElement(oe.a,{pill:!0,style:{background:"#cb4b16",color:"white"}},"Tip")," ",a.createElement(oe.a,{pill:!0,style:{background:"#b58900",color:"white"}},"Unknown"),a.createElement("br",null),"Vertices: ",e.size,", Tips: ",l,", Confirmed/UnConfirmed: ",t,"/",e.size-t,","," ","BPS: ",i.mps,a.createElement("br",null),"Selected: ",n?a.createElement(H.Link,{to:"/explorer/block/"+n.id},n.id.substr(0,10)):"-",a.createElement("br",null),n?Object.keys(n.parentIDsByType).map(e=>a.createElement("span",null," ",e,":"," ",n.parentIDsByType[e].map(e=>a.createElement(H.Link,{to:"/explorer/block/"+e},e.substr(0,10)+" ")))):""))),a.createElement("div",{className:"visualizer",style:{zIndex:-1,position:"absolute",top:0,left:0,width:"100%",height:"100%",background:"#ededed"},id:"visualizer"}))}};Vt=Zt([Object(l.b)("visualizerStore"),Object(l.b)("nodeStore"),l.c],Vt);var Wt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Ut=class extends a.Component{render(){let{blksLiveFeed:e}=this.props.chatStore;return a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Live Feed"),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,{xs:12,style:{height:"500px",overflow:"auto"}},a.createElement("h6",null,"Blocks"),a.createElement(ue.a,null,a.createElement("thead",null,a.createElement("tr",null,a.createElement("td",null,"From"),a.createElement("td",null,"Block"),a.createElement("td",null,"BlockID"),a.createElement("td",null,"Timestamp"))),a.createElement("tbody",null,e))))))))}};Ut=Wt([Object(l.b)("nodeStore"),Object(l.b)("chatStore"),l.c],Ut);var Gt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},Jt=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},Qt=function(e,t,n,a){return new(n||(n=Promise))((function(r,l){function s(e){try{i(a.next(e))}catch(e){l(e)}}function o(e){try{i(a.throw(e))}catch(e){l(e)}}function i(e){var t;e.done?r(e.value):(t=e.value,t instanceof n?t:new n((function(e){e(t)}))).then(s,o)}i((a=a.apply(e,t||[])).next())}))};let qt=class extends a.Component{constructor(e){super(e),this.timeout=null,this.updateSend=e=>{this.setState({chatBlock:e.target.value})},this.sendBlock=e=>Qt(this,void 0,void 0,(function*(){"Enter"===e.key&&""!==this.state.chatBlock&&this.props.chatStore.sendBlock(this.state.chatBlock).then(()=>{this.setState({chatBlock:"",infoBlock:"Block sent!",error:!1}),this.timeout=setTimeout(()=>{this.setState({infoBlock:null,error:!1})},1e4)}).catch(e=>{this.setState({infoBlock:"Ooops error!",error:!0}),this.timeout=setTimeout(()=>{this.setState({infoBlock:null,error:!1})},1e4)})})),this.state={chatBlock:"",error:!1,infoBlock:null}}componentWillUnmount(){this.timeout&&clearTimeout(this.timeout)}render(){let{sending:e}=this.props.chatStore;return a.createElement(a.Fragment,null,a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement("h6",null,"Send a block via the Tangle"),a.createElement(ce.a,{className:"mb-3"},a.createElement(ie.a,{placeholder:"Send Block","aria-label":"Send Block","aria-describedby":"basic-addon1",value:this.state.chatBlock,onChange:this.updateSend,onKeyUp:this.sendBlock,disabled:e,maxlength:"1000"})),this.state.infoBlock&&a.createElement("p",{style:{color:""+(this.state.error?"red":"inherit")}},this.state.infoBlock))))}};qt=Gt([Object(l.b)("nodeStore"),Object(l.b)("chatStore"),l.c,Jt("design:paramtypes",[Object])],qt);var Xt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Kt=class extends a.Component{render(){return a.createElement(d.a,null,a.createElement("h3",null,"Chat"),a.createElement(qt,null),a.createElement(Ut,null))}};Kt=Xt([Object(l.b)("nodeStore"),l.c],Kt);var Yt=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let $t=class extends a.Component{render(){let{conflictsLiveFeed:e}=this.props.conflictsStore;return a.createElement(d.a,null,a.createElement("h3",null,"Conflicts"),a.createElement(ue.a,{bordered:!0},a.createElement("thead",null,a.createElement("tr",null,a.createElement("th",null,"ConflictID"),a.createElement("th",null,"ArrivalTime"),a.createElement("th",null,"Resolved"),a.createElement("th",null,"TimeToResolve - ms"))),a.createElement("tbody",null,e)))}};$t=Yt([Object(l.b)("nodeStore"),Object(l.b)("conflictsStore"),l.c],$t);var en=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let tn=class extends a.Component{componentDidMount(){this.props.explorerStore.getTips()}componentWillUnmount(){this.props.explorerStore.reset()}render(){let{tipsList:e}=this.props.explorerStore;return a.createElement(d.a,null,a.createElement("h3",null,"Tips"),a.createElement(ue.a,{bordered:!0},a.createElement("thead",null,a.createElement("tr",null,a.createElement("th",null,"BlockID"))),a.createElement("tbody",null,e)))}};tn=en([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],tn);var nn=n(77),an=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let rn=class extends a.Component{render(){return a.createElement(E.a,{style:{height:"400px",paddingBottom:80}},a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Mana of Node: ",this.props.node),a.createElement(nn.a,{width:"100%",height:"300px",chartType:"AreaChart",loader:a.createElement("div",null,"Loading Chart"),data:[[{type:"date",id:"Date"},{type:"number",label:"Access Mana"},{type:"number",label:"Consensus Mana"}],...this.props.data],options:{title:"",hAxis:{title:"Time",titleTextStyle:{color:"#333"},format:"hh:mm:ss"},vAxis:{title:"Mana",minValue:0},series:{0:{color:"#213e3b"},1:{color:"#41aea9"}},legend:{position:"top"},chartArea:{width:"80%",height:"70%"},explorer:{actions:["dragToPan","rightClickToReset"],keepInBounds:!0}},rootProps:{"data-testid":"1"}})))}};rn=an([l.c],rn);var ln=rn,sn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let on=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,{style:{height:"300px",fontSize:"0.8rem"}},a.createElement(E.a.Title,null,this.props.title),a.createElement(E.a.Body,{style:{overflow:"auto",height:"90%",padding:"0px 0px 20px 0px"}},a.createElement(ue.a,null,a.createElement("thead",null,a.createElement("tr",{key:"header"},a.createElement("th",{style:{position:"sticky",top:0,background:"white"}},"Rank"),a.createElement("th",{style:{position:"sticky",top:0,background:"white"}},"NodeID"),a.createElement("th",{style:{position:"sticky",top:0,background:"white"}},"Mana"),a.createElement("th",{style:{position:"sticky",top:0,background:"white"}},"% of All"))),a.createElement("tbody",null,this.props.data)))))}};on=sn([l.c],on);var cn=on,dn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let pn=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,this.props.title),a.createElement(nn.a,{width:"100%",height:"400px",chartType:"Histogram",loader:a.createElement("div",null,"Loading Chart"),data:[["NodeID",{type:"number",label:"log10(Mana)"}],...this.props.data],options:{title:"Mana Histogram",hAxis:{title:"log10(Mana) of Nodes ",titleTextStyle:{color:"#333"}},vAxis:{title:"Number of Nodes in Bucket",titleTextStyle:{color:"#333"}},legend:{position:"none"},colors:["#41aea9"]},rootProps:{"data-testid":"1"}})))}};pn=dn([l.c],pn);var un=pn,mn=n(287),hn=n.n(mn),fn=n(288),yn=n.n(fn),bn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let gn=class extends a.Component{render(){const e=this.props.data[0],t=this.props.data[1];return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,{style:{marginBottom:"0rem"}},a.createElement(d.a,{fluid:!0,style:{padding:"0rem"}},a.createElement(p.a,null,a.createElement(u.a,null,this.props.title),a.createElement(u.a,null,a.createElement("b",null,Ot(e)," ",function(e,t){if(e===t)return[];return e>t?a.createElement("img",{src:hn.a,alt:"Up Arrow",width:"20px"}):a.createElement("img",{src:yn.a,alt:"Down Arrow",width:"20px"})}(e,t))))))))}};gn=bn([l.c],gn);var En=gn;var vn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let In=class extends a.Component{render(){if(void 0===this.props.manaStore.allowedPledgeIDs)return[];let e=this.props.manaStore.allowedPledgeIDs,t=[];e.accessFilter.enabled?e.accessFilter.allowedNodeIDs.forEach(e=>{t.push(a.createElement(vt.a,{key:e.shortID,onClick:()=>navigator.clipboard.writeText(e.fullID)},a.createElement("div",null," ",a.createElement("b",null,e.shortID)," (",a.createElement("a",null,e.fullID,")"))))}):t.push(a.createElement(vt.a,{key:"empty-allowed-access"},"Node accepts any access mana pledgeID."));let n=[];return e.consensusFilter.enabled?e.consensusFilter.allowedNodeIDs.forEach(e=>{n.push(a.createElement(vt.a,{key:e.shortID,onClick:()=>navigator.clipboard.writeText(e.fullID)},a.createElement("div",null," ",a.createElement("b",null,e.shortID)," (",a.createElement("a",null,e.fullID,")"))))}):n.push(a.createElement(vt.a,{key:"empty-allowed-consensus"},"Node accepts any consensus mana pledgeID.")),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Access Pledge Filter"," ",e.accessFilter.enabled?a.createElement(oe.a,{variant:"success"},"Enabled"):a.createElement(oe.a,{variant:"danger"},"Disabled")),a.createElement("p",null,"Accepted NodeIDs:"),a.createElement(R.a,{style:{fontSize:"0.75rem",maxHeight:"150px",overflowY:"auto"}},t)))),a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Consensus Pledge Filter"," ",e.consensusFilter.enabled?a.createElement(oe.a,{variant:"success"},"Enabled"):a.createElement(oe.a,{variant:"danger"},"Disabled")),a.createElement("p",null,"Accepted NodeIDs:"),a.createElement(R.a,{style:{fontSize:"0.75rem",maxHeight:"150px",overflowY:"auto"}},n)))))}};In=vn([Object(l.b)("manaStore"),l.c],In);var jn=In,kn=n(443),Sn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let xn=class extends a.Component{render(){return a.createElement(kn.a,{animated:!0,now:this.props.data,label:this.props.data.toFixed(2)+"%"})}};xn=Sn([l.c],xn);var On=xn,Dn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let wn=class extends a.Component{render(){return a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,this.props.title," ",null==this.props.since?a.createElement("i",{style:{fontSize:"0.8rem"}},"since genesis"):a.createElement("i",{style:{fontSize:"0.8rem"}},"since ",this.props.since.toLocaleString())),a.createElement(R.a,{style:{fontSize:"0.8rem",height:"300px",overflowY:"auto"}},this.props.listItems)))}};wn=Dn([Object(l.b)("manaStore"),l.c],wn);var Cn=wn,Rn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Tn=class extends a.Component{render(){let e=this.props.manaStore,t=this.props.nodeStore;const{searchNode:n,searchTxID:r}=this.props.manaStore;return a.createElement(d.a,null,!t.status.tangleTime.synced&&a.createElement(oe.a,{variant:"danger"},"WARNING: Node not in sync, displayed mana values might be outdated!"),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(En,{data:0===e.manaValues.length?[0,0]:[e.manaValues[e.manaValues.length-1][1],e.prevManaValues[0]],title:"Access Mana"})),a.createElement(u.a,null,a.createElement(En,{data:0===e.manaValues.length?[0,0]:[e.manaValues[e.manaValues.length-1][2],e.prevManaValues[1]],title:"Consensus Mana"}))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,a.createElement(d.a,{fluid:!0,style:{padding:"0rem"}},a.createElement(p.a,null,a.createElement(u.a,null,"Access Percentile"),a.createElement(u.a,null,a.createElement("b",null,e.accessPercentile.toFixed(2)," %"))))),a.createElement(On,{data:e.accessPercentile,key:e.accessPercentile.toString()})))),a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,a.createElement(d.a,{fluid:!0,style:{padding:"0rem"}},a.createElement(p.a,null,a.createElement(u.a,null,"Consensus Percentile"),a.createElement(u.a,null,a.createElement("b",null,e.consensusPercentile.toFixed(2)," %"))))),a.createElement(On,{data:e.consensusPercentile,key:e.consensusPercentile.toString()}))))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(ln,{node:t.status.id,data:0===e.manaValues.length?[[new Date,0,0]]:e.manaValues}))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(E.a,null,a.createElement(E.a.Body,null,a.createElement(E.a.Title,null,"Filters"),a.createElement(p.a,null,a.createElement(u.a,null,a.createElement("div",null,a.createElement(oe.a,{pill:!0,style:{backgroundColor:"#41aea9",color:"white"}},"Events")," ",a.createElement(oe.a,{pill:!0,style:{backgroundColor:"#a6f6f1",color:"white"}},"Leaderboards")),a.createElement("label",null,"Search Node:"),a.createElement("input",{placeholder:"Enter a node ID",type:"text",value:n,onChange:t=>e.updateNodeSearch(t.target.value)})),a.createElement(u.a,null,a.createElement("div",null,a.createElement(oe.a,{pill:!0,style:{backgroundColor:"#41aea9",color:"white"}},"Events")),a.createElement("label",null,"Search Transaction:"),a.createElement("input",{placeholder:"Enter a transaction ID",type:"text",value:r,onChange:t=>e.updateTxSearch(t.target.value)}))))))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(Cn,{title:"Access Events",listItems:t.status.tangleTime.synced?e.accessEventList:[e.nodeNotSyncedListItem],since:e.lastRemovedAccessEventTime})),a.createElement(u.a,null,a.createElement(Cn,{title:"Consensus Events",listItems:t.status.tangleTime.synced?e.consensusEventList:[e.nodeNotSyncedListItem],since:e.lastRemovedConsensusEventTime}))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(cn,{data:e.networkRichestFeedAccess,title:"Access Leaderboard"})),a.createElement(u.a,null,a.createElement(cn,{data:e.networkRichestFeedConsensus,title:"Consensus Leaderboard"}))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(cn,{data:e.activeRichestFeedAccess,title:"Active Access Leaderboard"})),a.createElement(u.a,null,a.createElement(cn,{data:e.activeRichestFeedConsensus,title:"Active Consensus Leaderboard"}))),a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(un,{data:e.accessHistogramInput,title:"Access Distribution"})),a.createElement(u.a,null,a.createElement(un,{data:e.consensusHistogramInput,title:"Consensus Distribution"}))),a.createElement(jn,{manaStore:e}))}};Tn=Rn([Object(l.b)("nodeStore"),Object(l.b)("manaStore"),l.c],Tn);var Nn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Mn=class extends a.Component{componentDidMount(){this.props.explorerStore.getTransaction(this.props.txId)}componentWillUnmount(){this.props.explorerStore.reset()}render(){let{txId:e}=this.props,{query_err:t,tx:n}=this.props.explorerStore;return t?a.createElement(d.a,null,a.createElement("h4",null,"Transaction not available - 404"),a.createElement("p",null,"Transaction with ID ",e," not found.")):a.createElement(it,{txID:e,tx:n})}};Mn=Nn([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],Mn);var _n=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Ln=class extends a.Component{componentDidMount(){this.props.explorerStore.getTransactionAttachments(this.props.txId)}componentWillUnmount(){this.props.explorerStore.reset()}render(){let{txAttachments:e}=this.props.explorerStore;return a.createElement("div",{style:{marginTop:"20px",marginBottom:"20px"}},a.createElement("h4",null,"Attachments"),e&&e.blockIDs&&a.createElement(p.a,{className:"mb-3"},a.createElement(u.a,null,a.createElement(R.a,null,e.blockIDs.map((e,t)=>a.createElement(R.a.Item,{key:t},a.createElement("a",{href:"/explorer/block/"+e},e)))))))}};Ln=_n([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],Ln);class Pn extends a.Component{render(){let{id:e}=this.props.match.params;return a.createElement(d.a,null,a.createElement(Mn,{txId:e}),a.createElement(ft,{txId:e}),a.createElement(Ln,{txId:e}))}}var An=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Bn=class extends a.Component{componentDidMount(){this.props.explorerStore.getOutput(this.props.match.params.id),this.props.explorerStore.getPendingMana(this.props.match.params.id),this.props.explorerStore.getOutputMetadata(this.props.match.params.id),this.props.explorerStore.getOutputConsumers(this.props.match.params.id)}componentWillUnmount(){this.props.explorerStore.reset()}render(){let{id:e}=this.props.match.params,{query_err:t,output:n,pendingMana:r,outputMetadata:l,outputConsumers:s}=this.props.explorerStore;return t?a.createElement(d.a,null,a.createElement("h4",null,"Output not found - 404"),a.createElement("span",null,e)):a.createElement(d.a,null,a.createElement("h4",null,"Output"),n&&a.createElement("div",{className:"mb-2"},nt(n),a.createElement(R.a,null,r&&a.createElement(R.a.Item,null,"Pending Mana",a.createElement("hr",null),a.createElement("div",null,"Value: ",Ot(r.mana)),a.createElement("div",null,"Timestamp: ",new Date(1e3*r.timestamp).toLocaleString())))),a.createElement("h4",null,"Metadata"),l&&a.createElement("div",{className:"mb-2"},a.createElement(R.a,null,a.createElement(R.a.Item,null,"Transaction ID: ",a.createElement("a",{href:"/explorer/transaction/"+l.outputID.transactionID},l.outputID.transactionID)," "),"ConflictIDs:",a.createElement(R.a,null,l.conflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:"ConflictID"+t+1,className:"text-break"},a.createElement(H.Link,{to:"/explorer/conflict/"+e},ct(e))))),a.createElement(R.a.Item,null,"Consumer Count: ",l.consumerCount),a.createElement(R.a.Item,null,"Confirmed Consumer: ",a.createElement("a",{href:"/explorer/transaction/"+l.confirmedConsumer},l.confirmedConsumer)," "),a.createElement(R.a.Item,null,"Confirmation State: ",tt(l.confirmationState)),a.createElement(R.a.Item,null,"Confirmation State Time: ",new Date(1e3*l.confirmationStateTime).toLocaleString()))),a.createElement("h4",null,"Consumers"),s&&a.createElement("div",null,a.createElement(R.a,null,s.consumers.map((e,t)=>{return a.createElement(R.a.Item,{key:t},a.createElement("div",null,"Transaction ID:  ",a.createElement("a",{href:"/explorer/transaction/"+e.transactionID},e.transactionID)),a.createElement("div",null,"Valid: ","true"===(n=e.valid)?a.createElement(oe.a,{variant:"success"},"True"):"false"===n?a.createElement(oe.a,{variant:"danger"},"False"):a.createElement(oe.a,{variant:"warning"},"Maybe")," "));var n}))))}};Bn=An([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],Bn);var zn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Hn=class extends a.Component{componentDidMount(){this.props.explorerStore.getConflict(this.props.match.params.id),this.props.explorerStore.getConflictChildren(this.props.match.params.id),this.props.explorerStore.getConflictConflicts(this.props.match.params.id),this.props.explorerStore.getConflictVoters(this.props.match.params.id)}componentWillUnmount(){this.props.explorerStore.reset()}render(){let{id:e}=this.props.match.params,{query_err:t,conflict:n,conflictChildren:r,conflictConflicts:l,conflictVoters:s}=this.props.explorerStore;return t?a.createElement(d.a,null,a.createElement("h4",null,"Conflict not found - 404"),a.createElement("span",null,e)):a.createElement(d.a,null,a.createElement("h4",null,"Conflict"),n&&a.createElement(R.a,null,a.createElement(R.a.Item,null,"ID: ",ct(n.id)),a.createElement(R.a.Item,null,"Parents:",a.createElement(R.a,null,n.parents.map((e,t)=>a.createElement(R.a.Item,{key:t},a.createElement("a",{href:"/explorer/conflict/"+e},ct(e)))))),a.createElement(R.a.Item,null,"Conflicts:",n.conflictIDs&&a.createElement(R.a,null,n.conflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:t},a.createElement("a",{href:"/explorer/output/"+e},e))))),a.createElement(R.a.Item,null,"ConfirmationState: ",tt(n.confirmationState)),a.createElement(R.a.Item,null," Children:",r&&a.createElement(R.a,null,r.childConflicts.map((e,t)=>a.createElement(R.a.Item,{key:t},a.createElement("a",{href:"/explorer/conflict/"+e.conflictID},ct(e.conflictID)))))),a.createElement(R.a.Item,null," Conflicts:",l&&a.createElement(R.a,null,l.conflicts.map((e,t)=>a.createElement("div",{key:t},"OutputID: ",a.createElement("a",{href:"/explorer/output/"+e.outputID.base58},e.outputID.base58),a.createElement(R.a,{className:"mb-2"},e.conflictIDs.map((e,t)=>a.createElement(R.a.Item,{key:t},a.createElement("a",{href:"/explorer/conflict/"+e},ct(e))))))))),a.createElement(R.a.Item,null," Voters:",s&&a.createElement(R.a,null,s.voters.map((e,t)=>a.createElement(R.a.Item,{key:e+t},e))))))}};Hn=zn([Object(l.b)("nodeStore"),Object(l.b)("explorerStore"),l.c],Hn);var Fn=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s};let Zn=class extends a.Component{renderDevTool(){0}componentDidMount(){this.props.nodeStore.connect()}render(){return a.createElement("div",{className:"container"},a.createElement(i.a,{expand:"lg",bg:"light",variant:"light",className:"mb-4"},a.createElement(i.a.Brand,null,"GoShimmer"),a.createElement(c.a,{className:"mr-auto"},a.createElement(we.LinkContainer,{to:"/dashboard"},a.createElement(c.a.Link,null,"Dashboard")),a.createElement(we.LinkContainer,{to:"/neighbors"},a.createElement(c.a.Link,null,"Neighbors")),a.createElement(we.LinkContainer,{to:"/explorer"},a.createElement(c.a.Link,null,"Explorer")),a.createElement(we.LinkContainer,{to:"/visualizer"},a.createElement(c.a.Link,null,"Visualizer")),a.createElement(we.LinkContainer,{to:"/mana"},a.createElement(c.a.Link,null,"Mana")),a.createElement(we.LinkContainer,{to:"/conflicts"},a.createElement(c.a.Link,null,"Conflicts")),a.createElement(we.LinkContainer,{to:"/tips"},a.createElement(c.a.Link,null,"Tips")),a.createElement(we.LinkContainer,{to:"/chat"},a.createElement(c.a.Link,null,"Chat"))),a.createElement(i.a.Collapse,{className:"justify-content-end"},a.createElement(xe,null),a.createElement(i.a.Text,null,!this.props.nodeStore.websocketConnected&&a.createElement(oe.a,{variant:"danger"},"WS not connected!")))),a.createElement(De.g,null,a.createElement(De.d,{exact:!0,path:"/dashboard",component:se}),a.createElement(De.d,{exact:!0,path:"/neighbors",component:zt}),a.createElement(De.d,{exact:!0,path:"/explorer/block/:id",component:St}),a.createElement(De.d,{exact:!0,path:"/explorer/address/:id",component:Ct}),a.createElement(De.d,{exact:!0,path:"/explorer/transaction/:id",component:Pn}),a.createElement(De.d,{exact:!0,path:"/explorer/output/:id",component:Bn}),a.createElement(De.d,{exact:!0,path:"/explorer/conflict/:id",component:Hn}),a.createElement(De.d,{exact:!0,path:"/explorer/404/:search",component:_t}),a.createElement(De.d,{exact:!0,path:"/chat",component:Kt}),a.createElement(De.d,{exact:!0,path:"/conflicts",component:$t}),a.createElement(De.d,{exact:!0,path:"/tips",component:tn}),a.createElement(De.d,{exact:!0,path:"/explorer",component:je}),a.createElement(De.d,{exact:!0,path:"/visualizer",component:Vt}),a.createElement(De.d,{exact:!0,path:"/visualizer/history",component:Vt}),a.createElement(De.d,{exact:!0,path:"/mana",component:Tn}),a.createElement(De.c,{to:"/dashboard"})),this.props.children,this.renderDevTool())}};Zn=Fn([Object(l.b)("nodeStore"),Object(l.b)("routerStore"),l.c],Zn);const Vn=Object(o.hot)(({history:e})=>a.createElement(Zn,{history:e}));var Wn=n(103),Un=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},Gn=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)};class Jn{}class Qn{constructor(){this.mem=new Xn,this.scheduler=new $n}}class qn{}class Xn{}class Kn{}class Yn{}class $n{}class ea{constructor(){this.collected=[],this.network_io=[]}addMetric(e){e.ts=F(Date.now(),"HH:MM:ss"),this.collected.push(e),this.collected.length>ra&&this.collected.shift();let t=this.currentNetIO;t&&(this.network_io.length>ra&&this.network_io.shift(),this.network_io.push(t))}get current(){return this.collected[this.collected.length-1]}get secondLast(){let e=this.collected.length-2;if(!(e<0))return this.collected[e]}get currentNetIO(){return this.current&&this.secondLast?{tx:this.current.packets_written-this.secondLast.packets_written,rx:this.current.packets_read-this.secondLast.packets_read,ts:F(new Date,"HH:MM:ss")}:null}get netIOSeries(){let e=Object.assign({},na,aa("Tx","rgba(53, 180, 219,1)","rgba(53, 180, 219,0.4)")),t=Object.assign({},na,aa("Rx","rgba(235, 134, 52)","rgba(235, 134, 52,0.4)")),n=[];for(let a=0;a<this.network_io.length;a++){let r=this.network_io[a];n.push(r.ts),e.data.push(r.tx),t.data.push(-r.rx)}return{labels:n,datasets:[e,t]}}}Un([Ce.observable,Gn("design:type",Array)],ea.prototype,"collected",void 0),Un([Ce.observable,Gn("design:type",Array)],ea.prototype,"network_io",void 0),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],ea.prototype,"netIOSeries",null);class ta{}const na={label:"Incoming",data:[],fill:!0,lineTension:0,backgroundColor:"rgba(58, 60, 171,0.4)",borderWidth:1,borderColor:"rgba(58, 60, 171,1)",borderCapStyle:"butt",borderDash:[],borderDashOffset:0,borderJoinStyle:"miter",pointBorderColor:"rgba(58, 60, 171,1)",pointBackgroundColor:"#fff",pointBorderWidth:1,pointHoverBackgroundColor:"rgba(58, 60, 171,1)",pointHoverBorderColor:"rgba(220,220,220,1)",pointHoverBorderWidth:2,pointRadius:0,pointHitRadius:20,pointHoverRadius:5};function aa(e,t,n){return{label:e,data:[],backgroundColor:n,borderColor:t,pointBorderColor:t,pointHoverBackgroundColor:t,pointHoverBorderColor:"rgba(220,220,220,1)"}}const ra=900;class la{constructor(){this.status=new Qn,this.websocketConnected=!1,this.last_mps_metric=new Jn,this.collected_mps_metrics=[],this.collected_rate_setter_metrics=[],this.last_rate_setter_metric=new Yn,this.collected_scheduler_metrics=[],this.collected_mem_metrics=[],this.neighbor_metrics=new Ce.ObservableMap,this.last_tips_metric=new Kn,this.collected_tips_metrics=[],this.last_component_counter_metric=new ta,this.collected_component_counter_metrics=[],this.collecting=!0,this.registerHandlers=()=>{Te(Oe.Status,this.updateStatus),Te(Oe.BPSMetrics,e=>{this.addBPSMetric(this.updateLastBPSMetric(e))}),Te(Oe.NeighborStats,this.updateNeighborMetrics),Te(Oe.TipsMetrics,this.updateLastTipsMetric),Te(Oe.ComponentCounterMetrics,this.updateLastComponentMetric),Te(Oe.RateSetter,this.updateLastRateSetterMetric),this.updateCollecting(!0)},this.unregisterHandlers=()=>{Ne(Oe.Status),Ne(Oe.BPSMetrics),Ne(Oe.NeighborStats),Ne(Oe.TipsMetrics),Ne(Oe.ComponentCounterMetrics),Ne(Oe.RateSetter),this.updateCollecting(!1)},this.updateCollecting=e=>{this.collecting=e},this.updateWebSocketConnected=e=>this.websocketConnected=e,this.updateStatus=e=>{e.mem.ts=F(Date.now(),"HH:MM:ss"),this.collected_mem_metrics.length>ra&&this.collected_mem_metrics.shift(),this.collected_mem_metrics.push(e.mem),this.status=e,e.scheduler.ts=F(Date.now(),"HH:MM:ss"),this.collected_scheduler_metrics.length>ra&&this.collected_scheduler_metrics.shift(),this.collected_scheduler_metrics.push(e.scheduler)},this.updateNeighborMetrics=e=>{if(!e)return;let t=[];for(let n=0;n<e.length;n++){let a=e[n],r=this.neighbor_metrics.get(a.id);r||(r=new ea),r.addMetric(a),this.neighbor_metrics.set(a.id,r),t.push(a.id)}for(const e of this.neighbor_metrics.keys())t.includes(e)||this.neighbor_metrics.delete(e)},this.updateLastRateSetterMetric=e=>{e.ts=F(Date.now(),"HH:MM:ss"),this.last_rate_setter_metric=e,this.collected_rate_setter_metrics.length>ra&&this.collected_rate_setter_metrics.shift(),this.collected_rate_setter_metrics.push(e)},this.updateLastBPSMetric=e=>{let t=new Jn;return t.mps=e,t.ts=F(Date.now(),"HH:MM:ss"),this.last_mps_metric=t,t},this.addBPSMetric=e=>{this.collected_mps_metrics.length>ra&&this.collected_mps_metrics.shift(),this.collected_mps_metrics.push(e)},this.updateLastTipsMetric=e=>{e.ts=F(Date.now(),"HH:MM:ss"),this.last_tips_metric=e,this.collected_tips_metrics.length>ra&&this.collected_tips_metrics.shift(),this.collected_tips_metrics.push(e)},this.updateLastComponentMetric=e=>{e.ts=F(Date.now(),"HH:MM:ss"),this.last_component_counter_metric=e,this.collected_component_counter_metrics.length>ra&&this.collected_component_counter_metrics.shift(),this.collected_component_counter_metrics.push(e)},this.status.tangleTime=new qn,this.status.tangleTime.ATT=0,this.status.tangleTime.RATT=0,this.status.tangleTime.CTT=0,this.status.tangleTime.RCTT=0,this.registerHandlers()}reset(){this.collected_mps_metrics=[],this.collected_mem_metrics=[],this.collected_scheduler_metrics=[],this.neighbor_metrics=new Ce.ObservableMap,this.collected_tips_metrics=[],this.collected_component_counter_metrics=[]}reconnect(){this.updateWebSocketConnected(!1),setTimeout(()=>{this.connect()},5e3)}connect(){!function(e,t,n,a){let r=window.location,l="ws:";"https:"===r.protocol&&(l="wss:"),l+="//"+r.host+e;let s=new WebSocket(l);s.onopen=t,s.onclose=n,s.onerror=a,s.onmessage=e=>{let t=JSON.parse(e.data),n=Re[t.type];n&&n(t.data)}}("/ws",()=>this.updateWebSocketConnected(!0),()=>this.reconnect(),()=>this.updateWebSocketConnected(!1))}get mpsSeries(){let e=Object.assign({},na,aa("BPS","rgba(67, 196, 99,1)","rgba(67, 196, 99,0.4)")),t=[];for(let n=0;n<this.collected_mps_metrics.length;n++){let a=this.collected_mps_metrics[n];t.push(a.ts),e.data.push(a.mps)}return{labels:t,datasets:[e]}}get tipsSeries(){let e=Object.assign({},na,aa("All tips","rgba(67, 196, 99,1)","rgba(67, 196, 99,0.4)")),t=[];for(let n=0;n<this.collected_tips_metrics.length;n++){let a=this.collected_tips_metrics[n];t.push(a.ts),e.data.push(a.totaltips)}return{labels:t,datasets:[e]}}get componentSeries(){let e=Object.assign({},na,aa("stored","rgba(209,165,253,1)","rgba(209,165,253,0.4)")),t=Object.assign({},na,aa("solidified","rgba(165,209,253,1)","rgba(165,209,253,0.4)")),n=Object.assign({},na,aa("scheduled","rgba(182, 141, 64,1)","rgba(182, 141, 64,0.4)")),a=Object.assign({},na,aa("booked","rgba(5, 68, 94,1)","rgba(5, 68, 94,0.4)")),r=[];for(let l=0;l<this.collected_component_counter_metrics.length;l++){let s=this.collected_component_counter_metrics[l];r.push(s.ts),e.data.push(s.store),t.data.push(s.solidifier),n.data.push(s.scheduler),a.data.push(s.booker)}return{labels:r,datasets:[e,t,n,a]}}get bufferSizeSeries(){let e=Object.assign({},na,aa("buffer size","rgba(209,165,253,1)","rgba(209,165,253,0.4)")),t=[];for(let n=0;n<this.collected_scheduler_metrics.length;n++){let a=this.collected_scheduler_metrics[n];t.push(a.ts),e.data.push(a.currentBufferSize)}return{labels:t,datasets:[e]}}get deficitSeries(){let e=Object.assign({},na,aa("deficit","rgba(182, 141, 64,1)","rgba(182, 141, 64,0.4)")),t=[];for(let n=0;n<this.collected_scheduler_metrics.length;n++){let a=this.collected_scheduler_metrics[n];t.push(a.ts),e.data.push(a.deficit)}return{labels:t,datasets:[e]}}get neighborsSeries(){return{}}get uptime(){let e,t,n,a;a=Math.floor(this.status.uptime/1e3),n=Math.floor(a/60),a%=60,t=Math.floor(n/60),n%=60,e=Math.floor(t/24),t%=24;let r="";return 1==e&&(r+=e+" Day, "),e>1&&(r+=e+" Days, "),t>=0&&(r+=t<10?"0"+t+":":t+":"),n>=0&&(r+=n<10?"0"+n+":":n+":"),a>=0&&(r+=a<10?"0"+a:a),r}get memSeries(){let e=Object.assign({},na,aa("Heap Sys","rgba(168, 50, 76,1)","rgba(168, 50, 76,0.4)")),t=Object.assign({},na,aa("Heap Alloc","rgba(222, 49, 87,1)","rgba(222, 49, 87,0.4)")),n=Object.assign({},na,aa("Heap Idle","rgba(222, 49, 182,1)","rgba(222, 49, 182,0.4)")),a=Object.assign({},na,aa("Heap Released","rgba(250, 76, 252,1)","rgba(250, 76, 252,0.4)")),r=[];for(let l=0;l<this.collected_mem_metrics.length;l++){let s=this.collected_mem_metrics[l];r.push(s.ts),e.data.push(s.heap_sys),t.data.push(s.heap_alloc),n.data.push(s.heap_idle),a.data.push(s.heap_released)}return{labels:r,datasets:[e,t,n,a]}}}Un([Ce.observable,Gn("design:type",Qn)],la.prototype,"status",void 0),Un([Ce.observable,Gn("design:type",Boolean)],la.prototype,"websocketConnected",void 0),Un([Ce.observable,Gn("design:type",Jn)],la.prototype,"last_mps_metric",void 0),Un([Ce.observable,Gn("design:type",Array)],la.prototype,"collected_mps_metrics",void 0),Un([Ce.observable,Gn("design:type",Array)],la.prototype,"collected_rate_setter_metrics",void 0),Un([Ce.observable,Gn("design:type",Yn)],la.prototype,"last_rate_setter_metric",void 0),Un([Ce.observable,Gn("design:type",Array)],la.prototype,"collected_scheduler_metrics",void 0),Un([Ce.observable,Gn("design:type",Array)],la.prototype,"collected_mem_metrics",void 0),Un([Ce.observable,Gn("design:type",Object)],la.prototype,"neighbor_metrics",void 0),Un([Ce.observable,Gn("design:type",Kn)],la.prototype,"last_tips_metric",void 0),Un([Ce.observable,Gn("design:type",Array)],la.prototype,"collected_tips_metrics",void 0),Un([Ce.observable,Gn("design:type",ta)],la.prototype,"last_component_counter_metric",void 0),Un([Ce.observable,Gn("design:type",Array)],la.prototype,"collected_component_counter_metrics",void 0),Un([Ce.observable,Gn("design:type",Boolean)],la.prototype,"collecting",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateCollecting",void 0),Un([Ce.action,Gn("design:type",Function),Gn("design:paramtypes",[]),Gn("design:returntype",void 0)],la.prototype,"reset",null),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateWebSocketConnected",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateStatus",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateNeighborMetrics",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateLastRateSetterMetric",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateLastBPSMetric",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"addBPSMetric",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateLastTipsMetric",void 0),Un([Ce.action,Gn("design:type",Object)],la.prototype,"updateLastComponentMetric",void 0),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"mpsSeries",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"tipsSeries",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"componentSeries",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"bufferSizeSeries",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"deficitSeries",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"neighborsSeries",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"uptime",null),Un([Ce.computed,Gn("design:type",Object),Gn("design:paramtypes",[])],la.prototype,"memSeries",null);var sa,oa=la,ia=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},ca=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},da=function(e,t,n,a){return new(n||(n=Promise))((function(r,l){function s(e){try{i(a.next(e))}catch(e){l(e)}}function o(e){try{i(a.throw(e))}catch(e){l(e)}}function i(e){var t;e.done?r(e.value):(t=e.value,t instanceof n?t:new n((function(e){e(t)}))).then(s,o)}i((a=a.apply(e,t||[])).next())}))};!function(e){e[e.NotFound=0]="NotFound"}(sa||(sa={}));class pa{constructor(e){this.send_addr="",this.send_access_mana_node_id="",this.send_consensus_mana_node_id="",this.sending=!1,this.sendResult=null,this.query_error="",this.sendReq=()=>da(this,void 0,void 0,(function*(){this.updateSending(!0);try{let e=yield fetch(`/api/faucet/${this.send_addr}?accessMana=${this.send_access_mana_node_id}&consensusMana=${this.send_consensus_mana_node_id}`);if(200!==e.status)return void this.updateQueryError(sa.NotFound);let t=yield e.json();setTimeout(()=>{this.updateSendResult(t)},2e3)}catch(e){this.updateQueryError(e)}})),this.updateSendResult=e=>{this.sending=!1,this.sendResult=e,this.routerStore.history.push("/explorer/address/"+this.send_addr)},this.updateSend=e=>{this.send_addr=e},this.updateSendAccessManaNodeID=e=>{this.send_access_mana_node_id=e},this.updateSendConsensusManaNodeID=e=>{this.send_consensus_mana_node_id=e},this.updateSending=e=>{this.sending=e,this.query_error=""},this.reset=()=>{this.send_addr=null,this.send_access_mana_node_id="",this.send_consensus_mana_node_id="",this.sending=!1,this.query_error=""},this.updateQueryError=e=>{this.sending=!1,this.query_error=e},this.routerStore=e}}ia([Ce.observable,ca("design:type",String)],pa.prototype,"send_addr",void 0),ia([Ce.observable,ca("design:type",String)],pa.prototype,"send_access_mana_node_id",void 0),ia([Ce.observable,ca("design:type",String)],pa.prototype,"send_consensus_mana_node_id",void 0),ia([Ce.observable,ca("design:type",Boolean)],pa.prototype,"sending",void 0),ia([Ce.observable,ca("design:type",class{})],pa.prototype,"sendResult",void 0),ia([Ce.observable,ca("design:type",String)],pa.prototype,"query_error",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"updateSendResult",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"updateSend",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"updateSendAccessManaNodeID",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"updateSendConsensusManaNodeID",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"updateSending",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"reset",void 0),ia([Ce.action,ca("design:type",Object)],pa.prototype,"updateQueryError",void 0);var ua=pa,ma=n(38),ha=n.n(ma),fa=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},ya=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},ba=function(e,t,n,a){return new(n||(n=Promise))((function(r,l){function s(e){try{i(a.next(e))}catch(e){l(e)}}function o(e){try{i(a.throw(e))}catch(e){l(e)}}function i(e){var t;e.done?r(e.value):(t=e.value,t instanceof n?t:new n((function(e){e(t)}))).then(s,o)}i((a=a.apply(e,t||[])).next())}))};class ga{}class Ea{constructor(e){this.vertices=new Ce.ObservableMap,this.verticesLimit=1500,this.finalized_count=0,this.tips_count=0,this.verticesIncomingOrder=[],this.draw=!1,this.selected_via_click=!1,this.selected_origin_color=0,this.search="",this.paused=!1,this.fetchHistory=()=>ba(this,void 0,void 0,(function*(){try{let e=yield fetch("/api/visualizer/history");(yield e.json()).vertices.forEach(e=>{this.addVertex(e)})}catch(e){console.log("Fail to fetch history in visualizer",e)}})),this.updateSearch=e=>{this.search=e.trim()},this.searchAndHighlight=()=>{if(this.clearSelected(),!this.search)return;let e=this.vertices.keys(),t=null;for(const n of e)if(n.indexOf(this.search)>=0){t=n;break}t&&this.updateSelected(this.vertices.get(t),!1)},this.pauseResume=()=>{if(this.paused)return this.renderer.resume(),void(this.paused=!1);this.renderer.pause(),this.paused=!0},this.updateVerticesLimit=e=>{this.verticesLimit=e},this.addVertex=e=>{let t=this.vertices.get(e.id);t?(!t.is_finalized&&e.is_finalized&&(t.is_finalized=!0,this.finalized_count++),t.parentIDsByType=e.parentIDsByType,e=t):(e.is_finalized&&this.finalized_count++,this.verticesIncomingOrder.push(e.id),this.checkLimit()),this.vertices.set(e.id,e),this.draw&&this.drawVertex(e)},this.addTipInfo=e=>{let t=this.vertices.get(e.id);t||(t=new ga,t.id=e.id,this.verticesIncomingOrder.push(t.id),this.checkLimit()),this.tips_count+=e.is_tip?1:t.is_tip?-1:0,t.is_tip=e.is_tip,this.vertices.set(t.id,t),this.draw&&this.drawVertex(t)},this.checkLimit=()=>{for(;this.verticesIncomingOrder.length>this.verticesLimit;){let e=this.verticesIncomingOrder.shift(),t=this.vertices.get(e);this.selected&&e===this.selected.id&&this.clearSelected(),this.vertices.delete(e),this.draw&&this.graph.removeNode(e),t&&(t.is_finalized&&this.finalized_count--,t.is_tip&&this.tips_count--,t.parentIDsByType&&Object.keys(t.parentIDsByType).map(e=>{t.parentIDsByType[e].forEach(e=>{this.deleteParentLink(e)})}))}},this.deleteParentLink=e=>{if(!e)return;let t=this.vertices.get(e);t&&(this.selected&&e===this.selected.id&&this.clearSelected(),t.is_finalized&&this.finalized_count--,t.is_tip&&this.tips_count--,this.vertices.delete(e)),this.draw&&this.graph.removeNode(e)},this.drawVertex=e=>{if(e){let t,n=this.graph.getNode(e.id);if(n){this.graphics.getNodeUI(e.id).color=ja(this.colorForVertexState(e)),t=n}else t=this.graph.addNode(e.id,e);e.parentIDsByType&&Object.keys(e.parentIDsByType).map(n=>{e.parentIDsByType[n].forEach(n=>{if(n&&(!t.links||!t.links.some(e=>e.fromId===n))){this.graph.getNode(n)&&this.graph.addLink(n,e.id)}})})}},this.colorForVertexState=e=>e&&e.parentIDsByType&&0!==Object.keys(e.parentIDsByType).length?e.is_tip?"#cb4b16":e.is_finalized?e.is_tx?"#fad02c":"#6c71c4":e.is_tx?"#393e46":"#b9b7bd":"#b58900",this.start=()=>{this.draw=!0,this.graph=ha.a.Graph.graph();let e=ha.a.Graph.View.webglGraphics();const t=ha.a.Graph.Layout.forceDirected(this.graph,{springLength:10,springCoeff:1e-4,stableThreshold:.15,gravity:-2,dragCoeff:.02,timeStep:20,theta:.8});e.node(e=>e.data?ha.a.Graph.View.webglSquare(20,this.colorForVertexState(e.data)):ha.a.Graph.View.webglSquare(10,this.colorForVertexState(e.data))),e.link(()=>ha.a.Graph.View.webglLine("#586e75"));let n=document.getElementById("visualizer");this.renderer=ha.a.Graph.View.renderer(this.graph,{container:n,graphics:e,layout:t});let a=ha.a.Graph.webglInputEvents(e,this.graph);a.mouseEnter(e=>{this.clearSelected(!0),this.updateSelected(e.data)}).mouseLeave(e=>{this.clearSelected(!1)}),a.click(e=>{this.clearSelected(!0),this.updateSelected(e.data,!0)}),this.graphics=e,this.renderer.run(),this.vertices.forEach(e=>{this.drawVertex(e)})},this.stop=()=>{this.draw=!1,this.renderer.dispose(),this.graph=null,this.paused=!1,this.selected=null},this.updateSelected=(e,t)=>{if(!e)return;this.selected=e,this.selected_via_click=!!t;let n=this.graphics.getNodeUI(e.id);this.selected_origin_color=n.color,n.color=ja("#859900"),n.size=30},this.resetLinks=()=>{this.graph.forEachLink((function(e){this.graphics.getLinkUI(e.id).color=ja("#586e75")}))},this.clearSelected=e=>{if(!this.selected||this.selected_via_click&&!e)return;let t=this.graph.getNode(this.selected.id);if(!t)return void this.resetLinks();let n=this.graphics.getNodeUI(this.selected.id);n.color=this.selected_origin_color,n.size=20;Ia(this.graph,t,e=>{},!0,e=>{this.graphics.getLinkUI(e.id).color=ja("#586e75")},[]),Ia(this.graph,t,e=>{},!1,e=>{this.graphics.getLinkUI(e.id).color=ja("#586e75")},[]),this.selected=null,this.selected_via_click=!1},this.routerStore=e,this.fetchHistory(),Te(Oe.Vertex,this.addVertex),Te(Oe.TipInfo,this.addTipInfo)}}fa([Ce.observable,ya("design:type",Object)],Ea.prototype,"vertices",void 0),fa([Ce.observable,ya("design:type",Object)],Ea.prototype,"verticesLimit",void 0),fa([Ce.observable,ya("design:type",Object)],Ea.prototype,"finalized_count",void 0),fa([Ce.observable,ya("design:type",Object)],Ea.prototype,"tips_count",void 0),fa([Ce.observable,ya("design:type",ga)],Ea.prototype,"selected",void 0),fa([Ce.observable,ya("design:type",String)],Ea.prototype,"search",void 0),fa([Ce.observable,ya("design:type",Boolean)],Ea.prototype,"paused",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"updateSearch",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"searchAndHighlight",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"pauseResume",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"updateVerticesLimit",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"addVertex",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"addTipInfo",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"checkLimit",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"deleteParentLink",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"updateSelected",void 0),fa([Ce.action,ya("design:type",Object)],Ea.prototype,"clearSelected",void 0);var va=Ea;function Ia(e,t,n,a,r=!1,l=[]){l.push(t);let s=0;for(;l.length>s;){const t=l[s++];if(n(t))return!0;for(const n of t.links)r&&r(n),a||n.toId!==t.id||l.includes(e.getNode(n.fromId))?a&&n.fromId===t.id&&!l.includes(e.getNode(n.toId))&&l.push(e.getNode(n.toId)):l.push(e.getNode(n.fromId))}}function ja(e){let t=10414335;if("number"==typeof e)return e;if("string"==typeof e&&e)if(4===e.length&&(e=e.replace(/([^#])/g,"$1$1")),9===e.length)t=parseInt(e.substr(1),16);else{if(7!==e.length)throw'Color expected in hex format with preceding "#". E.g. #00ff00. Got value: '+e;t=parseInt(e.substr(1),16)<<8|255}return t}var ka=n(290),Sa=n.n(ka),xa=n(291),Oa=n.n(xa),Da=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},wa=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)};class Ca{constructor(e,t,n){this.nodeID=e,this.time=t,this.txID=n}}class Ra extends Ca{constructor(e,t,n,a){super(e,t,n),this.amount=a}}class Ta extends Ca{constructor(e,t,n,a){super(e,t,n),this.amount=a}}const Na=a.createElement("tr",null,a.createElement("td",{key:"emptyRow",colSpan:4},"There are no nodes to view with the current search parameters.")),Ma=a.createElement(vt.a,{key:"emptyItem"},"There are no events to view with the current search parameters.");class _a{constructor(){this.manaValues=[],this.prevManaValues=[0,0],this.accessNetworkRichest=[],this.totalAccessNetwork=0,this.accessActiveRichest=[],this.totalAccessActive=0,this.consensusNetworkRichest=[],this.totalConsensusNetwork=0,this.consensusActiveRichest=[],this.totalConsensusActive=0,this.searchNode="",this.searchTxID="",this.accessEvents=[],this.consensusEvents=[],this.initAccessEvents=[],this.initConsensusEvents=[],this.lastRemovedAccessEventTime=null,this.lastRemovedConsensusEventTime=null,this.lastInitRemovedAccessEventTime=null,this.lastInitRemovedConsensusEventTime=null,this.eventsUpdated=!1,this.displayedAccessEvents=[],this.displayedConsensusEvents=[],this.nodeNotSyncedListItem=a.createElement(vt.a,null,"Wait for node to be synced to display mana events."),this.addNewManaValue=e=>{this.ownID=this.ownID?this.ownID:e.nodeID,100===this.manaValues.length&&this.manaValues.shift();let t=[new Date(1e3*e.time),e.access,e.consensus];this.manaValues.length>0&&(this.prevManaValues=[this.manaValues[this.manaValues.length-1][1],this.manaValues[this.manaValues.length-1][2]]),this.manaValues.push(t)},this.updateNetworkRichest=e=>{switch(e.manaType){case"Access":this.totalAccessNetwork=e.totalMana,this.accessNetworkRichest=e.nodes;break;case"Consensus":this.totalConsensusNetwork=e.totalMana,this.consensusNetworkRichest=e.nodes}},this.updateActiveRichest=e=>{switch(e.manaType){case"Access":this.totalAccessActive=e.totalMana,this.accessActiveRichest=e.nodes;break;case"Consensus":this.totalConsensusActive=e.totalMana,this.consensusActiveRichest=e.nodes}},this.updateAllowedPledgeIDs=e=>{this.allowedPledgeIDs=e},this.addNewInitPledge=e=>{let t=[];switch(e.manaType){case"Access":if(t=this.initAccessEvents,t.length>=100){let e=t.shift();this.lastInitRemovedAccessEventTime=e.time}break;case"Consensus":if(t=this.initConsensusEvents,t.length>=100){let e=t.shift();this.lastInitRemovedConsensusEventTime=e.time}break;default:return}let n=new Ra(e.nodeID,new Date(1e3*e.time),e.txID,e.amount);t.push(n)},this.addNewPledge=e=>{let t=[];switch(e.manaType){case"Access":if(t=this.accessEvents,t.length>=100){let e=t.shift();this.lastRemovedAccessEventTime=e.time}break;case"Consensus":if(t=this.consensusEvents,t.length>=100){let e=t.shift();this.lastRemovedConsensusEventTime=e.time}break;default:return}let n=new Ra(e.nodeID,new Date(1e3*e.time),e.txID,e.amount);t.push(n),this.eventsUpdated=!0},this.addNewInitRevoke=e=>{let t=[];switch(e.manaType){case"Consensus":if(t=this.initConsensusEvents,t.length>=100){let e=t.shift();this.lastInitRemovedConsensusEventTime=e.time}break;default:return}let n=new Ta(e.nodeID,new Date(1e3*e.time),e.txID,e.amount);t.push(n)},this.addNewRevoke=e=>{let t=[];switch(e.manaType){case"Consensus":if(t=this.consensusEvents,t.length>=100){let e=t.shift();this.lastRemovedConsensusEventTime=e.time}break;default:return}let n=new Ta(e.nodeID,new Date(1e3*e.time),e.txID,e.amount);t.push(n),this.eventsUpdated=!0},this.initDone=()=>{this.accessEvents=this.initAccessEvents,this.lastRemovedAccessEventTime=this.lastInitRemovedAccessEventTime,this.consensusEvents=this.initConsensusEvents,this.lastRemovedConsensusEventTime=this.lastInitRemovedConsensusEventTime,this.displayedAccessEvents=[...this.accessEvents],this.displayedConsensusEvents=[...this.consensusEvents],setInterval(()=>{this.eventsUpdated&&(this.displayedAccessEvents=[...this.accessEvents],this.displayedConsensusEvents=[...this.consensusEvents],this.eventsUpdated=!1)},500)},this.nodeList=(e,t)=>{if(null===e||null==e)return[];let n=[],r=(e,r)=>{n.push(a.createElement("tr",{key:e.shortNodeID,style:{backgroundColor:e.shortNodeID===this.ownID?"#e8ffff":"white"}},a.createElement("td",{style:{borderTopLeftRadius:e.shortNodeID===this.ownID?"10px":"0",borderBottomLeftRadius:e.shortNodeID===this.ownID?"10px":"0"}}," ",r+1," "),a.createElement("td",null,e.shortNodeID),a.createElement("td",null,Ot(e.mana)),a.createElement("td",{style:{borderTopRightRadius:e.shortNodeID===this.ownID?"10px":"0",borderBottomRightRadius:e.shortNodeID===this.ownID?"10px":"0"}},(e.mana/t*100).toFixed(2),"%")))};return e.forEach((e,t)=>{this.passesNodeFilter(e.shortNodeID)&&r(e,t)}),n},this.computeEventList=e=>{let t=[];if(t.push(a.createElement(vt.a,{style:{textAlign:"center"},key:"header"},a.createElement(p.a,null,a.createElement(u.a,{xs:1,className:"m-auto"}),a.createElement(u.a,null,"NodeID"),a.createElement(u.a,null,"Tx ID"),a.createElement(u.a,{xs:5},"Time")))),null==e)return t;return e.reverse().forEach((e,n)=>{this.passesNodeFilter(e.nodeID)&&this.passesTxFilter(e.txID)&&((e,n)=>{if(e instanceof Ra){let r=e=>a.createElement(Ht.a,{id:e.nodeID+n.toString()},a.createElement(Ht.a.Title,{as:"h3"},"Mana Pledged"),a.createElement(Ht.a.Content,null,a.createElement("div",null,"Base Mana: ",a.createElement("strong",null,"+",Ot(e.amount))),a.createElement("div",null,"With Transaction: ",a.createElement("strong",null,a.createElement("a",{onClick:()=>navigator.clipboard.writeText(e.txID),href:"/explorer/transaction/"+e.txID},e.txID))),a.createElement("div",null,"To NodeID:  ",a.createElement("strong",null,e.nodeID)),a.createElement("div",null,"Time of Pledge:  ",a.createElement("strong",null,e.time.toLocaleTimeString()))));t.push(a.createElement(Ft.a,{key:e.nodeID+n.toString(),trigger:"focus",placement:"top",overlay:r(e)},a.createElement(vt.a,{style:{backgroundColor:"#41aea9",color:"white",textAlign:"center"},key:e.nodeID+n.toString(10),as:"button"},a.createElement(p.a,null,a.createElement(u.a,{xs:1,className:"m-auto"},a.createElement("img",{src:Sa.a,alt:"Plus",width:"20px",className:"d-block mx-auto"})),a.createElement(u.a,null,e.nodeID),a.createElement(u.a,null,e.txID.substring(0,7)+"..."),a.createElement(u.a,{xs:5},e.time.toLocaleString())))))}else if(e instanceof Ta){let r=e=>a.createElement(Ht.a,{id:e.nodeID+n.toString()},a.createElement(Ht.a.Title,{as:"h3"},"Mana Revoked"),a.createElement(Ht.a.Content,null,a.createElement("div",null,"Base Mana 1: ",a.createElement("strong",null,"-",Ot(e.amount))),a.createElement("div",null,"With Transaction: ",a.createElement("strong",null,a.createElement("a",{onClick:()=>navigator.clipboard.writeText(e.txID),href:"/explorer/transaction/"+e.txID},e.txID))),a.createElement("div",null,"From NodeID:  ",a.createElement("strong",null,e.nodeID)),a.createElement("div",null,"Time of Revoke:  ",a.createElement("strong",null,e.time.toLocaleTimeString()))));t.push(a.createElement(Ft.a,{key:e.nodeID+n.toString(),trigger:"focus",placement:"top",overlay:r(e)},a.createElement(vt.a,{style:{backgroundColor:"#213e3b",color:"white",textAlign:"center"},key:e.nodeID+n.toString(10),as:"button"},a.createElement(p.a,null,a.createElement(u.a,{xs:1},a.createElement("img",{src:Oa.a,alt:"Minus",width:"20px",className:""})),a.createElement(u.a,null,e.nodeID),a.createElement(u.a,null,e.txID.substring(0,7)+"..."),a.createElement(u.a,{xs:5},e.time.toLocaleString())))))}})(e,n)}),t},this.passesNodeFilter=e=>0===this.searchNode.trim().length||!!e.toLowerCase().includes(this.searchNode.toLowerCase()),this.passesTxFilter=e=>0===this.searchTxID.trim().length||!!e.toLowerCase().includes(this.searchTxID.toLowerCase()),this.manaValues=[],Te(Oe.Mana,this.addNewManaValue),Te(Oe.ManaMapOverall,this.updateNetworkRichest),Te(Oe.ManaMapOnline,this.updateActiveRichest),Te(Oe.ManaAllowedPledge,this.updateAllowedPledgeIDs),Te(Oe.ManaInitPledge,this.addNewInitPledge),Te(Oe.ManaInitRevoke,this.addNewInitRevoke),Te(Oe.ManaInitDone,this.initDone),Te(Oe.ManaPledge,this.addNewPledge),Te(Oe.ManaRevoke,this.addNewRevoke)}updateNodeSearch(e){this.searchNode=e.trim()}updateTxSearch(e){this.searchTxID=e.trim()}get networkRichestFeedAccess(){let e=this.nodeList(this.accessNetworkRichest,this.totalAccessNetwork);return 0===e.length?[Na]:e}get networkRichestFeedConsensus(){let e=this.nodeList(this.consensusNetworkRichest,this.totalConsensusNetwork);return 0===e.length?[Na]:e}get activeRichestFeedAccess(){let e=this.nodeList(this.accessActiveRichest,this.totalAccessActive);return 0===e.length?[Na]:e}get activeRichestFeedConsensus(){let e=this.nodeList(this.consensusActiveRichest,this.totalConsensusActive);return 0===e.length?[Na]:e}get accessHistogramInput(){if(void 0===this.accessNetworkRichest||null===this.accessNetworkRichest)return[["",0]];let e=new Array;for(let t=0;t<this.accessNetworkRichest.length;t++)e.push([this.accessNetworkRichest[t].shortNodeID,Math.log10(this.accessNetworkRichest[t].mana)]);return e}get consensusHistogramInput(){if(void 0===this.consensusNetworkRichest||null===this.consensusNetworkRichest)return[["",0]];let e=new Array;for(let t=0;t<this.consensusNetworkRichest.length;t++)e.push([this.consensusNetworkRichest[t].shortNodeID,Math.log10(this.consensusNetworkRichest[t].mana)]);return e}get accessPercentile(){let e=0;if(void 0!==this.accessNetworkRichest&&null!==this.accessNetworkRichest){const t=e=>e.shortNodeID===this.ownID;let n=this.accessNetworkRichest.findIndex(t);switch(n){case-1:break;default:e=(this.accessNetworkRichest.length-(n+1))/this.accessNetworkRichest.length*100}}return e}get consensusPercentile(){let e=0;if(void 0!==this.consensusNetworkRichest&&null!==this.consensusNetworkRichest){const t=e=>e.shortNodeID===this.ownID;let n=this.consensusNetworkRichest.findIndex(t);switch(n){case-1:break;default:e=(this.consensusNetworkRichest.length-(n+1))/this.consensusNetworkRichest.length*100}}return e}get accessEventList(){let e=this.computeEventList(this.displayedAccessEvents);return 1===e.length&&e.push(Ma),e}get consensusEventList(){let e=this.computeEventList(this.displayedConsensusEvents);return 1===e.length&&e.push(Ma),e}}Da([Ce.observable,wa("design:type",Array)],_a.prototype,"manaValues",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"prevManaValues",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"accessNetworkRichest",void 0),Da([Ce.observable,wa("design:type",Number)],_a.prototype,"totalAccessNetwork",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"accessActiveRichest",void 0),Da([Ce.observable,wa("design:type",Number)],_a.prototype,"totalAccessActive",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"consensusNetworkRichest",void 0),Da([Ce.observable,wa("design:type",Number)],_a.prototype,"totalConsensusNetwork",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"consensusActiveRichest",void 0),Da([Ce.observable,wa("design:type",Number)],_a.prototype,"totalConsensusActive",void 0),Da([Ce.observable,wa("design:type",Object)],_a.prototype,"searchNode",void 0),Da([Ce.observable,wa("design:type",Object)],_a.prototype,"searchTxID",void 0),Da([Ce.observable,wa("design:type",class{})],_a.prototype,"allowedPledgeIDs",void 0),Da([Ce.observable,wa("design:type",Date)],_a.prototype,"lastRemovedAccessEventTime",void 0),Da([Ce.observable,wa("design:type",Date)],_a.prototype,"lastRemovedConsensusEventTime",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"displayedAccessEvents",void 0),Da([Ce.observable,wa("design:type",Array)],_a.prototype,"displayedConsensusEvents",void 0),Da([Ce.action,wa("design:type",Function),wa("design:paramtypes",[String]),wa("design:returntype",void 0)],_a.prototype,"updateNodeSearch",null),Da([Ce.action,wa("design:type",Function),wa("design:paramtypes",[String]),wa("design:returntype",void 0)],_a.prototype,"updateTxSearch",null),Da([Ce.action,wa("design:type",Object)],_a.prototype,"addNewManaValue",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"updateNetworkRichest",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"updateActiveRichest",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"updateAllowedPledgeIDs",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"addNewInitPledge",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"addNewPledge",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"addNewInitRevoke",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"addNewRevoke",void 0),Da([Ce.action,wa("design:type",Object)],_a.prototype,"initDone",void 0),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"networkRichestFeedAccess",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"networkRichestFeedConsensus",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"activeRichestFeedAccess",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"activeRichestFeedConsensus",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"accessHistogramInput",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"consensusHistogramInput",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"accessPercentile",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"consensusPercentile",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"accessEventList",null),Da([Ce.computed,wa("design:type",Object),wa("design:paramtypes",[])],_a.prototype,"consensusEventList",null);var La,Pa=_a,Aa=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},Ba=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},za=function(e,t,n,a){return new(n||(n=Promise))((function(r,l){function s(e){try{i(a.next(e))}catch(e){l(e)}}function o(e){try{i(a.throw(e))}catch(e){l(e)}}function i(e){var t;e.done?r(e.value):(t=e.value,t instanceof n?t:new n((function(e){e(t)}))).then(s,o)}i((a=a.apply(e,t||[])).next())}))};!function(e){e[e.NotFound=1]="NotFound",e[e.BadRequest=2]="BadRequest"}(La||(La={}));class Ha{}class Fa{constructor(){this.currentOracleEpoch=null,this.previousOracleEpoch=null,this.query_loading=!1,this.query_err=null,this.getOracleEpochs=()=>za(this,void 0,void 0,(function*(){this.updateQueryLoading(!0);try{let e=yield fetch("/api/epochs/oracle/current");if(404===e.status)return void this.updateQueryError(La.NotFound);let t=(yield e.json()).epochID,n=yield this.getEpochData(t),a=yield n;this.updateOracleEpoch(!0,a),n=yield this.getEpochData(t-1),a=yield n,this.updateOracleEpoch(!1,a),this.updateQueryLoading(!1)}catch(e){this.updateQueryError(e)}})),this.getEpochData=e=>za(this,void 0,void 0,(function*(){try{let t=yield fetch("/api/epochs/"+e);return 404===t.status?void this.updateQueryError(La.NotFound):400===t.status?void this.updateQueryError(La.BadRequest):t.json()}catch(e){return void this.updateQueryError(e)}})),this.updateOracleEpoch=(e,t)=>{t.weights.sort((e,t)=>t.mana-e.mana),e?this.currentOracleEpoch=t:this.previousOracleEpoch=t},this.reset=()=>{this.currentOracleEpoch=null,this.previousOracleEpoch=null,this.query_err=null,this.query_loading=null},this.updateQueryError=e=>{this.query_err=e,this.query_loading=!1},this.updateQueryLoading=e=>this.query_loading=e}}Aa([Ce.observable,Ba("design:type",Ha)],Fa.prototype,"currentOracleEpoch",void 0),Aa([Ce.observable,Ba("design:type",Ha)],Fa.prototype,"previousOracleEpoch",void 0),Aa([Ce.observable,Ba("design:type",Boolean)],Fa.prototype,"query_loading",void 0),Aa([Ce.observable,Ba("design:type",Object)],Fa.prototype,"query_err",void 0),Aa([Ce.action,Ba("design:type",Object)],Fa.prototype,"getOracleEpochs",void 0),Aa([Ce.action,Ba("design:type",Object)],Fa.prototype,"getEpochData",void 0),Aa([Ce.action,Ba("design:type",Object)],Fa.prototype,"updateOracleEpoch",void 0),Aa([Ce.action,Ba("design:type",Object)],Fa.prototype,"reset",void 0),Aa([Ce.action,Ba("design:type",Object)],Fa.prototype,"updateQueryError",void 0),Aa([Ce.action,Ba("design:type",Object)],Fa.prototype,"updateQueryLoading",void 0);var Za=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},Va=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},Wa=function(e,t,n,a){return new(n||(n=Promise))((function(r,l){function s(e){try{i(a.next(e))}catch(e){l(e)}}function o(e){try{i(a.throw(e))}catch(e){l(e)}}function i(e){var t;e.done?r(e.value):(t=e.value,t instanceof n?t:new n((function(e){e(t)}))).then(s,o)}i((a=a.apply(e,t||[])).next())}))};class Ua{constructor(e,t){this.latest_blks=[],this.blk=null,this.send_loading=!1,this.send_err=null,this.block="",this.sending=!1,this.addLiveFeed=e=>{-1===this.latest_blks.findIndex(t=>t.blockID==e.blockID)&&(this.latest_blks.length>=10&&this.latest_blks.shift(),this.latest_blks.push(e))},this.updateSend=e=>{this.block=e},this.updateSending=e=>this.sending=e,this.updateSendLoading=e=>this.send_loading=e,this.updateSendError=e=>{this.send_err=e,this.send_loading=!1,this.sending=!1},this.sendBlock=e=>Wa(this,void 0,void 0,(function*(){try{let t=yield fetch("/api/chat",{method:"POST",headers:{Accept:"application/json","Content-Type":"application/json"},body:JSON.stringify({from:this.nodeStore.status.id,to:"all",block:e})});if(400===t.status)return void this.updateSendError("Not able to send");const n=yield t.json();this.updateSending(!1),console.log(n)}catch(e){throw console.log(e),new Error(e)}})),this.routerStore=e,this.nodeStore=t,Te(Oe.Chat,this.addLiveFeed)}reset(){this.block="",this.send_loading=!1,this.sending=!1}get blksLiveFeed(){let e=[];for(let t=this.latest_blks.length-1;t>=0;t--){let n=this.latest_blks[t];e.push(a.createElement("tr",{key:n.blockID},a.createElement("td",null,n.from),a.createElement("td",null,n.block),a.createElement("td",null,a.createElement(H.Link,{to:"/explorer/block/"+n.blockID},n.blockID)),a.createElement("td",null,n.timestamp)))}return e}}Za([Ce.observable,Va("design:type",Array)],Ua.prototype,"latest_blks",void 0),Za([Ce.observable,Va("design:type",class{})],Ua.prototype,"blk",void 0),Za([Ce.observable,Va("design:type",Boolean)],Ua.prototype,"send_loading",void 0),Za([Ce.observable,Va("design:type",Object)],Ua.prototype,"send_err",void 0),Za([Ce.observable,Va("design:type",String)],Ua.prototype,"block",void 0),Za([Ce.observable,Va("design:type",Boolean)],Ua.prototype,"sending",void 0),Za([Ce.action,Va("design:type",Object)],Ua.prototype,"addLiveFeed",void 0),Za([Ce.action,Va("design:type",Object)],Ua.prototype,"updateSend",void 0),Za([Ce.action,Va("design:type",Object)],Ua.prototype,"updateSending",void 0),Za([Ce.action,Va("design:type",Object)],Ua.prototype,"updateSendLoading",void 0),Za([Ce.action,Va("design:type",Object)],Ua.prototype,"updateSendError",void 0),Za([Ce.computed,Va("design:type",Object),Va("design:paramtypes",[])],Ua.prototype,"blksLiveFeed",null);var Ga=Ua,Ja=function(e,t,n,a){var r,l=arguments.length,s=l<3?t:null===a?a=Object.getOwnPropertyDescriptor(t,n):a;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,a);else for(var o=e.length-1;o>=0;o--)(r=e[o])&&(s=(l<3?r(s):l>3?r(t,n,s):r(t,n))||s);return l>3&&s&&Object.defineProperty(t,n,s),s},Qa=function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)};class qa{constructor(e,t){this.updateConflictSets=e=>{this.conflictSets.set(e.conflictSetID,e)},this.updateConflicts=e=>{this.conflicts.set(e.conflictID,e)},this.routerStore=e,this.nodeStore=t,this.conflictSets=new Map,this.conflicts=new Map,Te(Oe.ConflictSet,this.updateConflictSets),Te(Oe.Conflict,this.updateConflicts)}get conflictsLiveFeed(){let e=Array.from(this.conflictSets.values());e.sort((e,t)=>t.arrivalTime-e.arrivalTime||e.conflictSetID.localeCompare(t.conflictSetID));let t=[];for(let n of e){if(t.push(a.createElement("tr",{key:n.conflictSetID,onClick:()=>n.shown=!n.shown,style:{cursor:"pointer"}},a.createElement("td",null,a.createElement(H.Link,{to:"/explorer/output/"+n.conflictSetID},n.conflictSetID)),a.createElement("td",null,new Date(1e3*n.arrivalTime).toLocaleString()),a.createElement("td",null,n.resolved?"Yes":"No"),a.createElement("td",null,n.timeToResolve/1e6))),!n.shown)continue;let e=Array.from(this.conflicts.values());e.sort((e,t)=>e.issuingTime-t.issuingTime||e.conflictID.localeCompare(t.conflictID));let r=[];for(let t of e)for(let e of t.conflictSetIDs)e===n.conflictSetID&&r.push(a.createElement("tr",{key:t.conflictID,className:t.confirmationState>et.Accepted?"table-success":""},a.createElement("td",null,a.createElement(H.Link,{to:"/explorer/branch/"+t.conflictID},t.conflictID)),a.createElement("td",null,tt(t.confirmationState)),a.createElement("td",null," ",new Date(1e3*t.issuingTime).toLocaleString()),a.createElement("td",null,t.issuerNodeID)));t.push(a.createElement("tr",{key:n.conflictSetID+"_branches"},a.createElement("td",{colSpan:4},a.createElement(ue.a,{size:"sm"},a.createElement("thead",null,a.createElement("tr",null,a.createElement("th",null,"BranchID"),a.createElement("th",null,"ConfirmationState"),a.createElement("th",null,"IssuingTime"),a.createElement("th",null,"Issuer NodeID"))),a.createElement("tbody",null,r)))))}return t}}Ja([Ce.observable,Qa("design:type",Map)],qa.prototype,"conflictSets",void 0),Ja([Ce.observable,Qa("design:type",Map)],qa.prototype,"conflicts",void 0),Ja([Ce.action,Qa("design:type",Object)],qa.prototype,"updateConflictSets",void 0),Ja([Ce.action,Qa("design:type",Object)],qa.prototype,"updateConflicts",void 0),Ja([Ce.computed,Qa("design:type",Object),Qa("design:paramtypes",[])],qa.prototype,"conflictsLiveFeed",null);var Xa=qa;const Ka=new Wn.RouterStore,Ya=new oa,$a={routerStore:Ka,nodeStore:Ya,explorerStore:new Ve(Ka),chatStore:new Ga(Ka,Ya),conflictsStore:new Xa(Ka,Ya),faucetStore:new ua(Ka),visualizerStore:new va(Ka),manaStore:new Pa,epochStore:new Fa},er=Object(s.a)(),tr=Object(Wn.syncHistoryWithStore)(er,Ka);r.render(a.createElement(l.a,Object.assign({},$a),a.createElement(De.e,{history:tr},a.createElement(Vn,{history:tr}))),document.getElementById("root"))}},[[438,1,2]]]);
\ No newline at end of file
@@ -36,6 +36,10 @@ export class ExtendedLockedOutputComponent extends React.Component<Props, any> {
                         this.props.output.timelock &&
                         <ListGroup.Item>Timelocked Until: {new Date(this.props.output.timelock * 1000).toLocaleString()}</ListGroup.Item>
                     }
+                    {
+                        this.props.output.hashLock &&
+                        <ListGroup.Item>Hash Lock (SHA-256): {this.props.output.hashLock}</ListGroup.Item>
+                    }
                     <ListGroup.Item>Transaction: <a href={`/explorer/transaction/${this.props.id.transactionID}`}> {this.props.id.transactionID}</a></ListGroup.Item>
                     <ListGroup.Item>Output Index: {this.props.id.outputIndex}</ListGroup.Item>
                 </ListGroup>
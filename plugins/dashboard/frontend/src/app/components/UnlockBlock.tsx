@@ -33,6 +33,9 @@ export class UnlockBlock extends React.Component<UnlockProps, any> {
                         {
                             block.publicKey && <ListGroup.Item>Public Key: {block.publicKey}</ListGroup.Item>
                         }
+                        {
+                            block.preimage && <ListGroup.Item>Preimage: {block.preimage}</ListGroup.Item>
+                        }
                     </ListGroup>
                 </Col>
             </Row>
@@ -60,6 +60,7 @@ export class UnlockBlock {
     signatureType: number;
     publicKey: string;
     signature: string;
+    preimage?: string;
 }
 
 export class SigLockedSingleOutput {
@@ -94,6 +95,7 @@ export class ExtendedLockedOutput {
     fallbackAddress?: string;
     fallbackDeadline?: number;
     timelock?: number;
+    hashLock?: string;
     payload: any;
 
 }
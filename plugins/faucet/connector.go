@@ -32,8 +32,8 @@ func (f *Connector) UnspentOutputs(addresses ...address.Address) (unspentOutputs
 	unspentOutputs = make(map[address.Address]map[utxo.OutputID]*wallet.Output)
 
 	for _, addr := range addresses {
-		f.indexer.CachedAddressOutputMappings(addr.Address()).Consume(func(mapping *indexer.AddressOutputMapping) {
-			f.protocol.Engine().Ledger.Storage.CachedOutput(mapping.OutputID()).Consume(func(output utxo.Output) {
+		if err = f.indexer.StreamUnspentOutputIDs(addr.Address(), func(outputID utxo.OutputID) bool {
+			f.protocol.Engine().Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
 				if typedOutput, ok := output.(devnetvm.Output); ok {
 					f.protocol.Engine().Ledger.Storage.CachedOutputMetadata(typedOutput.ID()).Consume(func(outputMetadata *ledger.OutputMetadata) {
 						if !outputMetadata.IsSpent() {
@@ -56,7 +56,11 @@ func (f *Connector) UnspentOutputs(addresses ...address.Address) (unspentOutputs
 					})
 				}
 			})
-		})
+
+			return true
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to stream unspent outputs of address %s", addr.Base58())
+		}
 	}
 	return
 }
@@ -0,0 +1,42 @@
+package firewall
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the firewall plugin.
+type ParametersDefinition struct {
+	// Threshold defines the score at which faulty peers are dropped and blocklisted.
+	Threshold float64 `default:"10" usage:"the score at which faulty peers are dropped and blocklisted"`
+	// HalfLife defines the time after which the score of a peer has decayed to half of its value.
+	HalfLife time.Duration `default:"10m" usage:"the time after which the score of a faulty peer has decayed to half of its value"`
+	// BlockDuration defines the time for which blocked peers are excluded from the autopeering.
+	BlockDuration time.Duration `default:"1h" usage:"the time for which blocked peers are excluded from the autopeering"`
+	// Penalties contains the penalties that are added to the score of a peer for the different kinds of faults.
+	Penalties struct {
+		// InvalidPacket defines the penalty for packets that can not be parsed.
+		InvalidPacket float64 `default:"1" usage:"the penalty for packets that can not be parsed"`
+		// FilteredBlock defines the penalty for invalid blocks that are rejected by the filter.
+		FilteredBlock float64 `default:"2" usage:"the penalty for invalid blocks that are rejected by the filter (blocks that are issued too far in the future are not penalized)"`
+		// InvalidAttestations defines the penalty for commitments whose claimed weight is not backed by attestations.
+		InvalidAttestations float64 `default:"5" usage:"the penalty for commitments whose claimed weight is not backed by attestations"`
+		// RateLimitHit defines the penalty for peers that exceed a rate limit.
+		RateLimitHit float64 `default:"3" usage:"the penalty for peers that exceed a rate limit"`
+	}
+	// BlockRequestsRateLimit contains the rate limit for the block requests of a peer.
+	BlockRequestsRateLimit struct {
+		// Interval defines the interval in which the block requests are counted.
+		Interval time.Duration `default:"1m" usage:"the interval in which the block requests of a peer are counted"`
+		// Limit defines the maximum number of block requests of a peer in the interval.
+		Limit int `default:"10000" usage:"the maximum number of block requests of a peer in the interval"`
+	}
+}
+
+// Parameters contains the configuration used by the firewall plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "firewall")
+}
@@ -1,44 +1,56 @@
 package firewall
 
-/*
 import (
+	"context"
+
+	"github.com/iotaledger/hive.go/core/autopeering/peer"
+	"github.com/iotaledger/hive.go/core/autopeering/selection"
+	"github.com/iotaledger/hive.go/core/daemon"
+	"github.com/iotaledger/hive.go/core/generics/event"
 	"github.com/iotaledger/hive.go/core/node"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/app/firewall"
+	"github.com/iotaledger/goshimmer/packages/app/ratelimiter"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
-	"github.com/iotaledger/goshimmer/packages/protocol"
-
+	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
-
-	"github.com/iotaledger/goshimmer/packages/app/firewall"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/filter"
 )
 
-// PluginName is the name of the gossip plugin.
+// PluginName is the name of the firewall plugin.
 const PluginName = "Firewall"
 
 var (
-	// Plugin is the plugin instance of the gossip plugin.
+	// Plugin is the plugin instance of the firewall plugin.
 	Plugin *node.Plugin
 
 	deps = new(dependencies)
+
+	blockRequestsRateLimiter *ratelimiter.PeerRateLimiter
 )
 
 type dependencies struct {
 	dig.In
 
-	//GossipMgr *gossipold.Manager
-	Server   *echo.Echo
+	Server   *echo.Echo `optional:"true"`
+	Local    *peer.Local
 	Firewall *firewall.Firewall
 	Protocol *protocol.Protocol
 }
 
 type firewallDeps struct {
 	dig.In
+
 	AutopeeringMgr *selection.Protocol `optional:"true"`
 	P2PMgr         *p2p.Manager
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
 
 	Plugin.Events.Init.Hook(event.NewClosure(func(event *node.InitEvent) {
 		if err := event.Container.Provide(createFirewall); err != nil {
@@ -48,15 +60,33 @@ func init() {
 }
 
 func createFirewall(fDeps firewallDeps) *firewall.Firewall {
-	f, err := firewall.NewFirewall(fDeps.P2PMgr, fDeps.AutopeeringMgr, Plugin.Logger())
+	f, err := firewall.NewFirewall(fDeps.P2PMgr, fDeps.AutopeeringMgr, Plugin.Logger(),
+		firewall.WithThreshold(Parameters.Threshold),
+		firewall.WithHalfLife(Parameters.HalfLife),
+		firewall.WithBlockDuration(Parameters.BlockDuration),
+	)
 	if err != nil {
 		Plugin.LogFatalfAndExit("Couldn't initialize firewall instance: %+v", err)
 	}
 	return f
 }
 
-func configure(_ *node.Plugin) {
-	configureWebAPI()
+func configure(plugin *node.Plugin) {
+	var err error
+	if blockRequestsRateLimiter, err = ratelimiter.NewPeerRateLimiter(Parameters.BlockRequestsRateLimit.Interval, Parameters.BlockRequestsRateLimit.Limit, plugin.Logger()); err != nil {
+		plugin.LogFatalfAndExit("Couldn't create block requests rate limiter: %+v", err)
+	}
+	deps.Firewall.MonitorRateLimiter(blockRequestsRateLimiter, "Block requests rate limit hit", Parameters.Penalties.RateLimitHit)
+
+	configureMisbehaviorDetection()
+
+	deps.Firewall.Events.PeerBlocked.Attach(event.NewClosure(func(peerScore *firewall.PeerScore) {
+		plugin.LogInfof("Peer %s blocked (score %.2f after %d faults)", peerScore.PeerID, peerScore.Score, peerScore.FaultinessCount)
+	}))
+
+	if deps.Server != nil {
+		configureWebAPI()
+	}
 }
 
 func run(plugin *node.Plugin) {
@@ -68,38 +98,72 @@ func run(plugin *node.Plugin) {
 func start(ctx context.Context) {
 	defer Plugin.LogInfo("Stopping " + PluginName + " ... done")
 
-	//if mrl := deps.GossipMgr.BlocksRateLimiter(); mrl != nil {
-	//	mrlClosure := event.NewClosure(func(event *ratelimiter.HitEvent) {
-	//		if !deps.Protocol.Engine().IsBootstrapped() {
-	//			return
-	//		}
-	//		deps.Firewall.HandleFaultyPeer(event.Source.ID(), &firewall.FaultinessDetails{
-	//			Reason: "Blocks rate limit hit",
-	//			Info: map[string]interface{}{
-	//				"rateLimit": event.RateLimit,
-	//			},
-	//		})
-	//	})
-	//	mrl.Events.Hit.Attach(mrlClosure)
-	//	defer mrl.Events.Hit.Detach(mrlClosure)
-	//}
-	//if mrrl := deps.GossipMgr.BlockRequestsRateLimiter(); mrrl != nil {
-	//	mrlClosure := event.NewClosure(func(event *ratelimiter.HitEvent) {
-	//		deps.Firewall.HandleFaultyPeer(event.Source.ID(), &firewall.FaultinessDetails{
-	//			Reason: "Block requests rate limit hit",
-	//			Info: map[string]interface{}{
-	//				"rateLimit": event.RateLimit,
-	//			},
-	//		})
-	//	})
-	//	mrrl.Events.Hit.Attach(mrlClosure)
-	//	defer mrrl.Events.Hit.Detach(mrlClosure)
-	//}
 	Plugin.LogInfof("%s started", PluginName)
 
 	<-ctx.Done()
 
 	Plugin.LogInfo("Stopping " + PluginName + " ...")
+	blockRequestsRateLimiter.Close()
 }
 
-*/
+// configureMisbehaviorDetection attributes the protocol violations to the peers that sent the offending data.
+func configureMisbehaviorDetection() {
+	deps.Protocol.Network().Events.Error.Attach(event.NewClosure(func(errorEvent *network.ErrorEvent) {
+		deps.Firewall.HandleFaultyPeer(errorEvent.Source, &firewall.FaultinessDetails{
+			Reason:  "Invalid packet",
+			Penalty: Parameters.Penalties.InvalidPacket,
+			Info: map[string]interface{}{
+				"error": errorEvent.Error.Error(),
+			},
+		})
+	}))
+
+	deps.Protocol.Network().Events.BlockRequestReceived.Attach(event.NewClosure(func(requestEvent *network.BlockRequestReceivedEvent) {
+		blockRequestsRateLimiter.Count(requestEvent.Source)
+	}))
+
+	deps.Protocol.Events.Engine.Filter.BlockFiltered.Attach(event.NewClosure(func(filteredEvent *filter.BlockFilteredEvent) {
+		// blocks that are issued by the node itself are processed with the local identity as their source
+		if filteredEvent.Source == deps.Local.ID() || !isSenderFault(filteredEvent.Reason) {
+			return
+		}
+
+		deps.Firewall.HandleFaultyPeer(filteredEvent.Source, &firewall.FaultinessDetails{
+			Reason:  "Invalid block",
+			Penalty: Parameters.Penalties.FilteredBlock,
+			Info: map[string]interface{}{
+				"blockID": filteredEvent.Block.ID().Base58(),
+				"error":   filteredEvent.Reason.Error(),
+			},
+		})
+	}))
+
+	deps.Protocol.Events.InvalidAttestationsReceived.Attach(event.NewClosure(func(invalidAttestationsEvent *protocol.InvalidAttestationsEvent) {
+		deps.Firewall.HandleFaultyPeer(invalidAttestationsEvent.Source, &firewall.FaultinessDetails{
+			Reason:  "Invalid commitment",
+			Penalty: Parameters.Penalties.InvalidAttestations,
+			Info: map[string]interface{}{
+				"commitmentID": invalidAttestationsEvent.Commitment.ID().Base58(),
+				"error":        invalidAttestationsEvent.Error.Error(),
+			},
+		})
+	}))
+}
+
+// isSenderFault returns true if the block was filtered for a reason that the sender of the block can check by itself.
+// Blocks that are filtered because of the local wall clock (e.g. blocks that are issued too far in the future) can be
+// sent by honest peers with a drifting clock, so they are not penalized.
+func isSenderFault(reason error) bool {
+	for _, senderFault := range []error{
+		filter.ErrInvalidSignature,
+		filter.ErrInvalidParents,
+		filter.ErrPayloadTooLarge,
+		filter.ErrCommitmentNotCommittable,
+	} {
+		if errors.Is(reason, senderFault) {
+			return true
+		}
+	}
+
+	return false
+}
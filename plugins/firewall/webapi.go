@@ -1,32 +1,67 @@
 package firewall
 
-/*
 import (
 	"net/http"
 
-	"github.com/pkg/errors"
 	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/labstack/echo"
+	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 )
 
-// RoutePeerFaultinessCount defines the HTTP path for firewall/is-peer-faulty endpoint.
-const RoutePeerFaultinessCount = "firewall/peer-faultiness-count/:peerId"
+const (
+	// RoutePeerFaultinessCount defines the HTTP path for firewall/is-peer-faulty endpoint.
+	RoutePeerFaultinessCount = "firewall/peer-faultiness-count/:peerId"
+
+	// RoutePeerScores defines the HTTP path for the firewall/peers endpoint.
+	RoutePeerScores = "firewall/peers"
+
+	// RoutePeerScore defines the HTTP path for the firewall/peers/:peerId endpoint.
+	RoutePeerScore = "firewall/peers/:peerId"
+)
 
 func configureWebAPI() {
 	deps.Server.GET(RoutePeerFaultinessCount, getPeerFaultinessCountHandler)
+	deps.Server.GET(RoutePeerScores, getPeerScoresHandler)
+	deps.Server.GET(RoutePeerScore, getPeerScoreHandler)
 }
 
 func getPeerFaultinessCountHandler(c echo.Context) error {
-	peerID, err := identity.DecodeIDBase58(c.Param("peerId"))
+	peerID, err := peerIDFromContext(c)
 	if err != nil {
-		Plugin.Logger().Errorw("Failed to decode peer id from the URL",
-			"err", err)
-		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid peer id in the URL")))
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	count := deps.Firewall.GetPeerFaultinessCount(peerID)
 	return c.JSON(http.StatusOK, count)
 }
 
-*/
+func getPeerScoresHandler(c echo.Context) error {
+	response := &jsonmodels.PeerScoresResponse{
+		Threshold: deps.Firewall.Threshold(),
+		Peers:     make([]*jsonmodels.PeerScore, 0),
+	}
+	for _, peerScore := range deps.Firewall.PeerScores() {
+		response.Peers = append(response.Peers, jsonmodels.NewPeerScore(peerScore))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func getPeerScoreHandler(c echo.Context) error {
+	peerID, err := peerIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewPeerScore(deps.Firewall.PeerScore(peerID)))
+}
+
+func peerIDFromContext(c echo.Context) (peerID identity.ID, err error) {
+	if peerID, err = identity.DecodeIDBase58(c.Param("peerId")); err != nil {
+		Plugin.Logger().Errorw("Failed to decode peer id from the URL", "err", err)
+		return identity.ID{}, errors.Wrap(err, "invalid peer id in the URL")
+	}
+
+	return peerID, nil
+}
@@ -0,0 +1,18 @@
+package indexer
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the indexer plugin.
+type ParametersDefinition struct {
+	// TransactionHistory defines whether the transactions that created or spent the outputs of an address are recorded.
+	TransactionHistory bool `default:"false" usage:"whether to record the transaction history of the addresses"`
+}
+
+// Parameters contains the configuration parameters of the indexer plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "indexer")
+}
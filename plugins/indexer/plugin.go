@@ -6,7 +6,7 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/protocol"
-	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm/indexer"
 )
 
@@ -35,15 +35,12 @@ func init() {
 }
 
 func provide(deps dependencies) (i *indexer.Indexer) {
-	// TODO: needs to consider switching of instance/ledger in the future
-	// TODO: load snapshot / attach to events from snapshot loading
-	i = indexer.New(func() *ledger.Ledger {
-		return deps.Protocol.Engine().Ledger
-	})
-
-	deps.Protocol.Events.Engine.Ledger.OutputCreated.Attach(event.NewClosure(i.OnOutputCreated))
-	deps.Protocol.Events.Engine.Ledger.OutputSpent.Attach(event.NewClosure(i.OnOutputSpentRejected))
-	deps.Protocol.Events.Engine.Ledger.OutputRejected.Attach(event.NewClosure(i.OnOutputSpentRejected))
+	i = indexer.New(indexer.WithTransactionHistory(Parameters.TransactionHistory))
+	i.LinkTo(deps.Protocol.Engine())
+
+	deps.Protocol.Events.MainEngineSwitched.Attach(event.NewClosure(func(engineInstance *engine.Engine) {
+		i.LinkTo(engineInstance)
+	}))
 
 	return i
 }
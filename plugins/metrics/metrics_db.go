@@ -1,13 +1,23 @@
 package metrics
 
 import (
+	"strconv"
+
+	"github.com/iotaledger/hive.go/core/generics/options"
+
 	"github.com/iotaledger/goshimmer/packages/app/collector"
+	"github.com/iotaledger/goshimmer/packages/storage"
 )
 
 const (
 	dbNamespace = "db"
 
-	sizeBytes = "size_bytes"
+	sizeBytes               = "size_bytes"
+	permanentRealmSizeBytes = "permanent_realm_size_bytes"
+	prunableRealmSizeBytes  = "prunable_realm_size_bytes"
+	prunableBucketSizeBytes = "prunable_bucket_size_bytes"
+	indexerRealmSizeBytes   = "indexer_realm_size_bytes"
+	retainerRealmSizeBytes  = "retainer_realm_size_bytes"
 
 	storagePermanentSizeLabel = "storage_permanent"
 	storagePrunableSizeLabel  = "storage_prunable"
@@ -28,4 +38,58 @@ var DBMetrics = collector.NewCollection(dbNamespace,
 			)
 		}),
 	)),
+	realmSizeMetric(permanentRealmSizeBytes, "Size in bytes of the data of each component in the permanent storage (without compression)", func(usage *storage.Usage) map[string]int64 {
+		return usage.Permanent
+	}),
+	realmSizeMetric(prunableRealmSizeBytes, "Size in bytes of the data of each component in the prunable storage (without compression)", func(usage *storage.Usage) map[string]int64 {
+		return usage.Prunable
+	}),
+	realmSizeMetric(indexerRealmSizeBytes, "Size in bytes of the data of each realm of the indexer in the permanent storage (without compression)", func(usage *storage.Usage) map[string]int64 {
+		return usage.Indexer
+	}),
+	realmSizeMetric(retainerRealmSizeBytes, "Size in bytes of the data of each realm of the retainer (without compression)", func(usage *storage.Usage) map[string]int64 {
+		return usage.Retainer
+	}),
+	collector.WithMetric(collector.NewMetric(prunableBucketSizeBytes,
+		collector.WithType(collector.GaugeVec),
+		collector.WithHelp("Size in bytes on disk of each DB instance of the prunable storage by the first epoch it holds."),
+		collector.WithLabels("epoch"),
+		collector.WithResetBeforeCollecting(true),
+		collector.WithCollectFunc(func() map[string]float64 {
+			res := make(map[string]float64)
+			for index, size := range deps.Protocol.MainStorage().PrunableDatabaseSizes() {
+				res[strconv.FormatInt(int64(index), 10)] = float64(size)
+			}
+
+			return res
+		}),
+	)),
 )
+
+// realmSizeMetric returns a gauge of the sizes of the realms that the given function selects from the disk usage of the
+// storage (as of its most recent periodic computation by the storage WebAPI plugin).
+func realmSizeMetric(name, help string, realmSizes func(usage *storage.Usage) map[string]int64) options.Option[collector.Collection] {
+	return collector.WithMetric(collector.NewMetric(name,
+		collector.WithType(collector.GaugeVec),
+		collector.WithHelp(help+" as of the most recent periodic computation of the usage of the storage."),
+		collector.WithLabels("realm"),
+		collector.WithResetBeforeCollecting(true),
+		collector.WithCollectFunc(func() map[string]float64 {
+			usage := deps.Protocol.MainStorage().LastUsage()
+			if usage == nil {
+				return nil
+			}
+
+			return toFloatValues(realmSizes(usage))
+		}),
+	))
+}
+
+func toFloatValues(sizes map[string]int64) map[string]float64 {
+	res := make(map[string]float64, len(sizes))
+	for name, size := range sizes {
+		res[name] = float64(size)
+	}
+
+	return res
+}
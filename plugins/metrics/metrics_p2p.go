@@ -0,0 +1,189 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/iotaledger/hive.go/core/generics/event"
+
+	"github.com/iotaledger/goshimmer/packages/app/collector"
+	"github.com/iotaledger/goshimmer/packages/network/p2p"
+)
+
+const (
+	p2pNamespace = "p2p"
+
+	trafficBytes   = "traffic_bytes_total"
+	trafficPackets = "traffic_packets_total"
+	droppedPackets = "dropped_packets_total"
+
+	neighborInboundBytes           = "neighbor_inbound_bytes_total"
+	neighborOutboundBytes          = "neighbor_outbound_bytes_total"
+	neighborInboundPackets         = "neighbor_inbound_packets_total"
+	neighborOutboundPackets        = "neighbor_outbound_packets_total"
+	neighborDroppedInboundPackets  = "neighbor_dropped_inbound_packets_total"
+	neighborDroppedOutboundPackets = "neighbor_dropped_outbound_packets_total"
+
+	inboundDirection  = "inbound"
+	outboundDirection = "outbound"
+	neighborLabel     = "neighbor"
+)
+
+// P2PMetrics is the collection of metrics for the traffic of the neighbors.
+var P2PMetrics = collector.NewCollection(p2pNamespace,
+	collector.WithMetric(collector.NewMetric(trafficBytes,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of bytes exchanged with all neighbors per direction"),
+		collector.WithLabels("direction"),
+		collector.WithCollectFunc(trafficCollectFunc(func(stats p2p.TrafficStats) (inbound, outbound uint64) {
+			return stats.BytesRead, stats.BytesWritten
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(trafficPackets,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of packets exchanged with all neighbors per direction"),
+		collector.WithLabels("direction"),
+		collector.WithCollectFunc(trafficCollectFunc(func(stats p2p.TrafficStats) (inbound, outbound uint64) {
+			return stats.PacketsRead, stats.PacketsWritten
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(droppedPackets,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of packets that were dropped due to rate limits or full send queues per direction"),
+		collector.WithLabels("direction"),
+		collector.WithCollectFunc(trafficCollectFunc(func(stats p2p.TrafficStats) (inbound, outbound uint64) {
+			return stats.PacketsDroppedInbound, stats.PacketsDroppedOutbound
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborInboundBytes,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of bytes received from each connected neighbor"),
+		collector.WithLabels(neighborLabel),
+		collector.WithCollectFunc(neighborTrafficCollectFunc(func(stats p2p.TrafficStats) uint64 {
+			return stats.BytesRead
+		})),
+		collector.WithInitFunc(func() {
+			// the neighbors are removed from all per-neighbor metrics in a single attachment
+			onNeighborRemoved := event.NewClosure(func(event *p2p.NeighborRemovedEvent) {
+				for _, metricName := range []string{neighborInboundBytes, neighborOutboundBytes, neighborInboundPackets, neighborOutboundPackets, neighborDroppedInboundPackets, neighborDroppedOutboundPackets} {
+					deps.Collector.ResetMetricLabels(p2pNamespace, metricName, map[string]string{
+						neighborLabel: event.Neighbor.ID().String(),
+					})
+				}
+			})
+
+			if deps.P2Pmgr != nil {
+				deps.P2Pmgr.NeighborGroupEvents(p2p.NeighborsGroupAuto).NeighborRemoved.Attach(onNeighborRemoved)
+				deps.P2Pmgr.NeighborGroupEvents(p2p.NeighborsGroupManual).NeighborRemoved.Attach(onNeighborRemoved)
+			}
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborOutboundBytes,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of bytes sent to each connected neighbor"),
+		collector.WithLabels(neighborLabel),
+		collector.WithCollectFunc(neighborTrafficCollectFunc(func(stats p2p.TrafficStats) uint64 {
+			return stats.BytesWritten
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborInboundPackets,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of packets received from each connected neighbor"),
+		collector.WithLabels(neighborLabel),
+		collector.WithCollectFunc(neighborTrafficCollectFunc(func(stats p2p.TrafficStats) uint64 {
+			return stats.PacketsRead
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborOutboundPackets,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of packets sent to each connected neighbor"),
+		collector.WithLabels(neighborLabel),
+		collector.WithCollectFunc(neighborTrafficCollectFunc(func(stats p2p.TrafficStats) uint64 {
+			return stats.PacketsWritten
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborDroppedInboundPackets,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of packets received from each connected neighbor that were dropped due to rate limits"),
+		collector.WithLabels(neighborLabel),
+		collector.WithCollectFunc(neighborTrafficCollectFunc(func(stats p2p.TrafficStats) uint64 {
+			return stats.PacketsDroppedInbound
+		})),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborDroppedOutboundPackets,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of packets for each connected neighbor that were dropped due to full send queues"),
+		collector.WithLabels(neighborLabel),
+		collector.WithCollectFunc(neighborTrafficCollectFunc(func(stats p2p.TrafficStats) uint64 {
+			return stats.PacketsDroppedOutbound
+		})),
+	)),
+)
+
+// trafficCollectFunc returns a collect function that adds the traffic of all neighbors per direction to a counter.
+func trafficCollectFunc(directionValues func(stats p2p.TrafficStats) (inbound, outbound uint64)) func() map[string]float64 {
+	deltas := newCounterDeltas()
+
+	return func() map[string]float64 {
+		if deps.P2Pmgr == nil {
+			return nil
+		}
+		inbound, outbound := directionValues(deps.P2Pmgr.TrafficStats())
+
+		return deltas.Since(collector.MultiLabelsValues([]string{inboundDirection, outboundDirection}, inbound, outbound))
+	}
+}
+
+// neighborTrafficCollectFunc returns a collect function that adds the traffic of each connected neighbor to a counter.
+func neighborTrafficCollectFunc(value func(stats p2p.TrafficStats) uint64) func() map[string]float64 {
+	deltas := newCounterDeltas()
+
+	return func() map[string]float64 {
+		if deps.P2Pmgr == nil {
+			return nil
+		}
+
+		values := make(map[string]float64)
+		for _, neighbor := range deps.P2Pmgr.AllNeighbors() {
+			values[neighbor.ID().String()] = float64(value(neighbor.TrafficStats()))
+		}
+
+		return deltas.Since(values)
+	}
+}
+
+// region counterDeltas ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// counterDeltas converts the cumulative values of a counter into the increments since the last collection (the
+// collector adds the collected values of counters instead of setting them).
+type counterDeltas struct {
+	lastValues map[string]float64
+	mutex      sync.Mutex
+}
+
+// newCounterDeltas returns a new counterDeltas.
+func newCounterDeltas() *counterDeltas {
+	return &counterDeltas{
+		lastValues: make(map[string]float64),
+	}
+}
+
+// Since returns the increments of the given values since the last call. Values that decreased were reset (e.g.
+// because a neighbor reconnected) and are returned in full, labels that disappeared are forgotten.
+func (c *counterDeltas) Since(values map[string]float64) (deltas map[string]float64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	deltas = make(map[string]float64, len(values))
+	for label, value := range values {
+		if lastValue, exists := c.lastValues[label]; exists && value >= lastValue {
+			deltas[label] = value - lastValue
+		} else {
+			deltas[label] = value
+		}
+	}
+	c.lastValues = values
+
+	return deltas
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,9 @@
 package metrics
 
-import "github.com/iotaledger/goshimmer/packages/app/collector"
+import (
+	"github.com/iotaledger/goshimmer/packages/app/collector"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
 
 const (
 	rateSetterNamespace = "ratesetter"
@@ -12,9 +15,9 @@ const (
 var RateSetterMetrics = collector.NewCollection(rateSetterNamespace,
 	collector.WithMetric(collector.NewMetric(estimate,
 		collector.WithType(collector.Gauge),
-		collector.WithHelp("Current rate estimate for the node."),
+		collector.WithHelp("Current rate estimate for the node to issue a block without payload."),
 		collector.WithCollectFunc(func() map[string]float64 {
-			return collector.SingleValue(deps.BlockIssuer.RateSetter.Estimate())
+			return collector.SingleValue(deps.BlockIssuer.RateSetter.EstimateWork(models.PayloadWork(0)))
 		}),
 	)),
 	collector.WithMetric(collector.NewMetric(ownRate,
@@ -4,11 +4,13 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/app/collector"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol/icca/scheduler"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/filter"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
@@ -25,6 +27,7 @@ const (
 	timeSinceReceivedPerComponent = "time_since_received_per_component_seconds"
 	requestQueueSize              = "request_queue_size"
 	blocksOrphanedCount           = "blocks_orphaned_total"
+	blocksFilteredCount           = "blocks_filtered_total"
 	acceptedBlocksCount           = "accepted_blocks_count"
 )
 
@@ -104,6 +107,16 @@ var TangleMetrics = collector.NewCollection(tangleNamespace,
 			}))
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(blocksFilteredCount,
+		collector.WithType(collector.CounterVec),
+		collector.WithHelp("Number of blocks filtered per reason"),
+		collector.WithLabels("reason"),
+		collector.WithInitFunc(func() {
+			deps.Protocol.Events.Engine.Filter.BlockFiltered.Attach(event.NewClosure(func(filteredEvent *filter.BlockFilteredEvent) {
+				deps.Collector.Increment(tangleNamespace, blocksFilteredCount, filterReason(filteredEvent.Reason))
+			}))
+		}),
+	)),
 	collector.WithMetric(collector.NewMetric(blocksOrphanedCount,
 		collector.WithType(collector.Counter),
 		collector.WithHelp("Number of orphaned blocks"),
@@ -144,3 +157,21 @@ var TangleMetrics = collector.NewCollection(tangleNamespace,
 )
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// filterReason returns the label of the reason why a block was filtered.
+func filterReason(reason error) string {
+	switch {
+	case errors.Is(reason, filter.ErrCommitmentNotCommittable):
+		return "commitment_not_committable"
+	case errors.Is(reason, filter.ErrBlockTimeTooFarAheadInFuture):
+		return "too_far_in_future"
+	case errors.Is(reason, filter.ErrInvalidParents):
+		return "invalid_parents"
+	case errors.Is(reason, filter.ErrPayloadTooLarge):
+		return "payload_too_large"
+	case errors.Is(reason, filter.ErrInvalidSignature):
+		return "invalid_signature"
+	default:
+		return "other"
+	}
+}
@@ -148,6 +148,7 @@ func registerMetrics() {
 	deps.Collector.RegisterCollection(DBMetrics)
 	deps.Collector.RegisterCollection(ManaMetrics)
 	deps.Collector.RegisterCollection(AutopeeringMetrics)
+	deps.Collector.RegisterCollection(P2PMetrics)
 	deps.Collector.RegisterCollection(RateSetterMetrics)
 	deps.Collector.RegisterCollection(SchedulerMetrics)
 	deps.Collector.RegisterCollection(CommitmentsMetrics)
@@ -8,9 +8,14 @@ import (
 	"github.com/iotaledger/hive.go/core/autopeering/peer"
 	"github.com/iotaledger/hive.go/core/autopeering/peer/service"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/iotaledger/goshimmer/packages/core/libp2putil"
+	"github.com/iotaledger/goshimmer/packages/network"
+	nwmodels "github.com/iotaledger/goshimmer/packages/network/models"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
+	"github.com/iotaledger/goshimmer/packages/network/warpsync"
 )
 
 var localAddr *net.TCPAddr
@@ -42,7 +47,38 @@ func createManager(lPeer *peer.Local) *p2p.Manager {
 		Plugin.LogFatalfAndExit("Couldn't create libp2p host: %s", err)
 	}
 
-	return p2p.NewManager(libp2pHost, lPeer, Plugin.Logger())
+	return p2p.NewManager(libp2pHost, lPeer, Plugin.Logger(),
+		p2p.WithInboundLimits(trafficLimits(Parameters.Inbound)),
+		p2p.WithOutboundLimits(trafficLimits(Parameters.Outbound)),
+		p2p.WithProtocolInboundLimits(network.ProtocolID, trafficLimits(Parameters.Gossip.Inbound)),
+		p2p.WithProtocolOutboundLimits(network.ProtocolID, trafficLimits(Parameters.Gossip.Outbound)),
+		p2p.WithProtocolInboundLimits(warpsync.ProtocolID, trafficLimits(Parameters.Warpsync.Inbound)),
+		p2p.WithProtocolOutboundLimits(warpsync.ProtocolID, trafficLimits(Parameters.Warpsync.Outbound)),
+		p2p.WithPacketPriorityFunc(packetPriority),
+	)
+}
+
+func trafficLimits(limits TrafficLimitsDefinition) p2p.TrafficLimits {
+	return p2p.TrafficLimits{
+		PacketsPerSecond: limits.PacketsPerSecond,
+		PacketBurst:      limits.PacketBurst,
+		BytesPerSecond:   limits.BytesPerSecond,
+		ByteBurst:        limits.ByteBurst,
+	}
+}
+
+// packetPriority prioritizes requests and their responses over the gossip of blocks and the bulk traffic of warpsync.
+func packetPriority(_ protocol.ID, packet proto.Message) p2p.PacketPriority {
+	gossipPacket, isGossipPacket := packet.(*nwmodels.Packet)
+	if !isGossipPacket {
+		return p2p.PacketPriorityLow
+	}
+
+	if _, isBlock := gossipPacket.GetBody().(*nwmodels.Packet_Block); isBlock {
+		return p2p.PacketPriorityLow
+	}
+
+	return p2p.PacketPriorityHigh
 }
 
 func start(ctx context.Context) {
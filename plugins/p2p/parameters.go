@@ -6,6 +6,36 @@ import "github.com/iotaledger/goshimmer/plugins/config"
 type ParametersDefinition struct {
 	// BindAddress defines on which address the p2p service should listen.
 	BindAddress string `default:"0.0.0.0:14666" usage:"the bind address for p2p connections"`
+	// Inbound defines the limits of the inbound traffic of each neighbor (all protocols combined).
+	Inbound TrafficLimitsDefinition
+	// Outbound defines the limits of the outbound traffic to each neighbor (all protocols combined).
+	Outbound TrafficLimitsDefinition
+	// Gossip defines the limits of the traffic of the protocol that gossips blocks and answers requests.
+	Gossip struct {
+		// Inbound defines the limits of the inbound traffic of the gossip protocol of each neighbor.
+		Inbound TrafficLimitsDefinition
+		// Outbound defines the limits of the outbound traffic of the gossip protocol to each neighbor.
+		Outbound TrafficLimitsDefinition
+	}
+	// Warpsync defines the limits of the traffic of the protocol that syncs whole epochs.
+	Warpsync struct {
+		// Inbound defines the limits of the inbound traffic of the warpsync protocol of each neighbor.
+		Inbound TrafficLimitsDefinition
+		// Outbound defines the limits of the outbound traffic of the warpsync protocol to each neighbor.
+		Outbound TrafficLimitsDefinition
+	}
+}
+
+// TrafficLimitsDefinition contains the definition of the token bucket limits of the traffic in one direction.
+type TrafficLimitsDefinition struct {
+	// PacketsPerSecond defines the rate at which packets are allowed.
+	PacketsPerSecond float64 `default:"0" usage:"the rate at which packets are allowed (0 disables the limit)"`
+	// PacketBurst defines the amount of packets that are allowed in a burst.
+	PacketBurst int `default:"1000" usage:"the amount of packets that are allowed in a burst"`
+	// BytesPerSecond defines the rate at which bytes are allowed.
+	BytesPerSecond float64 `default:"0" usage:"the rate at which bytes are allowed (0 disables the limit)"`
+	// ByteBurst defines the amount of bytes that are allowed in a burst.
+	ByteBurst int `default:"4194304" usage:"the amount of bytes that are allowed in a burst"`
 }
 
 // Parameters contains the configuration parameters of the p2p plugin.
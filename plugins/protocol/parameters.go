@@ -18,6 +18,34 @@ type ParametersDefinition struct {
 	BootstrapWindow time.Duration `default:"20s" usage:"the time window in which the node considers itself as bootstrapped according to AcceptanceTime"`
 	// GenesisTime resets the genesis time to the specified value, Unix time in seconds.
 	GenesisTime int64 `default:"0" usage:"resets the genesis time to the specified value, unix time in seconds"`
+	// RollbackToEpoch rolls the ledger state back to the given committed epoch before the node starts.
+	RollbackToEpoch int64 `default:"0" usage:"rolls the ledger state back to the given committed epoch before the node starts (0 disables the rollback, the rollback is only applied once)"`
+	// Filter contains the configuration parameters of the validation of the received blocks.
+	Filter struct {
+		// MaxAllowedClockDrift defines how far ahead of the local wall clock blocks are allowed to be issued.
+		MaxAllowedClockDrift time.Duration `default:"5s" usage:"the maximum time that blocks are allowed to be issued ahead of the local wall clock"`
+		// SignatureWorkerCount defines the amount of workers that verify the signatures of the received blocks.
+		SignatureWorkerCount int `default:"4" usage:"the amount of workers that verify the signatures of the received blocks"`
+		// SignatureVerificationQueueSize defines the maximum amount of received blocks that wait for the verification of their signatures.
+		SignatureVerificationQueueSize int64 `default:"10000" usage:"the maximum amount of received blocks that wait for the verification of their signatures (further blocks are dropped)"`
+	}
+	// Requester contains the configuration parameters of the requests for missing blocks and commitments.
+	Requester struct {
+		// RetryInterval defines the time to wait before a missing block or commitment is requested again.
+		RetryInterval time.Duration `default:"10s" usage:"the time to wait before a missing block or commitment is requested again"`
+		// RetryJitter defines the maximum random time that is added to the retry interval.
+		RetryJitter time.Duration `default:"5s" usage:"the maximum random time that is added to the retry interval"`
+		// RetryBackOffFactor defines the factor by which the retry interval grows with every retry.
+		RetryBackOffFactor float64 `default:"1.5" usage:"the factor by which the retry interval grows with every retry"`
+		// MaxRetryInterval defines the upper bound of the retry interval.
+		MaxRetryInterval time.Duration `default:"1m" usage:"the upper bound of the retry interval"`
+		// MaxRequestThreshold defines how often a missing block or commitment is requested before the request fails.
+		MaxRequestThreshold int `default:"100" usage:"how often a missing block or commitment is requested before the request fails"`
+		// Fanout defines the amount of neighbors that are asked once the neighbor that referenced the entity did not answer.
+		Fanout int `default:"2" usage:"the amount of neighbors that are asked once the neighbor that referenced the entity did not answer"`
+		// MaxFanout defines the maximum amount of neighbors that are asked in a single attempt.
+		MaxFanout int `default:"8" usage:"the maximum amount of neighbors that are asked in a single attempt"`
+	}
 	// Snapshot contains snapshots related configuration parameters.
 	Snapshot struct {
 		// Path is the path to the snapshot file.
@@ -31,12 +59,12 @@ type ParametersDefinition struct {
 type SchedulerParametersDefinition struct {
 	// MaxBufferSize defines the maximum buffer size (in number of blocks).
 	MaxBufferSize int `default:"10000" usage:"maximum buffer size (in number of blocks)"` // 300 blocks
-	// Rate defines the frequency to schedule a block.
-	Rate time.Duration `default:"1ms" usage:"block scheduling interval [time duration string]"` // 1000 blocks per second
+	// Rate defines the time it takes to schedule a unit of work.
+	Rate time.Duration `default:"1ms" usage:"block scheduling interval per unit of work [time duration string]"` // 1000 small blocks per second
 	// ConfirmedBlockThreshold time threshold after which confirmed blocks are not scheduled [time duration string]
 	ConfirmedBlockThreshold time.Duration `default:"1m" usage:"time threshold after which confirmed blocks are not scheduled [time duration string]"`
 	// MaxDeficit defines the maximum defict a node can build up.
-	MaxDeficit int `default:"10" usage:"max deficit (in units of work)"` // 10 units of work
+	MaxDeficit int `default:"64" usage:"max deficit (in units of work, at least the work of the largest block)"` // 64 units of work
 }
 
 // NotarizationParametersDefinition contains the definition of the parameters used by the notarization plugin.
@@ -53,6 +81,9 @@ type DatabaseParametersDefinition struct {
 	// InMemory defines whether to use an in-memory database.
 	InMemory bool `default:"false" usage:"whether the database is only kept in memory and not persisted"`
 
+	// Engine defines the database engine that is used to persist the data.
+	Engine string `default:"rocksdb" usage:"the database engine (rocksdb/pebble)"`
+
 	MaxOpenDBs       int    `default:"10" usage:"maximum number of open database instances"`
 	PruningThreshold uint64 `default:"360" usage:"how many confirmed epochs should be retained"`
 	DBGranularity    int64  `default:"1" usage:"how many epochs should be contained in a single DB instance"`
@@ -4,25 +4,35 @@ import (
 	"context"
 
 	"github.com/iotaledger/hive.go/core/daemon"
+	hivedb "github.com/iotaledger/hive.go/core/database"
 	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/generics/options"
 	"github.com/iotaledger/hive.go/core/node"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/eventticker"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
 	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/chainmanager"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol/icca/scheduler"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/filter"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tsc"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/requester/requestrouter"
+	"github.com/iotaledger/goshimmer/packages/protocol/requester/warpsync"
 	"github.com/iotaledger/goshimmer/packages/protocol/tipmanager"
+	warpsyncplugin "github.com/iotaledger/goshimmer/plugins/warpsync"
 )
 
 // PluginName is the name of the gossip plugin.
@@ -57,12 +67,6 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 		epoch.GenesisTime = Parameters.GenesisTime
 	}
 
-	var dbProvider database.DBProvider
-	if DatabaseParameters.InMemory {
-		dbProvider = database.NewMemDB
-	} else {
-		dbProvider = database.NewDB
-	}
 	p = protocol.New(n,
 		protocol.WithSybilProtectionProvider(
 			dpos.NewProvider(
@@ -82,6 +86,23 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 				ledger.WithCacheTimeProvider(cacheTimeProvider),
 			),
 			engine.WithSnapshotDepth(Parameters.Snapshot.Depth),
+			engine.WithRequesterOptions(requesterOptions[models.BlockID]()...),
+			engine.WithFilterOptions(
+				filter.WithMaxAllowedWallClockDrift(Parameters.Filter.MaxAllowedClockDrift),
+				filter.WithSignatureWorkerCount(Parameters.Filter.SignatureWorkerCount),
+				filter.WithSignatureVerificationQueueSize(Parameters.Filter.SignatureVerificationQueueSize),
+			),
+		),
+		protocol.WithChainManagerOptions(
+			chainmanager.WithCommitmentRequesterOptions(requesterOptions[commitment.ID]()...),
+		),
+		protocol.WithBlockRequestRouterOptions(
+			requestrouter.WithFanout[models.BlockID](Parameters.Requester.Fanout),
+			requestrouter.WithMaxFanout[models.BlockID](Parameters.Requester.MaxFanout),
+		),
+		protocol.WithCommitmentRequestRouterOptions(
+			requestrouter.WithFanout[commitment.ID](Parameters.Requester.Fanout),
+			requestrouter.WithMaxFanout[commitment.ID](Parameters.Requester.MaxFanout),
 		),
 		protocol.WithTipManagerOptions(
 			tipmanager.WithWidth(Parameters.TangleWidth),
@@ -95,11 +116,17 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 				scheduler.WithMaxDeficit(SchedulerParameters.MaxDeficit),
 			),
 		),
+		protocol.WithLogger(Plugin.Logger()),
+		protocol.WithWarpsyncOptions(
+			warpsync.WithConcurrency(warpsyncplugin.Parameters.Concurrency),
+			warpsync.WithBlockBatchSize(warpsyncplugin.Parameters.BlockBatchSize),
+			warpsync.WithSyncRangeTimeout(warpsyncplugin.Parameters.SyncRangeTimeOut),
+		),
 		protocol.WithBaseDirectory(DatabaseParameters.Directory),
 		protocol.WithSnapshotPath(Parameters.Snapshot.Path),
 		protocol.WithPruningThreshold(DatabaseParameters.PruningThreshold),
 		protocol.WithStorageDatabaseManagerOptions(
-			database.WithDBProvider(dbProvider),
+			database.WithDBProvider(DBProvider(DatabaseParameters.Directory)),
 			database.WithMaxOpenDBs(DatabaseParameters.MaxOpenDBs),
 			database.WithGranularity(DatabaseParameters.DBGranularity),
 		),
@@ -108,6 +135,17 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 	return p
 }
 
+// requesterOptions returns the options of the EventTickers that request missing blocks and commitments.
+func requesterOptions[T epoch.IndexedID]() []options.Option[eventticker.EventTicker[T]] {
+	return []options.Option[eventticker.EventTicker[T]]{
+		eventticker.RetryInterval[T](Parameters.Requester.RetryInterval),
+		eventticker.RetryJitter[T](Parameters.Requester.RetryJitter),
+		eventticker.RetryBackOffFactor[T](Parameters.Requester.RetryBackOffFactor),
+		eventticker.MaxRetryInterval[T](Parameters.Requester.MaxRetryInterval),
+		eventticker.MaxRequestThreshold[T](Parameters.Requester.MaxRequestThreshold),
+	}
+}
+
 func configureLogging(*node.Plugin) {
 	// deps.Protocol.Events.Engine.Tangle.BlockDAG.BlockAttached.Attach(event.NewClosure(func(block *blockdag.Block) {
 	// 	Plugin.LogDebugf("Block %s attached", block.ID())
@@ -141,6 +179,8 @@ func configureLogging(*node.Plugin) {
 }
 
 func run(*node.Plugin) {
+	scheduleConfiguredRollback()
+
 	deps.Protocol.Run()
 
 	if err := daemon.BackgroundWorker("protocol", func(ctx context.Context) {
@@ -153,7 +193,59 @@ func run(*node.Plugin) {
 		Plugin.Panicf("Error starting as daemon: %s", err)
 	}
 
+	onNeighborRemoved := event.NewClosure(func(event *p2p.NeighborRemovedEvent) {
+		deps.Protocol.RemoveNeighbor(event.Neighbor.ID())
+	})
+	deps.Network.NeighborGroupEvents(p2p.NeighborsGroupAuto).NeighborRemoved.Attach(onNeighborRemoved)
+	deps.Network.NeighborGroupEvents(p2p.NeighborsGroupManual).NeighborRemoved.Attach(onNeighborRemoved)
+
 	deps.Protocol.Network().Events.Error.Attach(event.NewClosure(func(errorEvent *network.ErrorEvent) {
 		Plugin.LogErrorf("Error in Network: %s (source: %s)", errorEvent.Error, errorEvent.Source.String())
 	}))
+
+	deps.Protocol.Events.Error.Attach(event.NewClosure(func(err error) {
+		Plugin.LogWarnf("Error in Protocol: %s", err)
+	}))
+}
+
+// DBProvider returns the database.DBProvider of the configured database engine for the given directory.
+func DBProvider(directory string) database.DBProvider {
+	if DatabaseParameters.InMemory {
+		return database.NewMemDB
+	}
+
+	engine, err := hivedb.EngineFromStringAllowed(DatabaseParameters.Engine, database.Engines...)
+	if err != nil {
+		Plugin.LogFatalfAndExit("invalid database engine: %s", err)
+	}
+
+	dbProvider, err := database.DBProviderFromEngine(engine, directory)
+	if err != nil {
+		Plugin.LogFatalfAndExit("failed to create database provider: %s", err)
+	}
+
+	return dbProvider
+}
+
+// scheduleConfiguredRollback schedules the rollback that is configured by the RollbackToEpoch parameter. The rollback
+// is only applied once, so later restarts with the parameter still being set do not roll back the ledger state again.
+func scheduleConfiguredRollback() {
+	if Parameters.RollbackToEpoch <= 0 {
+		return
+	}
+
+	targetEpoch := epoch.Index(Parameters.RollbackToEpoch)
+	if deps.Protocol.RollbackApplied(targetEpoch) {
+		Plugin.LogInfof("Rollback to epoch %d was already applied, the parameter can be removed", targetEpoch)
+		return
+	}
+
+	if latestEpoch := deps.Protocol.Engine().Storage.Settings.LatestCommitment().Index(); targetEpoch >= latestEpoch {
+		Plugin.LogWarnf("Skipping rollback to epoch %d as the latest committed epoch is %d", targetEpoch, latestEpoch)
+		return
+	}
+
+	if err := deps.Protocol.ScheduleRollback(targetEpoch); err != nil {
+		Plugin.Panicf("Failed to schedule rollback to epoch %d: %s", targetEpoch, err)
+	}
 }
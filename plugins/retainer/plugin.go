@@ -45,12 +45,5 @@ func configure(*node.Plugin) {
 }
 
 func createRetainer(p *protocol.Protocol) *retainer.Retainer {
-	var dbProvider database.DBProvider
-	if protocolplugin.DatabaseParameters.InMemory {
-		dbProvider = database.NewMemDB
-	} else {
-		dbProvider = database.NewDB
-	}
-
-	return retainer.NewRetainer(p, database.NewManager(protocol.DatabaseVersion, database.WithGranularity(Parameters.DBGranularity), database.WithMaxOpenDBs(Parameters.MaxOpenDBs), database.WithDBProvider(dbProvider), database.WithBaseDir(Parameters.Directory)))
+	return retainer.NewRetainer(p, database.NewManager(protocol.DatabaseVersion, database.WithGranularity(Parameters.DBGranularity), database.WithMaxOpenDBs(Parameters.MaxOpenDBs), database.WithDBProvider(protocolplugin.DBProvider(Parameters.Directory)), database.WithBaseDir(Parameters.Directory)))
 }
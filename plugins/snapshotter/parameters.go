@@ -0,0 +1,17 @@
+package snapshotter
+
+import "github.com/iotaledger/goshimmer/plugins/config"
+
+// ParametersDefinition contains the definition of the parameters used by the snapshotter plugin.
+type ParametersDefinition struct {
+	Directory string `default:"snapshots" usage:"path to the directory that the snapshots are written to"`
+	Interval  uint64 `default:"360" usage:"after how many committed epochs a snapshot is written (0 disables the automatic snapshots)"`
+	Retention int    `default:"3" usage:"how many snapshots should be retained"`
+}
+
+// Parameters contains the configuration used by the snapshotter plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "snapshotter")
+}
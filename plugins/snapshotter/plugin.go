@@ -0,0 +1,69 @@
+package snapshotter
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/core/daemon"
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/node"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/snapshotter"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+)
+
+// PluginName is the name of the snapshotter plugin.
+const PluginName = "Snapshotter"
+
+var (
+	// Plugin is the plugin instance of the snapshotter plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Snapshotter *snapshotter.Snapshotter
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+
+	Plugin.Events.Init.Hook(event.NewClosure(func(event *node.InitEvent) {
+		if err := event.Container.Provide(createSnapshotter); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(plugin *node.Plugin) {
+	deps.Snapshotter.Events.SnapshotWritten.Attach(event.NewClosure(func(snapshotInfo *snapshotter.SnapshotInfo) {
+		plugin.LogInfof("Snapshot of epoch %d written to %s", snapshotInfo.Index, snapshotInfo.FilePath)
+	}))
+	deps.Snapshotter.Events.SnapshotRemoved.Attach(event.NewClosure(func(snapshotInfo *snapshotter.SnapshotInfo) {
+		plugin.LogInfof("Snapshot of epoch %d removed", snapshotInfo.Index)
+	}))
+	deps.Snapshotter.Events.Error.Attach(event.NewClosure(func(err error) {
+		plugin.LogError(err)
+	}))
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+		deps.Snapshotter.Shutdown()
+	}, shutdown.PrioritySnapshotter); err != nil {
+		plugin.Logger().Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+func createSnapshotter(p *protocol.Protocol) *snapshotter.Snapshotter {
+	return snapshotter.New(p,
+		snapshotter.WithDirectory(Parameters.Directory),
+		snapshotter.WithInterval(epoch.Index(Parameters.Interval)),
+		snapshotter.WithRetention(Parameters.Retention),
+	)
+}
@@ -9,10 +9,9 @@ import (
 	"github.com/iotaledger/hive.go/core/generics/event"
 	"github.com/iotaledger/hive.go/core/node"
 
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
-	"github.com/iotaledger/goshimmer/packages/network/p2p"
 	"github.com/iotaledger/goshimmer/packages/protocol"
-	"github.com/iotaledger/goshimmer/packages/protocol/requester/warpsync"
 )
 
 // PluginName is the name of the warpsync plugin.
@@ -28,47 +27,18 @@ var (
 type dependencies struct {
 	dig.In
 
-	Protocol    *protocol.Protocol
-	WarpsyncMgr *warpsync.Manager
-	P2PMgr      *p2p.Manager
+	Protocol *protocol.Protocol
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
-
-	Plugin.Events.Init.Hook(event.NewClosure(func(event *node.InitEvent) {
-		if err := event.Container.Provide(func(p *protocol.Protocol, p2pManager *p2p.Manager) *warpsync.Manager {
-			// TODO: refactor when its ready
-			// // TODO: use a different block loader function
-			// loadBlockFunc := func(blockID models.BlockID) (*models.Block, error) {
-			//	block, exists := p.Engine().Block(blockID)
-			//	if !exists {
-			//		return nil, errors.Errorf("block %s not found", blockID)
-			//	}
-			//	return block, nil
-			// }
-			// processBlockFunc := func(blk *models.Block, peer *peer.Peer) {
-			//	p..Parser.Events.BlockParsed.Trigger(&tangleold.BlockParsedEvent{
-			//		Block: blk,
-			//		Peer:  peer,
-			//	})
-			// }
-			// return warpsync.NewManager(p2pManager, loadBlockFunc, processBlockFunc, Plugin.Logger(), warpsync.WithConcurrency(Parameters.Concurrency), warpsync.WithBlockBatchSize(Parameters.BlockBatchSize))
-			return nil
-		}); err != nil {
-			Plugin.Panic(err)
-		}
-	}))
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
 }
 
 func configure(_ *node.Plugin) {
-	// deps.NotarizationMgr.Events.SyncRange.Attach(event.NewClosure(func(event *notarization.SyncRangeEvent) {
-	// 	ctx, cancel := context.WithTimeout(context.Background(), Parameters.SyncRangeTimeOut)
-	// 	defer cancel()
-	// 	if err := deps.WarpsyncMgr.WarpRange(ctx, event.StartEI, event.EndEI, event.StartEC, event.EndPrevEC); err != nil {
-	// 		Plugin.LogWarn("failed to warpsync:", err)
-	// 	}
-	// }))
+	// the warpsync manager itself is owned by the protocol and configured with the Parameters of this plugin.
+	deps.Protocol.Events.WarpsyncStarted.Attach(event.NewClosure(func(targetCommitment *commitment.Commitment) {
+		Plugin.LogInfof("Warpsyncing to epoch %d (commitment %s)", targetCommitment.Index(), targetCommitment.ID())
+	}))
 }
 
 func start(ctx context.Context) {
@@ -16,6 +16,9 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/webapi/mana"
 	"github.com/iotaledger/goshimmer/plugins/webapi/ratesetter"
 	"github.com/iotaledger/goshimmer/plugins/webapi/scheduler"
+	"github.com/iotaledger/goshimmer/plugins/webapi/snapshot"
+	"github.com/iotaledger/goshimmer/plugins/webapi/storage"
+	"github.com/iotaledger/goshimmer/plugins/webapi/subscriptions"
 	"github.com/iotaledger/goshimmer/plugins/webapi/weightprovider"
 )
 
@@ -32,8 +35,10 @@ var WebAPI = node.Plugins(
 	epoch.Plugin,
 	mana.Plugin,
 	ledgerstate.Plugin,
-	// snapshot.Plugin,
+	snapshot.Plugin,
+	storage.Plugin,
 	weightprovider.Plugin,
 	ratesetter.Plugin,
 	scheduler.Plugin,
+	subscriptions.Plugin,
 )
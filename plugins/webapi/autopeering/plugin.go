@@ -14,6 +14,7 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/network/p2p"
 )
 
 // PluginName is the name of the web API autopeering endpoint plugin.
@@ -31,6 +32,7 @@ type dependencies struct {
 	Server    *echo.Echo
 	Selection *selection.Protocol `optional:"true"`
 	Discover  *discover.Protocol  `optional:"true"`
+	P2PMgr    *p2p.Manager        `optional:"true"`
 }
 
 func init() {
@@ -73,10 +75,44 @@ func createNeighborFromPeer(p *peer.Peer) jsonmodels.Neighbor {
 		PublicKey: p.PublicKey().String(),
 	}
 	n.Services = getServices(p)
+	n.Traffic = getTrafficStats(p)
 
 	return n
 }
 
+// getTrafficStats returns the traffic statistics of the peer if it is a connected neighbor.
+func getTrafficStats(p *peer.Peer) *jsonmodels.TrafficStats {
+	if deps.P2PMgr == nil {
+		return nil
+	}
+
+	nbr, err := deps.P2PMgr.GetNeighbor(p.ID())
+	if err != nil {
+		return nil
+	}
+
+	trafficStats := newTrafficStats(nbr.TrafficStats())
+	trafficStats.Protocols = make(map[string]jsonmodels.TrafficStats)
+	for _, protocolID := range nbr.Protocols() {
+		if protocolStats, exists := nbr.ProtocolTrafficStats(protocolID); exists {
+			trafficStats.Protocols[string(protocolID)] = *newTrafficStats(protocolStats)
+		}
+	}
+
+	return trafficStats
+}
+
+func newTrafficStats(stats p2p.TrafficStats) *jsonmodels.TrafficStats {
+	return &jsonmodels.TrafficStats{
+		PacketsRead:            stats.PacketsRead,
+		PacketsWritten:         stats.PacketsWritten,
+		BytesRead:              stats.BytesRead,
+		BytesWritten:           stats.BytesWritten,
+		PacketsDroppedInbound:  stats.PacketsDroppedInbound,
+		PacketsDroppedOutbound: stats.PacketsDroppedOutbound,
+	}
+}
+
 func getServices(p *peer.Peer) []jsonmodels.PeerService {
 	var services []jsonmodels.PeerService
 
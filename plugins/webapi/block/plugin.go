@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/app/retainer"
+	"github.com/iotaledger/goshimmer/packages/protocol"
 	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
@@ -29,6 +30,7 @@ type dependencies struct {
 	dig.In
 
 	Server      *echo.Echo
+	Protocol    *protocol.Protocol
 	Retainer    *retainer.Retainer
 	BlockIssuer *blockissuer.BlockIssuer
 }
@@ -40,6 +42,7 @@ func init() {
 func configure(_ *node.Plugin) {
 	deps.Server.GET("blocks/:blockID", GetBlock)
 	deps.Server.GET("blocks/:blockID/metadata", GetBlockMetadata)
+	deps.Server.GET("blocks/:blockID/proof", GetBlockProof)
 	deps.Server.POST("blocks/payload", PostPayload)
 
 	// TODO: add markers to be retained by the retainer
@@ -146,6 +149,25 @@ func GetBlock(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetBlockProof ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetBlockProof is the handler for the /blocks/:blockID/proof endpoint.
+func GetBlockProof(c echo.Context) (err error) {
+	blockID, err := blockIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	proof, err := deps.Protocol.Engine().NotarizationManager.BlockInclusionProof(blockID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to generate proof for %s", blockID)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewProof(proof))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region BlockMetadata ///////////////////////////////////////////////////////////////////////////////////////////
 
 // GetBlockMetadata is the handler for the /blocks/:blockID/metadata endpoint.
@@ -12,6 +12,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 )
 
@@ -56,13 +57,19 @@ func broadcastData(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: "no data provided"})
 	}
 
-	if request.MaxEstimate > 0 && deps.BlockIssuer.Estimate().Milliseconds() > request.MaxEstimate {
+	dataPayload := payload.NewGenericDataPayload(request.Data)
+	dataPayloadBytes, err := dataPayload.Bytes()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: err.Error()})
+	}
+
+	if request.MaxEstimate > 0 && deps.BlockIssuer.EstimateWork(models.PayloadWork(len(dataPayloadBytes))).Milliseconds() > request.MaxEstimate {
 		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{
 			Error: fmt.Sprintf("issuance estimate greater than %d ms", request.MaxEstimate),
 		})
 	}
 
-	constructedBlock, err := deps.BlockIssuer.CreateBlock(payload.NewGenericDataPayload(request.Data))
+	constructedBlock, err := deps.BlockIssuer.CreateBlock(dataPayload)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, jsonmodels.DataResponse{Error: err.Error()})
 	}
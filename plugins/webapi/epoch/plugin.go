@@ -1,14 +1,31 @@
 package epoch
 
 import (
+	"net/http"
+	"strconv"
+
+	"github.com/iotaledger/hive.go/core/identity"
 	"github.com/iotaledger/hive.go/core/node"
 	"github.com/labstack/echo"
+	"github.com/pkg/errors"
 	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/plugins/webapi/paging"
 )
 
 // PluginName is the name of the web API epoch endpoint plugin.
 const PluginName = "WebAPIEpochEndpoint"
 
+// defaultPageSize is the amount of elements that is returned by paged endpoints if no pageSize is given.
+const defaultPageSize = 100
+
 var (
 	// Plugin is the plugin instance of the web API epoch endpoint plugin.
 	Plugin *node.Plugin
@@ -18,7 +35,8 @@ var (
 type dependencies struct {
 	dig.In
 
-	Server *echo.Echo
+	Server   *echo.Echo
+	Protocol *protocol.Protocol
 }
 
 func init() {
@@ -26,140 +44,261 @@ func init() {
 }
 
 func configure(_ *node.Plugin) {
-	// deps.Server.GET("epochs", getAllCommittedEpochs)
-	// deps.Server.GET("ec", getCurrentEC)
-	// deps.Server.GET("epoch/:ei", getCommittedEpoch)
-	// deps.Server.GET("epoch/:ei/utxos", getUTXOs)
-	// deps.Server.GET("epoch/:ei/blocks", getBlocks)
-	// deps.Server.GET("epoch/:ei/transactions", getTransactions)
-	// deps.Server.GET("epoch/:ei/pending-conflict-count", getPendingConflictsCount)
-	// deps.Server.GET("epoch/:ei/voters-weight", getVotersWeight)
+	deps.Server.GET("epochs", getCommittedEpochs)
+	deps.Server.GET("ec", getLatestCommitment)
+	deps.Server.GET("epoch/:ei", getCommittedEpoch)
+	deps.Server.GET("epoch/:ei/roots", getRoots)
+	deps.Server.GET("epoch/:ei/utxos", getUTXOs)
+	deps.Server.GET("epoch/:ei/blocks", getBlocks)
+	deps.Server.GET("epoch/:ei/transactions", getTransactions)
+	deps.Server.GET("epoch/:ei/voters-weight", getVotersWeight)
+}
+
+// getCommittedEpochs returns the committed epochs starting at the epoch given as cursor (or the latest committed epoch)
+// and going backwards in time.
+func getCommittedEpochs(c echo.Context) error {
+	pageSize, err := paging.PageSizeFromContext(c, defaultPageSize)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	storageInstance := deps.Protocol.Engine().Storage
+
+	startIndex := storageInstance.Settings.LatestCommitment().Index()
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		cursorIndex, parseErr := strconv.ParseUint(cursor, 10, 64)
+		if parseErr != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(parseErr, "can't parse cursor from URL param")))
+		}
+
+		if epoch.Index(cursorIndex) < startIndex {
+			startIndex = epoch.Index(cursorIndex)
+		}
+	}
+
+	resp := jsonmodels.EpochsResponse{Epochs: make([]*jsonmodels.EpochInfo, 0)}
+	for index := int64(startIndex); index >= 0; index-- {
+		if len(resp.Epochs) == pageSize {
+			resp.NextCursor = strconv.FormatInt(index, 10)
+			break
+		}
+
+		epochCommitment, loadErr := storageInstance.Commitments.Load(epoch.Index(index))
+		if loadErr != nil {
+			break
+		}
+
+		resp.Epochs = append(resp.Epochs, jsonmodels.EpochInfoFromRecord(epochCommitment))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func getLatestCommitment(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.EpochInfoFromRecord(deps.Protocol.Engine().Storage.Settings.LatestCommitment()))
+}
+
+func getCommittedEpoch(c echo.Context) error {
+	ei, err := getEI(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	epochCommitment, err := deps.Protocol.Engine().Storage.Commitments.Load(ei)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to load commitment of epoch %d", ei)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.EpochInfoFromRecord(epochCommitment))
+}
+
+func getRoots(c echo.Context) error {
+	ei, err := getEI(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	roots, err := deps.Protocol.Engine().Storage.Roots.Load(ei)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to load roots of epoch %d", ei)))
+	} else if roots == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("roots of epoch %d are not available", ei)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.EpochRootsResponseFromRoots(roots))
+}
+
+// getUTXOs returns the outputs that were spent and created in the given epoch. Both lists are paged as a single stream
+// (the spent outputs followed by the created outputs), so that a single cursor identifies the next page of both lists.
+func getUTXOs(c echo.Context) error {
+	ei, err := getCommittedEI(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	offset, pageSize, err := paging.FromContext(c, defaultPageSize)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	stateDiffs := deps.Protocol.Engine().LedgerState.StateDiffs
+
+	outputs := paging.NewPage[*epochOutput](offset, pageSize)
+	if err = stateDiffs.StreamSpentOutputs(ei, func(output *ledger.OutputWithMetadata) error {
+		return outputs.AddOrStop(&epochOutput{id: output.ID().Base58(), spent: true})
+	}); err != nil && !errors.Is(err, paging.ErrPageFull) {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to stream spent outputs of epoch %d", ei)))
+	}
+
+	if !outputs.HasMore() {
+		if err = stateDiffs.StreamCreatedOutputs(ei, func(output *ledger.OutputWithMetadata) error {
+			return outputs.AddOrStop(&epochOutput{id: output.ID().Base58()})
+		}); err != nil && !errors.Is(err, paging.ErrPageFull) {
+			return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to stream created outputs of epoch %d", ei)))
+		}
+	}
+
+	resp := jsonmodels.EpochUTXOsResponse{
+		SpentOutputs:   make([]string, 0),
+		CreatedOutputs: make([]string, 0),
+		NextCursor:     outputs.NextCursor(),
+	}
+	for _, output := range outputs.Elements() {
+		if output.spent {
+			resp.SpentOutputs = append(resp.SpentOutputs, output.id)
+		} else {
+			resp.CreatedOutputs = append(resp.CreatedOutputs, output.id)
+		}
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// epochOutput is an element of the paged stream of the outputs of an epoch.
+type epochOutput struct {
+	id    string
+	spent bool
+}
+
+func getBlocks(c echo.Context) error {
+	ei, err := getCommittedEI(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	offset, pageSize, err := paging.FromContext(c, defaultPageSize)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	acceptedBlocks, err := deps.Protocol.Engine().NotarizationManager.AcceptedBlocks(ei)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+	}
+
+	blocks := paging.NewPage[string](offset, pageSize)
+	if err = acceptedBlocks.Stream(func(blockID models.BlockID) bool {
+		return blocks.Add(blockID.Base58())
+	}); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to stream accepted blocks of epoch %d", ei)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.EpochBlocksResponse{
+		Blocks:     blocks.Elements(),
+		NextCursor: paging.NextCursor(offset, pageSize, blocks.HasMore()),
+	})
+}
+
+func getTransactions(c echo.Context) error {
+	ei, err := getCommittedEI(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	offset, pageSize, err := paging.FromContext(c, defaultPageSize)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	acceptedTransactions, err := deps.Protocol.Engine().NotarizationManager.AcceptedTransactions(ei)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+	}
+
+	transactions := paging.NewPage[string](offset, pageSize)
+	if err = acceptedTransactions.Stream(func(transactionID utxo.TransactionID) bool {
+		return transactions.Add(transactionID.Base58())
+	}); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to stream accepted transactions of epoch %d", ei)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.EpochTransactionsResponse{
+		Transactions: transactions.Elements(),
+		NextCursor:   paging.NextCursor(offset, pageSize, transactions.HasMore()),
+	})
 }
 
-//
-// func getAllCommittedEpochs(c echo.Context) error {
-// 	allEpochs := epochstorage.GetCommittableEpochs()
-// 	allEpochsInfos := make([]*jsonmodels.EpochInfo, 0, len(allEpochs))
-// 	for _, ecRecord := range allEpochs {
-// 		allEpochsInfos = append(allEpochsInfos, jsonmodels.EpochInfoFromRecord(ecRecord))
-// 	}
-// 	sort.Slice(allEpochsInfos, func(i, j int) bool {
-// 		return allEpochsInfos[i].EI < allEpochsInfos[j].EI
-// 	})
-// 	return c.JSON(http.StatusOK, allEpochsInfos)
-// }
-//
-// func getCurrentEC(c echo.Context) error {
-// 	ecRecord, err := deps.NotarizationMgr.GetLatestEC()
-// 	if err != nil {
-// 		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	ec := ecRecord.ID()
-//
-// 	return c.JSON(http.StatusOK, ec.Base58())
-// }
-//
-// func getCommittedEpoch(c echo.Context) error {
-// 	ei, err := getEI(c)
-// 	if err != nil {
-// 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	allEpochs := epochstorage.GetCommittableEpochs()
-// 	epochInfo := jsonmodels.EpochInfoFromRecord(allEpochs[ei])
-//
-// 	return c.JSON(http.StatusOK, epochInfo)
-// }
-//
-// func getUTXOs(c echo.Context) error {
-// 	ei, err := getEI(c)
-// 	if err != nil {
-// 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	spentIDs, createdIDs := epochstorage.GetEpochUTXOs(ei)
-//
-// 	spent := make([]string, len(spentIDs))
-// 	for i, o := range spentIDs {
-// 		spent[i] = o.String()
-// 	}
-// 	created := make([]string, len(createdIDs))
-// 	for i, o := range createdIDs {
-// 		created[i] = o.String()
-// 	}
-//
-// 	resp := jsonmodels.EpochUTXOsResponse{SpentOutputs: spent, CreatedOutputs: created}
-//
-// 	return c.JSON(http.StatusOK, resp)
-// }
-//
-// func getBlocks(c echo.Context) error {
-// 	ei, err := getEI(c)
-// 	if err != nil {
-// 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	blockIDs := epochstorage.GetEpochBlockIDs(ei)
-//
-// 	blocks := make([]string, len(blockIDs))
-// 	for i, m := range blockIDs {
-// 		blocks[i] = m
-// 	}
-// 	resp := jsonmodels.EpochBlocksResponse{Blocks: blocks}
-//
-// 	return c.JSON(http.StatusOK, resp)
-// }
-//
-// func getTransactions(c echo.Context) error {
-// 	ei, err := getEI(c)
-// 	if err != nil {
-// 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	transactionIDs := epochstorage.GetEpochTransactions(ei)
-//
-// 	transactions := make([]string, len(transactionIDs))
-// 	for i, t := range transactionIDs {
-// 		transactions[i] = t.String()
-// 	}
-// 	resp := jsonmodels.EpochTransactionsResponse{Transactions: transactions}
-//
-// 	return c.JSON(http.StatusOK, resp)
-// }
-//
-// func getPendingConflictsCount(c echo.Context) error {
-// 	ei, err := getEI(c)
-// 	if err != nil {
-// 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	allEpochs := epochstorage.GetPendingConflictCount()
-// 	resp := jsonmodels.EpochPendingConflictCountResponse{PendingConflictCount: allEpochs[ei]}
-//
-// 	return c.JSON(http.StatusOK, resp)
-// }
-//
-// func getEI(c echo.Context) (epoch.Index, error) {
-// 	eiText := c.Param("ei")
-// 	eiNumber, err := strconv.Atoi(eiText)
-// 	if err != nil {
-// 		return 0, errors.Wrap(err, "can't parse Index from URL param")
-// 	}
-// 	return epoch.Index(uint64(eiNumber)), nil
-// }
-//
-// func getVotersWeight(c echo.Context) error {
-// 	ei, err := getEI(c)
-// 	if err != nil {
-// 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-// 	}
-// 	weights := epochstorage.GetEpochVotersWeight(ei)
-//
-// 	respMap := make(map[string]*jsonmodels.NodeWeight)
-// 	for ecr, nw := range weights {
-// 		ws := make(map[string]float64, 0)
-// 		for id, w := range nw {
-// 			ws[id.String()] = w
-// 		}
-// 		nodeWeights := &jsonmodels.NodeWeight{Weights: ws}
-// 		respMap[ecr.Base58()] = nodeWeights
-// 	}
-// 	resp := jsonmodels.EpochVotersWeightResponse{VotersWeight: respMap}
-//
-// 	return c.JSON(http.StatusOK, resp)
-// }
+// getVotersWeight returns the attestors of the given epoch with the weights that were committed at that epoch.
+func getVotersWeight(c echo.Context) error {
+	ei, err := getCommittedEI(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	offset, pageSize, err := paging.FromContext(c, defaultPageSize)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	engineInstance := deps.Protocol.Engine()
+
+	attestations, err := engineInstance.NotarizationManager.Attestations.Get(ei)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+	}
+
+	attestors := paging.NewPage[identity.ID](offset, pageSize)
+	if err = attestations.Stream(func(issuerID identity.ID, _ *notarization.Attestation) bool {
+		return attestors.Add(issuerID)
+	}); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to stream attestations of epoch %d", ei)))
+	}
+
+	committedWeights, err := engineInstance.CommittedWeights(ei)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to load the weights of epoch %d", ei)))
+	}
+
+	votersWeight := make(map[string]int64)
+	for _, issuerID := range attestors.Elements() {
+		votersWeight[issuerID.String()] = committedWeights[issuerID]
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.EpochVotersWeightResponse{
+		VotersWeight: votersWeight,
+		NextCursor:   paging.NextCursor(offset, pageSize, attestors.HasMore()),
+	})
+}
+
+func getEI(c echo.Context) (epoch.Index, error) {
+	eiText := c.Param("ei")
+	eiNumber, err := strconv.ParseUint(eiText, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't parse Index from URL param")
+	}
+	return epoch.Index(eiNumber), nil
+}
+
+// getCommittedEI returns the epoch index from the URL param and checks that the epoch is committed.
+func getCommittedEI(c echo.Context) (epoch.Index, error) {
+	ei, err := getEI(c)
+	if err != nil {
+		return 0, err
+	}
+
+	if latestIndex := deps.Protocol.Engine().Storage.Settings.LatestCommitment().Index(); ei > latestIndex {
+		return 0, errors.Errorf("epoch %d is not committed yet (latest committed epoch is %d)", ei, latestIndex)
+	}
+
+	return ei, nil
+}
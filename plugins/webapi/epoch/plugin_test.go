@@ -0,0 +1,171 @@
+package epoch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func TestGetCommittedEpochs(t *testing.T) {
+	server, _ := newTestServer(t, 4)
+
+	epochs := request[jsonmodels.EpochsResponse](t, server, "/epochs?pageSize=2")
+	require.Equal(t, []uint64{4, 3}, epochIndexes(epochs))
+	require.Equal(t, "2", epochs.NextCursor)
+
+	epochs = request[jsonmodels.EpochsResponse](t, server, "/epochs?pageSize=2&cursor="+epochs.NextCursor)
+	require.Equal(t, []uint64{2, 1}, epochIndexes(epochs))
+	require.Equal(t, "0", epochs.NextCursor)
+
+	epochs = request[jsonmodels.EpochsResponse](t, server, "/epochs?pageSize=2&cursor="+epochs.NextCursor)
+	require.Equal(t, []uint64{0}, epochIndexes(epochs))
+	require.Empty(t, epochs.NextCursor)
+
+	requireStatus(t, server, "/epochs?cursor=foo", http.StatusBadRequest)
+	requireStatus(t, server, "/epochs?pageSize=0", http.StatusBadRequest)
+}
+
+func TestGetUTXOs(t *testing.T) {
+	server, tf := newTestServer(t, 1)
+	validatorID := validator(t, tf)
+
+	spentOutput := newOutput(t, 0, 10, validatorID)
+	createdOutputs := []*ledger.OutputWithMetadata{newOutput(t, 1, 20, validatorID), newOutput(t, 1, 30, validatorID)}
+	storeStateDiff(t, tf, []*ledger.OutputWithMetadata{spentOutput}, createdOutputs)
+
+	utxos := request[jsonmodels.EpochUTXOsResponse](t, server, "/epoch/1/utxos?pageSize=2")
+	require.Equal(t, []string{spentOutput.ID().Base58()}, utxos.SpentOutputs)
+	require.Len(t, utxos.CreatedOutputs, 1)
+	require.Equal(t, "2", utxos.NextCursor)
+
+	// the cursor continues the created outputs without repeating the spent ones
+	createdOutputIDs := utxos.CreatedOutputs
+	utxos = request[jsonmodels.EpochUTXOsResponse](t, server, "/epoch/1/utxos?pageSize=2&cursor="+utxos.NextCursor)
+	require.Empty(t, utxos.SpentOutputs)
+	require.Len(t, utxos.CreatedOutputs, 1)
+	require.Empty(t, utxos.NextCursor)
+
+	require.ElementsMatch(t, []string{createdOutputs[0].ID().Base58(), createdOutputs[1].ID().Base58()}, append(createdOutputIDs, utxos.CreatedOutputs...))
+
+	requireStatus(t, server, "/epoch/2/utxos", http.StatusBadRequest)
+}
+
+func TestGetVotersWeight(t *testing.T) {
+	server, tf := newTestServer(t, 1)
+	validatorID := validator(t, tf)
+
+	committedWeight, exists := tf.Protocol.Engine().SybilProtection.Weights().Get(validatorID)
+	require.True(t, exists)
+
+	// the weight of the validator changes in epoch 1 after it attested to epoch 0
+	storeStateDiff(t, tf, nil, []*ledger.OutputWithMetadata{newOutput(t, 1, 50, validatorID)})
+	require.NoError(t, tf.Protocol.Engine().LedgerState.ApplyStateDiff(1))
+
+	currentWeight, exists := tf.Protocol.Engine().SybilProtection.Weights().Get(validatorID)
+	require.True(t, exists)
+	require.Equal(t, committedWeight.Value+50, currentWeight.Value)
+
+	votersWeight := request[jsonmodels.EpochVotersWeightResponse](t, server, "/epoch/0/voters-weight")
+	require.Equal(t, map[string]int64{validatorID.String(): committedWeight.Value}, votersWeight.VotersWeight)
+	require.Empty(t, votersWeight.NextCursor)
+}
+
+// newTestServer returns a server with the endpoints of the plugin whose protocol committed the epochs up to latestEpoch.
+func newTestServer(t *testing.T, latestEpoch epoch.Index) (server *echo.Echo, tf *protocol.TestFramework) {
+	tf = protocol.NewTestFramework(t)
+	tf.Protocol.Run()
+
+	storageInstance := tf.Protocol.Engine().Storage
+	for index := epoch.Index(1); index <= latestEpoch; index++ {
+		latestCommitment := storageInstance.Settings.LatestCommitment()
+		newCommitment := commitment.New(index, latestCommitment.ID(), types.Identifier{}, latestCommitment.CumulativeWeight())
+		require.NoError(t, storageInstance.Commitments.Store(newCommitment))
+		require.NoError(t, storageInstance.Settings.SetLatestCommitment(newCommitment))
+	}
+
+	deps.Server = echo.New()
+	deps.Protocol = tf.Protocol
+	configure(nil)
+
+	return deps.Server, tf
+}
+
+// validator returns the ID of the validator that attested to the genesis snapshot.
+func validator(t *testing.T, tf *protocol.TestFramework) (validatorID identity.ID) {
+	attestations, err := tf.Protocol.Engine().NotarizationManager.Attestations.Get(0)
+	require.NoError(t, err)
+	var validatorIDs []identity.ID
+	require.NoError(t, attestations.Stream(func(issuerID identity.ID, _ *notarization.Attestation) bool {
+		validatorIDs = append(validatorIDs, issuerID)
+		return true
+	}))
+	require.Len(t, validatorIDs, 1)
+
+	return validatorIDs[0]
+}
+
+// newOutput returns a new output that was created in the given epoch and pledges its balance to the given identity.
+func newOutput(t *testing.T, index epoch.Index, balance uint64, pledgeID identity.ID) (output *ledger.OutputWithMetadata) {
+	var txID utxo.TransactionID
+	require.NoError(t, txID.FromRandomness())
+
+	devnetOutput := devnetvm.NewSigLockedSingleOutput(balance, devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))
+	devnetOutput.SetID(utxo.NewOutputID(txID, 0))
+
+	return ledger.NewOutputWithMetadata(index, devnetOutput.ID(), devnetOutput, pledgeID, pledgeID)
+}
+
+// storeStateDiff stores the given outputs in the state diff of epoch 1.
+func storeStateDiff(t *testing.T, tf *protocol.TestFramework, spentOutputs, createdOutputs []*ledger.OutputWithMetadata) {
+	stateDiffs := tf.Protocol.Engine().LedgerState.StateDiffs
+	for _, spentOutput := range spentOutputs {
+		spentOutput.SetSpentInEpoch(1)
+		require.NoError(t, stateDiffs.StoreSpentOutput(spentOutput))
+	}
+	for _, createdOutput := range createdOutputs {
+		require.NoError(t, stateDiffs.StoreCreatedOutput(createdOutput))
+	}
+}
+
+// request requests the given path and checks that the request succeeded before the response is decoded.
+func request[T any](t *testing.T, server *echo.Echo, path string) (response *T) {
+	recorder := requireStatus(t, server, path, http.StatusOK)
+
+	response = new(T)
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+
+	return response
+}
+
+// requireStatus requests the given path and checks the status code of the response.
+func requireStatus(t *testing.T, server *echo.Echo, path string, expectedStatus int) (recorder *httptest.ResponseRecorder) {
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, expectedStatus, recorder.Code, recorder.Body.String())
+
+	return recorder
+}
+
+func epochIndexes(epochs *jsonmodels.EpochsResponse) (indexes []uint64) {
+	for _, epochInfo := range epochs.Epochs {
+		indexes = append(indexes, epochInfo.EI)
+	}
+
+	return indexes
+}
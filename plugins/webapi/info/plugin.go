@@ -176,7 +176,7 @@ func getInfo(c echo.Context) error {
 		},
 		RateSetter: jsonmodels.RateSetter{
 			Rate:     deps.BlockIssuer.Rate(),
-			Estimate: deps.BlockIssuer.Estimate(),
+			Estimate: deps.BlockIssuer.EstimateWork(models.PayloadWork(0)),
 		},
 	})
 }
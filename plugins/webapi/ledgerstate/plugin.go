@@ -2,12 +2,15 @@ package ledgerstate
 
 import (
 	"context"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/iotaledger/hive.go/core/daemon"
 	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/hive.go/core/logger"
 	"github.com/iotaledger/hive.go/core/node"
 	"github.com/labstack/echo"
@@ -15,6 +18,7 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/protocol"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
@@ -27,6 +31,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/goshimmer/plugins/webapi/paging"
 )
 
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -41,6 +46,7 @@ type dependencies struct {
 	dig.In
 
 	Server      *echo.Echo
+	AdminRoutes *echo.Group `name:"adminRoutes"`
 	Protocol    *protocol.Protocol
 	BlockIssuer *blockissuer.BlockIssuer
 	Indexer     *indexer.Indexer
@@ -124,6 +130,8 @@ func run(*node.Plugin) {
 	// register endpoints
 	deps.Server.GET("ledgerstate/addresses/:address", GetAddress)
 	deps.Server.GET("ledgerstate/addresses/:address/unspentOutputs", GetAddressUnspentOutputs)
+	deps.Server.GET("ledgerstate/addresses/:address/spentOutputs", GetAddressSpentOutputs)
+	deps.Server.GET("ledgerstate/addresses/:address/transactions", GetAddressTransactions)
 	deps.Server.POST("ledgerstate/addresses/unspentOutputs", PostAddressUnspentOutputs)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID", GetConflict)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID/children", GetConflictChildren)
@@ -133,10 +141,14 @@ func run(*node.Plugin) {
 	deps.Server.GET("ledgerstate/outputs/:outputID", GetOutput)
 	deps.Server.GET("ledgerstate/outputs/:outputID/consumers", GetOutputConsumers)
 	deps.Server.GET("ledgerstate/outputs/:outputID/metadata", GetOutputMetadata)
+	deps.Server.GET("ledgerstate/outputs/:outputID/proof", GetOutputProof)
 	deps.Server.GET("ledgerstate/transactions/:transactionID", GetTransaction)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/metadata", GetTransactionMetadata)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/attachments", GetTransactionAttachments)
+	deps.Server.GET("ledgerstate/transactions/:transactionID/proof", GetTransactionProof)
 	deps.Server.POST("ledgerstate/transactions", PostTransaction)
+	deps.Server.POST("ledgerstate/transactions/simulate", PostTransactionSimulate)
+	deps.AdminRoutes.POST("/ledgerstate/rollback", PostRollback)
 }
 
 func worker(ctx context.Context) {
@@ -157,15 +169,35 @@ func worker(ctx context.Context) {
 	deps.Protocol.Engine().Ledger.Events.TransactionAccepted.Detach(onTransactionAccepted)
 }
 
-func outputsOnAddress(address devnetvm.Address) (outputs devnetvm.Outputs) {
-	deps.Indexer.CachedAddressOutputMappings(address).Consume(func(mapping *indexer.AddressOutputMapping) {
-		deps.Protocol.Engine().Ledger.Storage.CachedOutput(mapping.OutputID()).Consume(func(output utxo.Output) {
-			if typedOutput, ok := output.(devnetvm.Output); ok {
-				outputs = append(outputs, typedOutput)
-			}
+// outputsOnAddress returns a page of the unspent outputs of the given address (a pageSize of 0 returns all outputs). If
+// skipSpent is set, the pending outputs that are already spent in the mempool are skipped before the page is filled.
+func outputsOnAddress(address devnetvm.Address, offset, pageSize int, skipSpent bool) (outputs devnetvm.Outputs, hasMore bool, err error) {
+	outputsPage := paging.NewPage[devnetvm.Output](offset, pageSize)
+	if err = deps.Indexer.StreamUnspentOutputIDs(address, func(outputID utxo.OutputID) bool {
+		if skipSpent && isSpent(outputID) {
+			return true
+		}
+
+		var typedOutput devnetvm.Output
+		deps.Protocol.Engine().Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
+			typedOutput, _ = output.(devnetvm.Output)
 		})
+
+		return typedOutput == nil || outputsPage.Add(typedOutput)
+	}); err != nil {
+		return nil, false, errors.Wrapf(err, "failed to retrieve outputs of address %s", address.Base58())
+	}
+
+	return outputsPage.Elements(), outputsPage.HasMore(), nil
+}
+
+// isSpent returns true if the output with the given ID is spent in the mempool.
+func isSpent(outputID utxo.OutputID) (spent bool) {
+	deps.Protocol.Engine().Ledger.Storage.CachedOutputMetadata(outputID).Consume(func(outputMetadata *ledger.OutputMetadata) {
+		spent = outputMetadata.IsSpent()
 	})
-	return
+
+	return spent
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -179,9 +211,20 @@ func GetAddress(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	outputs := outputsOnAddress(address)
+	offset, pageSize, err := paging.FromContext(c, 0)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	outputs, hasMore, err := outputsOnAddress(address, offset, pageSize, false)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	res := jsonmodels.NewGetAddressResponse(address, outputs)
+	res.NextCursor = paging.NextCursor(offset, pageSize, hasMore)
 
-	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressResponse(address, outputs))
+	return c.JSON(http.StatusOK, res)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -195,15 +238,133 @@ func GetAddressUnspentOutputs(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	outputs := outputsOnAddress(address)
+	offset, pageSize, err := paging.FromContext(c, 0)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
 
-	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressResponse(address, outputs.Filter(func(output devnetvm.Output) (isUnspent bool) {
-		deps.Protocol.Engine().Ledger.Storage.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledger.OutputMetadata) {
-			isUnspent = !outputMetadata.IsSpent()
-		})
+	outputs, hasMore, err := outputsOnAddress(address, offset, pageSize, true)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
 
-		return
-	})))
+	res := jsonmodels.NewGetAddressResponse(address, outputs)
+	res.NextCursor = paging.NextCursor(offset, pageSize, hasMore)
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressSpentOutputs ///////////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressSpentOutputs is the handler for the /ledgerstate/addresses/:address/spentOutputs endpoint. The optional
+// startEpoch and endEpoch query params limit the history to the outputs that were spent in the given epoch range.
+func GetAddressSpentOutputs(c echo.Context) error {
+	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	startEpoch, endEpoch, err := epochRangeFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	offset, pageSize, err := paging.FromContext(c, 0)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	} else if pageSize == 0 {
+		pageSize = paging.MaxPageSize
+	}
+
+	spentOutputs, hasMore, err := deps.Indexer.SpentOutputs(address, startEpoch, endEpoch, offset, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	res := &jsonmodels.GetAddressSpentOutputsResponse{
+		Address:      jsonmodels.NewAddress(address),
+		SpentOutputs: make([]*jsonmodels.SpentOutput, len(spentOutputs)),
+		NextCursor:   paging.NextCursor(offset, pageSize, hasMore),
+	}
+	for i, spentOutput := range spentOutputs {
+		res.SpentOutputs[i] = &jsonmodels.SpentOutput{
+			OutputID:      spentOutput.OutputID.Base58(),
+			CreationEpoch: int64(spentOutput.CreationEpoch),
+			SpentEpoch:    int64(spentOutput.SpentEpoch),
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressTransactions ///////////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressTransactions is the handler for the /ledgerstate/addresses/:address/transactions endpoint. The optional
+// startEpoch and endEpoch query params limit the history to the transactions that were included in the given epoch range.
+func GetAddressTransactions(c echo.Context) error {
+	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	startEpoch, endEpoch, err := epochRangeFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	offset, pageSize, err := paging.FromContext(c, 0)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	} else if pageSize == 0 {
+		pageSize = paging.MaxPageSize
+	}
+
+	entries, hasMore, err := deps.Indexer.Transactions(address, startEpoch, endEpoch, offset, pageSize)
+	if err != nil {
+		if errors.Is(err, indexer.ErrTransactionHistoryDisabled) {
+			return c.JSON(http.StatusNotImplemented, jsonmodels.NewErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	res := &jsonmodels.GetAddressTransactionsResponse{
+		Address:      jsonmodels.NewAddress(address),
+		Transactions: make([]*jsonmodels.AddressTransaction, len(entries)),
+		NextCursor:   paging.NextCursor(offset, pageSize, hasMore),
+	}
+	for i, entry := range entries {
+		res.Transactions[i] = &jsonmodels.AddressTransaction{
+			TransactionID:     entry.TransactionID.Base58(),
+			InclusionEpoch:    int64(entry.InclusionEpoch),
+			CreatedOutputs:    entry.CreatedOutputs,
+			SpentOutputs:      entry.SpentOutputs,
+			ConfirmationState: entry.ConfirmationState,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// epochRangeFromContext returns the (inclusive) epoch range of the request that is given by the unsigned startEpoch and
+// endEpoch query params (the range is unbounded if they are omitted).
+func epochRangeFromContext(c echo.Context) (startEpoch, endEpoch epoch.Index, err error) {
+	startEpoch, endEpoch = epoch.Index(0), epoch.Index(math.MaxInt64)
+	for param, target := range map[string]*epoch.Index{"startEpoch": &startEpoch, "endEpoch": &endEpoch} {
+		if paramText := c.QueryParam(param); paramText != "" {
+			parsedEpoch, parseErr := strconv.ParseUint(paramText, 10, 63)
+			if parseErr != nil {
+				return 0, 0, errors.Wrapf(parseErr, "can't parse %s from URL param", param)
+			}
+			*target = epoch.Index(parsedEpoch)
+		}
+	}
+
+	return startEpoch, endEpoch, nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -230,19 +391,17 @@ func PostAddressUnspentOutputs(c echo.Context) error {
 	}
 	for i, addy := range addresses {
 		res.UnspentOutputs[i] = new(jsonmodels.WalletOutputsOnAddress)
-		outputs := outputsOnAddress(addy)
+		outputs, _, err := outputsOnAddress(addy, 0, 0, true)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+		}
 		res.UnspentOutputs[i].Address = jsonmodels.Address{
 			Type:   addy.Type().String(),
 			Base58: addy.Base58(),
 		}
 		res.UnspentOutputs[i].Outputs = make([]jsonmodels.WalletOutput, 0)
 
-		for _, output := range outputs.Filter(func(output devnetvm.Output) (isUnspent bool) {
-			deps.Protocol.Engine().Ledger.Storage.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledger.OutputMetadata) {
-				isUnspent = !outputMetadata.IsSpent()
-			})
-			return
-		}) {
+		for _, output := range outputs {
 			deps.Protocol.Engine().Ledger.Storage.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledger.OutputMetadata) {
 				if !outputMetadata.IsSpent() {
 					deps.Protocol.Engine().Ledger.Storage.CachedOutput(output.ID()).Consume(func(ledgerOutput utxo.Output) {
@@ -431,6 +590,25 @@ func GetOutputMetadata(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetOutputProof ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputProof is the handler for the ledgerstate/outputs/:outputID/proof endpoint.
+func GetOutputProof(c echo.Context) (err error) {
+	var outputID utxo.OutputID
+	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	proof, err := deps.Protocol.Engine().NotarizationManager.OutputInclusionProof(outputID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to generate proof for %s", outputID)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewProof(proof))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetTransaction ///////////////////////////////////////////////////////////////////////////////////////////////
 
 // GetTransaction is the handler for the /ledgerstate/transactions/:transactionID endpoint.
@@ -493,6 +671,32 @@ func GetTransactionAttachments(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetTransactionProof //////////////////////////////////////////////////////////////////////////////////////////
+
+// GetTransactionProof is the handler for the ledgerstate/transactions/:transactionID/proof endpoint.
+func GetTransactionProof(c echo.Context) (err error) {
+	var transactionID utxo.TransactionID
+	if err = transactionID.FromBase58(c.Param("transactionID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	var inclusionEpoch epoch.Index
+	if !deps.Protocol.Engine().Ledger.Storage.CachedTransactionMetadata(transactionID).Consume(func(transactionMetadata *ledger.TransactionMetadata) {
+		inclusionEpoch = transactionMetadata.InclusionEpoch()
+	}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load TransactionMetadata of Transaction with %s", transactionID)))
+	}
+
+	proof, err := deps.Protocol.Engine().NotarizationManager.TransactionInclusionProof(transactionID, inclusionEpoch)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to generate proof for %s", transactionID)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewProof(proof))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region conflictIDFromContext //////////////////////////////////////////////////////////////////////////////////////////
 
 // conflictIDFromContext determines the ConflictID from the conflictID parameter in an echo.Context. It expects it to either
@@ -511,6 +715,25 @@ func conflictIDFromContext(c echo.Context) (conflictID utxo.TransactionID, err e
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region PostRollback /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PostRollback is the handler for the /admin/ledgerstate/rollback endpoint. It schedules a rollback of the ledger state to
+// the given committed epoch that is applied the next time the node is started.
+func PostRollback(c echo.Context) error {
+	var request jsonmodels.PostRollbackRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if err := deps.Protocol.ScheduleRollback(epoch.Index(request.TargetEpoch)); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, &jsonmodels.PostRollbackResponse{TargetEpoch: request.TargetEpoch})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostTransaction //////////////////////////////////////////////////////////////////////////////////////////////
 
 const maxBookedAwaitTime = 5 * time.Second
@@ -573,3 +796,127 @@ func PostTransaction(c echo.Context) error {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostTransactionSimulate //////////////////////////////////////////////////////////////////////////////////////
+
+// PostTransactionSimulate is the handler for the /ledgerstate/transactions/simulate endpoint. It runs the given
+// transaction against the current ledger state without booking it and reports the outputs it would create, the state of
+// its inputs and the reasons why it is invalid.
+func PostTransactionSimulate(c echo.Context) error {
+	var request jsonmodels.PostTransactionRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionSimulateResponse{Error: err.Error()})
+	}
+
+	vm := new(devnetvm.VM)
+	parsedTransaction, err := vm.ParseTransaction(request.TransactionBytes)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionSimulateResponse{Error: err.Error()})
+	}
+	tx := parsedTransaction.(*devnetvm.Transaction)
+
+	response := &jsonmodels.PostTransactionSimulateResponse{
+		TransactionID: tx.ID().Base58(),
+	}
+
+	// resolve the inputs and check if they are already spent by other transactions
+	conflictingTransactionIDs := utxo.NewTransactionIDs()
+	inputs := make(devnetvm.Outputs, 0, len(tx.Essence().Inputs()))
+	for i, input := range tx.Essence().Inputs() {
+		simulatedInput, output := simulateInput(i, vm.ResolveInput(input), tx.ID(), conflictingTransactionIDs)
+		response.Inputs = append(response.Inputs, simulatedInput)
+
+		if !simulatedInput.Solid {
+			err = errors.Errorf("referenced %s is unknown", simulatedInput.OutputID.Base58)
+			response.ValidationErrors = append(response.ValidationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorUnsolidInput, err, i))
+			continue
+		}
+		inputs = append(inputs, output)
+	}
+
+	if has, conflictingID := FilterHasConflict(tx.Essence().Inputs()); has && conflictingID != tx.ID() {
+		conflictingTransactionIDs.Add(conflictingID)
+	}
+	response.Conflicting = !conflictingTransactionIDs.IsEmpty()
+	response.ConflictingTransactionIDs = lo.Map(conflictingTransactionIDs.Slice(), utxo.TransactionID.Base58)
+
+	// the transaction can only be executed if all of its inputs are known
+	if len(response.ValidationErrors) == 0 {
+		response.ValidationErrors = validateSimulatedTransaction(vm, tx, inputs)
+	}
+	if len(response.ValidationErrors) == 0 {
+		outputs, executionErr := vm.ExecuteTransaction(tx, utxo.NewOutputs(inputs.UTXOOutputs()...))
+		if executionErr != nil {
+			response.ValidationErrors = append(response.ValidationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorLedger, executionErr))
+		}
+		for _, output := range outputs {
+			response.Outputs = append(response.Outputs, jsonmodels.NewOutput(output.(devnetvm.Output)))
+		}
+	}
+	response.Valid = len(response.ValidationErrors) == 0
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// simulateInput loads the Output with the given OutputID that is referenced by the Input at the given index, and adds
+// the transactions, other than the simulated one, that already spend it to the conflictingTransactionIDs.
+func simulateInput(index int, outputID utxo.OutputID, txID utxo.TransactionID, conflictingTransactionIDs utxo.TransactionIDs) (simulatedInput *jsonmodels.SimulatedInput, output devnetvm.Output) {
+	ledgerInstance := deps.Protocol.Engine().Ledger
+
+	simulatedInput = &jsonmodels.SimulatedInput{
+		Index:    index,
+		OutputID: jsonmodels.NewOutputID(outputID),
+	}
+
+	simulatedInput.Solid = ledgerInstance.Storage.CachedOutput(outputID).Consume(func(utxoOutput utxo.Output) {
+		output = utxoOutput.(devnetvm.Output)
+		simulatedInput.Output = jsonmodels.NewOutput(output)
+	})
+
+	ledgerInstance.Storage.CachedOutputMetadata(outputID).Consume(func(outputMetadata *ledger.OutputMetadata) {
+		simulatedInput.Spent = outputMetadata.IsSpent()
+		simulatedInput.Metadata = jsonmodels.NewOutputMetadata(outputMetadata, ledgerInstance.Utils.ConfirmedConsumer(outputID))
+	})
+
+	ledgerInstance.Storage.CachedConsumers(outputID).Consume(func(consumer *ledger.Consumer) {
+		simulatedInput.Consumers = append(simulatedInput.Consumers, jsonmodels.NewConsumer(consumer))
+		if consumer.TransactionID() != txID {
+			conflictingTransactionIDs.Add(consumer.TransactionID())
+		}
+	})
+
+	return simulatedInput, output
+}
+
+// validateSimulatedTransaction runs all the checks of the VM and the ledger on the simulated transaction and collects
+// every failed check instead of stopping at the first one.
+func validateSimulatedTransaction(vm *devnetvm.VM, tx *devnetvm.Transaction, inputs devnetvm.Outputs) (validationErrors []*jsonmodels.TransactionValidationError) {
+	if !devnetvm.TransactionBalancesValid(inputs, tx.Essence().Outputs()) {
+		validationErrors = append(validationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorBalances, errors.New("sum of consumed and created balances is not 0")))
+	}
+
+	unlockErrors, err := devnetvm.UnlockBlocksErrors(inputs, tx)
+	if err != nil {
+		validationErrors = append(validationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorUnlock, err))
+	}
+	for i := range inputs {
+		if unlockErr, exists := unlockErrors[i]; exists {
+			validationErrors = append(validationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorUnlock, unlockErr, i))
+		}
+	}
+
+	if !devnetvm.AliasInitialStateValid(inputs, tx) {
+		validationErrors = append(validationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorAliasInitialState, errors.New("initial state of created alias output is invalid")))
+	}
+
+	// the ledger additionally checks things like causally related inputs that are not covered by the VM
+	if len(validationErrors) == 0 {
+		if err = deps.Protocol.Engine().Ledger.CheckTransaction(context.Background(), tx); err != nil {
+			validationErrors = append(validationErrors, jsonmodels.NewTransactionValidationError(jsonmodels.ValidationErrorLedger, err))
+		}
+	}
+
+	return validationErrors
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
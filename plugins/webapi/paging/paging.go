@@ -0,0 +1,127 @@
+// Package paging contains the helpers of the paged endpoints of the web API.
+package paging
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+// MaxPageSize is the maximum amount of elements that can be requested for a single page.
+const MaxPageSize = 1000
+
+// ErrPageFull is returned by Page.AddOrStop to abort an iteration once the page is full.
+var ErrPageFull = errors.New("page is full")
+
+// FromContext returns the offset (parsed from the cursor query param) and the pageSize of the request. The given
+// defaultPageSize is used if the request does not contain a pageSize (otherwise it needs to be between 1 and MaxPageSize).
+func FromContext(c echo.Context, defaultPageSize int) (offset, pageSize int, err error) {
+	if pageSize, err = PageSizeFromContext(c, defaultPageSize); err != nil {
+		return 0, 0, err
+	}
+
+	if cursorText := c.QueryParam("cursor"); cursorText != "" {
+		if offset, err = strconv.Atoi(cursorText); err != nil {
+			return 0, 0, errors.Wrap(err, "can't parse cursor from URL param")
+		}
+
+		if offset < 0 {
+			return 0, 0, errors.Errorf("cursor must not be negative")
+		}
+	}
+
+	return offset, pageSize, nil
+}
+
+// PageSizeFromContext returns the pageSize of the request for endpoints whose cursor is not an offset. The given
+// defaultPageSize is used if the request does not contain a pageSize.
+func PageSizeFromContext(c echo.Context, defaultPageSize int) (pageSize int, err error) {
+	pageSizeText := c.QueryParam("pageSize")
+	if pageSizeText == "" {
+		return defaultPageSize, nil
+	}
+
+	if pageSize, err = strconv.Atoi(pageSizeText); err != nil {
+		return 0, errors.Wrap(err, "can't parse pageSize from URL param")
+	}
+
+	if pageSize <= 0 || pageSize > MaxPageSize {
+		return 0, errors.Errorf("pageSize must be between 1 and %d", MaxPageSize)
+	}
+
+	return pageSize, nil
+}
+
+// NextCursor returns the cursor of the next page (or an empty string if there are no more elements).
+func NextCursor(offset, pageSize int, hasMore bool) string {
+	if !hasMore {
+		return ""
+	}
+
+	return strconv.Itoa(offset + pageSize)
+}
+
+// region Page /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Page collects the elements of a single page of a stream of elements.
+type Page[T any] struct {
+	offset   int
+	size     int
+	skipped  int
+	elements []T
+	hasMore  bool
+}
+
+// NewPage creates a new Page that skips the first offset elements and holds at most size elements (a size of 0 does
+// not limit the amount of elements).
+func NewPage[T any](offset, size int) *Page[T] {
+	return &Page[T]{
+		offset:   offset,
+		size:     size,
+		elements: make([]T, 0),
+	}
+}
+
+// Add adds the element to the Page and returns false once the Page is full and the iteration can be stopped.
+func (p *Page[T]) Add(element T) (continueIteration bool) {
+	if p.skipped < p.offset {
+		p.skipped++
+		return true
+	}
+
+	if p.size != 0 && len(p.elements) == p.size {
+		p.hasMore = true
+		return false
+	}
+
+	p.elements = append(p.elements, element)
+
+	return true
+}
+
+// AddOrStop is the error returning counterpart of Add that returns ErrPageFull once the Page is full.
+func (p *Page[T]) AddOrStop(element T) (err error) {
+	if !p.Add(element) {
+		return ErrPageFull
+	}
+
+	return nil
+}
+
+// Elements returns the elements of the Page.
+func (p *Page[T]) Elements() []T {
+	return p.elements
+}
+
+// HasMore returns true if the stream contained more elements than fit into the Page.
+func (p *Page[T]) HasMore() bool {
+	return p.hasMore
+}
+
+// NextCursor returns the cursor of the next page (or an empty string if there are no more elements).
+func (p *Page[T]) NextCursor() string {
+	return NextCursor(p.offset, p.size, p.hasMore)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
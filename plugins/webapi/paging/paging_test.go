@@ -0,0 +1,78 @@
+package paging
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext(t *testing.T) {
+	for query, expected := range map[string]struct {
+		offset   int
+		pageSize int
+		valid    bool
+	}{
+		"":                       {offset: 0, pageSize: 10, valid: true},
+		"?pageSize=5&cursor=15":  {offset: 15, pageSize: 5, valid: true},
+		"?pageSize=0":            {},
+		"?pageSize=1001":         {},
+		"?cursor=-1":             {},
+		"?cursor=foo":            {},
+		"?pageSize=1000&cursor=": {offset: 0, pageSize: 1000, valid: true},
+	} {
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/"+query, nil), httptest.NewRecorder())
+
+		offset, pageSize, err := FromContext(c, 10)
+		if !expected.valid {
+			require.Error(t, err, query)
+			continue
+		}
+
+		require.NoError(t, err, query)
+		require.Equal(t, expected.offset, offset, query)
+		require.Equal(t, expected.pageSize, pageSize, query)
+	}
+}
+
+func TestPageSizeFromContext(t *testing.T) {
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?pageSize=5&cursor=foo", nil), httptest.NewRecorder())
+	pageSize, err := PageSizeFromContext(c, 10)
+	require.NoError(t, err)
+	require.Equal(t, 5, pageSize)
+
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	pageSize, err = PageSizeFromContext(c, 10)
+	require.NoError(t, err)
+	require.Equal(t, 10, pageSize)
+}
+
+func TestPage(t *testing.T) {
+	page := NewPage[int](2, 3)
+	for i := 0; i < 10 && page.Add(i); i++ {
+	}
+	require.Equal(t, []int{2, 3, 4}, page.Elements())
+	require.True(t, page.HasMore())
+	require.Equal(t, "5", page.NextCursor())
+
+	lastPage := NewPage[int](8, 3)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, lastPage.AddOrStop(i))
+	}
+	require.Equal(t, []int{8, 9}, lastPage.Elements())
+	require.False(t, lastPage.HasMore())
+	require.Empty(t, lastPage.NextCursor())
+
+	unlimitedPage := NewPage[int](0, 0)
+	for i := 0; i < 10; i++ {
+		require.True(t, unlimitedPage.Add(i))
+	}
+	require.Len(t, unlimitedPage.Elements(), 10)
+	require.False(t, unlimitedPage.HasMore())
+
+	fullPage := NewPage[int](0, 1)
+	require.NoError(t, fullPage.AddOrStop(0))
+	require.ErrorIs(t, fullPage.AddOrStop(1), ErrPageFull)
+}
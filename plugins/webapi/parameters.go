@@ -1,6 +1,10 @@
 package webapi
 
-import "github.com/iotaledger/goshimmer/plugins/config"
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
 
 // ParametersDefinition contains the definition of the parameters used by the webAPI plugin.
 type ParametersDefinition struct {
@@ -17,6 +21,32 @@ type ParametersDefinition struct {
 		Password string `default:"goshimmer" usage:"HTTP basic auth password"`
 	}
 
+	// AdminAPI
+	AdminAPI struct {
+		// Enabled defines whether the admin routes that modify the state of the node (e.g. rollbacks) are available.
+		Enabled bool `default:"false" usage:"whether to enable the admin routes that modify the state of the node"`
+		// Token defines the token that needs to be sent in the X-Admin-Token header to access the admin routes.
+		Token string `default:"" usage:"the token that needs to be sent in the X-Admin-Token header to access the admin routes"`
+	}
+
+	// Subscriptions
+	Subscriptions struct {
+		// BufferSize defines the amount of events that are buffered for a subscriber before it is disconnected.
+		BufferSize int `default:"1000" usage:"the amount of events that are buffered for a subscriber before it is disconnected for being too slow"`
+		// MaxTopics defines the maximum amount of topics that a single subscriber can subscribe to.
+		MaxTopics int `default:"100" usage:"the maximum amount of topics that a single subscriber can subscribe to"`
+		// MaxSubscribers defines the maximum amount of subscribers that can be connected at the same time.
+		MaxSubscribers int `default:"100" usage:"the maximum amount of subscribers that can be connected at the same time"`
+		// AllowedOrigins defines the origins of the web pages that are allowed to subscribe (besides the node itself).
+		AllowedOrigins []string `default:"" usage:"the origins of the web pages that are allowed to subscribe besides the node itself (* allows all origins)"`
+	}
+
+	// StorageUsage
+	StorageUsage struct {
+		// Interval defines how often the disk usage of the storage is computed (it iterates over all stored data).
+		Interval time.Duration `default:"10m" usage:"how often the disk usage of the storage is computed (it iterates over all stored data)"`
+	}
+
 	// EnableDSFilter determines if the DoubleSpendFilter should be enabled.
 	EnableDSFilter bool `default:"false" usage:"whether to enable double spend filter"`
 }
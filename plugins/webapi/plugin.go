@@ -2,6 +2,7 @@ package webapi
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"time"
@@ -16,11 +17,20 @@ import (
 	"github.com/iotaledger/hive.go/core/logger"
 	"github.com/iotaledger/hive.go/core/node"
 
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 )
 
-// PluginName is the name of the web API plugin.
-const PluginName = "WebAPI"
+const (
+	// PluginName is the name of the web API plugin.
+	PluginName = "WebAPI"
+
+	// AdminRoutesName is the name under which the group of admin routes is provided to the other plugins.
+	AdminRoutesName = "adminRoutes"
+
+	// AdminTokenHeader is the header that needs to contain the configured token to access the admin routes.
+	AdminTokenHeader = "X-Admin-Token"
+)
 
 var (
 	// Plugin is the plugin instance of the web API plugin.
@@ -46,6 +56,10 @@ func init() {
 		}); err != nil {
 			Plugin.Panic(err)
 		}
+
+		if err := event.Container.Provide(newAdminRoutes, dig.Name(AdminRoutesName)); err != nil {
+			Plugin.Panic(err)
+		}
 	}))
 }
 
@@ -110,6 +124,24 @@ func newServer() *echo.Echo {
 	return server
 }
 
+// newAdminRoutes creates the group of routes that modify the state of the node. They are only accessible if they were
+// enabled and the configured token is sent along with the request.
+func newAdminRoutes(server *echo.Echo) *echo.Group {
+	return server.Group("/admin", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !Parameters.AdminAPI.Enabled || Parameters.AdminAPI.Token == "" {
+				return c.JSON(http.StatusForbidden, jsonmodels.NewErrorResponse(errors.New("the admin routes are disabled")))
+			}
+
+			if subtle.ConstantTimeCompare([]byte(c.Request().Header.Get(AdminTokenHeader)), []byte(Parameters.AdminAPI.Token)) != 1 {
+				return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(errors.New("invalid admin token")))
+			}
+
+			return next(c)
+		}
+	})
+}
+
 func configure(*node.Plugin) {
 	log = logger.NewLogger(PluginName)
 	// configure the server
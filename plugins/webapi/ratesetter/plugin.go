@@ -2,6 +2,7 @@ package ratesetter
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/iotaledger/hive.go/core/node"
 	"github.com/labstack/echo"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
 )
 
 // PluginName is the name of the web API info endpoint plugin.
@@ -35,9 +37,19 @@ func configure(_ *node.Plugin) {
 	deps.Server.GET("ratesetter", getRateSetterEstimate)
 }
 
+// getRateSetterEstimate returns the rate of the node and the estimated time until it can issue a block whose payload has
+// the size (in bytes) that is passed in the optional payloadSize query parameter.
 func getRateSetterEstimate(c echo.Context) error {
+	var payloadSize uint64
+	if payloadSizeParam := c.QueryParam("payloadSize"); payloadSizeParam != "" {
+		var err error
+		if payloadSize, err = strconv.ParseUint(payloadSizeParam, 10, 32); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+	}
+
 	return c.JSON(http.StatusOK, jsonmodels.RateSetter{
 		Rate:     deps.BlockIssuer.Rate(),
-		Estimate: deps.BlockIssuer.Estimate(),
+		Estimate: deps.BlockIssuer.EstimateWork(models.PayloadWork(int(payloadSize))),
 	})
 }
@@ -1,65 +1,87 @@
 package snapshot
 
-/*
-
 import (
+	"fmt"
 	"net/http"
-
-	"go.uber.org/dig"
+	"os"
+	"strconv"
 
 	"github.com/iotaledger/hive.go/core/node"
 	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/snapshotter"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
 )
 
-// region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
+// PluginName is the name of the web API snapshot endpoint plugin.
+const PluginName = "WebAPISnapshotEndpoint"
 
-const (
-	snapshotFileName = "/tmp/snapshot.bin"
+var (
+	// Plugin is the plugin instance of the web API snapshot endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
 )
 
 type dependencies struct {
 	dig.In
 
-	Server *echo.Echo
-	// SnapshotMgr *snapshot.Manager
+	Server      *echo.Echo
+	Snapshotter *snapshotter.Snapshotter `optional:"true"`
 }
 
-var (
-	// Plugin holds the singleton instance of the Plugin.
-	Plugin *node.Plugin
-
-	deps = new(dependencies)
-)
-
 func init() {
-	Plugin = node.NewPlugin("WebAPISnapshot", deps, node.Disabled, configure)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
 }
 
 func configure(_ *node.Plugin) {
-	deps.Server.GET("snapshot", DumpCurrentLedger)
+	if deps.Snapshotter == nil {
+		Plugin.LogInfo("Snapshotter is disabled, the snapshot endpoints are not available")
+		return
+	}
+
+	deps.Server.GET("snapshots", getSnapshots)
+	deps.Server.GET("snapshots/:ei", getSnapshot)
 }
 
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+// getSnapshots returns the snapshots that are available for download (ordered from the latest to the oldest epoch).
+func getSnapshots(c echo.Context) error {
+	snapshots, err := deps.Snapshotter.Snapshots()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	resp := &jsonmodels.SnapshotsResponse{Snapshots: make([]*jsonmodels.SnapshotInfo, 0, len(snapshots))}
+	for _, snapshotInfo := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, jsonmodels.NewSnapshotInfo(snapshotInfo))
+	}
 
-// region DumpCurrentLedger ///////////////////////////////////////////////////////////////////////////////////////////////////
+	return c.JSON(http.StatusOK, resp)
+}
 
-// DumpCurrentLedger dumps a snapshot (all unspent UTXO and all of the access mana) from now.
-func DumpCurrentLedger(c echo.Context) (err error) {
-	header, err := deps.SnapshotMgr.CreateSnapshot(snapshotFileName)
+// getSnapshot sends the snapshot file of the given epoch.
+func getSnapshot(c echo.Context) error {
+	eiNumber, err := strconv.ParseUint(c.Param("ei"), 10, 64)
 	if err != nil {
-		Plugin.LogErrorf("unable to get snapshot bytes %s", err)
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "can't parse Index from URL param")))
+	}
+
+	// the snapshot is sent while it is read by the Snapshotter, so that it can not be pruned during the download
+	if err = deps.Snapshotter.ReadSnapshot(epoch.Index(eiNumber), func(snapshotInfo *snapshotter.SnapshotInfo, file *os.File) error {
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEOctetStream)
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", snapshotInfo.FileName()))
+		http.ServeContent(c.Response(), c.Request(), snapshotInfo.FileName(), snapshotInfo.ModTime, file)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, snapshotter.ErrSnapshotNotFound) {
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("snapshot of epoch %d is not available", eiNumber)))
+		}
+
 		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
 	}
-	Plugin.LogInfo("Snapshot information: ")
-	Plugin.LogInfo("     Number of outputs: ", header.OutputWithMetadataCount)
-	Plugin.LogInfo("     FullEpochIndex: ", header.FullEpochIndex)
-	Plugin.LogInfo("     DiffEpochIndex: ", header.DiffEpochIndex)
-	Plugin.LogInfo("     LatestECRecord: ", header.LatestECRecord)
 
-	return c.Attachment(snapshotFileName, snapshotFileName)
+	return nil
 }
-*/
-
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
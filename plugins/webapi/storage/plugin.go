@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/daemon"
+	"github.com/iotaledger/hive.go/core/node"
+	"github.com/iotaledger/hive.go/core/timeutil"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/retainer"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm/indexer"
+	"github.com/iotaledger/goshimmer/packages/storage"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+)
+
+// PluginName is the name of the web API storage endpoint plugin.
+const PluginName = "WebAPIStorageEndpoint"
+
+var (
+	// Plugin is the plugin instance of the web API storage endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+
+	// compactionMutex prevents that multiple compactions are run at the same time.
+	compactionMutex sync.Mutex
+)
+
+type dependencies struct {
+	dig.In
+
+	Server      *echo.Echo
+	AdminRoutes *echo.Group `name:"adminRoutes"`
+	Protocol    *protocol.Protocol
+	Retainer    *retainer.Retainer `optional:"true"`
+	Indexer     *indexer.Indexer   `optional:"true"`
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+}
+
+func configure(_ *node.Plugin) {
+	deps.AdminRoutes.GET("/storage/usage", getUsage)
+	deps.AdminRoutes.POST("/storage/compaction", postCompaction)
+}
+
+func run(_ *node.Plugin) {
+	if err := daemon.BackgroundWorker("Storage usage", func(ctx context.Context) {
+		// computing the usage iterates over all stored data, so it is only done periodically and the endpoint and the
+		// metrics serve the most recent result
+		updateUsage()
+		timeutil.NewTicker(updateUsage, webapi.Parameters.StorageUsage.Interval, ctx)
+
+		<-ctx.Done()
+	}, shutdown.PriorityWebAPI); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// updateUsage computes the disk usage of the storage (which caches the result).
+func updateUsage() {
+	if _, err := deps.Protocol.MainStorage().Usage(usageReporters()); err != nil {
+		Plugin.LogWarnf("failed to compute the usage of the storage: %s", err)
+	}
+}
+
+// getUsage returns the most recently computed disk usage of the storage broken down by component and by prunable DB
+// instance.
+func getUsage(c echo.Context) error {
+	usage := deps.Protocol.MainStorage().LastUsage()
+	if usage == nil {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(errors.New("the usage of the storage was not computed yet")))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewStorageUsageResponse(
+		usage,
+		deps.Protocol.MainStorage().PermanentDatabaseSize(),
+		deps.Protocol.MainStorage().PrunableDatabaseSize(),
+		retainerSize(),
+	))
+}
+
+// postCompaction runs the garbage collection of the databases of the storage and of the retainer and returns their
+// size before and after it.
+func postCompaction(c echo.Context) error {
+	if !compactionMutex.TryLock() {
+		return c.JSON(http.StatusConflict, jsonmodels.NewErrorResponse(errors.New("a compaction is already running")))
+	}
+	defer compactionMutex.Unlock()
+
+	start := time.Now()
+	resp := &jsonmodels.StorageCompactionResponse{SizeBefore: totalSize()}
+
+	if err := deps.Protocol.MainStorage().GC(); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrap(err, "failed to compact the storage")))
+	}
+
+	if deps.Retainer != nil {
+		if err := deps.Retainer.GC(); err != nil {
+			return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Wrap(err, "failed to compact the retainer")))
+		}
+	}
+
+	resp.SizeAfter = totalSize()
+	resp.Duration = time.Since(start).Milliseconds()
+
+	Plugin.LogInfof("Compacted the storage from %d to %d bytes in %dms", resp.SizeBefore, resp.SizeAfter, resp.Duration)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func totalSize() int64 {
+	return deps.Protocol.MainStorage().PermanentDatabaseSize() + deps.Protocol.MainStorage().PrunableDatabaseSize() + retainerSize()
+}
+
+// usageReporters returns the components that break down the usage of their data themselves (nil if they are disabled).
+func usageReporters() (indexerReporter, retainerReporter storage.UsageReporter) {
+	if deps.Indexer != nil {
+		indexerReporter = deps.Indexer
+	}
+
+	if deps.Retainer != nil {
+		retainerReporter = deps.Retainer
+	}
+
+	return indexerReporter, retainerReporter
+}
+
+func retainerSize() int64 {
+	if deps.Retainer == nil {
+		return 0
+	}
+
+	return deps.Retainer.DatabaseSize()
+}
@@ -0,0 +1,182 @@
+package subscriptions
+
+import (
+	"strings"
+
+	"github.com/iotaledger/hive.go/core/generics/event"
+	"github.com/iotaledger/hive.go/core/node"
+	"github.com/iotaledger/hive.go/core/types/confirmation"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/subscriptions"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm/indexer"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+)
+
+// PluginName is the name of the web API subscriptions endpoint plugin.
+const PluginName = "WebAPISubscriptionsEndpoint"
+
+var (
+	// Plugin is the plugin instance of the web API subscriptions endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+	hub    *subscriptions.Hub
+)
+
+type dependencies struct {
+	dig.In
+
+	Server   *echo.Echo
+	Protocol *protocol.Protocol
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+}
+
+func configure(_ *node.Plugin) {
+	hub = subscriptions.NewHub(
+		subscriptions.WithBufferSize(webapi.Parameters.Subscriptions.BufferSize),
+		subscriptions.WithMaxTopics(webapi.Parameters.Subscriptions.MaxTopics),
+		subscriptions.WithMaxSubscribers(webapi.Parameters.Subscriptions.MaxSubscribers),
+	)
+	upgrader.CheckOrigin = checkOrigin(webapi.Parameters.Subscriptions.AllowedOrigins)
+
+	configureLedgerEvents()
+	configureConsensusEvents()
+
+	deps.Server.GET("subscriptions", handleSubscriptions)
+}
+
+func configureLedgerEvents() {
+	deps.Protocol.Events.Engine.Ledger.OutputCreated.Attach(event.NewClosure(func(outputID utxo.OutputID) {
+		publishOutputEvent(outputID, jsonmodels.SubscriptionEventOutputBooked)
+	}))
+	deps.Protocol.Events.Engine.Ledger.OutputRejected.Attach(event.NewClosure(func(outputID utxo.OutputID) {
+		publishOutputEvent(outputID, jsonmodels.SubscriptionEventOutputRejected)
+	}))
+	deps.Protocol.Events.Engine.Ledger.TransactionBooked.Attach(event.NewClosure(func(event *ledger.TransactionBookedEvent) {
+		publishTransactionState(event.TransactionID, confirmation.Pending)
+	}))
+	deps.Protocol.Events.Engine.Ledger.TransactionAccepted.Attach(event.NewClosure(func(event *ledger.TransactionEvent) {
+		for _, createdOutput := range event.CreatedOutputs {
+			publishOutput(createdOutput.Output(), jsonmodels.SubscriptionEventOutputAccepted)
+		}
+		for _, spentOutput := range event.SpentOutputs {
+			publishOutput(spentOutput.Output(), jsonmodels.SubscriptionEventOutputSpent)
+		}
+
+		publishTransactionState(event.Metadata.ID(), confirmation.Accepted)
+	}))
+	deps.Protocol.Events.Engine.Ledger.TransactionRejected.Attach(event.NewClosure(func(metadata *ledger.TransactionMetadata) {
+		publishTransactionState(metadata.ID(), confirmation.Rejected)
+	}))
+}
+
+func configureConsensusEvents() {
+	deps.Protocol.Events.Engine.Consensus.BlockGadget.BlockAccepted.Attach(event.NewClosure(func(block *blockgadget.Block) {
+		publishBlockState(block, jsonmodels.SubscriptionEventBlockAccepted)
+	}))
+	deps.Protocol.Events.Engine.Consensus.BlockGadget.BlockConfirmed.Attach(event.NewClosure(func(block *blockgadget.Block) {
+		publishBlockState(block, jsonmodels.SubscriptionEventBlockConfirmed)
+	}))
+	deps.Protocol.Events.Engine.NotarizationManager.EpochCommitted.Attach(event.NewClosure(func(details *notarization.EpochCommittedDetails) {
+		publish(jsonmodels.TopicCommitments, jsonmodels.SubscriptionEventCommitment, &jsonmodels.CommitmentEvent{
+			Commitment:                jsonmodels.EpochInfoFromRecord(details.Commitment),
+			AcceptedBlocksCount:       details.AcceptedBlocksCount,
+			AcceptedTransactionsCount: details.AcceptedTransactionsCount,
+			ActiveValidatorsCount:     details.ActiveValidatorsCount,
+		})
+	}))
+}
+
+// publishOutputEvent loads the output with the given id and publishes an event to the topics of its addresses.
+func publishOutputEvent(outputID utxo.OutputID, eventType string) {
+	deps.Protocol.Engine().Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
+		publishOutput(output, eventType)
+	})
+}
+
+// publishOutput publishes an event to the topics of the addresses of the given output.
+func publishOutput(output utxo.Output, eventType string) {
+	devnetOutput, ok := output.(devnetvm.Output)
+	if !ok {
+		return
+	}
+
+	var outputEvent *jsonmodels.OutputEvent
+	for _, address := range indexer.OutputAddresses(devnetOutput) {
+		topic := jsonmodels.AddressTopic(address.Base58())
+		if !hub.HasSubscribers(topic) {
+			continue
+		}
+
+		if outputEvent == nil {
+			outputEvent = &jsonmodels.OutputEvent{Output: jsonmodels.NewOutput(devnetOutput)}
+		}
+
+		publish(topic, eventType, outputEvent)
+	}
+}
+
+func publishTransactionState(transactionID utxo.TransactionID, confirmationState confirmation.State) {
+	publish(jsonmodels.TransactionTopic(transactionID.Base58()), jsonmodels.SubscriptionEventTransactionState, &jsonmodels.TransactionStateEvent{
+		TransactionID:     transactionID.Base58(),
+		ConfirmationState: confirmationState.String(),
+	})
+}
+
+func publishBlockState(block *blockgadget.Block, eventType string) {
+	publish(jsonmodels.BlockTopic(block.ID().Base58()), eventType, &jsonmodels.BlockStateEvent{
+		BlockID:   block.ID().Base58(),
+		Accepted:  block.IsAccepted(),
+		Confirmed: block.IsConfirmed(),
+	})
+}
+
+func publish(topic, eventType string, data interface{}) {
+	if err := hub.Publish(topic, eventType, data); err != nil {
+		Plugin.LogErrorf("failed to publish event: %s", err)
+	}
+}
+
+// parseTopic validates the given topic and returns it in its canonical form.
+func parseTopic(topic string) (parsedTopic string, err error) {
+	switch {
+	case topic == jsonmodels.TopicCommitments:
+		return topic, nil
+	case strings.HasPrefix(topic, jsonmodels.TopicPrefixAddress):
+		address, parseErr := devnetvm.AddressFromBase58EncodedString(strings.TrimPrefix(topic, jsonmodels.TopicPrefixAddress))
+		if parseErr != nil {
+			return "", errors.Wrapf(parseErr, "failed to parse address of topic %s", topic)
+		}
+
+		return jsonmodels.AddressTopic(address.Base58()), nil
+	case strings.HasPrefix(topic, jsonmodels.TopicPrefixTransaction):
+		var transactionID utxo.TransactionID
+		if parseErr := transactionID.FromBase58(strings.TrimPrefix(topic, jsonmodels.TopicPrefixTransaction)); parseErr != nil {
+			return "", errors.Wrapf(parseErr, "failed to parse transaction ID of topic %s", topic)
+		}
+
+		return jsonmodels.TransactionTopic(transactionID.Base58()), nil
+	case strings.HasPrefix(topic, jsonmodels.TopicPrefixBlock):
+		var blockID models.BlockID
+		if parseErr := blockID.FromBase58(strings.TrimPrefix(topic, jsonmodels.TopicPrefixBlock)); parseErr != nil {
+			return "", errors.Wrapf(parseErr, "failed to parse block ID of topic %s", topic)
+		}
+
+		return jsonmodels.BlockTopic(blockID.Base58()), nil
+	default:
+		return "", errors.Errorf("unknown topic %s", topic)
+	}
+}
@@ -0,0 +1,175 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/subscriptions"
+)
+
+const webSocketWriteTimeout = 3 * time.Second
+
+var upgrader = websocket.Upgrader{
+	HandshakeTimeout: webSocketWriteTimeout,
+}
+
+// checkOrigin returns a function that accepts the WebSocket handshakes of clients that don't send an Origin header
+// (i.e. non-browser clients), of pages served by the node itself and of pages served from the given allowed origins
+// ("*" allows all origins).
+func checkOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	allowed := make(map[string]bool)
+	for _, allowedOrigin := range allowedOrigins {
+		if allowedOrigin = strings.TrimSpace(allowedOrigin); allowedOrigin != "" {
+			allowed[strings.ToLower(allowedOrigin)] = true
+		}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || allowed["*"] || allowed[strings.ToLower(origin)] {
+			return true
+		}
+
+		originURL, err := url.Parse(origin)
+
+		return err == nil && strings.EqualFold(originURL.Host, r.Host)
+	}
+}
+
+// handleSubscriptions upgrades the connection to a WebSocket and streams the events of the topics that the client
+// subscribes to. The initial topics can be passed as a comma separated list in the "topics" query parameter, further
+// topics are added and removed by sending SubscriptionRequests. The connection is refused if the maximum amount of
+// subscribers is connected.
+func handleSubscriptions(c echo.Context) error {
+	subscriber, err := hub.NewSubscriber()
+	if err != nil {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(err))
+	}
+	defer subscriber.Close()
+
+	ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	requestErrors := make(chan error, 1)
+	if initialTopics := c.QueryParam("topics"); initialTopics != "" {
+		if err = subscribe(subscriber, strings.Split(initialTopics, ",")); err != nil {
+			requestErrors <- err
+		}
+	}
+
+	go readRequests(ws, subscriber, requestErrors, done)
+
+	for {
+		select {
+		case event, open := <-subscriber.Events():
+			if !open {
+				if closeErr := subscriber.Err(); closeErr != nil {
+					_ = writeEvent(ws, &jsonmodels.SubscriptionEvent{Type: jsonmodels.SubscriptionEventError, Error: closeErr.Error()})
+				}
+
+				return nil
+			}
+
+			if err = writeEvent(ws, event); err != nil {
+				return nil
+			}
+		case requestErr := <-requestErrors:
+			if err = writeEvent(ws, &jsonmodels.SubscriptionEvent{Type: jsonmodels.SubscriptionEventError, Error: requestErr.Error()}); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// readRequests applies the SubscriptionRequests of the client until the connection is closed.
+func readRequests(ws *websocket.Conn, subscriber *subscriptions.Subscriber, requestErrors chan<- error, done <-chan struct{}) {
+	defer subscriber.Close()
+
+	reportError := func(err error) bool {
+		select {
+		case requestErrors <- err:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		request := new(jsonmodels.SubscriptionRequest)
+		if err = json.Unmarshal(message, request); err != nil {
+			err = errors.Wrap(err, "failed to parse request")
+		} else {
+			err = applyRequest(subscriber, request)
+		}
+
+		if err != nil && !reportError(err) {
+			return
+		}
+	}
+}
+
+// applyRequest changes the topics of the Subscriber according to the given request.
+func applyRequest(subscriber *subscriptions.Subscriber, request *jsonmodels.SubscriptionRequest) error {
+	switch request.Action {
+	case jsonmodels.SubscriptionActionSubscribe:
+		return subscribe(subscriber, request.Topics)
+	case jsonmodels.SubscriptionActionUnsubscribe:
+		topics, err := parseTopics(request.Topics)
+		if err != nil {
+			return err
+		}
+
+		subscriber.Unsubscribe(topics...)
+
+		return nil
+	default:
+		return errors.Errorf("unknown action %s", request.Action)
+	}
+}
+
+func subscribe(subscriber *subscriptions.Subscriber, topics []string) error {
+	parsedTopics, err := parseTopics(topics)
+	if err != nil {
+		return err
+	}
+
+	return subscriber.Subscribe(parsedTopics...)
+}
+
+func parseTopics(topics []string) (parsedTopics []string, err error) {
+	parsedTopics = make([]string, len(topics))
+	for i, topic := range topics {
+		if parsedTopics[i], err = parseTopic(topic); err != nil {
+			return nil, err
+		}
+	}
+
+	return parsedTopics, nil
+}
+
+func writeEvent(ws *websocket.Conn, event *jsonmodels.SubscriptionEvent) error {
+	if err := ws.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+		return err
+	}
+
+	return ws.WriteJSON(event)
+}
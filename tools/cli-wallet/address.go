@@ -19,6 +19,7 @@ func execAddressCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	listPtr := command.Bool("list", false, "list all addresses")
 	listUnspentPtr := command.Bool("listunspent", false, "list all unspent addresses")
 	listSpentPtr := command.Bool("listspent", false, "list all spent addresses")
+	listPublicKeysPtr := command.Bool("listpublickeys", false, "list all addresses with their public keys (to share them with the co-signers of a threshold address)")
 	helpPtr := command.Bool("help", false, "display this help screen")
 
 	err := command.Parse(os.Args[2:])
@@ -46,6 +47,9 @@ func execAddressCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	if *newReceiveAddressPtr {
 		setFlagCount++
 	}
+	if *listPublicKeysPtr {
+		setFlagCount++
+	}
 	if setFlagCount == 0 {
 		printUsage(command)
 	}
@@ -131,4 +135,27 @@ func execAddressCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", "<EMPTY>", "<EMPTY>", "<EMPTY>")
 		}
 	}
+
+	if *listPublicKeysPtr {
+		// initialize tab writer
+		w := new(tabwriter.Writer)
+		w.Init(os.Stdout, 0, 8, 2, '\t', 0)
+		defer w.Flush()
+
+		// print header
+		fmt.Println()
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", "INDEX", "ADDRESS", "PUBLIC KEY")
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", "-----", "--------------------------------------------", "--------------------------------------------")
+
+		addressPrinted := false
+		for _, addr := range cliWallet.AddressManager().Addresses() {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", addr.Index, addr.Base58(), cliWallet.Seed().KeyPair(addr.Index).PublicKey.String())
+
+			addressPrinted = true
+		}
+
+		if !addressPrinted {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", "<EMPTY>", "<EMPTY>", "<EMPTY>")
+		}
+	}
 }
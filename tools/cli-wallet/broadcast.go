@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func execBroadcastCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "transaction.signed", "file that contains the signed transaction")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	txBytes, err := os.ReadFile(*inPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	tx := new(devnetvm.Transaction)
+	if err = tx.FromBytes(txBytes); err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println("Broadcasting transaction...")
+	if err = wallet.NewWebConnector(config.WebAPI, clientOptions()...).SendTransaction(tx); err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Broadcasting transaction " + tx.ID().Base58() + " ... [DONE]")
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func execChangePassphraseCommand(command *flag.FlagSet) {
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(nil, err.Error())
+	}
+
+	walletState := readWalletStateFile(walletStateFile)
+
+	// make sure that we do not encrypt anything else than a valid wallet state
+	if _, _, _, _, err = parseWalletState(walletState); err != nil {
+		panic(err)
+	}
+
+	writeEncryptedStateFile(walletStateFile, walletState, readNewPassphrase(newPassphraseEnvVar))
+
+	// the backup is still encrypted with the old passphrase (that might have been leaked)
+	if err = os.Remove(walletStateFile + ".bkp"); err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+
+	fmt.Println()
+	fmt.Println("CHANGING PASSPHRASE OF WALLET STATE FILE (" + walletStateFile + ") ...     [DONE]")
+}
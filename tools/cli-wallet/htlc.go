@@ -0,0 +1,189 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/claimhashlockedoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/refundhashlockedoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func execCreateHTLCCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	addressPtr := command.String("dest-addr", "", "destination address that can claim the funds by revealing the secret")
+	amountPtr := command.Int64("amount", 0, "the amount of tokens that are supposed to be locked")
+	colorPtr := command.String("color", "IOTA", "(optional) color of the tokens to lock")
+	deadlinePtr := command.Int64("refund-deadline", 0, "unix timestamp after which the funds can't be claimed anymore and are refunded to this wallet")
+	hashLockPtr := command.String("hash-lock", "", "(optional) hex encoded SHA-256 hash of the secret, a new secret is generated if it is not set")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *addressPtr == "" {
+		printUsage(command, "dest-addr has to be set")
+	}
+	if *amountPtr <= 0 {
+		printUsage(command, "amount has to be set and be bigger than 0")
+	}
+	if *colorPtr == "" {
+		printUsage(command, "color must be set")
+	}
+	if *deadlinePtr <= 0 {
+		printUsage(command, "refund-deadline has to be set")
+	}
+
+	var color devnetvm.Color
+	switch *colorPtr {
+	case "IOTA":
+		color = devnetvm.ColorIOTA
+	case "NEW":
+		color = devnetvm.ColorMint
+	default:
+		colorBytes, parseErr := base58.Decode(*colorPtr)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+
+		color, _, parseErr = devnetvm.ColorFromBytes(colorBytes)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+	}
+
+	var secret string
+	var hashLock [devnetvm.HashLockSize]byte
+	if *hashLockPtr != "" {
+		if hashLock, err = jsonmodels.HashLockFromHexString(*hashLockPtr); err != nil {
+			printUsage(command, err.Error())
+		}
+	} else {
+		var preimage [devnetvm.PreimageSize]byte
+		if _, err = rand.Read(preimage[:]); err != nil {
+			printUsage(command, err.Error())
+		}
+		secret = hex.EncodeToString(preimage[:])
+		hashLock = devnetvm.NewHashLock(preimage)
+	}
+
+	deadline := time.Unix(*deadlinePtr, 0)
+	if deadline.Before(time.Now()) {
+		printUsage(command, fmt.Sprintf("refund deadline %s is in the past", deadline.String()))
+	}
+
+	fmt.Println("Locking funds...")
+	tx, err := cliWallet.SendFunds(
+		sendoptions.Destination(parseAddress(command, *addressPtr), uint64(*amountPtr), color),
+		sendoptions.Fallback(cliWallet.ReceiveAddress().Address(), deadline),
+		sendoptions.HashLock(hashLock),
+		sendoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		sendoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+		sendoptions.UsePendingOutputs(false),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	for i, output := range tx.Essence().Outputs() {
+		if casted, ok := output.(*devnetvm.ExtendedLockedOutput); ok && casted.HashLocked() {
+			fmt.Println("Output ID: " + utxo.NewOutputID(tx.ID(), uint16(i)).Base58())
+		}
+	}
+	fmt.Println("Hash Lock: " + hex.EncodeToString(hashLock[:]))
+	if secret != "" {
+		fmt.Println("Secret:    " + secret + " (keep it private until the counterparty has locked its funds)")
+	}
+	fmt.Println("Refund:    " + deadline.String())
+	fmt.Println()
+	fmt.Println("Locking funds ... [DONE]")
+}
+
+func execClaimHTLCCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	outputIDPtr := command.String("output-id", "", "base58 encoded ID of the hash locked output to claim")
+	preimagePtr := command.String("preimage", "", "hex encoded secret whose hash matches the hash lock of the output")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *outputIDPtr == "" {
+		printUsage(command, "output-id has to be set")
+	}
+	if *preimagePtr == "" {
+		printUsage(command, "preimage has to be set")
+	}
+
+	fmt.Println("Claiming hash locked funds...")
+	_, err = cliWallet.ClaimHashLockedFunds(
+		claimhashlockedoptions.OutputID(*outputIDPtr),
+		claimhashlockedoptions.Preimage(*preimagePtr),
+		claimhashlockedoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		claimhashlockedoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Claiming hash locked funds... [DONE]")
+}
+
+func execRefundHTLCCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	outputIDPtr := command.String("output-id", "", "base58 encoded ID of the expired hash locked output to refund")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *outputIDPtr == "" {
+		printUsage(command, "output-id has to be set")
+	}
+
+	fmt.Println("Refunding hash locked funds...")
+	_, err = cliWallet.RefundHashLockedFunds(
+		refundhashlockedoptions.OutputID(*outputIDPtr),
+		refundhashlockedoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		refundhashlockedoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Refunding hash locked funds... [DONE]")
+}
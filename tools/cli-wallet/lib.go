@@ -16,6 +16,7 @@ import (
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet"
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/walletfile"
 )
 
 // Exit should be used inside panic intead of os.Exit(). This will allow to call deferred statements.
@@ -25,18 +26,14 @@ func printBanner() {
 	fmt.Println("IOTA 2.0 DevNet CLI-Wallet 0.2")
 }
 
-func loadWallet() *wallet.Wallet {
-	seed, lastAddressIndex, spentAddresses, assetRegistry, err := importWalletStateFile("wallet.dat")
+// loadWallet loads the wallet from the wallet state file. An offline wallet does not connect to the node (i.e. to
+// manage the addresses on an air-gapped machine).
+func loadWallet(offline bool) *wallet.Wallet {
+	seed, lastAddressIndex, spentAddresses, assetRegistry, err := importWalletStateFile(walletStateFile)
 	if err != nil {
 		panic(err)
 	}
 
-	// configure basic-auth
-	options := []client.Option{}
-	if config.BasicAuth.IsEnabled() {
-		options = append(options, client.WithBasicAuth(config.BasicAuth.Credentials()))
-	}
-
 	if assetRegistry != nil {
 		// we do have an asset registry parsed
 		if config.AssetRegistryNetwork != assetRegistry.Network() && registryservice.Networks[config.AssetRegistryNetwork] {
@@ -50,9 +47,13 @@ func loadWallet() *wallet.Wallet {
 	}
 
 	walletOptions := []wallet.Option{
-		wallet.WebAPI(config.WebAPI, options...),
 		wallet.Import(seed, lastAddressIndex, spentAddresses, assetRegistry),
 	}
+	if offline {
+		walletOptions = append(walletOptions, wallet.GenericConnector(new(offlineConnector)))
+	} else {
+		walletOptions = append(walletOptions, wallet.WebAPI(config.WebAPI, clientOptions()...))
+	}
 	if config.ReuseAddresses {
 		walletOptions = append(walletOptions, wallet.ReusableAddress(true))
 	}
@@ -62,6 +63,15 @@ func loadWallet() *wallet.Wallet {
 	return wallet.New(walletOptions...)
 }
 
+// clientOptions returns the options of the client of the node API (i.e. basic-auth).
+func clientOptions() (options []client.Option) {
+	if config.BasicAuth.IsEnabled() {
+		options = append(options, client.WithBasicAuth(config.BasicAuth.Credentials()))
+	}
+
+	return options
+}
+
 func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *wallet.AssetRegistry, err error) {
 	walletStateBytes, err := os.ReadFile(filename)
 	if err != nil {
@@ -70,9 +80,11 @@ func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressI
 		}
 
 		if len(os.Args) < 2 || os.Args[1] != "init" {
-			printUsage(nil, "no wallet file (wallet.dat) found: please call \""+filepath.Base(os.Args[0])+" init\"")
+			printUsage(nil, "no wallet file ("+filename+") found: please call \""+filepath.Base(os.Args[0])+" init\"")
 		}
 
+		walletPassphrase = readNewPassphrase(passphraseEnvVar)
+
 		seed = walletseed.NewSeed()
 		lastAddressIndex = 0
 		spentAddresses = []bitmask.BitMask{}
@@ -92,9 +104,47 @@ func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressI
 	}
 
 	if len(os.Args) >= 2 && os.Args[1] == "init" {
-		printUsage(nil, "please remove the wallet.dat before trying to create a new wallet")
+		printUsage(nil, "please remove the "+filename+" before trying to create a new wallet")
+	}
+
+	if walletfile.IsEncrypted(walletStateBytes) {
+		walletPassphrase = readPassphrase(passphraseEnvVar, "Enter wallet passphrase: ")
+		if walletStateBytes, err = walletfile.Decrypt(walletStateBytes, walletPassphrase); err != nil {
+			return
+		}
+	} else {
+		// wallet files of older versions are not encrypted: they are encrypted the next time the state is written
+		fmt.Println("ENCRYPTING UNENCRYPTED WALLET STATE FILE (" + filename + ") ...")
+		walletPassphrase = readNewPassphrase(passphraseEnvVar)
 	}
 
+	return parseWalletState(walletStateBytes)
+}
+
+// readWalletStateFile reads the given wallet state file (and decrypts it if it is encrypted) without loading the wallet.
+func readWalletStateFile(filename string) (walletState []byte) {
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			printUsage(nil, "no wallet file ("+filename+") found: please call \""+filepath.Base(os.Args[0])+" init\"")
+		}
+
+		panic(err)
+	}
+
+	if !walletfile.IsEncrypted(fileBytes) {
+		return fileBytes
+	}
+
+	if walletState, err = walletfile.Decrypt(fileBytes, readPassphrase(passphraseEnvVar, "Enter wallet passphrase: ")); err != nil {
+		panic(err)
+	}
+
+	return walletState
+}
+
+// parseWalletState parses the exported state of a wallet.
+func parseWalletState(walletStateBytes []byte) (seed *walletseed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *wallet.AssetRegistry, err error) {
 	marshalUtil := marshalutil.New(walletStateBytes)
 
 	seedBytes, err := marshalUtil.ReadBytes(ed25519.SeedSize)
@@ -117,6 +167,17 @@ func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressI
 }
 
 func writeWalletStateFile(wallet *wallet.Wallet, filename string) {
+	writeEncryptedStateFile(filename, wallet.ExportState(), walletPassphrase)
+}
+
+// writeEncryptedStateFile encrypts the given wallet state with the passphrase and writes it to the given file (the
+// previous version of the file is kept as a backup).
+func writeEncryptedStateFile(filename string, walletState, passphrase []byte) {
+	fileBytes, err := walletfile.Encrypt(walletState, passphrase)
+	if err != nil {
+		panic(err)
+	}
+
 	var skipRename bool
 	info, err := os.Stat(filename)
 	if err != nil {
@@ -137,11 +198,17 @@ func writeWalletStateFile(wallet *wallet.Wallet, filename string) {
 		}
 	}
 
-	//nolint:gosec // users should be able to read the file
-	err = os.WriteFile(filename, wallet.ExportState(), 0o644)
+	err = os.WriteFile(filename, fileBytes, 0o600)
 	if err != nil {
 		panic(err)
 	}
+
+	// do not keep the unencrypted seed of a migrated wallet file around
+	if backupBytes, readErr := os.ReadFile(filename + ".bkp"); readErr == nil && !walletfile.IsEncrypted(backupBytes) {
+		if err = os.Remove(filename + ".bkp"); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func printUsage(command *flag.FlagSet, optionalErrorBlock ...string) {
@@ -164,6 +231,12 @@ func printUsage(command *flag.FlagSet, optionalErrorBlock ...string) {
 		fmt.Println("        consolidate available funds under one wallet address")
 		fmt.Println("  claim-conditional")
 		fmt.Println("        claim (move) conditionally owned funds into the wallet")
+		fmt.Println("  create-htlc")
+		fmt.Println("        lock funds for an atomic swap until the recipient reveals a secret or the refund deadline passes")
+		fmt.Println("  claim-htlc")
+		fmt.Println("        claim hash locked funds by revealing their secret")
+		fmt.Println("  refund-htlc")
+		fmt.Println("        refund hash locked funds whose secret wasn't revealed before the refund deadline")
 		fmt.Println("  request-funds")
 		fmt.Println("        request funds from the testnet-faucet")
 		fmt.Println("  create-asset")
@@ -192,6 +265,20 @@ func printUsage(command *flag.FlagSet, optionalErrorBlock ...string) {
 		fmt.Println("        start the address manager of this wallet")
 		fmt.Println("  init")
 		fmt.Println("        generate a new wallet using a random seed")
+		fmt.Println("  change-passphrase")
+		fmt.Println("        change the passphrase that the wallet state file is encrypted with")
+		fmt.Println("  prepare")
+		fmt.Println("        prepare an unsigned transfer of funds on an online machine (without the wallet state file)")
+		fmt.Println("  sign")
+		fmt.Println("        sign a prepared transaction on an offline machine")
+		fmt.Println("  broadcast")
+		fmt.Println("        send a signed transaction to the node")
+		fmt.Println("  threshold-address")
+		fmt.Println("        derive an M-of-N threshold address from the public keys of its co-signers")
+		fmt.Println("  cosign")
+		fmt.Println("        add the partial signatures of this wallet to a transaction bundle of a threshold address")
+		fmt.Println("  combine")
+		fmt.Println("        combine the partial signatures of several co-signed transaction bundles")
 		fmt.Println("  server-status")
 		fmt.Println("        display the server status")
 		fmt.Println("  pledge-id")
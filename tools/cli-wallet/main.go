@@ -8,7 +8,8 @@ import (
 )
 
 const (
-	lockFile = "wallet.LOCK"
+	lockFile        = "wallet.LOCK"
+	walletStateFile = "wallet.dat"
 )
 
 // entry point for the program
@@ -48,9 +49,36 @@ func main() {
 		printUsage(nil)
 	}
 
-	// load wallet
-	wallet := loadWallet()
-	defer writeWalletStateFile(wallet, "wallet.dat")
+	// execute the commands that do not load the wallet (the wallet state file or the node might not be available)
+	if len(os.Args) >= 2 {
+		switch os.Args[1] {
+		case "change-passphrase":
+			execChangePassphraseCommand(flag.NewFlagSet("change-passphrase", flag.ExitOnError))
+			return
+		case "prepare":
+			execPrepareCommand(flag.NewFlagSet("prepare", flag.ExitOnError))
+			return
+		case "sign":
+			execSignCommand(flag.NewFlagSet("sign", flag.ExitOnError))
+			return
+		case "broadcast":
+			execBroadcastCommand(flag.NewFlagSet("broadcast", flag.ExitOnError))
+			return
+		case "threshold-address":
+			execThresholdAddressCommand(flag.NewFlagSet("threshold-address", flag.ExitOnError))
+			return
+		case "cosign":
+			execCoSignCommand(flag.NewFlagSet("cosign", flag.ExitOnError))
+			return
+		case "combine":
+			execCombineCommand(flag.NewFlagSet("combine", flag.ExitOnError))
+			return
+		}
+	}
+
+	// load wallet (the address manager does not need the node)
+	wallet := loadWallet(len(os.Args) >= 2 && os.Args[1] == "address")
+	defer writeWalletStateFile(wallet, walletStateFile)
 
 	// check if parameters potentially include sub commands
 	if len(os.Args) < 2 {
@@ -62,6 +90,9 @@ func main() {
 	sendFundsCommand := flag.NewFlagSet("send-funds", flag.ExitOnError)
 	consolidateFundsCommand := flag.NewFlagSet("consolidate-funds", flag.ExitOnError)
 	claimConditionalFundsCommand := flag.NewFlagSet("claim-conditional", flag.ExitOnError)
+	createHTLCCommand := flag.NewFlagSet("create-htlc", flag.ExitOnError)
+	claimHTLCCommand := flag.NewFlagSet("claim-htlc", flag.ExitOnError)
+	refundHTLCCommand := flag.NewFlagSet("refund-htlc", flag.ExitOnError)
 	createAssetCommand := flag.NewFlagSet("create-asset", flag.ExitOnError)
 	assetInfoCommand := flag.NewFlagSet("asset-info", flag.ExitOnError)
 	createNFTCommand := flag.NewFlagSet("create-nft", flag.ExitOnError)
@@ -88,6 +119,12 @@ func main() {
 		execConsolidateFundsCommand(consolidateFundsCommand, wallet)
 	case "claim-conditional":
 		execClaimConditionalCommand(claimConditionalFundsCommand, wallet)
+	case "create-htlc":
+		execCreateHTLCCommand(createHTLCCommand, wallet)
+	case "claim-htlc":
+		execClaimHTLCCommand(claimHTLCCommand, wallet)
+	case "refund-htlc":
+		execRefundHTLCCommand(refundHTLCCommand, wallet)
 	case "create-asset":
 		execCreateAssetCommand(createAssetCommand, wallet)
 	case "asset-info":
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/iotaledger/hive.go/core/types/confirmation"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+// errOffline is returned by the offlineConnector for all requests that need the node.
+var errOffline = errors.New("the wallet is not connected to a node")
+
+// offlineConnector is a wallet.Connector for wallets that do not connect to a node (i.e. on an air-gapped machine). It
+// does not know any unspent outputs.
+type offlineConnector struct{}
+
+// UnspentOutputs returns no unspent outputs.
+func (o *offlineConnector) UnspentOutputs(...address.Address) (unspentOutputs wallet.OutputsByAddressAndOutputID, err error) {
+	return wallet.NewAddressToOutputs(), nil
+}
+
+// SendTransaction returns errOffline.
+func (o *offlineConnector) SendTransaction(*devnetvm.Transaction) (err error) {
+	return errOffline
+}
+
+// RequestFaucetFunds returns errOffline.
+func (o *offlineConnector) RequestFaucetFunds(address.Address, int) (err error) {
+	return errOffline
+}
+
+// GetTransactionConfirmationState returns errOffline.
+func (o *offlineConnector) GetTransactionConfirmationState(utxo.TransactionID) (confirmationState confirmation.State, err error) {
+	return confirmationState, errOffline
+}
+
+// GetUnspentAliasOutput returns errOffline.
+func (o *offlineConnector) GetUnspentAliasOutput(*devnetvm.AliasAddress) (output *devnetvm.AliasOutput, err error) {
+	return nil, errOffline
+}
+
+var _ wallet.Connector = new(offlineConnector)
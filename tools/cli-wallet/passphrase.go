@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+)
+
+const (
+	// passphraseEnvVar is the environment variable that can be used to provide the passphrase of the wallet file (i.e.
+	// on build machines where no terminal is available).
+	passphraseEnvVar = "CLI_WALLET_PASSPHRASE"
+
+	// newPassphraseEnvVar is the environment variable that can be used to provide the new passphrase of the wallet file
+	// to the change-passphrase command.
+	newPassphraseEnvVar = "CLI_WALLET_NEW_PASSPHRASE"
+)
+
+// walletPassphrase is the passphrase that the wallet state file is encrypted with.
+var walletPassphrase []byte
+
+// readPassphrase reads a passphrase from the given environment variable or prompts the user for it.
+func readPassphrase(envVar, prompt string) []byte {
+	if passphrase, exists := os.LookupEnv(envVar); exists {
+		return []byte(passphrase)
+	}
+
+	stdin := int(os.Stdin.Fd())
+	if !term.IsTerminal(stdin) {
+		panic(errors.Errorf("no passphrase provided: please set %s or run the wallet in a terminal", envVar))
+	}
+
+	fmt.Print(prompt)
+	passphrase, err := term.ReadPassword(stdin)
+	fmt.Println()
+	if err != nil {
+		panic(errors.Wrap(err, "failed to read passphrase"))
+	}
+
+	return passphrase
+}
+
+// readNewPassphrase reads a new (non-empty) passphrase from the given environment variable or prompts the user to
+// enter it twice.
+func readNewPassphrase(envVar string) (passphrase []byte) {
+	if _, exists := os.LookupEnv(envVar); exists {
+		passphrase = readPassphrase(envVar, "")
+	} else if passphrase = readPassphrase(envVar, "Enter new passphrase: "); !bytes.Equal(passphrase, readPassphrase(envVar, "Repeat new passphrase: ")) {
+		panic(errors.New("the passphrases do not match"))
+	}
+
+	if len(passphrase) == 0 {
+		panic(errors.New("the passphrase must not be empty"))
+	}
+
+	return passphrase
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func execPrepareCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	sourceAddressesPtr := command.String("source-addr", "", "comma separated list of the addresses of the wallet that fund the transfer")
+	addressPtr := command.String("dest-addr", "", "destination address for the transfer")
+	amountPtr := command.Int64("amount", 0, "the amount of tokens that are supposed to be sent")
+	colorPtr := command.String("color", "IOTA", "(optional) color of the tokens to transfer")
+	remainderAddressPtr := command.String("remainder-addr", "", "(optional) address that receives the remainder (defaults to the first source address)")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+	thresholdPtr := command.Uint("threshold", 0, "(optional) threshold of the threshold address among the source addresses")
+	publicKeysPtr := command.String("public-keys", "", "(optional) comma separated list of the public keys of the co-signers of the threshold address among the source addresses")
+	outPtr := command.String("out", "transaction.bundle", "file that the unsigned transaction bundle is written to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *sourceAddressesPtr == "" {
+		printUsage(command, "source-addr has to be set")
+	}
+	if *addressPtr == "" {
+		printUsage(command, "dest-addr has to be set")
+	}
+	if *amountPtr <= 0 {
+		printUsage(command, "amount has to be set and be bigger than 0")
+	}
+	if *colorPtr == "" {
+		printUsage(command, "color must be set")
+	}
+
+	sourceAddresses := make([]address.Address, 0)
+	for _, sourceAddress := range strings.Split(*sourceAddressesPtr, ",") {
+		sourceAddresses = append(sourceAddresses, parseAddress(command, strings.TrimSpace(sourceAddress)))
+	}
+
+	var color devnetvm.Color
+	switch *colorPtr {
+	case "IOTA":
+		color = devnetvm.ColorIOTA
+	case "NEW":
+		color = devnetvm.ColorMint
+	default:
+		colorBytes, parseErr := base58.Decode(*colorPtr)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+
+		color, _, parseErr = devnetvm.ColorFromBytes(colorBytes)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+	}
+
+	options := []sendoptions.SendFundsOption{
+		sendoptions.Destination(parseAddress(command, *addressPtr), uint64(*amountPtr), color),
+		sendoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		sendoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+		sendoptions.UsePendingOutputs(false),
+	}
+	if *remainderAddressPtr != "" {
+		options = append(options, sendoptions.Remainder(parseAddress(command, *remainderAddressPtr)))
+	}
+
+	fmt.Println("Preparing transaction...")
+	bundle, err := wallet.PrepareSendFunds(wallet.NewWebConnector(config.WebAPI, clientOptions()...), sourceAddresses, options...)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	if *thresholdPtr != 0 || *publicKeysPtr != "" {
+		if _, err = bundle.AddThresholdSigners(parseThreshold(command, *thresholdPtr), parsePublicKeys(command, *publicKeysPtr)); err != nil {
+			printUsage(command, err.Error())
+		}
+	}
+
+	writeTransactionBundle(bundle, *outPtr)
+
+	fmt.Println()
+	fmt.Println("Preparing transaction ... [DONE]")
+	fmt.Println("Unsigned transaction bundle: " + *outPtr)
+}
+
+// parseAddress parses the given base58 encoded address or prints the usage of the command if it is invalid.
+func parseAddress(command *flag.FlagSet, base58EncodedAddress string) address.Address {
+	parsedAddress, err := devnetvm.AddressFromBase58EncodedString(base58EncodedAddress)
+	if err != nil {
+		printUsage(command, fmt.Sprintf("invalid address %s: %s", base58EncodedAddress, err.Error()))
+	}
+
+	return address.Address{AddressBytes: parsedAddress.Array()}
+}
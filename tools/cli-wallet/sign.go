@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func execSignCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "transaction.bundle", "file that contains the unsigned transaction bundle")
+	outPtr := command.String("out", "transaction.signed", "file that the signed transaction is written to")
+	yesPtr := command.Bool("yes", false, "sign the transaction without asking for confirmation")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	bundle := readTransactionBundle(command, *inPtr)
+
+	seed, lastAddressIndex, _, _, err := parseWalletState(readWalletStateFile(walletStateFile))
+	if err != nil {
+		panic(err)
+	}
+
+	// show what is about to be signed
+	printTransactionEssence(bundle, seed, lastAddressIndex)
+	if !*yesPtr && !confirm("Sign the transaction? [y/N]: ") {
+		fmt.Println("Signing aborted.")
+		return
+	}
+
+	tx, err := bundle.Sign(seed, lastAddressIndex)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		panic(err)
+	}
+
+	//nolint:gosec // users should be able to read the file
+	if err = os.WriteFile(*outPtr, txBytes, 0o644); err != nil {
+		panic(err)
+	}
+
+	fmt.Println()
+	fmt.Println("Signing transaction " + tx.ID().Base58() + " ... [DONE]")
+	fmt.Println("Signed transaction: " + *outPtr)
+}
+
+// printTransactionEssence prints the mana pledge IDs, the inputs and the outputs of the transaction of the given bundle
+// (i.e. before it is signed). Outputs that are sent back to the addresses of the given wallet are marked as remainder.
+func printTransactionEssence(bundle *wallet.TransactionBundle, walletSeed *walletseed.Seed, lastAddressIndex uint64) {
+	walletAddresses := make(map[string]bool)
+	for i := uint64(0); i <= lastAddressIndex; i++ {
+		walletAddresses[walletSeed.Address(i).Address().Base58()] = true
+	}
+
+	fmt.Println()
+	fmt.Println("Access mana pledge ID:    " + bundle.Essence.AccessPledgeID().String())
+	fmt.Println("Consensus mana pledge ID: " + bundle.Essence.ConsensusPledgeID().String())
+
+	fmt.Println()
+	fmt.Println("Inputs of the transaction:")
+	for _, consumedOutput := range bundle.ConsumedOutputs {
+		fmt.Println("\t" + consumedOutput.ID().Base58() + " (" + consumedOutput.Address().Base58() + ")")
+		printBalances(consumedOutput.Balances())
+	}
+
+	fmt.Println()
+	fmt.Println("Outputs of the transaction:")
+	for _, output := range bundle.Essence.Outputs() {
+		if walletAddresses[output.Address().Base58()] {
+			fmt.Println("\t" + output.Address().Base58() + " (remainder)")
+		} else {
+			fmt.Println("\t" + output.Address().Base58())
+		}
+		printBalances(output.Balances())
+	}
+	fmt.Println()
+}
+
+// printBalances prints the given balances of an input or output.
+func printBalances(balances *devnetvm.ColoredBalances) {
+	balances.ForEach(func(color devnetvm.Color, balance uint64) bool {
+		fmt.Printf("\t\t%d %s\n", balance, color.String())
+		return true
+	})
+}
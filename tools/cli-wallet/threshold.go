@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func execThresholdAddressCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	thresholdPtr := command.Uint("threshold", 0, "number of co-signers that need to sign to spend the funds of the address")
+	publicKeysPtr := command.String("public-keys", "", "comma separated list of the public keys of the co-signers")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	thresholdAddress, err := devnetvm.NewThresholdAddress(parseThreshold(command, *thresholdPtr), parsePublicKeys(command, *publicKeysPtr))
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Threshold Address: " + thresholdAddress.Base58())
+}
+
+func execCoSignCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "transaction.bundle", "file that contains the transaction bundle")
+	outPtr := command.String("out", "", "(optional) file that the co-signed transaction bundle is written to (defaults to the input file)")
+	yesPtr := command.Bool("yes", false, "co-sign the transaction without asking for confirmation")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+	if *outPtr == "" {
+		*outPtr = *inPtr
+	}
+
+	bundle := readTransactionBundle(command, *inPtr)
+
+	seed, lastAddressIndex, _, _, err := parseWalletState(readWalletStateFile(walletStateFile))
+	if err != nil {
+		panic(err)
+	}
+
+	// show what is about to be co-signed
+	printTransactionEssence(bundle, seed, lastAddressIndex)
+	if !*yesPtr && !confirm("Co-sign the transaction? [y/N]: ") {
+		fmt.Println("Co-signing aborted.")
+		return
+	}
+
+	addedSignatures, err := bundle.CoSign(seed, lastAddressIndex)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if addedSignatures == 0 {
+		printUsage(command, "the wallet holds none of the missing keys of the threshold addresses of the transaction bundle")
+	}
+
+	writeTransactionBundle(bundle, *outPtr)
+
+	fmt.Println()
+	fmt.Printf("Adding %d partial signature(s) ... [DONE]\n", addedSignatures)
+	printThresholdSigners(bundle)
+	fmt.Println("Co-signed transaction bundle: " + *outPtr)
+}
+
+func execCombineCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "", "comma separated list of the co-signed transaction bundles of the co-signers")
+	outPtr := command.String("out", "transaction.bundle", "file that the combined transaction bundle is written to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+	if *inPtr == "" {
+		printUsage(command, "in has to be set")
+	}
+
+	var bundle *wallet.TransactionBundle
+	for _, bundleFile := range strings.Split(*inPtr, ",") {
+		coSignedBundle := readTransactionBundle(command, strings.TrimSpace(bundleFile))
+		if bundle == nil {
+			bundle = coSignedBundle
+			continue
+		}
+
+		if _, err = bundle.Merge(coSignedBundle); err != nil {
+			printUsage(command, fmt.Sprintf("failed to combine %s: %s", bundleFile, err.Error()))
+		}
+	}
+
+	writeTransactionBundle(bundle, *outPtr)
+
+	fmt.Println()
+	fmt.Println("Combining partial signatures ... [DONE]")
+	printThresholdSigners(bundle)
+	fmt.Println("Combined transaction bundle: " + *outPtr)
+}
+
+// confirm asks the user the given question and returns true if it was answered with yes.
+func confirm(question string) bool {
+	fmt.Println()
+	fmt.Print(question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		panic(errors.Wrap(err, "failed to read answer"))
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// printThresholdSigners prints how many partial signatures were collected for the threshold addresses of the bundle.
+func printThresholdSigners(bundle *wallet.TransactionBundle) {
+	for _, thresholdSigners := range bundle.ThresholdSigners {
+		fmt.Printf("\t%s: %d of %d required signatures\n", thresholdSigners.Address.Base58(), len(thresholdSigners.Signatures), thresholdSigners.Threshold)
+	}
+}
+
+// parseThreshold parses the given threshold or prints the usage of the command if it is invalid.
+func parseThreshold(command *flag.FlagSet, threshold uint) uint8 {
+	if threshold == 0 || threshold > devnetvm.MaxThresholdPublicKeys {
+		printUsage(command, fmt.Sprintf("threshold has to be between 1 and %d", devnetvm.MaxThresholdPublicKeys))
+	}
+
+	return uint8(threshold)
+}
+
+// parsePublicKeys parses the given comma separated list of base58 encoded public keys or prints the usage of the command
+// if it is invalid.
+func parsePublicKeys(command *flag.FlagSet, base58EncodedPublicKeys string) (publicKeys []ed25519.PublicKey) {
+	if base58EncodedPublicKeys == "" {
+		printUsage(command, "public-keys has to be set")
+	}
+
+	for _, base58EncodedPublicKey := range strings.Split(base58EncodedPublicKeys, ",") {
+		publicKey, err := ed25519.PublicKeyFromString(strings.TrimSpace(base58EncodedPublicKey))
+		if err != nil {
+			printUsage(command, fmt.Sprintf("invalid public key %s: %s", base58EncodedPublicKey, err.Error()))
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys
+}
+
+// readTransactionBundle reads the transaction bundle from the given file or prints the usage of the command if it is
+// invalid.
+func readTransactionBundle(command *flag.FlagSet, bundleFile string) *wallet.TransactionBundle {
+	bundleBytes, err := os.ReadFile(bundleFile)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	bundle, err := wallet.TransactionBundleFromBytes(bundleBytes)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	return bundle
+}
+
+// writeTransactionBundle writes the given transaction bundle to the given file.
+func writeTransactionBundle(bundle *wallet.TransactionBundle, bundleFile string) {
+	bundleBytes, err := bundle.Bytes()
+	if err != nil {
+		panic(err)
+	}
+
+	//nolint:gosec // users should be able to read the file
+	if err = os.WriteFile(bundleFile, bundleBytes, 0o644); err != nil {
+		panic(err)
+	}
+}
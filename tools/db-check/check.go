@@ -0,0 +1,398 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	hivedb "github.com/iotaledger/hive.go/core/database"
+	"github.com/iotaledger/hive.go/core/generics/constraints"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/ads"
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/storage"
+	"github.com/iotaledger/goshimmer/packages/storage/typedkey"
+)
+
+// mainStorageDirectory is the directory inside the database directory that holds the storage of the main engine.
+const mainStorageDirectory = "main"
+
+const (
+	statusOK      = "ok"
+	statusFailed  = "failed"
+	statusSkipped = "skipped"
+)
+
+// Report is the result of the consistency checks of the storage of a node.
+type Report struct {
+	Directory           string            `json:"directory"`
+	LatestCommitment    *CommitmentReport `json:"latestCommitment"`
+	LedgerStateEpoch    int64             `json:"ledgerStateEpoch"`
+	WeightsEpoch        int64             `json:"weightsEpoch"`
+	AttestationsEpoch   int64             `json:"attestationsEpoch"`
+	MaxPrunedEpoch      int64             `json:"maxPrunedEpoch"`
+	PrunableDatabases   []int64           `json:"prunableDatabases"`
+	LastConsistentEpoch int64             `json:"lastConsistentEpoch"`
+	Checks              []*CheckReport    `json:"checks"`
+	Repair              *RepairReport     `json:"repair,omitempty"`
+
+	storage         *storage.Storage
+	granularity     int64
+	layoutIsAligned bool
+}
+
+// CommitmentReport identifies a commitment of the commitment chain of the storage.
+type CommitmentReport struct {
+	Index int64  `json:"index"`
+	ID    string `json:"id"`
+}
+
+// CheckReport is the result of a single consistency check.
+type CheckReport struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Details string `json:"details"`
+}
+
+// openStorage opens the storage of the main engine in the given database directory of a node. Unless writable is set,
+// the storage is opened read-only (see dbProviderFromEngine for engines that can not be opened read-only).
+func openStorage(directory, engineName string, granularity int64, writable, allowFileChanges bool) (s *storage.Storage, err error) {
+	storageDirectory := filepath.Join(directory, mainStorageDirectory)
+	if _, err = os.Stat(filepath.Join(storageDirectory, "settings.bin")); err != nil {
+		return nil, errors.Wrapf(err, "'%s' does not contain the storage of a node", directory)
+	}
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(engineName, hivedb.EngineRocksDB, hivedb.EnginePebble)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid database engine")
+	}
+
+	dbProvider, err := dbProviderFromEngine(dbEngine, directory, writable, allowFileChanges)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create database provider")
+	}
+
+	// the database manager panics if the database can not be opened (i.e. because of an incompatible version)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = errors.Errorf("failed to open storage '%s': %v", storageDirectory, recovered)
+		}
+	}()
+
+	return storage.New(storageDirectory, protocol.DatabaseVersion, database.WithDBProvider(dbProvider), database.WithGranularity(granularity)), nil
+}
+
+// dbProviderFromEngine returns the DBProvider of the given engine that opens the DB instances read-only (unless
+// writable is set). Engines that can not be opened read-only are rejected unless allowFileChanges is set, in which case
+// their DB instances are opened in their regular mode but all writes to their stores are rejected.
+func dbProviderFromEngine(engine hivedb.Engine, directory string, writable, allowFileChanges bool) (provider database.DBProvider, err error) {
+	if writable {
+		return database.DBProviderFromEngine(engine, directory)
+	}
+
+	if provider, err = database.ReadOnlyDBProviderFromEngine(engine, directory); errors.Is(err, database.ErrReadOnlyUnsupported) {
+		if !allowFileChanges {
+			return nil, errors.Wrapf(err, "use --%s to check it anyway", cfgAllowFileChanges)
+		}
+
+		return database.WriteProtectedDBProviderFromEngine(engine, directory)
+	}
+
+	return provider, err
+}
+
+// newReport runs the consistency checks on the given storage.
+func newReport(directory string, s *storage.Storage) (report *Report) {
+	report = &Report{
+		Directory:           directory,
+		LatestCommitment:    newCommitmentReport(s.Settings.LatestCommitment()),
+		LedgerStateEpoch:    int64(lastCommittedEpoch(s.UnspentOutputIDs(), ledgerstate.PrefixUnspentOutputsLatestCommittedIndex)),
+		WeightsEpoch:        int64(lastCommittedEpoch(s.SybilProtection(), dpos.PrefixLastCommittedEpoch)),
+		AttestationsEpoch:   int64(lastCommittedEpoch(s.Permanent.Attestations(), notarization.PrefixAttestationsLastCommittedEpoch)),
+		LastConsistentEpoch: int64(s.Settings.LatestCommitment().Index()),
+		Checks:              make([]*CheckReport, 0),
+
+		storage: s,
+	}
+
+	if !s.Settings.SnapshotImported() {
+		report.fail("settings", "no snapshot was imported")
+
+		return report
+	}
+
+	report.checkPrunableDatabases()
+	report.checkCommitmentChain()
+	report.checkCommittedEpochs()
+	report.checkRoots()
+
+	return report
+}
+
+// Consistent returns true if none of the checks failed.
+func (r *Report) Consistent() bool {
+	for _, check := range r.Checks {
+		if check.Status == statusFailed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withRepair attaches the result of a repair to the report.
+func (r *Report) withRepair(repair *RepairReport) *Report {
+	r.Repair = repair
+
+	return r
+}
+
+// checkPrunableDatabases checks that the DB instances of the prunable storage are aligned to the configured granularity,
+// that there are no gaps between them and that the latest commitment was not pruned.
+func (r *Report) checkPrunableDatabases() {
+	baseIndexes, granularity := r.storage.PrunableDatabaseInstances()
+	r.granularity = granularity
+
+	r.PrunableDatabases = make([]int64, len(baseIndexes))
+	for i, baseIndex := range baseIndexes {
+		r.PrunableDatabases[i] = int64(baseIndex)
+	}
+
+	// the pruned epochs are derived from the oldest DB instance in the same way as database.Manager.RestoreFromDisk
+	r.MaxPrunedEpoch = -1
+	if len(baseIndexes) != 0 {
+		r.MaxPrunedEpoch = int64(baseIndexes[len(baseIndexes)-1]) - 1
+	}
+
+	for i, baseIndex := range baseIndexes {
+		if int64(baseIndex)%granularity != 0 {
+			r.fail("prunable databases", "DB instance %d is not aligned to a granularity of %d epochs", baseIndex, granularity)
+
+			return
+		}
+
+		if i != 0 && int64(baseIndexes[i-1]-baseIndex) != granularity {
+			r.fail("prunable databases", "the DB instances of the epochs %d to %d are missing", baseIndex+epoch.Index(granularity), baseIndexes[i-1]-1)
+
+			return
+		}
+	}
+	r.layoutIsAligned = true
+
+	if latestEpoch := r.LatestCommitment.Index; latestEpoch <= r.MaxPrunedEpoch {
+		r.fail("prunable databases", "the latest committed epoch %d was already pruned (max pruned epoch %d)", latestEpoch, r.MaxPrunedEpoch)
+	} else {
+		r.succeed("prunable databases", "%d DB instances, max pruned epoch %d", len(baseIndexes), r.MaxPrunedEpoch)
+	}
+}
+
+// checkCommitmentChain checks that the commitments up to the latest commitment are linked through their PrevID and that
+// the latest commitment of the settings was stored.
+func (r *Report) checkCommitmentChain() {
+	latestCommitment := r.storage.Settings.LatestCommitment()
+
+	var previousCommitment *commitment.Commitment
+	for index := epoch.Index(0); index <= latestCommitment.Index(); index++ {
+		loadedCommitment, err := r.storage.Commitments.Load(index)
+		if err != nil {
+			r.breakChainAt(index, "failed to load the commitment of epoch %d: %s", index, err)
+
+			return
+		}
+
+		if loadedCommitment.Index() != index {
+			r.breakChainAt(index, "the commitment stored for epoch %d belongs to epoch %d", index, loadedCommitment.Index())
+
+			return
+		}
+
+		if previousCommitment != nil && loadedCommitment.PrevID() != previousCommitment.ID() {
+			r.breakChainAt(index, "the commitment of epoch %d does not reference the commitment of epoch %d", index, index-1)
+
+			return
+		}
+
+		previousCommitment = loadedCommitment
+	}
+
+	if previousCommitment.ID() != latestCommitment.ID() {
+		r.breakChainAt(latestCommitment.Index(), "the latest commitment %s of the settings was not stored", latestCommitment.ID())
+
+		return
+	}
+
+	r.succeed("commitment chain", "the commitments of the epochs 0 to %d are linked", latestCommitment.Index())
+}
+
+// checkCommittedEpochs checks that the ledger state, the consensus weights and the attestations were committed to the
+// latest committed epoch. Components that lag behind it lower the last consistent epoch, as the storage can only be
+// rolled back to an epoch that all of them reached.
+func (r *Report) checkCommittedEpochs() {
+	latestEpoch := r.LatestCommitment.Index
+	for _, component := range []struct {
+		name  string
+		epoch int64
+	}{
+		{"ledger state", r.LedgerStateEpoch},
+		{"consensus weights", r.WeightsEpoch},
+		{"attestations", r.AttestationsEpoch},
+	} {
+		switch {
+		case component.epoch > latestEpoch:
+			r.fail(component.name, "committed epoch %d is ahead of the latest committed epoch %d", component.epoch, latestEpoch)
+		case component.epoch < latestEpoch:
+			r.fail(component.name, "committed epoch %d is behind the latest committed epoch %d", component.epoch, latestEpoch)
+		default:
+			r.succeed(component.name, "committed epoch %d", component.epoch)
+		}
+
+		if component.epoch < r.LastConsistentEpoch {
+			r.LastConsistentEpoch = component.epoch
+		}
+	}
+}
+
+// checkRoots recomputes the roots of the ledger state, the consensus weights and the attestations and compares them to
+// the roots of the last consistent epoch.
+func (r *Report) checkRoots() {
+	index := epoch.Index(r.LastConsistentEpoch)
+	if !r.layoutIsAligned || r.LastConsistentEpoch <= r.MaxPrunedEpoch || !r.isOnDisk(index) {
+		r.skip("roots", "the prunable storage of epoch %d is not available", index)
+
+		return
+	}
+
+	roots, err := r.storage.Roots.Load(index)
+	if err != nil {
+		r.fail("roots", "failed to load the roots of epoch %d: %s", index, err)
+
+		return
+	} else if roots == nil {
+		r.skip("roots", "no roots were stored for epoch %d", index)
+
+		return
+	}
+
+	if expectedCommitment, loadErr := r.storage.Commitments.Load(index); loadErr != nil {
+		r.fail("roots", "failed to load the commitment of epoch %d: %s", index, loadErr)
+	} else if roots.ID() != expectedCommitment.RootsID() {
+		r.fail("roots", "the roots of epoch %d do not belong to its commitment", index)
+	} else {
+		r.succeed("roots", "the roots of epoch %d belong to its commitment", index)
+	}
+
+	r.compareRoot("state root", r.LedgerStateEpoch == r.LastConsistentEpoch, roots.StateRoot(), func() (types.Identifier, error) {
+		return recomputedSetRoot(ads.NewSet[utxo.OutputID](r.storage.UnspentOutputIDs(ledgerstate.PrefixUnspentOutputsIDs)))
+	})
+
+	r.compareRoot("mana root", r.WeightsEpoch == r.LastConsistentEpoch, roots.ManaRoot(), func() (types.Identifier, error) {
+		return recomputedMapRoot(ads.NewMap[identity.ID, sybilprotection.Weight](r.storage.SybilProtection(dpos.PrefixWeights)))
+	})
+
+	r.compareRoot("attestations root", r.AttestationsEpoch >= r.LastConsistentEpoch, roots.AttestationsRoot(), func() (types.Identifier, error) {
+		attestationsStorage, realmErr := r.storage.Prunable.Attestations(index).WithExtendedRealm([]byte{notarization.PrefixAttestations})
+		if realmErr != nil {
+			return types.Identifier{}, errors.Wrapf(realmErr, "failed to access the attestations of epoch %d", index)
+		}
+
+		return recomputedMapRoot(ads.NewMap[identity.ID, notarization.Attestation](attestationsStorage))
+	})
+}
+
+// compareRoot compares the given root of the last consistent epoch to the recomputed one (if it is comparable).
+func (r *Report) compareRoot(name string, comparable bool, expectedRoot types.Identifier, recompute func() (types.Identifier, error)) {
+	if !comparable {
+		r.skip(name, "the data was not committed to epoch %d", r.LastConsistentEpoch)
+
+		return
+	}
+
+	if recomputedRoot, err := recompute(); err != nil {
+		r.fail(name, "failed to recompute the root: %s", err)
+	} else if recomputedRoot != expectedRoot {
+		r.fail(name, "recomputed root %s does not match the root %s of epoch %d", recomputedRoot, expectedRoot, r.LastConsistentEpoch)
+	} else {
+		r.succeed(name, "matches the root of epoch %d", r.LastConsistentEpoch)
+	}
+}
+
+// breakChainAt records that the commitment chain is broken at the given epoch.
+func (r *Report) breakChainAt(index epoch.Index, format string, args ...any) {
+	r.LastConsistentEpoch = int64(index) - 1
+	r.fail("commitment chain", format, args...)
+}
+
+// isOnDisk returns true if the DB instance of the prunable storage that holds the given epoch exists on disk.
+func (r *Report) isOnDisk(index epoch.Index) bool {
+	baseIndex := int64(index) / r.granularity * r.granularity
+	for _, prunableDatabase := range r.PrunableDatabases {
+		if prunableDatabase == baseIndex {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Report) succeed(name, format string, args ...any) {
+	r.Checks = append(r.Checks, &CheckReport{Name: name, Status: statusOK, Details: fmt.Sprintf(format, args...)})
+}
+
+func (r *Report) fail(name, format string, args ...any) {
+	r.Checks = append(r.Checks, &CheckReport{Name: name, Status: statusFailed, Details: fmt.Sprintf(format, args...)})
+}
+
+func (r *Report) skip(name, format string, args ...any) {
+	r.Checks = append(r.Checks, &CheckReport{Name: name, Status: statusSkipped, Details: fmt.Sprintf(format, args...)})
+}
+
+func newCommitmentReport(c *commitment.Commitment) *CommitmentReport {
+	return &CommitmentReport{
+		Index: int64(c.Index()),
+		ID:    c.ID().Base58(),
+	}
+}
+
+// lastCommittedEpoch reads the last committed epoch that a component persisted under the given key of its store.
+func lastCommittedEpoch(store kvstore.KVStore, keyBytes ...byte) epoch.Index {
+	return typedkey.NewGenericType[epoch.Index](store, keyBytes...).Get()
+}
+
+// recomputedSetRoot returns the root of a new Set that contains the elements of the given one.
+func recomputedSetRoot[K any, KPtr constraints.MarshalablePtr[K]](set *ads.Set[K, KPtr]) (root types.Identifier, err error) {
+	recomputedSet := ads.NewSet[K, KPtr](mapdb.NewMapDB())
+	if err = set.Stream(func(key K) bool {
+		recomputedSet.Add(key)
+		return true
+	}); err != nil {
+		return types.Identifier{}, err
+	}
+
+	return recomputedSet.Root(), nil
+}
+
+// recomputedMapRoot returns the root of a new Map that contains the entries of the given one.
+func recomputedMapRoot[K, V constraints.Serializable, KPtr constraints.MarshalablePtr[K], VPtr constraints.MarshalablePtr[V]](m *ads.Map[K, V, KPtr, VPtr]) (root types.Identifier, err error) {
+	recomputedMap := ads.NewMap[K, V, KPtr, VPtr](mapdb.NewMapDB())
+	if err = m.Stream(func(key K, value VPtr) bool {
+		recomputedMap.Set(key, value)
+		return true
+	}); err != nil {
+		return types.Identifier{}, err
+	}
+
+	return recomputedMap.Root(), nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/crypto/ed25519"
+	hivedb "github.com/iotaledger/hive.go/core/database"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/iotaledger/hive.go/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/snapshotcreator"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1"
+	"github.com/iotaledger/goshimmer/packages/storage"
+)
+
+func TestCheckDatabase_Consistent(t *testing.T) {
+	directory := newTestDatabase(t, 2, false)
+	filesBefore := databaseFiles(t, directory)
+
+	report, err := checkDatabase(directory, "pebble", 1, false, false)
+	require.NoError(t, err)
+	require.True(t, report.Consistent(), "%+v", report.Checks)
+	require.EqualValues(t, 2, report.LatestCommitment.Index)
+	require.EqualValues(t, 2, report.LastConsistentEpoch)
+
+	require.Equal(t, filesBefore, databaseFiles(t, directory), "the check must not modify the database")
+}
+
+func TestCheckDatabase_StateAheadOfSettings(t *testing.T) {
+	directory := newTestDatabase(t, 2, true)
+	filesBefore := databaseFiles(t, directory)
+
+	report, err := checkDatabase(directory, "pebble", 1, false, false)
+	require.NoError(t, err)
+	require.False(t, report.Consistent())
+	require.EqualValues(t, 1, report.LatestCommitment.Index)
+	require.EqualValues(t, 2, report.LedgerStateEpoch)
+	require.EqualValues(t, 2, report.WeightsEpoch)
+	require.EqualValues(t, 2, report.AttestationsEpoch)
+	require.EqualValues(t, 1, report.LastConsistentEpoch)
+	require.Nil(t, report.Repair)
+
+	require.Equal(t, filesBefore, databaseFiles(t, directory), "the check must not modify the database")
+
+	report, err = checkDatabase(directory, "pebble", 1, false, true)
+	require.NoError(t, err)
+	require.NotNil(t, report.Repair)
+	require.Empty(t, report.Repair.Error)
+	require.EqualValues(t, 2, report.Repair.FromEpoch)
+	require.EqualValues(t, 1, report.Repair.TargetEpoch)
+	require.True(t, report.Consistent(), "%+v", report.Checks)
+	require.EqualValues(t, 1, report.LatestCommitment.Index)
+	require.EqualValues(t, 1, report.LedgerStateEpoch)
+	require.EqualValues(t, 1, report.WeightsEpoch)
+	require.EqualValues(t, 1, report.AttestationsEpoch)
+}
+
+func TestCheckDatabase_MissingDatabase(t *testing.T) {
+	directory := t.TempDir()
+
+	_, err := checkDatabase(directory, "pebble", 1, false, false)
+	require.Error(t, err)
+	require.Empty(t, databaseFiles(t, directory))
+}
+
+func TestCheckDatabase_RocksDBRequiresFileChanges(t *testing.T) {
+	// the database is rejected before any DB instance is opened, so the storage of the node only needs its settings
+	directory := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(directory, mainStorageDirectory), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, mainStorageDirectory, "settings.bin"), nil, 0o600))
+	filesBefore := databaseFiles(t, directory)
+
+	_, err := checkDatabase(directory, "rocksdb", 1, false, false)
+	require.ErrorIs(t, err, database.ErrReadOnlyUnsupported)
+	require.ErrorContains(t, err, cfgAllowFileChanges)
+	require.Equal(t, filesBefore, databaseFiles(t, directory))
+}
+
+// newTestDatabase creates the database of a node with a pebble storage whose epochs up to latestEpoch are committed. If
+// crashed is set, the node stopped after committing latestEpoch to the ledger state, its consumers and the attestations
+// but before it was committed to the settings (in the order in which the notarization manager writes them).
+func newTestDatabase(t *testing.T, latestEpoch epoch.Index, crashed bool) (directory string) {
+	directory = t.TempDir()
+
+	identityID := identity.New(ed25519.GenerateKeyPair().PublicKey).ID()
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.bin")
+	snapshotcreator.CreateSnapshot(protocol.DatabaseVersion, snapshotPath, 100, make([]byte, ed25519.SeedSize), map[identity.ID]uint64{identityID: 100}, []identity.ID{identityID})
+
+	dbProvider, err := database.DBProviderFromEngine(hivedb.EnginePebble, directory)
+	require.NoError(t, err)
+
+	s := storage.New(filepath.Join(directory, mainStorageDirectory), protocol.DatabaseVersion, database.WithDBProvider(dbProvider), database.WithGranularity(1))
+	e := engine.New(s, dpos.NewProvider(), mana1.NewProvider())
+	require.NoError(t, e.Initialize(snapshotPath))
+
+	for index := epoch.Index(1); index <= latestEpoch; index++ {
+		require.NoError(t, e.LedgerState.ApplyStateDiff(index))
+		e.NotarizationManager.Attestations.SetLastCommittedEpoch(index)
+
+		if crashed && index == latestEpoch {
+			break
+		}
+
+		latestCommitment := s.Settings.LatestCommitment()
+		newCommitment := commitment.New(index, latestCommitment.ID(), types.Identifier{}, latestCommitment.CumulativeWeight())
+		require.NoError(t, s.Settings.SetLatestCommitment(newCommitment))
+		require.NoError(t, s.Commitments.Store(newCommitment))
+	}
+
+	e.Shutdown()
+	s.Shutdown()
+
+	return directory
+}
+
+// databaseFiles returns the hashes of the contents of all files in the given directory.
+func databaseFiles(t *testing.T, directory string) (files map[string]string) {
+	files = make(map[string]string)
+	require.NoError(t, filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[path] = fmt.Sprintf("%x", sha256.Sum256(content))
+
+		return nil
+	}))
+
+	return files
+}
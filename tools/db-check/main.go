@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	cfgJSON        = "json"
+	cfgRepair      = "repair"
+	cfgEngine      = "engine"
+	cfgGranularity = "granularity"
+
+	cfgAllowFileChanges = "allow-file-changes"
+
+	usage = `usage:
+  db-check [--json] [--engine <engine>] [--granularity <epochs>] [--allow-file-changes] [--repair] <database-directory>
+
+Verifies the consistency of the database of a stopped node (the directory that is configured as database.directory).
+The database is not modified unless --repair is given, which rolls the node back to the last consistent epoch.
+
+RocksDB databases can not be opened read-only: RocksDB replays its write-ahead log and rewrites its LOG and MANIFEST
+files whenever it is opened. They are therefore only checked if --allow-file-changes is given (the data itself is still
+not modified without --repair).`
+)
+
+func main() {
+	jsonOutput := flag.Bool(cfgJSON, false, "print the output as JSON")
+	repairDatabase := flag.Bool(cfgRepair, false, "roll the database back to the last consistent epoch if it is inconsistent")
+	engineName := flag.String(cfgEngine, "rocksdb", "the database engine of the node (rocksdb or pebble)")
+	granularity := flag.Int64(cfgGranularity, 1, "how many epochs are contained in a single prunable DB instance of the node")
+	allowFileChanges := flag.Bool(cfgAllowFileChanges, false, "check databases whose engine modifies their files when they are opened (rocksdb)")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 || *granularity < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	report, err := checkDatabase(flag.Arg(0), *engineName, *granularity, *allowFileChanges, *repairDatabase)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *jsonOutput {
+		printJSON(report)
+	} else {
+		printReport(os.Stdout, report)
+	}
+
+	if !report.Consistent() {
+		os.Exit(1)
+	}
+}
+
+// checkDatabase checks the database in the given directory (which is opened read-only) and rolls it back to the last
+// consistent epoch if it is inconsistent and repairDatabase is set (which reopens it writable). Databases whose engine
+// can not be opened read-only are only checked if allowFileChanges is set.
+func checkDatabase(directory, engineName string, granularity int64, allowFileChanges, repairDatabase bool) (report *Report, err error) {
+	s, err := openStorage(directory, engineName, granularity, false, allowFileChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	report = newReport(directory, s)
+	s.Shutdown()
+
+	if !repairDatabase || report.Consistent() {
+		return report, nil
+	}
+
+	if s, err = openStorage(directory, engineName, granularity, true, allowFileChanges); err != nil {
+		return nil, err
+	}
+	defer s.Shutdown()
+
+	repairReport, err := repair(s, report)
+	if err != nil {
+		return report.withRepair(&RepairReport{Error: err.Error()}), nil
+	}
+
+	return newReport(directory, s).withRepair(repairReport), nil
+}
+
+func printJSON(value any) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func printReport(w io.Writer, report *Report) {
+	fmt.Fprintf(w, "Database: %s\n", report.Directory)
+
+	fmt.Fprintln(w, "--- Epochs ---")
+	fmt.Fprintf(w, "Latest commitment: %d (%s)\n", report.LatestCommitment.Index, report.LatestCommitment.ID)
+	fmt.Fprintf(w, "Ledger state: %d\n", report.LedgerStateEpoch)
+	fmt.Fprintf(w, "Consensus weights: %d\n", report.WeightsEpoch)
+	fmt.Fprintf(w, "Attestations: %d\n", report.AttestationsEpoch)
+	fmt.Fprintf(w, "Max pruned: %d\n", report.MaxPrunedEpoch)
+	fmt.Fprintf(w, "Prunable DB instances: %v\n", report.PrunableDatabases)
+
+	fmt.Fprintln(w, "--- Checks ---")
+	for _, check := range report.Checks {
+		fmt.Fprintf(w, "[%s] %s: %s\n", check.Status, check.Name, check.Details)
+	}
+
+	fmt.Fprintln(w, "--- Result ---")
+	if report.Repair != nil {
+		if report.Repair.Error != "" {
+			fmt.Fprintf(w, "Repair failed: %s\n", report.Repair.Error)
+		} else {
+			fmt.Fprintf(w, "Rolled back from epoch %d to epoch %d\n", report.Repair.FromEpoch, report.Repair.TargetEpoch)
+		}
+	}
+
+	if report.Consistent() {
+		fmt.Fprintln(w, "The database is consistent")
+	} else {
+		fmt.Fprintf(w, "The database is inconsistent (last consistent epoch: %d)\n", report.LastConsistentEpoch)
+	}
+}
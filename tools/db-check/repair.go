@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1"
+	"github.com/iotaledger/goshimmer/packages/storage"
+)
+
+// RepairReport is the result of the rollback of an inconsistent storage.
+type RepairReport struct {
+	FromEpoch   int64  `json:"fromEpoch"`
+	TargetEpoch int64  `json:"targetEpoch"`
+	Error       string `json:"error,omitempty"`
+}
+
+// repair truncates the storage to the last consistent epoch of the report by rolling back the state diffs of the later
+// epochs that were applied to the ledger state or its consumers and rewinding the settings (the indexer of the node
+// rebuilds itself when it is started again). The storage needs to be opened writable.
+func repair(s *storage.Storage, report *Report) (repairReport *RepairReport, err error) {
+	repairReport = &RepairReport{
+		FromEpoch:   report.latestEpoch(),
+		TargetEpoch: report.LastConsistentEpoch,
+	}
+
+	if !report.layoutIsAligned {
+		return nil, errors.New("the prunable storage can not be accessed with the configured granularity")
+	} else if repairReport.FromEpoch <= repairReport.TargetEpoch {
+		return nil, errors.Errorf("the storage (epoch %d) can not be rolled back to epoch %d: a snapshot needs to be imported instead", repairReport.FromEpoch, repairReport.TargetEpoch)
+	}
+
+	for index := repairReport.TargetEpoch + 1; index <= repairReport.FromEpoch; index++ {
+		if index <= report.MaxPrunedEpoch || !report.isOnDisk(epoch.Index(index)) {
+			return nil, errors.Errorf("the state diff of epoch %d is not available: a snapshot needs to be imported instead", index)
+		}
+	}
+
+	e := engine.New(s, dpos.NewProvider(), mana1.NewProvider())
+	defer e.Shutdown()
+
+	if err = e.RollbackTo(epoch.Index(repairReport.TargetEpoch)); err != nil {
+		return nil, errors.Wrapf(err, "failed to roll back to epoch %d", repairReport.TargetEpoch)
+	}
+
+	return repairReport, nil
+}
+
+// latestEpoch returns the latest epoch that was committed by the settings or any of the checked components.
+func (r *Report) latestEpoch() (latestEpoch int64) {
+	latestEpoch = r.LatestCommitment.Index
+	for _, componentEpoch := range []int64{r.LedgerStateEpoch, r.WeightsEpoch, r.AttestationsEpoch} {
+		if componentEpoch > latestEpoch {
+			latestEpoch = componentEpoch
+		}
+	}
+
+	return latestEpoch
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 	"os"
 
-	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
 	flag "github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -26,74 +25,25 @@ import (
 )
 
 const (
-	cfgGenesisTokenAmount     = "token-amount"
-	cfgPledgeTokenAmount      = "plege-token-amount"
-	cfgSnapshotFileName       = "snapshot-file"
-	cfgSnapshotGenesisSeed    = "seed"
-	defaultSnapshotFileName   = "./snapshot.bin"
-	defaultGenesisTokenAmount = 1000000000000000
+	cfgGenesisFile          = "genesis-file"
+	cfgSnapshotFileName     = "snapshot-file"
+	defaultGenesisFile      = "./genesis/feature-network.yml"
+	defaultSnapshotFileName = "./snapshot.bin"
 )
 
-// Feature network.
-var nodesToPledge = []string{
-	"Xv5Kmv9uZfNME4KD2zBoHZ3kVqovJN59ec62rH3AeLA",  // entrynode
-	"EUq4re4sZBMbmzdKo8LJF8uVQhbS24ZNeLRf7AntGH7b", // bootstrap_01
-	"6PqeR7gpR9KtVt7ZgxrEnTj76TS7S439R1gmmzLLrBcU", // vanilla_01
-	"DmKUMcbs6go8sMhJLfZxL8NKXHtYdxQMwVjyacsw4c6C", // node_01
-	"GCvqziTVeHHvM4SvSeLBobY2KYTNiyB1miS9giVDgJbk", // node_02
-	"64RCLnQC7ECpHGpq7dWp3Xtpc79uVi61XYBv5fgXsD9h", // node_03
-	"Amkmn4nt8qwboUGPmFhCoM9ogeCbvS3eBSTjuoE3a5ci", // node_04
-	"4FJbEsv448BoXeRo1a5Cq9xizkP2AkRBcx9W4PwDt2GL", // node_05
-	"GbkZ3CoiTuUPUAYjgZLM8Y1VgvUbPujHVxmYmPVY2GDC", // faucet_01
-}
-
-var initialAttestations = []string{
-	"EUq4re4sZBMbmzdKo8LJF8uVQhbS24ZNeLRf7AntGH7b", // bootstrap_01
-}
-
-// Devnet
-// var nodesToPledge = []string{
-// 	"7Yr1tz7atYcbQUv5njuzoC5MiDsMmr3hqaWtAsgJfxxr", // entrynode
-// 	"AuQXPFmRu9nKNtUq3g1RLqVgSmxNrYeogt6uRwqYLGvK", // bootstrap_01
-// 	"D9SPFofAGhA5V9QRDngc1E8qG9bTrnATmpZMdoyRiBoW", // vanilla_01
-// 	"CfkVFzXRjJdshjgPpQAZ4fccZs2SyVPGkTc8LmtnbsT",  // node_01
-// 	"AQfLfcKpvt1nWn916ZGSBy7bRPkjEv5sN7fSZ2rFKoPh", // node_02
-// 	"9GLqh2VaDYUiKGn7kwV2EXsnU6Eiv7AEW73bXhfnX6FD", // node_03
-// 	"C3VeWTBAi12JHXKWTvYCxBRyVya6UpbdziiGZNqgh1sB", // node_04
-// 	"HGiFs4jR74yxDMCN8K1Z16QPdwchXokXzYhBLqKW2ssW", // node_05
-// 	"5heLsHxMRdTewXooaaDFGpAoj5c41ah5wTmpMukjdvi7", // faucet_01
-// }
-//
-//var initialAttestations = []string{
-//		"AuQXPFmRu9nKNtUq3g1RLqVgSmxNrYeogt6uRwqYLGvK", // bootstrap_01
-//}
-
-// Docker network.
-//var nodesToPledge = []string{
-//	"2GtxMQD94KvDH1SJPJV7icxofkyV1njuUZKtsqKmtux5", // peer_master
-//	"AXSoTPcN6SNwH64tywpz4k2XfAc24NR7ckKX8wPjeUZD", // peer_master2
-//	"FZ6xmPZXRs2M8z9m9ETTQok4PCga4X8FRHwQE6uYm4rV", // faucet
-//}
-//
-//var initialAttestations = []string{
-//	"2GtxMQD94KvDH1SJPJV7icxofkyV1njuUZKtsqKmtux5", // peer_master
-//}
-
 func main() {
-	snapshotFileName := viper.GetString(cfgSnapshotFileName)
-	log.Printf("creating createdSnapshot %s...", snapshotFileName)
-
-	genesisTokenAmount := viper.GetUint64(cfgGenesisTokenAmount)
-	totalTokensToPledge := viper.GetUint64(cfgPledgeTokenAmount)
-	genesisSeed, err := base58.Decode(viper.GetString(cfgSnapshotGenesisSeed))
+	genesisFile := viper.GetString(cfgGenesisFile)
+	genesis, err := snapshotcreator.LoadGenesis(genesisFile)
 	if err != nil {
-		log.Fatal(errors.Wrap(err, "failed to decode base58 seed"))
+		log.Fatal(err)
 	}
 
-	manaDistribution := createManaDistribution(totalTokensToPledge)
-	initialAttestationsSlice := createInitialAttestations()
+	snapshotFileName := viper.GetString(cfgSnapshotFileName)
+	log.Printf("creating snapshot %s from genesis file %s...", snapshotFileName, genesisFile)
 
-	snapshotcreator.CreateSnapshot(protocol.DatabaseVersion, snapshotFileName, genesisTokenAmount, genesisSeed, manaDistribution, initialAttestationsSlice)
+	if err = snapshotcreator.CreateSnapshotFromGenesis(protocol.DatabaseVersion, snapshotFileName, genesis); err != nil {
+		log.Fatal(errors.Wrap(err, "failed to create snapshot"))
+	}
 
 	diagnosticPrintSnapshotFromFile(snapshotFileName)
 }
@@ -102,38 +52,9 @@ func createTempStorage() (s *storage.Storage) {
 	return storage.New(lo.PanicOnErr(os.MkdirTemp(os.TempDir(), "*")), protocol.DatabaseVersion)
 }
 
-func createManaDistribution(totalTokensToPledge uint64) (manaDistribution map[identity.ID]uint64) {
-	manaDistribution = make(map[identity.ID]uint64)
-	for _, node := range nodesToPledge {
-		nodeID, err := identity.DecodeIDBase58(node)
-		if err != nil {
-			panic("failed to decode node id: " + err.Error())
-		}
-
-		manaDistribution[nodeID] = totalTokensToPledge / uint64(len(nodesToPledge))
-	}
-
-	return manaDistribution
-}
-
-func createInitialAttestations() (parsed []identity.ID) {
-	for _, node := range initialAttestations {
-		nodeID, err := identity.DecodeIDBase58(node)
-		if err != nil {
-			panic("failed to decode node id: " + err.Error())
-		}
-
-		parsed = append(parsed, nodeID)
-	}
-
-	return parsed
-}
-
 func init() {
-	flag.Uint64(cfgGenesisTokenAmount, defaultGenesisTokenAmount, "the amount of tokens to add to the genesis output") // this amount is pledged to the empty nodeID.
+	flag.String(cfgGenesisFile, defaultGenesisFile, "the JSON or YAML file that describes the genesis")
 	flag.String(cfgSnapshotFileName, defaultSnapshotFileName, "the name of the generated snapshot file")
-	flag.String(cfgSnapshotGenesisSeed, "7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih", "the genesis seed")
-	flag.Uint(cfgPledgeTokenAmount, defaultGenesisTokenAmount, "the amount of tokens to pledge to defined nodes (other than genesis)")
 
 	flag.Parse()
 	if err := viper.BindPFlags(flag.CommandLine); err != nil {
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Diff is the section by section comparison of two snapshots.
+type Diff struct {
+	A        string         `json:"a"`
+	B        string         `json:"b"`
+	Sections []*SectionDiff `json:"sections"`
+}
+
+// SectionDiff contains the differences of a single section (it is empty if the section is equal in both snapshots).
+type SectionDiff struct {
+	Name        string   `json:"name"`
+	Equal       bool     `json:"equal"`
+	Differences []string `json:"differences,omitempty"`
+}
+
+// newDiff compares the two reports section by section.
+func newDiff(a, b *Report) (diff *Diff) {
+	diff = &Diff{A: a.File, B: b.File}
+	diff.add("header", diffHeaders(a.Header, b.Header))
+	diff.add("commitments", diffCommitments(a.Commitments, b.Commitments))
+	diff.add("root blocks", diffMaps(stringSet(a.RootBlocks), stringSet(b.RootBlocks)))
+	diff.add("unspent outputs", diffUnspentOutputs(a.UnspentOutputs, b.UnspentOutputs))
+	diff.add("consensus weights", append(diffValue("total weight", a.TotalWeight, b.TotalWeight), diffMaps(a.ConsensusWeights, b.ConsensusWeights)...))
+	diff.add("access mana", diffMaps(a.AccessMana, b.AccessMana))
+	diff.add("attestations", diffAttestations(a.Attestations, b.Attestations))
+
+	return diff
+}
+
+// Equal returns true if no differences were found.
+func (d *Diff) Equal() bool {
+	for _, section := range d.Sections {
+		if !section.Equal {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *Diff) add(name string, differences []string) {
+	d.Sections = append(d.Sections, &SectionDiff{
+		Name:        name,
+		Equal:       len(differences) == 0,
+		Differences: differences,
+	})
+}
+
+func diffHeaders(a, b *HeaderReport) (differences []string) {
+	if a == nil || b == nil {
+		if a != b {
+			differences = append(differences, fmt.Sprintf("format: a=%s b=%s", formatName(a), formatName(b)))
+		}
+
+		return differences
+	}
+
+	differences = append(differences, diffValue("format version", a.FormatVersion, b.FormatVersion)...)
+	differences = append(differences, diffValue("database version", a.DatabaseVersion, b.DatabaseVersion)...)
+	differences = append(differences, diffValue("genesis time", a.GenesisTime, b.GenesisTime)...)
+	differences = append(differences, diffValue("epoch duration", a.EpochDuration, b.EpochDuration)...)
+	differences = append(differences, diffValue("target epoch", a.TargetEpoch, b.TargetEpoch)...)
+	differences = append(differences, diffValue("target commitment", a.TargetCommitmentID, b.TargetCommitmentID)...)
+
+	return append(differences, diffMaps(sectionHashes(a.Sections), sectionHashes(b.Sections))...)
+}
+
+func diffCommitments(a, b []*CommitmentReport) (differences []string) {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var commitmentA, commitmentB string
+		if i < len(a) {
+			commitmentA = a[i].ID
+		}
+		if i < len(b) {
+			commitmentB = b[i].ID
+		}
+
+		differences = append(differences, diffValue(fmt.Sprintf("epoch %d", i), orMissing(commitmentA), orMissing(commitmentB))...)
+	}
+
+	return differences
+}
+
+func diffUnspentOutputs(a, b *UnspentOutputsReport) (differences []string) {
+	differences = append(differences, diffValue("count", a.Count, b.Count)...)
+	differences = append(differences, diffMaps(prefixKeys("total", a.Totals), prefixKeys("total", b.Totals))...)
+
+	return append(differences, diffMaps(flattenBalances(a.Balances), flattenBalances(b.Balances))...)
+}
+
+func diffAttestations(a, b *AttestationsReport) (differences []string) {
+	differences = append(differences, diffValue("epoch", a.Epoch, b.Epoch)...)
+	differences = append(differences, diffValue("weight", a.Weight, b.Weight)...)
+
+	return append(differences, diffMaps(a.Attestations, b.Attestations)...)
+}
+
+// diffValue returns the difference of a single value (if there is any).
+func diffValue[V comparable](name string, a, b V) (differences []string) {
+	if a != b {
+		differences = append(differences, fmt.Sprintf("%s: a=%v b=%v", name, a, b))
+	}
+
+	return differences
+}
+
+// diffMaps returns the differences of two maps (sorted by key).
+func diffMaps[V comparable](a, b map[string]V) (differences []string) {
+	keys := make(map[string]bool)
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	for _, key := range sortedKeys {
+		valueA, existsA := a[key]
+		valueB, existsB := b[key]
+
+		switch {
+		case !existsA:
+			differences = append(differences, fmt.Sprintf("%s: only in b (%v)", key, valueB))
+		case !existsB:
+			differences = append(differences, fmt.Sprintf("%s: only in a (%v)", key, valueA))
+		case valueA != valueB:
+			differences = append(differences, fmt.Sprintf("%s: a=%v b=%v", key, valueA, valueB))
+		}
+	}
+
+	return differences
+}
+
+func stringSet(elements []string) (set map[string]bool) {
+	set = make(map[string]bool)
+	for _, element := range elements {
+		set[element] = true
+	}
+
+	return set
+}
+
+func sectionHashes(sections []*SectionReport) (hashes map[string]string) {
+	hashes = make(map[string]string)
+	for _, section := range sections {
+		hashes["section "+section.Name] = section.Hash
+	}
+
+	return hashes
+}
+
+func flattenBalances(balances map[string]map[string]uint64) (flattened map[string]uint64) {
+	flattened = make(map[string]uint64)
+	for address, colorBalances := range balances {
+		for color, balance := range colorBalances {
+			flattened[address+"/"+color] = balance
+		}
+	}
+
+	return flattened
+}
+
+func prefixKeys[V any](prefix string, source map[string]V) (prefixed map[string]V) {
+	prefixed = make(map[string]V)
+	for key, value := range source {
+		prefixed[prefix+" "+key] = value
+	}
+
+	return prefixed
+}
+
+func formatName(header *HeaderReport) string {
+	if header == nil {
+		return "legacy"
+	}
+
+	return fmt.Sprintf("v%d", header.FormatVersion)
+}
+
+func orMissing(value string) string {
+	if value == "" {
+		return "<missing>"
+	}
+
+	return value
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	cfgJSON = "json"
+
+	usage = `usage:
+  snapshot-inspector [--json] <snapshot-file>
+  snapshot-inspector diff [--json] <snapshot-file-a> <snapshot-file-b>`
+)
+
+func main() {
+	jsonOutput := flag.Bool(cfgJSON, false, "print the output as JSON")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	switch args := flag.Args(); {
+	case len(args) == 1 && args[0] != "diff":
+		report, err := newReport(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if *jsonOutput {
+			printJSON(report)
+		} else {
+			printReport(os.Stdout, report)
+		}
+	case len(args) == 3 && args[0] == "diff":
+		reportA, err := newReport(args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		reportB, err := newReport(args[2])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		diff := newDiff(reportA, reportB)
+		if *jsonOutput {
+			printJSON(diff)
+		} else {
+			printDiff(os.Stdout, diff)
+		}
+
+		if !diff.Equal() {
+			os.Exit(1)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printJSON(value any) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func printReport(w io.Writer, report *Report) {
+	fmt.Fprintf(w, "Snapshot: %s\n", report.File)
+
+	fmt.Fprintln(w, "--- Header ---")
+	if report.Header == nil {
+		fmt.Fprintln(w, "legacy format (no header)")
+	} else {
+		fmt.Fprintf(w, "Format version: %d\n", report.Header.FormatVersion)
+		fmt.Fprintf(w, "Database version: %d\n", report.Header.DatabaseVersion)
+		fmt.Fprintf(w, "Genesis time: %s\n", time.Unix(report.Header.GenesisTime, 0).UTC())
+		fmt.Fprintf(w, "Epoch duration: %ds\n", report.Header.EpochDuration)
+		fmt.Fprintf(w, "Target epoch: %d (%s)\n", report.Header.TargetEpoch, report.Header.TargetCommitmentID)
+		for _, section := range report.Header.Sections {
+			fmt.Fprintf(w, "Section %-20s %10d bytes  %s\n", section.Name, section.Length, section.Hash)
+		}
+	}
+
+	fmt.Fprintln(w, "--- Latest Commitment ---")
+	printCommitment(w, report.LatestCommitment)
+
+	fmt.Fprintln(w, "--- Commitments ---")
+	for _, commitment := range report.Commitments {
+		printCommitment(w, commitment)
+	}
+
+	fmt.Fprintln(w, "--- Root Blocks ---")
+	for _, rootBlock := range report.RootBlocks {
+		fmt.Fprintln(w, rootBlock)
+	}
+
+	fmt.Fprintln(w, "--- Unspent Outputs ---")
+	fmt.Fprintf(w, "Count: %d\n", report.UnspentOutputs.Count)
+	for _, color := range sortedKeys(report.UnspentOutputs.Totals) {
+		fmt.Fprintf(w, "Total %s: %d\n", color, report.UnspentOutputs.Totals[color])
+	}
+	for _, address := range sortedKeys(report.UnspentOutputs.Balances) {
+		for _, color := range sortedKeys(report.UnspentOutputs.Balances[address]) {
+			fmt.Fprintf(w, "%s %s: %d\n", address, color, report.UnspentOutputs.Balances[address][color])
+		}
+	}
+
+	fmt.Fprintln(w, "--- Consensus Weights ---")
+	fmt.Fprintf(w, "Total: %d\n", report.TotalWeight)
+	for _, id := range sortedKeys(report.ConsensusWeights) {
+		fmt.Fprintf(w, "%s: %d\n", id, report.ConsensusWeights[id])
+	}
+
+	fmt.Fprintln(w, "--- Access Mana ---")
+	for _, id := range sortedKeys(report.AccessMana) {
+		fmt.Fprintf(w, "%s: %d\n", id, report.AccessMana[id])
+	}
+
+	fmt.Fprintln(w, "--- Attestations ---")
+	fmt.Fprintf(w, "Epoch: %d, Weight: %d\n", report.Attestations.Epoch, report.Attestations.Weight)
+	for _, issuerID := range sortedKeys(report.Attestations.Attestations) {
+		attestation := report.Attestations.Attestations[issuerID]
+		fmt.Fprintf(w, "%s: issued %s for commitment %s\n", issuerID, attestation.IssuingTime, attestation.CommitmentID)
+	}
+}
+
+func printCommitment(w io.Writer, commitment *CommitmentReport) {
+	fmt.Fprintf(w, "Epoch %d: %s (prev: %s, roots: %s, cumulative weight: %d)\n", commitment.Index, commitment.ID, commitment.PrevID, commitment.RootsID, commitment.CumulativeWeight)
+}
+
+func printDiff(w io.Writer, diff *Diff) {
+	fmt.Fprintf(w, "a: %s\nb: %s\n", diff.A, diff.B)
+	for _, section := range diff.Sections {
+		if section.Equal {
+			fmt.Fprintf(w, "--- %s: equal ---\n", section.Name)
+			continue
+		}
+
+		fmt.Fprintf(w, "--- %s: %d differences ---\n", section.Name, len(section.Differences))
+		for _, difference := range section.Differences {
+			fmt.Fprintln(w, difference)
+		}
+	}
+}
+
+func sortedKeys[V any](source map[string]V) (keys []string) {
+	keys = make([]string, 0, len(source))
+	for key := range source {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/generics/options"
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/epoch"
+	"github.com/iotaledger/goshimmer/packages/core/snapshot"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/storage"
+)
+
+// Report is the content of a snapshot as it is seen by an engine that imported it.
+type Report struct {
+	File             string                `json:"file"`
+	Header           *HeaderReport         `json:"header,omitempty"`
+	LatestCommitment *CommitmentReport     `json:"latestCommitment"`
+	Commitments      []*CommitmentReport   `json:"commitments"`
+	RootBlocks       []string              `json:"rootBlocks"`
+	UnspentOutputs   *UnspentOutputsReport `json:"unspentOutputs"`
+	ConsensusWeights map[string]int64      `json:"consensusWeights"`
+	TotalWeight      int64                 `json:"totalWeight"`
+	AccessMana       map[string]int64      `json:"accessMana"`
+	Attestations     *AttestationsReport   `json:"attestations"`
+}
+
+// HeaderReport is the header of a versioned snapshot (it is missing for snapshots in the legacy format).
+type HeaderReport struct {
+	FormatVersion      uint16           `json:"formatVersion"`
+	DatabaseVersion    uint8            `json:"databaseVersion"`
+	GenesisTime        int64            `json:"genesisTime"`
+	EpochDuration      int64            `json:"epochDuration"`
+	TargetEpoch        uint64           `json:"targetEpoch"`
+	TargetCommitmentID string           `json:"targetCommitmentID"`
+	Sections           []*SectionReport `json:"sections"`
+}
+
+// SectionReport is an entry of the section table of a versioned snapshot.
+type SectionReport struct {
+	Name   string `json:"name"`
+	Length uint64 `json:"length"`
+	Hash   string `json:"hash"`
+}
+
+// CommitmentReport is a commitment of the commitment chain of a snapshot.
+type CommitmentReport struct {
+	Index            uint64 `json:"index"`
+	ID               string `json:"id"`
+	PrevID           string `json:"prevID"`
+	RootsID          string `json:"rootsID"`
+	CumulativeWeight int64  `json:"cumulativeWeight"`
+}
+
+// UnspentOutputsReport summarizes the unspent outputs of a snapshot.
+type UnspentOutputsReport struct {
+	Count    int                          `json:"count"`
+	Totals   map[string]uint64            `json:"totals"`
+	Balances map[string]map[string]uint64 `json:"balances"`
+}
+
+// AttestationsReport contains the attestations of the latest committed epoch of a snapshot.
+type AttestationsReport struct {
+	Epoch        uint64                       `json:"epoch"`
+	Weight       int64                        `json:"weight"`
+	Attestations map[string]AttestationReport `json:"attestations"`
+}
+
+// AttestationReport is a single attestation of an issuer.
+type AttestationReport struct {
+	IssuingTime  string `json:"issuingTime"`
+	CommitmentID string `json:"commitmentID"`
+}
+
+// newReport imports the snapshot at the given path into an in-memory engine and collects its content.
+func newReport(filePath string) (report *Report, err error) {
+	report = &Report{File: filePath}
+	header, err := readHeader(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	storageDirectory, err := os.MkdirTemp(os.TempDir(), "snapshot-inspector-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary storage directory")
+	}
+	defer os.RemoveAll(storageDirectory)
+
+	s := storage.New(storageDirectory, protocol.DatabaseVersion, database.WithDBProvider(database.NewMemDB))
+	defer s.Shutdown()
+
+	var engineOptions []options.Option[engine.Engine]
+	if header != nil {
+		report.Header = newHeaderReport(header)
+
+		// the engine verifies the snapshot against the epoch parameters of its network, so we adopt the ones of the snapshot
+		engineOptions = append(engineOptions, engine.WithEpochParameters(header.GenesisTime, header.EpochDuration))
+	}
+
+	e := engine.New(s, dpos.NewProvider(), mana1.NewProvider(), engineOptions...)
+	defer e.Shutdown()
+
+	if err = e.Initialize(filePath); err != nil {
+		return nil, errors.Wrapf(err, "failed to import snapshot '%s'", filePath)
+	}
+
+	for _, collect := range []func(*engine.Engine) error{
+		report.collectCommitments,
+		report.collectRootBlocks,
+		report.collectUnspentOutputs,
+		report.collectWeights,
+		report.collectAttestations,
+	} {
+		if err = collect(e); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// readHeader reads the header of the snapshot (it returns nil for snapshots in the legacy format).
+func readHeader(filePath string) (header *snapshot.Header, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open snapshot file")
+	}
+	defer file.Close()
+
+	if header, err = snapshot.ReadHeader(file); err != nil {
+		if errors.Is(err, snapshot.ErrLegacyFormat) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to read header of snapshot '%s'", filePath)
+	}
+
+	return header, nil
+}
+
+// newHeaderReport creates the report of the given snapshot header.
+func newHeaderReport(header *snapshot.Header) (headerReport *HeaderReport) {
+	headerReport = &HeaderReport{
+		FormatVersion:      header.FormatVersion,
+		DatabaseVersion:    uint8(header.DatabaseVersion),
+		GenesisTime:        header.GenesisTime,
+		EpochDuration:      header.EpochDuration,
+		TargetEpoch:        uint64(header.TargetEpoch),
+		TargetCommitmentID: header.TargetCommitmentID.Base58(),
+	}
+	for _, sectionInfo := range header.Sections {
+		headerReport.Sections = append(headerReport.Sections, &SectionReport{
+			Name:   sectionInfo.Name,
+			Length: sectionInfo.Length,
+			Hash:   sectionInfo.Hash.Base58(),
+		})
+	}
+
+	return headerReport
+}
+
+func (r *Report) collectCommitments(e *engine.Engine) (err error) {
+	r.LatestCommitment = newCommitmentReport(e.Storage.Settings.LatestCommitment())
+
+	for index := epoch.Index(0); index <= e.Storage.Settings.LatestCommitment().Index(); index++ {
+		loadedCommitment, loadErr := e.Storage.Commitments.Load(index)
+		if loadErr != nil {
+			return errors.Wrapf(loadErr, "failed to load commitment of epoch %d", index)
+		}
+
+		r.Commitments = append(r.Commitments, newCommitmentReport(loadedCommitment))
+	}
+
+	return nil
+}
+
+func (r *Report) collectRootBlocks(e *engine.Engine) (err error) {
+	r.RootBlocks = make([]string, 0)
+	for index := epoch.Index(0); index <= e.Storage.Settings.LatestCommitment().Index(); index++ {
+		if err = e.Storage.RootBlocks.Stream(index, func(rootBlockID models.BlockID) error {
+			r.RootBlocks = append(r.RootBlocks, rootBlockID.Base58())
+
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "failed to stream root blocks of epoch %d", index)
+		}
+	}
+	sort.Strings(r.RootBlocks)
+
+	return nil
+}
+
+func (r *Report) collectUnspentOutputs(e *engine.Engine) (err error) {
+	r.UnspentOutputs = &UnspentOutputsReport{
+		Totals:   make(map[string]uint64),
+		Balances: make(map[string]map[string]uint64),
+	}
+
+	if err = e.LedgerState.UnspentOutputs.IDs.Stream(func(outputID utxo.OutputID) bool {
+		r.UnspentOutputs.Count++
+
+		if !e.Ledger.Storage.CachedOutput(outputID).Consume(func(output utxo.Output) {
+			devnetOutput, isDevnetOutput := output.(devnetvm.Output)
+			if !isDevnetOutput {
+				err = errors.Errorf("unsupported output type %T of output %s", output, outputID)
+				return
+			}
+
+			address := devnetOutput.Address().Base58()
+			if _, exists := r.UnspentOutputs.Balances[address]; !exists {
+				r.UnspentOutputs.Balances[address] = make(map[string]uint64)
+			}
+
+			devnetOutput.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+				r.UnspentOutputs.Balances[address][color.String()] += balance
+				r.UnspentOutputs.Totals[color.String()] += balance
+
+				return true
+			})
+		}) {
+			err = errors.Errorf("failed to load unspent output %s", outputID)
+		}
+
+		return err == nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to stream unspent outputs")
+	}
+
+	return err
+}
+
+func (r *Report) collectWeights(e *engine.Engine) (err error) {
+	r.ConsensusWeights = make(map[string]int64)
+	if err = e.SybilProtection.Weights().ForEach(func(id identity.ID, weight *sybilprotection.Weight) bool {
+		r.ConsensusWeights[id.EncodeBase58()] = weight.Value
+
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to iterate over consensus weights")
+	}
+	r.TotalWeight = e.SybilProtection.Weights().TotalWeight().Value
+
+	r.AccessMana = make(map[string]int64)
+	for id, mana := range e.ThroughputQuota.BalanceByIDs() {
+		r.AccessMana[id.EncodeBase58()] = mana
+	}
+
+	return nil
+}
+
+func (r *Report) collectAttestations(e *engine.Engine) (err error) {
+	attestationsEpoch := e.Storage.Settings.LatestCommitment().Index()
+	r.Attestations = &AttestationsReport{
+		Epoch:        uint64(attestationsEpoch),
+		Attestations: make(map[string]AttestationReport),
+	}
+
+	attestations, err := e.NotarizationManager.Attestations.Get(attestationsEpoch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load attestations of epoch %d", attestationsEpoch)
+	}
+
+	if err = attestations.Stream(func(issuerID identity.ID, attestation *notarization.Attestation) bool {
+		r.Attestations.Attestations[issuerID.EncodeBase58()] = AttestationReport{
+			IssuingTime:  attestation.IssuingTime.UTC().Format(time.RFC3339),
+			CommitmentID: attestation.CommitmentID.Base58(),
+		}
+
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to stream attestations of epoch %d", attestationsEpoch)
+	}
+
+	if r.Attestations.Weight, err = e.NotarizationManager.Attestations.Weight(attestationsEpoch); err != nil {
+		return errors.Wrapf(err, "failed to load attestation weight of epoch %d", attestationsEpoch)
+	}
+
+	return nil
+}
+
+func newCommitmentReport(c *commitment.Commitment) *CommitmentReport {
+	return &CommitmentReport{
+		Index:            uint64(c.Index()),
+		ID:               c.ID().Base58(),
+		PrevID:           c.PrevID().Base58(),
+		RootsID:          c.RootsID().Base58(),
+		CumulativeWeight: c.CumulativeWeight(),
+	}
+}
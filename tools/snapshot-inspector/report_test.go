@@ -0,0 +1,83 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/snapshot"
+	"github.com/iotaledger/goshimmer/packages/core/snapshotcreator"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/ledger/vm/devnetvm"
+)
+
+func TestNewReport(t *testing.T) {
+	nodesToPledge := map[identity.ID]uint64{
+		identity.GenerateIdentity().ID(): 10,
+		identity.GenerateIdentity().ID(): 20,
+	}
+	snapshotFile := createSnapshot(t, "snapshot.bin", 100, nodesToPledge)
+
+	report, err := newReport(snapshotFile)
+	require.NoError(t, err)
+
+	require.NotNil(t, report.Header)
+	assert.Equal(t, snapshot.FormatVersion, report.Header.FormatVersion)
+	assert.EqualValues(t, protocol.DatabaseVersion, report.Header.DatabaseVersion)
+	assert.EqualValues(t, 0, report.Header.TargetEpoch)
+	assert.NotEmpty(t, report.Header.Sections)
+
+	assert.EqualValues(t, 0, report.LatestCommitment.Index)
+	require.Len(t, report.Commitments, 1)
+	assert.Equal(t, report.LatestCommitment.ID, report.Commitments[0].ID)
+
+	// the genesis output and one output per pledged node
+	assert.Equal(t, 3, report.UnspentOutputs.Count)
+	assert.EqualValues(t, 130, report.UnspentOutputs.Totals[devnetvm.ColorIOTA.String()])
+
+	for nodeID, pledgedAmount := range nodesToPledge {
+		assert.EqualValues(t, pledgedAmount, report.ConsensusWeights[nodeID.EncodeBase58()])
+		assert.Contains(t, report.Attestations.Attestations, nodeID.EncodeBase58())
+	}
+}
+
+func TestNewDiff(t *testing.T) {
+	nodesToPledge := map[identity.ID]uint64{
+		identity.GenerateIdentity().ID(): 10,
+	}
+
+	snapshotFile := createSnapshot(t, "snapshot.bin", 100, nodesToPledge)
+	report, err := newReport(snapshotFile)
+	require.NoError(t, err)
+	sameReport, err := newReport(snapshotFile)
+	require.NoError(t, err)
+	otherReport, err := newReport(createSnapshot(t, "other-snapshot.bin", 200, nodesToPledge))
+	require.NoError(t, err)
+
+	// importing the same snapshot twice yields the same content
+	assert.True(t, newDiff(report, sameReport).Equal())
+
+	diff := newDiff(report, otherReport)
+	require.False(t, diff.Equal())
+	for _, section := range diff.Sections {
+		if section.Name == "unspent outputs" {
+			assert.False(t, section.Equal)
+		}
+	}
+}
+
+// createSnapshot writes a genesis snapshot with the given token amount and pledges to the test directory.
+func createSnapshot(t *testing.T, fileName string, genesisTokenAmount uint64, nodesToPledge map[identity.ID]uint64) (snapshotFile string) {
+	initialAttestations := make([]identity.ID, 0, len(nodesToPledge))
+	for nodeID := range nodesToPledge {
+		initialAttestations = append(initialAttestations, nodeID)
+	}
+
+	snapshotFile = filepath.Join(t.TempDir(), fileName)
+	snapshotcreator.CreateSnapshot(protocol.DatabaseVersion, snapshotFile, genesisTokenAmount, make([]byte, 32), nodesToPledge, initialAttestations)
+
+	return snapshotFile
+}